@@ -0,0 +1,167 @@
+package fivegc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"io"
+	"strings"
+)
+
+// requiredPropertyPrefix is the message prefix used by the openapi models when a required property is absent.
+const requiredPropertyPrefix = "no value given for required property "
+
+// ErrMediaType is the decoding error of a request body whose media type is not supported by the operation.
+var ErrMediaType = errors.New("unsupported media type")
+
+// BindingError is the error passed to CommonInterface.Error when the request body cannot be decoded.
+// It carries the default ProblemDetails built by the SDK, so implementations can enrich it rather than build one from scratch.
+type BindingError struct {
+	Err            error
+	ProblemDetails openapicommon.ProblemDetails
+}
+
+// NewBindingError wraps a decoding error and derives its default ProblemDetails.
+// Without the request body, a missing mandatory attribute is not pointed at, as it may be nested in the body.
+func NewBindingError(err error) *BindingError {
+	return newBindingError(err, nil)
+}
+
+// newBindingError wraps a decoding error of the request body and derives its default ProblemDetails.
+func newBindingError(err error, body []byte) *BindingError {
+	return &BindingError{
+		Err:            err,
+		ProblemDetails: bindingProblemDetails(err, body),
+	}
+}
+
+// Error returns the message of the decoding error.
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the decoding error.
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// BindingProblemDetails returns the ProblemDetails to send when the request body cannot be decoded.
+// The default ProblemDetails is handed to ci.Error through a BindingError; every field set in the returned
+// ProblemDetails overrides the default one, so the result always has a status.
+// The request body is read from the context when it has been kept by gin.Context.ShouldBindBodyWith.
+func BindingProblemDetails(ctx context.Context, ci CommonInterface, err error) openapicommon.ProblemDetails {
+	body, _ := ctx.Value(gin.BodyBytesKey).([]byte)
+	bindingErr := newBindingError(err, body)
+	return MergeProblemDetails(bindingErr.ProblemDetails, ci.Error(ctx, bindingErr))
+}
+
+// MergeProblemDetails returns base with every field set in override replaced.
+func MergeProblemDetails(base, override openapicommon.ProblemDetails) openapicommon.ProblemDetails {
+	if override.Type != nil {
+		base.Type = override.Type
+	}
+	if override.Title != nil {
+		base.Title = override.Title
+	}
+	if override.Status != nil {
+		base.Status = override.Status
+	}
+	if override.Detail != nil {
+		base.Detail = override.Detail
+	}
+	if override.Instance != nil {
+		base.Instance = override.Instance
+	}
+	if override.Cause != nil {
+		base.Cause = override.Cause
+	}
+	if override.InvalidParams != nil {
+		base.InvalidParams = override.InvalidParams
+	}
+	if override.SupportedFeatures != nil {
+		base.SupportedFeatures = override.SupportedFeatures
+	}
+	if override.AccessTokenError != nil {
+		base.AccessTokenError = override.AccessTokenError
+	}
+	if override.AccessTokenRequest != nil {
+		base.AccessTokenRequest = override.AccessTokenRequest
+	}
+	if override.NrfId != nil {
+		base.NrfId = override.NrfId
+	}
+	return base
+}
+
+// bindingProblemDetails builds a 400 ProblemDetails from a decoding error, as described in TS 29.500 clause 5.2.7.2,
+// or a 415 one without cause from an ErrMediaType. Errors providing their own ProblemDetails, such as the
+// *ApplicationError of an incorrect query parameter, are used as is.
+func bindingProblemDetails(err error, body []byte) openapicommon.ProblemDetails {
+	var problemDetailsErr interface {
+		error
+		ProblemDetails() openapicommon.ProblemDetails
+	}
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &problemDetailsErr):
+		return problemDetailsErr.ProblemDetails()
+	case errors.Is(err, ErrMediaType):
+		return openapicommon.ProblemDetails{
+			Title:  ToString(StatusText(StatusUnsupportedMediaType)),
+			Status: ToInt32(int32(StatusUnsupportedMediaType)),
+			Detail: ToString(err.Error()),
+		}
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrInvalidMsgFormat.WithDetail("request body is empty or truncated").ProblemDetails()
+	case errors.As(err, &syntaxErr):
+		return ErrInvalidMsgFormat.WithDetail("%s (offset %d)", syntaxErr.Error(), syntaxErr.Offset).ProblemDetails()
+	case errors.As(err, &typeErr):
+		appErr := ErrMandatoryIeIncorrect.WithDetail("%s", err.Error())
+		if typeErr.Field != "" {
+			appErr = appErr.WithInvalidParams(openapicommon.InvalidParam{
+				Param:  jsonPointer(strings.Split(typeErr.Field, ".")...),
+				Reason: ToString(fmt.Sprintf("expected %s, got JSON %s", typeErr.Type, typeErr.Value)),
+			})
+		}
+		return appErr.ProblemDetails()
+	case strings.Contains(err.Error(), requiredPropertyPrefix):
+		msg := err.Error()
+		property := msg[strings.Index(msg, requiredPropertyPrefix)+len(requiredPropertyPrefix):]
+		appErr := ErrMandatoryIeMissing.WithDetail("%s", msg)
+		// The models do not give the path of the property, which is only pointed at when the top-level object of the
+		// body lacks it. A property the top-level object has is missing from a nested one and keeps the detail alone.
+		if lacksProperty(body, property) {
+			appErr = appErr.WithInvalidParams(openapicommon.InvalidParam{
+				Param:  jsonPointer(property),
+				Reason: ToString("mandatory attribute is missing"),
+			})
+		}
+		return appErr.ProblemDetails()
+	default:
+		return ErrInvalidMsgFormat.WithDetail("%s", err.Error()).ProblemDetails()
+	}
+}
+
+// lacksProperty reports whether the JSON value is an object without the property.
+func lacksProperty(value []byte, property string) bool {
+	var object map[string]json.RawMessage
+	if json.Unmarshal(value, &object) != nil || object == nil {
+		return false
+	}
+	_, ok := object[property]
+	return !ok
+}
+
+// jsonPointer builds a JSON Pointer (RFC 6901) from a list of reference tokens.
+func jsonPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return b.String()
+}
@@ -0,0 +1,97 @@
+package fivegc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"io"
+	"testing"
+)
+
+type commonInterface struct {
+	problemDetails openapicommon.ProblemDetails
+}
+
+func (c commonInterface) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return c.problemDetails
+}
+
+func TestBindingProblemDetails(t *testing.T) {
+	var body struct {
+		LocationQoS struct {
+			HAccuracy float32 `json:"hAccuracy"`
+		} `json:"locationQoS"`
+	}
+	typeErr := json.Unmarshal([]byte(`{"locationQoS":{"hAccuracy":"high"}}`), &body)
+	syntaxErr := json.Unmarshal([]byte(`{"locationQoS":`), &body)
+
+	tests := []struct {
+		name   string
+		err    error
+		body   string
+		status StatusCode
+		cause  string
+		param  string
+	}{
+		{name: "empty body", err: io.EOF, cause: CauseInvalidMsgFormat},
+		{name: "syntax error", err: syntaxErr, cause: CauseInvalidMsgFormat},
+		{name: "type error", err: typeErr, cause: CauseMandatoryIeIncorrect, param: "/locationQoS/hAccuracy"},
+		{name: "missing property", err: errors.New("no value given for required property supi"), body: `{"gpsi":"msisdn-33600000000"}`, cause: CauseMandatoryIeMissing, param: "/supi"},
+		{name: "missing property with nested object", err: errors.New("no value given for required property supi"), body: `{"gpsi":"msisdn-33600000000","locationQoS":{"hAccuracy":10}}`, cause: CauseMandatoryIeMissing, param: "/supi"},
+		{name: "missing nested property", err: errors.New("no value given for required property supi"), body: `{"supi":"imsi-208930000000001","ueContext":{"gpsi":"msisdn-33600000000"}}`, cause: CauseMandatoryIeMissing},
+		{name: "missing property without body", err: errors.New("no value given for required property supi"), cause: CauseMandatoryIeMissing},
+		{name: "query parameter", err: ErrOptionalQueryParamIncorrect.WithInvalidParams(openapicommon.InvalidParam{Param: "plmn-id"}), cause: CauseOptionalQueryParamIncorrect, param: "plmn-id"},
+		{name: "unknown error", err: errors.New("invalid request"), cause: CauseInvalidMsgFormat},
+		{name: "media type", err: fmt.Errorf("%w: text/plain", ErrMediaType), status: StatusUnsupportedMediaType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.body != "" {
+				ctx = context.WithValue(ctx, gin.BodyBytesKey, []byte(tt.body))
+			}
+			if tt.status == 0 {
+				tt.status = StatusBadRequest
+			}
+			problemDetails := BindingProblemDetails(ctx, commonInterface{}, tt.err)
+			if problemDetails.Status == nil || *problemDetails.Status != int32(tt.status) {
+				t.Errorf("status is not %d", tt.status)
+			}
+			if tt.cause != "" && (problemDetails.Cause == nil || *problemDetails.Cause != tt.cause) || tt.cause == "" && problemDetails.Cause != nil {
+				t.Errorf("cause is not %s", tt.cause)
+			}
+			if tt.param == "" && len(problemDetails.InvalidParams) != 0 {
+				t.Errorf("unexpected invalid params %v", problemDetails.InvalidParams)
+			}
+			if tt.param != "" && (len(problemDetails.InvalidParams) != 1 || problemDetails.InvalidParams[0].Param != tt.param) {
+				t.Errorf("invalid params do not point to %s: %v", tt.param, problemDetails.InvalidParams)
+			}
+		})
+	}
+}
+
+func TestBindingProblemDetailsOverride(t *testing.T) {
+	ci := commonInterface{problemDetails: openapicommon.ProblemDetails{
+		Status:   ToInt32(int32(StatusForbidden)),
+		Instance: ToString("/nlmf-loc/v1/determine-location"),
+	}}
+	problemDetails := BindingProblemDetails(context.Background(), ci, io.EOF)
+	if *problemDetails.Status != int32(StatusForbidden) {
+		t.Errorf("status has not been overridden")
+	}
+	if *problemDetails.Instance != "/nlmf-loc/v1/determine-location" {
+		t.Errorf("instance has not been set")
+	}
+	if *problemDetails.Cause != CauseInvalidMsgFormat {
+		t.Errorf("default cause has not been kept")
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	if p := jsonPointer("a/b", "m~n"); p != "/a~1b/m~0n" {
+		t.Errorf("unexpected pointer %s", p)
+	}
+}
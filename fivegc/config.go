@@ -9,6 +9,7 @@ type ClientConfiguration struct {
 	DefaultHeader    map[string]string `json:"defaultHeader,omitempty"`
 	UserAgent        string            `json:"userAgent,omitempty"`
 	Debug            bool              `json:"debug,omitempty"`
+	Validation       bool              `json:"validation,omitempty"` // Validates bodies against the 3GPP OpenAPI schemas, for the nlmf clients only
 	Servers          ServerConfigurations
 	OperationServers map[string]ServerConfigurations
 	HTTPClient       *http.Client
@@ -0,0 +1,193 @@
+package fivegc
+
+import (
+	"errors"
+	"fmt"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+)
+
+// Protocol and application error causes common to all the APIs, defined in TS 29.500 Table 5.2.7.2-1.
+const (
+	CauseInvalidApi                   = "INVALID_API"
+	CauseInvalidMsgFormat             = "INVALID_MSG_FORMAT"
+	CauseInvalidQueryParam            = "INVALID_QUERY_PARAM"
+	CauseMandatoryQueryParamIncorrect = "MANDATORY_QUERY_PARAM_INCORRECT"
+	CauseOptionalQueryParamIncorrect  = "OPTIONAL_QUERY_PARAM_INCORRECT"
+	CauseMandatoryQueryParamMissing   = "MANDATORY_QUERY_PARAM_MISSING"
+	CauseMandatoryIeIncorrect         = "MANDATORY_IE_INCORRECT"
+	CauseOptionalIeIncorrect          = "OPTIONAL_IE_INCORRECT"
+	CauseMandatoryIeMissing           = "MANDATORY_IE_MISSING"
+	CauseUnspecifiedMsgFailure        = "UNSPECIFIED_MSG_FAILURE"
+	CauseInvalidDiscoveryParam        = "INVALID_DISCOVERY_PARAM"
+	CauseModificationNotAllowed       = "MODIFICATION_NOT_ALLOWED"
+	CauseSubscriptionNotFound         = "SUBSCRIPTION_NOT_FOUND"
+	CauseResourceUriStructureNotFound = "RESOURCE_URI_STRUCTURE_NOT_FOUND"
+	CauseIncorrectLength              = "INCORRECT_LENGTH"
+	CauseNfCongestionRisk             = "NF_CONGESTION_RISK"
+	CauseInsufficientResources        = "INSUFFICIENT_RESOURCES"
+	CauseUnspecifiedNfFailure         = "UNSPECIFIED_NF_FAILURE"
+	CauseSystemFailure                = "SYSTEM_FAILURE"
+	CauseNfFailover                   = "NF_FAILOVER"
+	CauseNfServiceFailover            = "NF_SERVICE_FAILOVER"
+	CauseNfCongestion                 = "NF_CONGESTION"
+	CauseTargetNfNotReachable         = "TARGET_NF_NOT_REACHABLE"
+	CauseTimedOutRequest              = "TIMED_OUT_REQUEST"
+)
+
+// Application errors common to all the APIs, defined in TS 29.500 Table 5.2.7.2-1.
+var (
+	ErrInvalidApi                   = NewApplicationError(StatusBadRequest, CauseInvalidApi)
+	ErrInvalidMsgFormat             = NewApplicationError(StatusBadRequest, CauseInvalidMsgFormat)
+	ErrInvalidQueryParam            = NewApplicationError(StatusBadRequest, CauseInvalidQueryParam)
+	ErrMandatoryQueryParamIncorrect = NewApplicationError(StatusBadRequest, CauseMandatoryQueryParamIncorrect)
+	ErrOptionalQueryParamIncorrect  = NewApplicationError(StatusBadRequest, CauseOptionalQueryParamIncorrect)
+	ErrMandatoryQueryParamMissing   = NewApplicationError(StatusBadRequest, CauseMandatoryQueryParamMissing)
+	ErrMandatoryIeIncorrect         = NewApplicationError(StatusBadRequest, CauseMandatoryIeIncorrect)
+	ErrOptionalIeIncorrect          = NewApplicationError(StatusBadRequest, CauseOptionalIeIncorrect)
+	ErrMandatoryIeMissing           = NewApplicationError(StatusBadRequest, CauseMandatoryIeMissing)
+	ErrUnspecifiedMsgFailure        = NewApplicationError(StatusBadRequest, CauseUnspecifiedMsgFailure)
+	ErrInvalidDiscoveryParam        = NewApplicationError(StatusBadRequest, CauseInvalidDiscoveryParam)
+	ErrModificationNotAllowed       = NewApplicationError(StatusForbidden, CauseModificationNotAllowed)
+	ErrSubscriptionNotFound         = NewApplicationError(StatusNotFound, CauseSubscriptionNotFound)
+	ErrResourceUriStructureNotFound = NewApplicationError(StatusNotFound, CauseResourceUriStructureNotFound)
+	ErrIncorrectLength              = NewApplicationError(StatusLengthRequired, CauseIncorrectLength)
+	ErrNfCongestionRisk             = NewApplicationError(StatusTooManyRequests, CauseNfCongestionRisk)
+	ErrInsufficientResources        = NewApplicationError(StatusInternalServerError, CauseInsufficientResources)
+	ErrUnspecifiedNfFailure         = NewApplicationError(StatusInternalServerError, CauseUnspecifiedNfFailure)
+	ErrSystemFailure                = NewApplicationError(StatusInternalServerError, CauseSystemFailure)
+	ErrNfFailover                   = NewApplicationError(StatusInternalServerError, CauseNfFailover)
+	ErrNfServiceFailover            = NewApplicationError(StatusInternalServerError, CauseNfServiceFailover)
+	ErrNfCongestion                 = NewApplicationError(StatusServiceUnavailable, CauseNfCongestion)
+	ErrTargetNfNotReachable         = NewApplicationError(StatusGatewayTimeout, CauseTargetNfNotReachable)
+	ErrTimedOutRequest              = NewApplicationError(StatusGatewayTimeout, CauseTimedOutRequest)
+)
+
+// ApplicationError is an application error identified by its cause, see TS 29.500 clause 5.2.7.
+// It can be returned as a Go error and turned into the ProblemDetails sent to the consumer.
+type ApplicationError struct {
+	Status        StatusCode
+	Cause         string
+	Detail        string
+	InvalidParams []openapicommon.InvalidParam
+}
+
+// NewApplicationError creates an application error with the given status code and cause.
+func NewApplicationError(status StatusCode, cause string) *ApplicationError {
+	return &ApplicationError{
+		Status: status,
+		Cause:  cause,
+	}
+}
+
+// Error returns the cause and the detail of the application error.
+func (e *ApplicationError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%s (%d %s)", e.Cause, e.Status, StatusText(e.Status))
+	}
+	return fmt.Sprintf("%s (%d %s): %s", e.Cause, e.Status, StatusText(e.Status), e.Detail)
+}
+
+// Is reports whether target is an application error with the same status code and cause,
+// so errors.Is(err, fivegc.ErrNfCongestion) holds whatever the detail of err.
+func (e *ApplicationError) Is(target error) bool {
+	t, ok := target.(*ApplicationError)
+	return ok && t.Status == e.Status && t.Cause == e.Cause
+}
+
+// WithDetail returns a copy of the application error with the given detail.
+func (e *ApplicationError) WithDetail(format string, a ...interface{}) *ApplicationError {
+	err := *e
+	err.Detail = fmt.Sprintf(format, a...)
+	return &err
+}
+
+// WithInvalidParams returns a copy of the application error with the given invalid parameters.
+func (e *ApplicationError) WithInvalidParams(invalidParams ...openapicommon.InvalidParam) *ApplicationError {
+	err := *e
+	err.InvalidParams = append(append([]openapicommon.InvalidParam{}, e.InvalidParams...), invalidParams...)
+	return &err
+}
+
+// ProblemDetails builds the ProblemDetails of the application error.
+func (e *ApplicationError) ProblemDetails() openapicommon.ProblemDetails {
+	problemDetails := openapicommon.ProblemDetails{
+		Title:         ToString(StatusText(e.Status)),
+		Status:        ToInt32(int32(e.Status)),
+		Cause:         ToString(e.Cause),
+		InvalidParams: e.InvalidParams,
+	}
+	if e.Detail != "" {
+		problemDetails.Detail = ToString(e.Detail)
+	}
+	return problemDetails
+}
+
+// ProblemDetailsError is an error carrying a ProblemDetails built by the handler.
+type ProblemDetailsError openapicommon.ProblemDetails
+
+// Error returns the cause and the detail of the ProblemDetails.
+func (e ProblemDetailsError) Error() string {
+	problemDetails := openapicommon.ProblemDetails(e)
+	msg := "problem details"
+	if problemDetails.Cause != nil {
+		msg = *problemDetails.Cause
+	}
+	if problemDetails.Status != nil {
+		msg = fmt.Sprintf("%s (%d %s)", msg, *problemDetails.Status, StatusText(StatusCode(*problemDetails.Status)))
+	}
+	if problemDetails.Detail != nil {
+		msg = fmt.Sprintf("%s: %s", msg, *problemDetails.Detail)
+	}
+	return msg
+}
+
+// ProblemDetails returns the ProblemDetails carried by the error.
+func (e ProblemDetailsError) ProblemDetails() openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails(e)
+}
+
+// ErrorResponse returns the response to send for an error returned by a handler: the redirect response of a *RedirectError,
+// or the ProblemDetails built by ProblemDetailsFromError for any other error.
+func ErrorResponse(err error) (openapicommon.ProblemDetails, RedirectResponse, StatusCode) {
+	var redirectErr *RedirectError
+	if errors.As(err, &redirectErr) {
+		return openapicommon.ProblemDetails{}, redirectErr.Response, redirectErr.StatusCode()
+	}
+	problemDetails, status := ProblemDetailsFromError(err)
+	return problemDetails, RedirectResponse{}, status
+}
+
+// ErrorFromResponse is the reverse of ErrorResponse, it returns the error matching a response which is not successful.
+func ErrorFromResponse(problemDetails openapicommon.ProblemDetails, redirectResponse RedirectResponse, status StatusCode) error {
+	switch status {
+	case StatusTemporaryRedirect:
+		return NewTemporaryRedirectError(redirectResponse)
+	case StatusPermanentRedirect:
+		return NewPermanentRedirectError(redirectResponse)
+	}
+	if problemDetails.Status == nil {
+		problemDetails.Status = ToInt32(int32(status))
+	}
+	return ProblemDetailsError(problemDetails)
+}
+
+// ProblemDetailsFromError returns the ProblemDetails and the status code to send for err.
+// Errors providing their own ProblemDetails, such as *ApplicationError, are used as is;
+// any other error is reported as a SYSTEM_FAILURE. A nil error is reported as a SYSTEM_FAILURE without detail.
+func ProblemDetailsFromError(err error) (openapicommon.ProblemDetails, StatusCode) {
+	var problemDetailsErr interface {
+		error
+		ProblemDetails() openapicommon.ProblemDetails
+	}
+	switch {
+	case err == nil:
+		problemDetailsErr = ErrSystemFailure
+	case !errors.As(err, &problemDetailsErr):
+		problemDetailsErr = ErrSystemFailure.WithDetail("%s", err.Error())
+	}
+	problemDetails := problemDetailsErr.ProblemDetails()
+	if problemDetails.Status == nil {
+		problemDetails.Status = ToInt32(int32(StatusInternalServerError))
+	}
+	return problemDetails, StatusCode(*problemDetails.Status)
+}
@@ -0,0 +1,51 @@
+package fivegc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestApplicationError(t *testing.T) {
+	err := fmt.Errorf("determine location: %w", ErrNfCongestion.WithDetail("%d pending requests", 42))
+	if !errors.Is(err, ErrNfCongestion) {
+		t.Errorf("error should match ErrNfCongestion")
+	}
+	if errors.Is(err, ErrNfCongestionRisk) {
+		t.Errorf("error should not match ErrNfCongestionRisk")
+	}
+	if ErrNfCongestion.Detail != "" {
+		t.Errorf("catalog error has been modified")
+	}
+
+	problemDetails, status := ProblemDetailsFromError(err)
+	if status != StatusServiceUnavailable || *problemDetails.Status != int32(StatusServiceUnavailable) {
+		t.Errorf("unexpected status %d", status)
+	}
+	if *problemDetails.Cause != CauseNfCongestion {
+		t.Errorf("unexpected cause %s", *problemDetails.Cause)
+	}
+	if *problemDetails.Detail != "42 pending requests" {
+		t.Errorf("unexpected detail %s", *problemDetails.Detail)
+	}
+}
+
+func TestProblemDetailsFromError(t *testing.T) {
+	problemDetails, status := ProblemDetailsFromError(errors.New("database unreachable"))
+	if status != StatusInternalServerError {
+		t.Errorf("unexpected status %d", status)
+	}
+	if *problemDetails.Cause != CauseSystemFailure {
+		t.Errorf("unexpected cause %s", *problemDetails.Cause)
+	}
+}
+
+func TestProblemDetailsFromNilError(t *testing.T) {
+	problemDetails, status := ProblemDetailsFromError(nil)
+	if status != StatusInternalServerError || *problemDetails.Status != int32(StatusInternalServerError) {
+		t.Errorf("unexpected status %d", status)
+	}
+	if *problemDetails.Cause != CauseSystemFailure || problemDetails.Detail != nil {
+		t.Errorf("unexpected problem details %+v", problemDetails)
+	}
+}
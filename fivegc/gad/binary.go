@@ -0,0 +1,226 @@
+package gad
+
+import (
+	"errors"
+	"fmt"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"math"
+)
+
+// ErrInvalidEncoding is returned when a binary GAD shape cannot be decoded.
+var ErrInvalidEncoding = errors.New("gad: invalid binary encoding")
+
+// Type of shape codes of the binary encoding, defined in TS 23.032 clause 7.2.
+const (
+	codePoint                    = 0x0
+	codePointUncertaintyCircle   = 0x1
+	codePointUncertaintyEllipse  = 0x3
+	codePolygon                  = 0x5
+	codePointAltitude            = 0x8
+	codePointAltitudeUncertainty = 0x9
+	codeEllipsoidArc             = 0xa
+)
+
+// Encode returns the binary encoding of a shape defined in TS 23.032 clause 7, used in the positioning protocols
+// and by legacy nodes. The shape is validated first. The coordinates, the uncertainties and the angles are coded
+// with the resolution of TS 23.032 clause 6, the uncertainties are rounded up. Local shapes have no binary encoding.
+func Encode(s Shape) ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	switch s := s.(type) {
+	case Point:
+		return appendPoint([]byte{codePoint << 4}, s.Point), nil
+	case PointUncertaintyCircle:
+		b := appendPoint([]byte{codePointUncertaintyCircle << 4}, s.Point)
+		return append(b, UncertaintyCode(s.Uncertainty)), nil
+	case PointUncertaintyEllipse:
+		b := appendPoint([]byte{codePointUncertaintyEllipse << 4}, s.Point)
+		b = appendEllipse(b, s.UncertaintyEllipse)
+		return append(b, byte(s.Confidence)), nil
+	case Polygon:
+		b := []byte{codePolygon<<4 | byte(len(s.PointList))}
+		for _, point := range s.PointList {
+			b = appendPoint(b, point)
+		}
+		return b, nil
+	case PointAltitude:
+		b := appendPoint([]byte{codePointAltitude << 4}, s.Point)
+		return appendAltitude(b, s.Altitude), nil
+	case PointAltitudeUncertainty:
+		b := appendPoint([]byte{codePointAltitudeUncertainty << 4}, s.Point)
+		b = appendAltitude(b, s.Altitude)
+		b = appendEllipse(b, s.UncertaintyEllipse)
+		return append(b, AltitudeUncertaintyCode(s.UncertaintyAltitude), byte(s.Confidence)), nil
+	case EllipsoidArc:
+		b := appendPoint([]byte{codeEllipsoidArc << 4}, s.Point)
+		// The inner radius is coded in steps of 5 meters, the angles in steps of 2 degrees.
+		innerRadius := s.InnerRadius / 5
+		b = append(b, byte(innerRadius>>8), byte(innerRadius), UncertaintyCode(s.UncertaintyRadius))
+		// The included angle N codes the angles in ]2N, 2N+2].
+		includedAngle := (s.IncludedAngle+1)/2 - 1
+		if includedAngle < 0 {
+			includedAngle = 0
+		}
+		return append(b, byte(s.OffsetAngle/2%180), byte(includedAngle), byte(s.Confidence)), nil
+	default:
+		return nil, fmt.Errorf("gad: %s has no binary encoding", s.Type())
+	}
+}
+
+// Decode decodes a shape from its binary encoding defined in TS 23.032 clause 7.
+// The coordinates are decoded at the center of their coded interval, so that they are encoded back to the same
+// value. The confidence codes above 100, which mean no information, are decoded as 0.
+func Decode(b []byte) (Shape, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: empty", ErrInvalidEncoding)
+	}
+	code := b[0] >> 4
+	length, ok := encodedLengths[code]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported type of shape %04b", ErrInvalidEncoding, code)
+	}
+	if code == codePolygon {
+		n := int(b[0] & 0x0f)
+		if n < MinPolygonPoints || n > MaxPolygonPoints {
+			return nil, fmt.Errorf("%w: polygon of %d points", ErrInvalidEncoding, n)
+		}
+		length = 1 + 6*n
+	}
+	if len(b) != length {
+		return nil, fmt.Errorf("%w: %d octets for a shape of %d octets", ErrInvalidEncoding, len(b), length)
+	}
+	switch code {
+	case codePoint:
+		return Point{Point: decodePoint(b[1:])}, nil
+	case codePointUncertaintyCircle:
+		return PointUncertaintyCircle{Point: decodePoint(b[1:]), Uncertainty: UncertaintyFromCode(b[7])}, nil
+	case codePointUncertaintyEllipse:
+		return PointUncertaintyEllipse{
+			Point:              decodePoint(b[1:]),
+			UncertaintyEllipse: decodeEllipse(b[7:]),
+			Confidence:         decodeConfidence(b[10]),
+		}, nil
+	case codePolygon:
+		points := make([]Coordinates, 0, b[0]&0x0f)
+		for i := 1; i < len(b); i += 6 {
+			points = append(points, decodePoint(b[i:]))
+		}
+		return Polygon{PointList: points}, nil
+	case codePointAltitude:
+		return PointAltitude{Point: decodePoint(b[1:]), Altitude: decodeAltitude(b[7:])}, nil
+	case codePointAltitudeUncertainty:
+		return PointAltitudeUncertainty{
+			Point:               decodePoint(b[1:]),
+			Altitude:            decodeAltitude(b[7:]),
+			UncertaintyEllipse:  decodeEllipse(b[9:]),
+			UncertaintyAltitude: AltitudeUncertaintyFromCode(b[12]),
+			Confidence:          decodeConfidence(b[13]),
+		}, nil
+	default:
+		if b[10] >= 180 || b[11] >= 180 {
+			return nil, fmt.Errorf("%w: ellipsoid arc angle above 360 degrees", ErrInvalidEncoding)
+		}
+		return EllipsoidArc{
+			Point:             decodePoint(b[1:]),
+			InnerRadius:       5 * (int32(b[7])<<8 | int32(b[8])),
+			UncertaintyRadius: UncertaintyFromCode(b[9]),
+			OffsetAngle:       2 * int32(b[10]),
+			IncludedAngle:     2 * (int32(b[11]) + 1),
+			Confidence:        decodeConfidence(b[12]),
+		}, nil
+	}
+}
+
+// EncodeGeographicArea returns the binary encoding of the GeographicArea of the openapi Nlmf_Location models.
+func EncodeGeographicArea(area nlmfocation.GeographicArea) ([]byte, error) {
+	shape, err := FromGeographicArea(area)
+	if err != nil {
+		return nil, err
+	}
+	return Encode(shape)
+}
+
+// DecodeGeographicArea decodes the GeographicArea of the openapi Nlmf_Location models from its binary encoding.
+func DecodeGeographicArea(b []byte) (nlmfocation.GeographicArea, error) {
+	shape, err := Decode(b)
+	if err != nil {
+		return nlmfocation.GeographicArea{}, err
+	}
+	return GeographicArea(shape)
+}
+
+// encodedLengths are the lengths in octets of the shapes, the length of a polygon depends on its number of points.
+var encodedLengths = map[byte]int{
+	codePoint:                    7,
+	codePointUncertaintyCircle:   8,
+	codePointUncertaintyEllipse:  11,
+	codePolygon:                  0,
+	codePointAltitude:            9,
+	codePointAltitudeUncertainty: 14,
+	codeEllipsoidArc:             13,
+}
+
+// appendPoint appends the latitude and the longitude of a point (TS 23.032 clause 6.1).
+func appendPoint(b []byte, c Coordinates) []byte {
+	// N <= 2^23 X / 90 < N+1, with X the absolute latitude, and a sign bit set for the south.
+	latitude := uint32(math.Min(math.Floor(math.Abs(c.Lat)*(1<<23)/90), 1<<23-1))
+	if c.Lat < 0 {
+		latitude |= 1 << 23
+	}
+	// N <= 2^24 X / 360 < N+1, in two's complement; 180 degrees east is coded as 180 degrees west.
+	longitude := int32(math.Floor(c.Lon * (1 << 24) / 360))
+	if longitude >= 1<<23 {
+		longitude -= 1 << 24
+	}
+	return append(b, byte(latitude>>16), byte(latitude>>8), byte(latitude),
+		byte(longitude>>16), byte(longitude>>8), byte(longitude))
+}
+
+func decodePoint(b []byte) Coordinates {
+	latitude := uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	lat := (float64(latitude&(1<<23-1)) + 0.5) * 90 / (1 << 23)
+	if latitude&(1<<23) != 0 {
+		lat = -lat
+	}
+	// The longitude is sign extended from 24 bits.
+	longitude := int32(uint32(b[3])<<24|uint32(b[4])<<16|uint32(b[5])<<8) >> 8
+	return Coordinates{Lat: lat, Lon: (float64(longitude) + 0.5) * 360 / (1 << 24)}
+}
+
+// appendEllipse appends the semi axes and the orientation of an ellipse, in steps of 2 degrees (TS 23.032 clause 6.3).
+func appendEllipse(b []byte, e UncertaintyEllipse) []byte {
+	return append(b, UncertaintyCode(e.SemiMajor), UncertaintyCode(e.SemiMinor), byte(e.OrientationMajor/2%90))
+}
+
+func decodeEllipse(b []byte) UncertaintyEllipse {
+	return UncertaintyEllipse{
+		SemiMajor:        UncertaintyFromCode(b[0]),
+		SemiMinor:        UncertaintyFromCode(b[1]),
+		OrientationMajor: 2 * int32(b[2]%90),
+	}
+}
+
+// appendAltitude appends an altitude in meters with its direction bit set for depths (TS 23.032 clause 6.3).
+func appendAltitude(b []byte, altitude float64) []byte {
+	n := uint16(math.Min(math.Floor(math.Abs(altitude)), MaxAltitude))
+	if altitude < 0 {
+		n |= 1 << 15
+	}
+	return append(b, byte(n>>8), byte(n))
+}
+
+func decodeAltitude(b []byte) float64 {
+	n := uint16(b[0])<<8 | uint16(b[1])
+	if n&(1<<15) != 0 {
+		return -float64(n &^ (1 << 15))
+	}
+	return float64(n)
+}
+
+func decodeConfidence(b byte) int32 {
+	if confidence := int32(b & 0x7f); confidence <= MaxConfidence {
+		return confidence
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package gad
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBinary(t *testing.T) {
+	paris := Coordinates{Lon: 2.3522, Lat: 48.8566}
+	sydney := Coordinates{Lon: 151.2093, Lat: -33.8688}
+	ellipse := UncertaintyEllipse{SemiMajor: 1000, SemiMinor: 100, OrientationMajor: 45}
+	// The expected encodings follow the coding of TS 23.032 clause 6 and the layouts of clause 7.
+	tests := []struct {
+		shape   Shape
+		encoded string
+	}{
+		{shape: Point{Point: Coordinates{}}, encoded: "00" + "000000" + "000000"},
+		{shape: Point{Point: Coordinates{Lon: -180, Lat: -90}}, encoded: "00" + "ffffff" + "800000"},
+		{shape: Point{Point: Coordinates{Lon: 180, Lat: 90}}, encoded: "00" + "7fffff" + "800000"},
+		{shape: Point{Point: paris}, encoded: "00" + "457c25" + "01ac34"},
+		{shape: PointUncertaintyCircle{Point: sydney, Uncertainty: 50}, encoded: "10" + "b02b40" + "6b86d0" + "13"},
+		{shape: PointUncertaintyEllipse{Point: paris, UncertaintyEllipse: ellipse, Confidence: 68}, encoded: "30" + "457c25" + "01ac34" + "311a16" + "44"},
+		{shape: Polygon{PointList: []Coordinates{paris, sydney, {}}}, encoded: "53" + "457c2501ac34" + "b02b406b86d0" + "000000000000"},
+		{shape: PointAltitude{Point: paris, Altitude: -25}, encoded: "80" + "457c25" + "01ac34" + "8019"},
+		{
+			shape:   PointAltitudeUncertainty{Point: paris, Altitude: 120, UncertaintyEllipse: ellipse, UncertaintyAltitude: 10, Confidence: 68},
+			encoded: "90" + "457c25" + "01ac34" + "0078" + "311a16" + "09" + "44",
+		},
+		{
+			shape:   EllipsoidArc{Point: paris, InnerRadius: 1000, UncertaintyRadius: 100, OffsetAngle: 30, IncludedAngle: 90, Confidence: 68},
+			encoded: "a0" + "457c25" + "01ac34" + "00c8" + "1a" + "0f" + "2c" + "44",
+		},
+	}
+	for _, test := range tests {
+		b, err := Encode(test.shape)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if hex.EncodeToString(b) != test.encoded {
+			t.Errorf("%+v is encoded as %x, expected %s", test.shape, b, test.encoded)
+		}
+		decoded, err := Decode(b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decoded.Type() != test.shape.Type() {
+			t.Errorf("%s is decoded as %s", test.shape.Type(), decoded.Type())
+		}
+		if c, _ := Center(test.shape); test.shape.Type() != ShapePolygon {
+			if d, _ := Center(decoded); Distance(c, d) > 3 {
+				t.Errorf("%+v is decoded %g m away", test.shape, Distance(c, d))
+			}
+		}
+		reencoded, err := Encode(decoded)
+		if err != nil || hex.EncodeToString(reencoded) != test.encoded {
+			t.Errorf("%+v is encoded back as %x: %v", decoded, reencoded, err)
+		}
+	}
+
+	arc, _ := Decode(mustDecodeHex("a0" + "457c25" + "01ac34" + "00c8" + "1a" + "0f" + "2c" + "44"))
+	if arc, ok := arc.(EllipsoidArc); !ok || arc.InnerRadius != 1000 || arc.OffsetAngle != 30 || arc.IncludedAngle != 90 || math.Abs(arc.UncertaintyRadius-UncertaintyFromCode(26)) > 1e-9 {
+		t.Errorf("unexpected arc %+v", arc)
+	}
+
+	for _, encoded := range []string{
+		"",
+		"00457c25",                   // truncated point
+		"20457c2501ac34",             // reserved type of shape
+		"52457c2501ac34457c2501ac34", // polygon of 2 points
+		"a0457c2501ac3400c81ab42c44", // offset angle above 360 degrees
+	} {
+		if _, err := Decode(mustDecodeHex(encoded)); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s should be invalid, got %v", encoded, err)
+		}
+	}
+	if _, err := Encode(Local2dPointUncertaintyEllipse{}); err == nil {
+		t.Errorf("local shapes have no binary encoding")
+	}
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
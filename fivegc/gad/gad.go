@@ -0,0 +1,144 @@
+// Package gad implements the Universal Geographical Area Description (GAD) shapes of TS 23.032, as used in the
+// location estimates of the 5GC location services (TS 29.572 clause 6.1.6).
+package gad
+
+import (
+	"fmt"
+)
+
+// ShapeType is the type of a GAD shape.
+type ShapeType string
+
+// GAD shapes defined in TS 29.572 clause 6.1.6.3.
+const (
+	ShapePoint                            ShapeType = "POINT"
+	ShapePointUncertaintyCircle           ShapeType = "POINT_UNCERTAINTY_CIRCLE"
+	ShapePointUncertaintyEllipse          ShapeType = "POINT_UNCERTAINTY_ELLIPSE"
+	ShapePolygon                          ShapeType = "POLYGON"
+	ShapePointAltitude                    ShapeType = "POINT_ALTITUDE"
+	ShapePointAltitudeUncertainty         ShapeType = "POINT_ALTITUDE_UNCERTAINTY"
+	ShapeEllipsoidArc                     ShapeType = "ELLIPSOID_ARC"
+	ShapeLocal2dPointUncertaintyEllipse   ShapeType = "LOCAL_2D_POINT_UNCERTAINTY_ELLIPSE"
+	ShapeLocal3dPointUncertaintyEllipsoid ShapeType = "LOCAL_3D_POINT_UNCERTAINTY_ELLIPSOID"
+)
+
+// Limits of the GAD shapes attributes, defined in TS 29.572 clause 6.1.6.
+const (
+	MaxAltitude      = 32767
+	MaxInnerRadius   = 327675
+	MaxOrientation   = 180
+	MaxAngle         = 360
+	MaxConfidence    = 100
+	MinPolygonPoints = 3
+	MaxPolygonPoints = 15
+	MaxLatitude      = 90
+	MaxLongitude     = 180
+)
+
+// Shape is a GAD shape.
+type Shape interface {
+	// Type returns the type of the shape.
+	Type() ShapeType
+	// Validate checks the attributes of the shape against the ranges of TS 29.572, it returns a *ValidationError.
+	Validate() error
+	// Contains reports whether a point lies in the shape. The altitude is not taken into account.
+	Contains(Coordinates) bool
+}
+
+// ValidationError is returned when an attribute of a shape is out of range.
+type ValidationError struct {
+	// Shape is the type of the invalid shape.
+	Shape ShapeType
+	// Pointer is the JSON pointer of the invalid attribute, relative to the shape.
+	Pointer string
+	// Reason explains why the attribute is invalid.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("gad: invalid %s: %s %s", e.Shape, e.Pointer, e.Reason)
+}
+
+// Coordinates are the coordinates of a point on the WGS 84 ellipsoid, in degrees.
+type Coordinates struct {
+	Lon float64 `json:"lon"`
+	Lat float64 `json:"lat"`
+}
+
+func (c Coordinates) validate(shape ShapeType, pointer string) error {
+	if c.Lat < -MaxLatitude || c.Lat > MaxLatitude {
+		return &ValidationError{Shape: shape, Pointer: pointer + "/lat", Reason: "out of [-90, 90]"}
+	}
+	if c.Lon < -MaxLongitude || c.Lon > MaxLongitude {
+		return &ValidationError{Shape: shape, Pointer: pointer + "/lon", Reason: "out of [-180, 180]"}
+	}
+	return nil
+}
+
+// UncertaintyEllipse is an ellipse of uncertainty. The semi axes are in meters, the orientation of the major axis is
+// in degrees clockwise from north.
+type UncertaintyEllipse struct {
+	SemiMajor        float64 `json:"semiMajor"`
+	SemiMinor        float64 `json:"semiMinor"`
+	OrientationMajor int32   `json:"orientationMajor"`
+}
+
+func (e UncertaintyEllipse) validate(shape ShapeType, pointer string) error {
+	if err := validateUncertainty(shape, pointer+"/semiMajor", e.SemiMajor); err != nil {
+		return err
+	}
+	if err := validateUncertainty(shape, pointer+"/semiMinor", e.SemiMinor); err != nil {
+		return err
+	}
+	return validateRange(shape, pointer+"/orientationMajor", float64(e.OrientationMajor), 0, MaxOrientation)
+}
+
+// UncertaintyEllipsoid is an ellipsoid of uncertainty. The semi axes are in meters, the orientation of the major axis
+// is in degrees clockwise from north.
+type UncertaintyEllipsoid struct {
+	SemiMajor        float64 `json:"semiMajor"`
+	SemiMinor        float64 `json:"semiMinor"`
+	Vertical         float64 `json:"vertical"`
+	OrientationMajor int32   `json:"orientationMajor"`
+}
+
+func (e UncertaintyEllipsoid) validate(shape ShapeType, pointer string) error {
+	if err := (UncertaintyEllipse{SemiMajor: e.SemiMajor, SemiMinor: e.SemiMinor, OrientationMajor: e.OrientationMajor}).validate(shape, pointer); err != nil {
+		return err
+	}
+	return validateUncertainty(shape, pointer+"/vertical", e.Vertical)
+}
+
+// RelativeCartesianLocation is a location relative to a local origin, in meters along the east (X), north (Y) and up
+// (Z) axes.
+type RelativeCartesianLocation struct {
+	X float64  `json:"x"`
+	Y float64  `json:"y"`
+	Z *float64 `json:"z,omitempty"`
+}
+
+// LocalOrigin is the origin of a local coordinate system.
+type LocalOrigin struct {
+	CoordinateID string       `json:"coordinateId,omitempty"`
+	Point        *Coordinates `json:"point,omitempty"`
+}
+
+func validateRange(shape ShapeType, pointer string, value, min, max float64) error {
+	if value < min || value > max {
+		return &ValidationError{Shape: shape, Pointer: pointer, Reason: fmt.Sprintf("out of [%g, %g]", min, max)}
+	}
+	return nil
+}
+
+// validateUncertainty checks that an uncertainty can be coded by TS 23.032.
+func validateUncertainty(shape ShapeType, pointer string, uncertainty float64) error {
+	return validateRange(shape, pointer, uncertainty, 0, MaxUncertainty)
+}
+
+func validateAltitudeUncertainty(shape ShapeType, pointer string, uncertainty float64) error {
+	return validateRange(shape, pointer, uncertainty, 0, MaxAltitudeUncertainty)
+}
+
+func validateConfidence(shape ShapeType, pointer string, confidence int32) error {
+	return validateRange(shape, pointer, float64(confidence), 0, MaxConfidence)
+}
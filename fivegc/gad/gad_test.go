@@ -0,0 +1,207 @@
+package gad
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestUncertaintyCode(t *testing.T) {
+	tests := []struct {
+		k        uint8
+		r        float64
+		altitude float64
+	}{
+		{k: 0, r: 0, altitude: 0},
+		{k: 1, r: 1, altitude: 1.125},
+		{k: 20, r: 57.275, altitude: 28.73},
+		{k: 127, r: 1806627, altitude: 990.5},
+	}
+	for _, test := range tests {
+		if r := UncertaintyFromCode(test.k); math.Abs(r-test.r)/math.Max(test.r, 1) > 1e-3 {
+			t.Errorf("uncertainty of K=%d is %g, expected %g", test.k, r, test.r)
+		}
+		if r := AltitudeUncertaintyFromCode(test.k); math.Abs(r-test.altitude)/math.Max(test.altitude, 1) > 1e-3 {
+			t.Errorf("altitude uncertainty of K=%d is %g, expected %g", test.k, r, test.altitude)
+		}
+	}
+	for k := uint8(0); k <= MaxUncertaintyCode; k++ {
+		if code := UncertaintyCode(UncertaintyFromCode(k)); code != k {
+			t.Errorf("uncertainty of K=%d is coded as %d", k, code)
+		}
+		if code := AltitudeUncertaintyCode(AltitudeUncertaintyFromCode(k)); code != k {
+			t.Errorf("altitude uncertainty of K=%d is coded as %d", k, code)
+		}
+	}
+	if code := UncertaintyCode(50); UncertaintyFromCode(code) < 50 || UncertaintyFromCode(code-1) >= 50 {
+		t.Errorf("50 m is coded as K=%d", code)
+	}
+	if code := UncertaintyCode(1e9); code != MaxUncertaintyCode {
+		t.Errorf("uncertainty above the maximum is coded as K=%d", code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	paris := Coordinates{Lon: 2.3522, Lat: 48.8566}
+	tests := []struct {
+		shape   Shape
+		pointer string
+	}{
+		{shape: Point{Point: paris}},
+		{shape: Point{Point: Coordinates{Lon: 2, Lat: 91}}, pointer: "/point/lat"},
+		{shape: PointUncertaintyCircle{Point: paris, Uncertainty: -1}, pointer: "/uncertainty"},
+		{shape: PointUncertaintyCircle{Point: paris, Uncertainty: 2e6}, pointer: "/uncertainty"},
+		{shape: PointUncertaintyEllipse{Point: paris, UncertaintyEllipse: UncertaintyEllipse{SemiMajor: 20, SemiMinor: 10, OrientationMajor: 181}}, pointer: "/uncertaintyEllipse/orientationMajor"},
+		{shape: Polygon{PointList: []Coordinates{paris, paris}}, pointer: "/pointList"},
+		{shape: Polygon{PointList: []Coordinates{paris, paris, {Lon: 181}}}, pointer: "/pointList/2/lon"},
+		{shape: PointAltitude{Point: paris, Altitude: 40000}, pointer: "/altitude"},
+		{shape: PointAltitudeUncertainty{Point: paris, UncertaintyAltitude: 1000, Confidence: 68}, pointer: "/uncertaintyAltitude"},
+		{shape: EllipsoidArc{Point: paris, InnerRadius: 100, UncertaintyRadius: 50, OffsetAngle: 10, IncludedAngle: 361}, pointer: "/includedAngle"},
+		{shape: Local2dPointUncertaintyEllipse{Confidence: 101}, pointer: "/confidence"},
+	}
+	for _, test := range tests {
+		err := test.shape.Validate()
+		var validationErr *ValidationError
+		switch {
+		case test.pointer == "" && err != nil:
+			t.Errorf("%s should be valid: %v", test.shape.Type(), err)
+		case test.pointer != "" && (!errors.As(err, &validationErr) || validationErr.Pointer != test.pointer):
+			t.Errorf("%s should be invalid at %s: %v", test.shape.Type(), test.pointer, err)
+		}
+	}
+}
+
+func TestGeometry(t *testing.T) {
+	paris := Coordinates{Lon: 2.3522, Lat: 48.8566}
+	london := Coordinates{Lon: -0.1276, Lat: 51.5072}
+	if d := Distance(paris, london); math.Abs(d-343.5e3) > 1e3 {
+		t.Errorf("unexpected distance %g", d)
+	}
+	north := Coordinates{Lon: paris.Lon, Lat: paris.Lat + 0.001} // 111 m north
+	east := Coordinates{Lon: paris.Lon + 0.001, Lat: paris.Lat}  // 73 m east
+
+	tests := []struct {
+		shape  Shape
+		inside []Coordinates
+		out    []Coordinates
+	}{
+		{shape: Point{Point: paris}, inside: []Coordinates{paris}, out: []Coordinates{north}},
+		{shape: PointUncertaintyCircle{Point: paris, Uncertainty: 100}, inside: []Coordinates{paris, east}, out: []Coordinates{north}},
+		{
+			shape:  PointUncertaintyEllipse{Point: paris, UncertaintyEllipse: UncertaintyEllipse{SemiMajor: 150, SemiMinor: 50}},
+			inside: []Coordinates{north},
+			out:    []Coordinates{east},
+		},
+		{
+			shape:  PointUncertaintyEllipse{Point: paris, UncertaintyEllipse: UncertaintyEllipse{SemiMajor: 150, SemiMinor: 50, OrientationMajor: 90}},
+			inside: []Coordinates{east},
+			out:    []Coordinates{north},
+		},
+		{
+			shape:  Polygon{PointList: []Coordinates{{Lon: 2, Lat: 48}, {Lon: 3, Lat: 48}, {Lon: 3, Lat: 49}, {Lon: 2, Lat: 49}}},
+			inside: []Coordinates{paris},
+			out:    []Coordinates{london},
+		},
+		{
+			shape:  Polygon{PointList: []Coordinates{{Lon: 179, Lat: -17}, {Lon: -179, Lat: -17}, {Lon: -179, Lat: -16}, {Lon: 179, Lat: -16}}},
+			inside: []Coordinates{{Lon: 179.5, Lat: -16.5}, {Lon: -179.5, Lat: -16.5}, {Lon: 180, Lat: -16.5}},
+			out:    []Coordinates{{Lon: 0, Lat: -16.5}, {Lon: -178, Lat: -16.5}, {Lon: 178, Lat: -16.5}},
+		},
+		{
+			shape:  EllipsoidArc{Point: paris, InnerRadius: 50, UncertaintyRadius: 100, OffsetAngle: 45, IncludedAngle: 90},
+			inside: []Coordinates{east},
+			out:    []Coordinates{paris, north},
+		},
+		{
+			shape:  Local2dPointUncertaintyEllipse{LocalOrigin: LocalOrigin{Point: &paris}, Point: RelativeCartesianLocation{Y: 111}, UncertaintyEllipse: UncertaintyEllipse{SemiMajor: 10, SemiMinor: 10}},
+			inside: []Coordinates{north},
+			out:    []Coordinates{paris},
+		},
+	}
+	for _, test := range tests {
+		for _, c := range test.inside {
+			if !test.shape.Contains(c) {
+				t.Errorf("%+v should contain %v", test.shape, c)
+			}
+		}
+		for _, c := range test.out {
+			if test.shape.Contains(c) {
+				t.Errorf("%+v should not contain %v", test.shape, c)
+			}
+		}
+	}
+}
+
+func TestCenter(t *testing.T) {
+	tests := []struct {
+		name     string
+		polygon  Polygon
+		expected Coordinates
+	}{
+		{
+			name:     "square",
+			polygon:  Polygon{PointList: []Coordinates{{Lon: 2, Lat: 48}, {Lon: 3, Lat: 48}, {Lon: 3, Lat: 49}, {Lon: 2, Lat: 49}}},
+			expected: Coordinates{Lon: 2.5, Lat: 48.5},
+		},
+		{
+			name:     "antimeridian",
+			polygon:  Polygon{PointList: []Coordinates{{Lon: 179, Lat: -17}, {Lon: -179, Lat: -17}, {Lon: -179, Lat: -16}, {Lon: 179, Lat: -16}}},
+			expected: Coordinates{Lon: -180, Lat: -16.5},
+		},
+		{
+			name:     "east of the antimeridian",
+			polygon:  Polygon{PointList: []Coordinates{{Lon: -179.5, Lat: -17}, {Lon: 179, Lat: -17}, {Lon: -178, Lat: -16}}},
+			expected: Coordinates{Lon: -179.5, Lat: -16.666667},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			center, err := Center(test.polygon)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if Distance(center, test.expected) > 1 {
+				t.Errorf("center is %v, expected %v", center, test.expected)
+			}
+		})
+	}
+}
+
+func TestGeographicArea(t *testing.T) {
+	shapes := []Shape{
+		Point{Point: Coordinates{Lon: 2.3522, Lat: 48.8566}},
+		PointUncertaintyCircle{Point: Coordinates{Lon: 2.3522, Lat: 48.8566}, Uncertainty: 57.275},
+		Polygon{PointList: []Coordinates{{Lon: 2, Lat: 48}, {Lon: 3, Lat: 48}, {Lon: 3, Lat: 49}}},
+		EllipsoidArc{Point: Coordinates{Lon: 2.3522, Lat: 48.8566}, InnerRadius: 50, UncertaintyRadius: 100, OffsetAngle: 45, IncludedAngle: 90, Confidence: 68},
+	}
+	for _, shape := range shapes {
+		area, err := GeographicArea(shape)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		decoded, err := FromGeographicArea(area)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(decoded, shape) {
+			t.Errorf("%+v is decoded as %+v", shape, decoded)
+		}
+	}
+
+	data, err := json.Marshal(Local2dPointUncertaintyEllipse{LocalOrigin: LocalOrigin{CoordinateID: "local"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shape, err := Unmarshal(data)
+	if err != nil || shape.Type() != ShapeLocal2dPointUncertaintyEllipse {
+		t.Errorf("unexpected shape %+v: %v", shape, err)
+	}
+	if _, err := GeographicArea(shape); err == nil {
+		t.Errorf("local shapes are not geographic areas")
+	}
+	if _, err := Unmarshal([]byte(`{"shape":"SQUARE"}`)); err == nil {
+		t.Errorf("unknown shapes should be rejected")
+	}
+}
@@ -0,0 +1,184 @@
+package gad
+
+import (
+	"errors"
+	"math"
+)
+
+// EarthRadius is the mean radius in meters of the WGS 84 ellipsoid, used to compute distances on a sphere.
+const EarthRadius = 6371008.8
+
+// ErrNoGeographicOrigin is returned when a shape in a local coordinate system has no geographic origin.
+var ErrNoGeographicOrigin = errors.New("gad: local origin without geographic coordinates")
+
+// Distance returns the great-circle distance in meters between two points.
+// The Earth is approximated by a sphere, the error is below 0.5%.
+func Distance(a, b Coordinates) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLon := lat2-lat1, radians(b.Lon-a.Lon)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Bearing returns the initial bearing in degrees clockwise from north of the great circle from a to b, in [0, 360).
+func Bearing(a, b Coordinates) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLon := radians(b.Lon - a.Lon)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Center returns the center of a shape: its point, the mean of the points of a polygon, or the geographic position
+// of the point of a shape in a local coordinate system. The longitudes of a polygon are averaged relative to its first
+// point, so that a polygon crossing the antimeridian is centered on it.
+func Center(s Shape) (Coordinates, error) {
+	switch s := s.(type) {
+	case Point:
+		return s.Point, nil
+	case PointUncertaintyCircle:
+		return s.Point, nil
+	case PointUncertaintyEllipse:
+		return s.Point, nil
+	case Polygon:
+		var center Coordinates
+		for _, point := range s.PointList {
+			center.Lat += point.Lat / float64(len(s.PointList))
+			center.Lon += longitude(point.Lon-s.PointList[0].Lon) / float64(len(s.PointList))
+		}
+		if len(s.PointList) > 0 {
+			center.Lon = longitude(s.PointList[0].Lon + center.Lon)
+		}
+		return center, nil
+	case PointAltitude:
+		return s.Point, nil
+	case PointAltitudeUncertainty:
+		return s.Point, nil
+	case EllipsoidArc:
+		return s.Point, nil
+	case Local2dPointUncertaintyEllipse:
+		return s.LocalOrigin.coordinates(s.Point)
+	case Local3dPointUncertaintyEllipsoid:
+		return s.LocalOrigin.coordinates(s.Point)
+	default:
+		return Coordinates{}, errors.New("gad: unknown shape")
+	}
+}
+
+// Contains reports whether p is the point.
+func (p Point) Contains(c Coordinates) bool {
+	return Distance(p.Point, c) < 1e-3
+}
+
+// Contains reports whether c lies in the uncertainty circle.
+func (p PointUncertaintyCircle) Contains(c Coordinates) bool {
+	return Distance(p.Point, c) <= p.Uncertainty
+}
+
+// Contains reports whether c lies in the uncertainty ellipse.
+func (p PointUncertaintyEllipse) Contains(c Coordinates) bool {
+	x, y := local(p.Point, c)
+	return p.UncertaintyEllipse.contains(x, y)
+}
+
+// Contains reports whether c lies in the polygon. The edges are straight lines in latitude and longitude, which is
+// accurate for the polygons of a few kilometers used as location estimates. As in Center, the longitudes are taken
+// relative to the first point, so that a polygon crossing the antimeridian contains the points on both sides of it.
+func (p Polygon) Contains(c Coordinates) bool {
+	if len(p.PointList) == 0 {
+		return false
+	}
+	origin := p.PointList[0].Lon
+	lon := longitude(c.Lon - origin)
+	inside := false
+	for i, j := 0, len(p.PointList)-1; i < len(p.PointList); j, i = i, i+1 {
+		a, b := p.PointList[i], p.PointList[j]
+		aLon, bLon := longitude(a.Lon-origin), longitude(b.Lon-origin)
+		if (a.Lat > c.Lat) != (b.Lat > c.Lat) && lon < (bLon-aLon)*(c.Lat-a.Lat)/(b.Lat-a.Lat)+aLon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Contains reports whether c is the point, whatever its altitude.
+func (p PointAltitude) Contains(c Coordinates) bool {
+	return Point{Point: p.Point}.Contains(c)
+}
+
+// Contains reports whether c lies in the uncertainty ellipse, whatever its altitude.
+func (p PointAltitudeUncertainty) Contains(c Coordinates) bool {
+	return PointUncertaintyEllipse{Point: p.Point, UncertaintyEllipse: p.UncertaintyEllipse}.Contains(c)
+}
+
+// Contains reports whether c lies in the arc.
+func (a EllipsoidArc) Contains(c Coordinates) bool {
+	distance := Distance(a.Point, c)
+	if distance < float64(a.InnerRadius) || distance > float64(a.InnerRadius)+a.UncertaintyRadius {
+		return false
+	}
+	if distance == 0 {
+		return true
+	}
+	angle := math.Mod(Bearing(a.Point, c)-float64(a.OffsetAngle)+360, 360)
+	return angle <= float64(a.IncludedAngle)
+}
+
+// Contains reports whether c lies in the uncertainty ellipse. It is false when the local origin has no geographic
+// coordinates.
+func (p Local2dPointUncertaintyEllipse) Contains(c Coordinates) bool {
+	if p.LocalOrigin.Point == nil {
+		return false
+	}
+	x, y := local(*p.LocalOrigin.Point, c)
+	return p.UncertaintyEllipse.contains(x-p.Point.X, y-p.Point.Y)
+}
+
+// Contains reports whether c lies in the horizontal section of the uncertainty ellipsoid. It is false when the local
+// origin has no geographic coordinates.
+func (p Local3dPointUncertaintyEllipsoid) Contains(c Coordinates) bool {
+	ellipse := UncertaintyEllipse{
+		SemiMajor:        p.UncertaintyEllipsoid.SemiMajor,
+		SemiMinor:        p.UncertaintyEllipsoid.SemiMinor,
+		OrientationMajor: p.UncertaintyEllipsoid.OrientationMajor,
+	}
+	return Local2dPointUncertaintyEllipse{LocalOrigin: p.LocalOrigin, Point: p.Point, UncertaintyEllipse: ellipse}.Contains(c)
+}
+
+// contains reports whether the point at x meters east and y meters north of the center lies in the ellipse.
+func (e UncertaintyEllipse) contains(x, y float64) bool {
+	orientation := radians(float64(e.OrientationMajor))
+	major := x*math.Sin(orientation) + y*math.Cos(orientation)
+	minor := x*math.Cos(orientation) - y*math.Sin(orientation)
+	a, b := math.Max(e.SemiMajor, 1e-3), math.Max(e.SemiMinor, 1e-3)
+	return (major/a)*(major/a)+(minor/b)*(minor/b) <= 1
+}
+
+// coordinates returns the geographic coordinates of a location relative to the origin.
+func (o LocalOrigin) coordinates(l RelativeCartesianLocation) (Coordinates, error) {
+	if o.Point == nil {
+		return Coordinates{}, ErrNoGeographicOrigin
+	}
+	return Coordinates{
+		Lat: o.Point.Lat + degrees(l.Y/EarthRadius),
+		Lon: o.Point.Lon + degrees(l.X/(EarthRadius*math.Cos(radians(o.Point.Lat)))),
+	}, nil
+}
+
+// local returns the position of c in meters east and north of the origin, on the plane tangent at the origin.
+func local(origin, c Coordinates) (x, y float64) {
+	return radians(longitude(c.Lon-origin.Lon)) * EarthRadius * math.Cos(radians(origin.Lat)), radians(c.Lat-origin.Lat) * EarthRadius
+}
+
+// longitude returns the longitude in [-180, 180) equivalent to d degrees.
+func longitude(d float64) float64 {
+	return math.Mod(math.Mod(d+180, 360)+360, 360) - 180
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
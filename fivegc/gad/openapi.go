@@ -0,0 +1,159 @@
+package gad
+
+import (
+	"encoding/json"
+	"fmt"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"reflect"
+)
+
+// The shapes are marshalled with their shape attribute, as defined by the GADShape schema of TS 29.572.
+// The types below drop the MarshalJSON methods of the shapes to marshal their attributes.
+type (
+	point                            Point
+	pointUncertaintyCircle           PointUncertaintyCircle
+	pointUncertaintyEllipse          PointUncertaintyEllipse
+	polygon                          Polygon
+	pointAltitude                    PointAltitude
+	pointAltitudeUncertainty         PointAltitudeUncertainty
+	ellipsoidArc                     EllipsoidArc
+	local2dPointUncertaintyEllipse   Local2dPointUncertaintyEllipse
+	local3dPointUncertaintyEllipsoid Local3dPointUncertaintyEllipsoid
+)
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		point
+	}{p.Type(), point(p)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p PointUncertaintyCircle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		pointUncertaintyCircle
+	}{p.Type(), pointUncertaintyCircle(p)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p PointUncertaintyEllipse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		pointUncertaintyEllipse
+	}{p.Type(), pointUncertaintyEllipse(p)})
+}
+
+// MarshalJSON marshals the polygon with its shape attribute.
+func (p Polygon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		polygon
+	}{p.Type(), polygon(p)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p PointAltitude) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		pointAltitude
+	}{p.Type(), pointAltitude(p)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p PointAltitudeUncertainty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		pointAltitudeUncertainty
+	}{p.Type(), pointAltitudeUncertainty(p)})
+}
+
+// MarshalJSON marshals the arc with its shape attribute.
+func (a EllipsoidArc) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		ellipsoidArc
+	}{a.Type(), ellipsoidArc(a)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p Local2dPointUncertaintyEllipse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		local2dPointUncertaintyEllipse
+	}{p.Type(), local2dPointUncertaintyEllipse(p)})
+}
+
+// MarshalJSON marshals the point with its shape attribute.
+func (p Local3dPointUncertaintyEllipsoid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Shape ShapeType `json:"shape"`
+		local3dPointUncertaintyEllipsoid
+	}{p.Type(), local3dPointUncertaintyEllipsoid(p)})
+}
+
+// Unmarshal decodes a JSON GAD shape according to its shape attribute. The shape is not validated.
+func Unmarshal(data []byte) (Shape, error) {
+	var discriminator struct {
+		Shape ShapeType `json:"shape"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, fmt.Errorf("gad: %w", err)
+	}
+	var shape interface{}
+	switch discriminator.Shape {
+	case ShapePoint:
+		shape = &Point{}
+	case ShapePointUncertaintyCircle:
+		shape = &PointUncertaintyCircle{}
+	case ShapePointUncertaintyEllipse:
+		shape = &PointUncertaintyEllipse{}
+	case ShapePolygon:
+		shape = &Polygon{}
+	case ShapePointAltitude:
+		shape = &PointAltitude{}
+	case ShapePointAltitudeUncertainty:
+		shape = &PointAltitudeUncertainty{}
+	case ShapeEllipsoidArc:
+		shape = &EllipsoidArc{}
+	case ShapeLocal2dPointUncertaintyEllipse:
+		shape = &Local2dPointUncertaintyEllipse{}
+	case ShapeLocal3dPointUncertaintyEllipsoid:
+		shape = &Local3dPointUncertaintyEllipsoid{}
+	default:
+		return nil, fmt.Errorf("gad: unknown shape %q", discriminator.Shape)
+	}
+	if err := json.Unmarshal(data, shape); err != nil {
+		return nil, fmt.Errorf("gad: %s: %w", discriminator.Shape, err)
+	}
+	// The shapes are returned as values, like they are built.
+	return reflect.ValueOf(shape).Elem().Interface().(Shape), nil
+}
+
+// GeographicArea converts a shape into the GeographicArea of the openapi Nlmf_Location models, e.g. the location
+// estimate of a LocationData. Local shapes, which are not geographic areas, are rejected.
+func GeographicArea(s Shape) (nlmfocation.GeographicArea, error) {
+	var area nlmfocation.GeographicArea
+	switch s.(type) {
+	case Local2dPointUncertaintyEllipse, Local3dPointUncertaintyEllipsoid:
+		return area, fmt.Errorf("gad: %s is not a geographic area", s.Type())
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return area, fmt.Errorf("gad: %w", err)
+	}
+	if err := json.Unmarshal(data, &area); err != nil {
+		return area, fmt.Errorf("gad: %w", err)
+	}
+	return area, nil
+}
+
+// FromGeographicArea converts the GeographicArea of the openapi Nlmf_Location models into a shape.
+func FromGeographicArea(area nlmfocation.GeographicArea) (Shape, error) {
+	data, err := json.Marshal(area)
+	if err != nil {
+		return nil, fmt.Errorf("gad: %w", err)
+	}
+	return Unmarshal(data)
+}
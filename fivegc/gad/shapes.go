@@ -0,0 +1,231 @@
+package gad
+
+import (
+	"strconv"
+)
+
+// Point is an ellipsoid point.
+type Point struct {
+	Point Coordinates `json:"point"`
+}
+
+// Type returns ShapePoint.
+func (p Point) Type() ShapeType {
+	return ShapePoint
+}
+
+// Validate checks the coordinates of the point.
+func (p Point) Validate() error {
+	return p.Point.validate(p.Type(), "/point")
+}
+
+// PointUncertaintyCircle is an ellipsoid point with an uncertainty circle, whose radius is in meters.
+type PointUncertaintyCircle struct {
+	Point       Coordinates `json:"point"`
+	Uncertainty float64     `json:"uncertainty"`
+}
+
+// Type returns ShapePointUncertaintyCircle.
+func (p PointUncertaintyCircle) Type() ShapeType {
+	return ShapePointUncertaintyCircle
+}
+
+// Validate checks the coordinates and the uncertainty of the point.
+func (p PointUncertaintyCircle) Validate() error {
+	if err := p.Point.validate(p.Type(), "/point"); err != nil {
+		return err
+	}
+	return validateUncertainty(p.Type(), "/uncertainty", p.Uncertainty)
+}
+
+// PointUncertaintyEllipse is an ellipsoid point with an uncertainty ellipse.
+type PointUncertaintyEllipse struct {
+	Point              Coordinates        `json:"point"`
+	UncertaintyEllipse UncertaintyEllipse `json:"uncertaintyEllipse"`
+	Confidence         int32              `json:"confidence"`
+}
+
+// Type returns ShapePointUncertaintyEllipse.
+func (p PointUncertaintyEllipse) Type() ShapeType {
+	return ShapePointUncertaintyEllipse
+}
+
+// Validate checks the coordinates, the uncertainty ellipse and the confidence of the point.
+func (p PointUncertaintyEllipse) Validate() error {
+	if err := p.Point.validate(p.Type(), "/point"); err != nil {
+		return err
+	}
+	if err := p.UncertaintyEllipse.validate(p.Type(), "/uncertaintyEllipse"); err != nil {
+		return err
+	}
+	return validateConfidence(p.Type(), "/confidence", p.Confidence)
+}
+
+// Polygon is a polygon of 3 to 15 points, not closed: the last point is connected to the first one.
+type Polygon struct {
+	PointList []Coordinates `json:"pointList"`
+}
+
+// Type returns ShapePolygon.
+func (p Polygon) Type() ShapeType {
+	return ShapePolygon
+}
+
+// Validate checks the number of points and their coordinates.
+func (p Polygon) Validate() error {
+	if len(p.PointList) < MinPolygonPoints || len(p.PointList) > MaxPolygonPoints {
+		return &ValidationError{Shape: p.Type(), Pointer: "/pointList", Reason: "does not have 3 to 15 points"}
+	}
+	for i, point := range p.PointList {
+		if err := point.validate(p.Type(), "/pointList/"+strconv.Itoa(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PointAltitude is an ellipsoid point with an altitude in meters.
+type PointAltitude struct {
+	Point    Coordinates `json:"point"`
+	Altitude float64     `json:"altitude"`
+}
+
+// Type returns ShapePointAltitude.
+func (p PointAltitude) Type() ShapeType {
+	return ShapePointAltitude
+}
+
+// Validate checks the coordinates and the altitude of the point.
+func (p PointAltitude) Validate() error {
+	if err := p.Point.validate(p.Type(), "/point"); err != nil {
+		return err
+	}
+	return validateRange(p.Type(), "/altitude", p.Altitude, -MaxAltitude, MaxAltitude)
+}
+
+// PointAltitudeUncertainty is an ellipsoid point with an altitude and an uncertainty ellipsoid, made of an
+// uncertainty ellipse and an altitude uncertainty in meters.
+type PointAltitudeUncertainty struct {
+	Point               Coordinates        `json:"point"`
+	Altitude            float64            `json:"altitude"`
+	UncertaintyEllipse  UncertaintyEllipse `json:"uncertaintyEllipse"`
+	UncertaintyAltitude float64            `json:"uncertaintyAltitude"`
+	Confidence          int32              `json:"confidence"`
+	VConfidence         *int32             `json:"vConfidence,omitempty"`
+}
+
+// Type returns ShapePointAltitudeUncertainty.
+func (p PointAltitudeUncertainty) Type() ShapeType {
+	return ShapePointAltitudeUncertainty
+}
+
+// Validate checks the coordinates, the altitude, the uncertainties and the confidences of the point.
+func (p PointAltitudeUncertainty) Validate() error {
+	if err := p.Point.validate(p.Type(), "/point"); err != nil {
+		return err
+	}
+	if err := validateRange(p.Type(), "/altitude", p.Altitude, -MaxAltitude, MaxAltitude); err != nil {
+		return err
+	}
+	if err := p.UncertaintyEllipse.validate(p.Type(), "/uncertaintyEllipse"); err != nil {
+		return err
+	}
+	if err := validateAltitudeUncertainty(p.Type(), "/uncertaintyAltitude", p.UncertaintyAltitude); err != nil {
+		return err
+	}
+	if err := validateConfidence(p.Type(), "/confidence", p.Confidence); err != nil {
+		return err
+	}
+	if p.VConfidence != nil {
+		return validateConfidence(p.Type(), "/vConfidence", *p.VConfidence)
+	}
+	return nil
+}
+
+// EllipsoidArc is an ellipsoid arc: the area between two circles centered on a point, limited by an offset angle
+// and an included angle. The radii are in meters, the angles in degrees clockwise from north.
+type EllipsoidArc struct {
+	Point             Coordinates `json:"point"`
+	InnerRadius       int32       `json:"innerRadius"`
+	UncertaintyRadius float64     `json:"uncertaintyRadius"`
+	OffsetAngle       int32       `json:"offsetAngle"`
+	IncludedAngle     int32       `json:"includedAngle"`
+	Confidence        int32       `json:"confidence"`
+}
+
+// Type returns ShapeEllipsoidArc.
+func (a EllipsoidArc) Type() ShapeType {
+	return ShapeEllipsoidArc
+}
+
+// Validate checks the coordinates, the radii, the angles and the confidence of the arc.
+func (a EllipsoidArc) Validate() error {
+	if err := a.Point.validate(a.Type(), "/point"); err != nil {
+		return err
+	}
+	if err := validateRange(a.Type(), "/innerRadius", float64(a.InnerRadius), 0, MaxInnerRadius); err != nil {
+		return err
+	}
+	if err := validateUncertainty(a.Type(), "/uncertaintyRadius", a.UncertaintyRadius); err != nil {
+		return err
+	}
+	if err := validateRange(a.Type(), "/offsetAngle", float64(a.OffsetAngle), 0, MaxAngle); err != nil {
+		return err
+	}
+	if err := validateRange(a.Type(), "/includedAngle", float64(a.IncludedAngle), 0, MaxAngle); err != nil {
+		return err
+	}
+	return validateConfidence(a.Type(), "/confidence", a.Confidence)
+}
+
+// Local2dPointUncertaintyEllipse is a point with an uncertainty ellipse in a local coordinate system.
+type Local2dPointUncertaintyEllipse struct {
+	LocalOrigin        LocalOrigin               `json:"localOrigin"`
+	Point              RelativeCartesianLocation `json:"point"`
+	UncertaintyEllipse UncertaintyEllipse        `json:"uncertaintyEllipse"`
+	Confidence         int32                     `json:"confidence"`
+}
+
+// Type returns ShapeLocal2dPointUncertaintyEllipse.
+func (p Local2dPointUncertaintyEllipse) Type() ShapeType {
+	return ShapeLocal2dPointUncertaintyEllipse
+}
+
+// Validate checks the local origin, the uncertainty ellipse and the confidence of the point.
+func (p Local2dPointUncertaintyEllipse) Validate() error {
+	if p.LocalOrigin.Point != nil {
+		if err := p.LocalOrigin.Point.validate(p.Type(), "/localOrigin/point"); err != nil {
+			return err
+		}
+	}
+	if err := p.UncertaintyEllipse.validate(p.Type(), "/uncertaintyEllipse"); err != nil {
+		return err
+	}
+	return validateConfidence(p.Type(), "/confidence", p.Confidence)
+}
+
+// Local3dPointUncertaintyEllipsoid is a point with an uncertainty ellipsoid in a local coordinate system.
+type Local3dPointUncertaintyEllipsoid struct {
+	LocalOrigin          LocalOrigin               `json:"localOrigin"`
+	Point                RelativeCartesianLocation `json:"point"`
+	UncertaintyEllipsoid UncertaintyEllipsoid      `json:"uncertaintyEllipsoid"`
+	Confidence           int32                     `json:"confidence"`
+}
+
+// Type returns ShapeLocal3dPointUncertaintyEllipsoid.
+func (p Local3dPointUncertaintyEllipsoid) Type() ShapeType {
+	return ShapeLocal3dPointUncertaintyEllipsoid
+}
+
+// Validate checks the local origin, the uncertainty ellipsoid and the confidence of the point.
+func (p Local3dPointUncertaintyEllipsoid) Validate() error {
+	if p.LocalOrigin.Point != nil {
+		if err := p.LocalOrigin.Point.validate(p.Type(), "/localOrigin/point"); err != nil {
+			return err
+		}
+	}
+	if err := p.UncertaintyEllipsoid.validate(p.Type(), "/uncertaintyEllipsoid"); err != nil {
+		return err
+	}
+	return validateConfidence(p.Type(), "/confidence", p.Confidence)
+}
@@ -0,0 +1,62 @@
+package gad
+
+import (
+	"math"
+)
+
+// MaxUncertaintyCode is the largest uncertainty code K of TS 23.032, coded on 7 bits.
+const MaxUncertaintyCode = 127
+
+// Largest uncertainties in meters which can be coded by TS 23.032.
+var (
+	MaxUncertainty         = UncertaintyFromCode(MaxUncertaintyCode)
+	MaxAltitudeUncertainty = AltitudeUncertaintyFromCode(MaxUncertaintyCode)
+)
+
+// Coefficients of the uncertainty formula r = C((1+x)^K - 1) of TS 23.032 clauses 6.2 and 6.4.
+const (
+	uncertaintyC         = 10
+	uncertaintyX         = 0.1
+	altitudeUncertaintyC = 45
+	altitudeUncertaintyX = 0.025
+)
+
+// UncertaintyFromCode returns the horizontal uncertainty in meters coded by K (TS 23.032 clause 6.2).
+// Only the 7 lower bits of K are used.
+func UncertaintyFromCode(k uint8) float64 {
+	return fromCode(k, uncertaintyC, uncertaintyX)
+}
+
+// UncertaintyCode returns the smallest code K whose horizontal uncertainty is not below r meters, so the coded
+// uncertainty never understates r. Uncertainties above MaxUncertainty are coded as MaxUncertaintyCode.
+func UncertaintyCode(r float64) uint8 {
+	return toCode(r, uncertaintyC, uncertaintyX)
+}
+
+// AltitudeUncertaintyFromCode returns the altitude uncertainty in meters coded by K (TS 23.032 clause 6.4).
+// Only the 7 lower bits of K are used.
+func AltitudeUncertaintyFromCode(k uint8) float64 {
+	return fromCode(k, altitudeUncertaintyC, altitudeUncertaintyX)
+}
+
+// AltitudeUncertaintyCode returns the smallest code K whose altitude uncertainty is not below r meters.
+// Uncertainties above MaxAltitudeUncertainty are coded as MaxUncertaintyCode.
+func AltitudeUncertaintyCode(r float64) uint8 {
+	return toCode(r, altitudeUncertaintyC, altitudeUncertaintyX)
+}
+
+func fromCode(k uint8, c, x float64) float64 {
+	return c * (math.Pow(1+x, float64(k&MaxUncertaintyCode)) - 1)
+}
+
+func toCode(r, c, x float64) uint8 {
+	if !(r > 0) {
+		return 0
+	}
+	// The tolerance keeps the decoded values of the codes mapped to themselves despite rounding errors.
+	k := math.Ceil(math.Log(r/c+1)/math.Log(1+x) - 1e-9)
+	if k > MaxUncertaintyCode {
+		return MaxUncertaintyCode
+	}
+	return uint8(k)
+}
@@ -0,0 +1,102 @@
+package lpp
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// ProvideAssistanceData is the ProvideAssistanceData message, sent by the location server with assistance data. Only
+// the errors of the assistance data are supported.
+type ProvideAssistanceData struct {
+	CommonIEs *CommonIEsProvideAssistanceData
+	// AGNSSError and OTDOAError are the errors of the A-GNSS and OTDOA assistance data.
+	AGNSSError *PositioningError
+	OTDOAError *PositioningError
+}
+
+// CommonIEsProvideAssistanceData are the common information elements of ProvideAssistanceData.
+type CommonIEsProvideAssistanceData struct{}
+
+func (p *ProvideAssistanceData) messageType() int {
+	return messageProvideAssistanceData
+}
+
+func (p *ProvideAssistanceData) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(p.CommonIEs != nil)
+	e.WriteBool(p.AGNSSError != nil)
+	e.WriteBool(p.OTDOAError != nil)
+	e.WriteBool(false) // epdu-Provide-Assistance-Data
+	if p.CommonIEs != nil {
+		writeExtensible(e)
+	}
+	for _, assistance := range []struct {
+		err    *PositioningError
+		causes errorCauses
+	}{{p.AGNSSError, agnssErrorCauses}, {p.OTDOAError, otdoaErrorCauses}} {
+		if assistance.err == nil {
+			continue
+		}
+		// Among the three optional components, only the error is present.
+		writeExtensible(e)
+		e.WriteBits(0b001, 3)
+		if err := assistance.err.encode(e, assistance.causes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ProvideAssistanceData) decode(d *per.Decoder) error {
+	skipExtensions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 4)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		p.CommonIEs = &CommonIEsProvideAssistanceData{}
+		if err := skipEmpty(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		if p.AGNSSError, err = readAssistanceError(d, agnssErrorCauses, "A-GNSS"); err != nil {
+			return err
+		}
+	}
+	if present[2] {
+		if p.OTDOAError, err = readAssistanceError(d, otdoaErrorCauses, "OTDOA"); err != nil {
+			return err
+		}
+	}
+	if present[3] {
+		return fmt.Errorf("%w: epdu-Provide-Assistance-Data", ErrUnsupported)
+	}
+	return skipExtensions()
+}
+
+// readAssistanceError decodes the assistance data of a positioning method, which must only carry an error.
+func readAssistanceError(d *per.Decoder, causes errorCauses, method string) (*PositioningError, error) {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return nil, err
+	}
+	present, err := readPresence(d, 3)
+	if err != nil {
+		return nil, err
+	}
+	if present[0] || present[1] {
+		return nil, fmt.Errorf("%w: %s assistance data", ErrUnsupported, method)
+	}
+	if !present[2] {
+		return nil, skip()
+	}
+	assistanceError := &PositioningError{}
+	if err := assistanceError.decode(d, causes); err != nil {
+		return nil, err
+	}
+	return assistanceError, skip()
+}
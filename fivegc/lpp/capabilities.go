@@ -0,0 +1,241 @@
+package lpp
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// RequestCapabilities is the RequestCapabilities message, sent by the location server to request the positioning
+// capabilities of the target device. The non nil fields select the positioning methods whose capabilities are
+// requested.
+type RequestCapabilities struct {
+	CommonIEs *CommonIEsRequestCapabilities
+	AGNSS     *AGNSSRequestCapabilities
+	OTDOA     *OTDOARequestCapabilities
+	ECID      *ECIDRequestCapabilities
+}
+
+// CommonIEsRequestCapabilities are the common information elements of RequestCapabilities.
+type CommonIEsRequestCapabilities struct{}
+
+// AGNSSRequestCapabilities requests the A-GNSS capabilities of the target device.
+type AGNSSRequestCapabilities struct {
+	GNSSSupportListReq           bool
+	AssistanceDataSupportListReq bool
+	LocationVelocityTypesReq     bool
+}
+
+// OTDOARequestCapabilities requests the OTDOA capabilities of the target device.
+type OTDOARequestCapabilities struct{}
+
+// ECIDRequestCapabilities requests the E-CID capabilities of the target device.
+type ECIDRequestCapabilities struct{}
+
+func (r *RequestCapabilities) messageType() int {
+	return messageRequestCapabilities
+}
+
+func (r *RequestCapabilities) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(r.CommonIEs != nil)
+	e.WriteBool(r.AGNSS != nil)
+	e.WriteBool(r.OTDOA != nil)
+	e.WriteBool(r.ECID != nil)
+	e.WriteBool(false) // epdu-RequestCapabilities
+	if r.CommonIEs != nil {
+		writeExtensible(e)
+	}
+	if r.AGNSS != nil {
+		writeExtensible(e)
+		e.WriteBool(r.AGNSS.GNSSSupportListReq)
+		e.WriteBool(r.AGNSS.AssistanceDataSupportListReq)
+		e.WriteBool(r.AGNSS.LocationVelocityTypesReq)
+	}
+	if r.OTDOA != nil {
+		writeExtensible(e)
+	}
+	if r.ECID != nil {
+		writeExtensible(e)
+	}
+	return nil
+}
+
+func (r *RequestCapabilities) decode(d *per.Decoder) error {
+	skipExtensions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 5)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		r.CommonIEs = &CommonIEsRequestCapabilities{}
+		if err := skipEmpty(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		r.AGNSS = &AGNSSRequestCapabilities{}
+		skip, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		for _, b := range []*bool{&r.AGNSS.GNSSSupportListReq, &r.AGNSS.AssistanceDataSupportListReq, &r.AGNSS.LocationVelocityTypesReq} {
+			if *b, err = d.ReadBool(); err != nil {
+				return err
+			}
+		}
+		if err := skip(); err != nil {
+			return err
+		}
+	}
+	if present[2] {
+		r.OTDOA = &OTDOARequestCapabilities{}
+		if err := skipEmpty(d); err != nil {
+			return err
+		}
+	}
+	if present[3] {
+		r.ECID = &ECIDRequestCapabilities{}
+		if err := skipEmpty(d); err != nil {
+			return err
+		}
+	}
+	if present[4] {
+		return fmt.Errorf("%w: epdu-RequestCapabilities", ErrUnsupported)
+	}
+	return skipExtensions()
+}
+
+// ProvideCapabilities is the ProvideCapabilities message, sent by the target device with its positioning
+// capabilities.
+type ProvideCapabilities struct {
+	CommonIEs *CommonIEsProvideCapabilities
+	OTDOA     *OTDOAProvideCapabilities
+	ECID      *ECIDProvideCapabilities
+}
+
+// CommonIEsProvideCapabilities are the common information elements of ProvideCapabilities.
+type CommonIEsProvideCapabilities struct{}
+
+// OTDOAMode is the bit mask of the OTDOA modes supported by the target device.
+type OTDOAMode uint8
+
+const (
+	OTDOAModeUEAssisted OTDOAMode = 1 << iota
+	OTDOAModeUEBased
+	OTDOAModeUEAssistedNB
+	OTDOAModeUEAssistedNBTDD
+)
+
+// OTDOAProvideCapabilities are the OTDOA capabilities of the target device.
+type OTDOAProvideCapabilities struct {
+	OTDOAMode OTDOAMode
+}
+
+// ECIDMeasurements is the bit mask of the E-CID measurements supported or requested.
+type ECIDMeasurements uint8
+
+const (
+	ECIDMeasurementRSRP ECIDMeasurements = 1 << iota
+	ECIDMeasurementRSRQ
+	ECIDMeasurementUERxTx
+	ECIDMeasurementNRSRP
+	ECIDMeasurementNRSRQ
+)
+
+// ECIDProvideCapabilities are the E-CID capabilities of the target device.
+type ECIDProvideCapabilities struct {
+	ECIDMeasSupported ECIDMeasurements
+}
+
+func (p *ProvideCapabilities) messageType() int {
+	return messageProvideCapabilities
+}
+
+func (p *ProvideCapabilities) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(p.CommonIEs != nil)
+	e.WriteBool(false) // a-gnss-ProvideCapabilities
+	e.WriteBool(p.OTDOA != nil)
+	e.WriteBool(p.ECID != nil)
+	e.WriteBool(false) // epdu-ProvideCapabilities
+	if p.CommonIEs != nil {
+		writeExtensible(e)
+	}
+	if p.OTDOA != nil {
+		writeExtensible(e)
+		if err := e.WriteBitString(namedBits(uint64(p.OTDOA.OTDOAMode), 1), 1, 8, false); err != nil {
+			return err
+		}
+	}
+	if p.ECID != nil {
+		writeExtensible(e)
+		if err := e.WriteBitString(namedBits(uint64(p.ECID.ECIDMeasSupported), 1), 1, 8, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ProvideCapabilities) decode(d *per.Decoder) error {
+	skipExtensions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 5)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		p.CommonIEs = &CommonIEsProvideCapabilities{}
+		if err := skipEmpty(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		return fmt.Errorf("%w: a-gnss-ProvideCapabilities", ErrUnsupported)
+	}
+	if present[2] {
+		mode, err := readNamedBits(d)
+		if err != nil {
+			return err
+		}
+		p.OTDOA = &OTDOAProvideCapabilities{OTDOAMode: OTDOAMode(mode)}
+	}
+	if present[3] {
+		measurements, err := readNamedBits(d)
+		if err != nil {
+			return err
+		}
+		p.ECID = &ECIDProvideCapabilities{ECIDMeasSupported: ECIDMeasurements(measurements)}
+	}
+	if present[4] {
+		return fmt.Errorf("%w: epdu-ProvideCapabilities", ErrUnsupported)
+	}
+	return skipExtensions()
+}
+
+// skipEmpty decodes an extensible SEQUENCE without root components.
+func skipEmpty(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	return skip()
+}
+
+// readNamedBits decodes an extensible SEQUENCE whose only root component is a BIT STRING (SIZE (1..8)) with named
+// bits.
+func readNamedBits(d *per.Decoder) (uint64, error) {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return 0, err
+	}
+	b, err := d.ReadBitString(1, 8, false)
+	if err != nil {
+		return 0, err
+	}
+	return bitMask(b), skip()
+}
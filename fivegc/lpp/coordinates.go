@@ -0,0 +1,204 @@
+package lpp
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// component is a component of a LocationCoordinates alternative. Except the orientation of the major axis, which is in
+// degrees, the components carry the fields of the binary encoding of the shapes defined in TS 23.032 clause 7.
+type component int
+
+const (
+	// componentPoint is the latitudeSign, the degreesLatitude and the degreesLongitude, in 6 octets.
+	componentPoint component = iota
+	// componentAltitude is the altitudeDirection and the altitude, in 2 octets.
+	componentAltitude
+	componentUncertainty
+	// componentOrientation is the orientation of the major axis in degrees, coded in steps of 2 degrees in 1 octet.
+	componentOrientation
+	componentConfidence
+	// componentInnerRadius is the inner radius in steps of 5 meters, in 2 octets.
+	componentInnerRadius
+	componentAngle
+)
+
+// coordinatesLayouts are the TS 23.032 type of shape codes and the components of the root alternatives of
+// LocationCoordinates, in order. The polygon is a list of points.
+var coordinatesLayouts = [...]struct {
+	code       byte
+	components []component
+}{
+	{0x0, []component{componentPoint}},
+	{0x1, []component{componentPoint, componentUncertainty}},
+	{0x3, []component{componentPoint, componentUncertainty, componentUncertainty, componentOrientation, componentConfidence}},
+	{0x5, nil},
+	{0x8, []component{componentPoint, componentAltitude}},
+	{0x9, []component{componentPoint, componentAltitude, componentUncertainty, componentUncertainty, componentOrientation,
+		componentUncertainty, componentConfidence}},
+	{0xa, []component{componentPoint, componentInnerRadius, componentUncertainty, componentAngle, componentAngle,
+		componentConfidence}},
+}
+
+const coordinatesPolygon = 3
+
+// writeLocationCoordinates writes a shape as LocationCoordinates. Local shapes are not supported.
+func writeLocationCoordinates(e *per.Encoder, s gad.Shape) error {
+	b, err := gad.Encode(s)
+	if err != nil {
+		return err
+	}
+	index := -1
+	for i, layout := range coordinatesLayouts {
+		if b[0]>>4 == layout.code {
+			index = i
+		}
+	}
+	if index < 0 {
+		return fmt.Errorf("%s has no location coordinates", s.Type())
+	}
+	var orientation int32
+	switch s := s.(type) {
+	case gad.PointUncertaintyEllipse:
+		orientation = s.UncertaintyEllipse.OrientationMajor % 180
+	case gad.PointAltitudeUncertainty:
+		orientation = s.UncertaintyEllipse.OrientationMajor % 180
+	}
+	return e.WriteChoice(index, len(coordinatesLayouts), true, func(e *per.Encoder) error {
+		fields := b[1:]
+		if index == coordinatesPolygon {
+			points := int(b[0] & 0x0f)
+			if err := e.WriteLength(points, gad.MinPolygonPoints, gad.MaxPolygonPoints); err != nil {
+				return err
+			}
+			for i := 0; i < points; i++ {
+				writePoint(e, fields[6*i:])
+			}
+			return nil
+		}
+		for _, c := range coordinatesLayouts[index].components {
+			switch c {
+			case componentPoint:
+				writePoint(e, fields)
+				fields = fields[6:]
+			case componentAltitude:
+				e.WriteBool(fields[0]&0x80 != 0)
+				e.WriteBits(uint64(fields[0]&0x7f)<<8|uint64(fields[1]), 15)
+				fields = fields[2:]
+			case componentInnerRadius:
+				e.WriteBits(uint64(fields[0])<<8|uint64(fields[1]), 16)
+				fields = fields[2:]
+			case componentOrientation:
+				e.WriteBits(uint64(orientation), 8)
+				fields = fields[1:]
+			default:
+				if err := e.WriteConstrainedInt(int64(fields[0]), 0, componentMax[c]); err != nil {
+					return err
+				}
+				fields = fields[1:]
+			}
+		}
+		return nil
+	})
+}
+
+// componentMax are the upper bounds of the components of 1 octet.
+var componentMax = map[component]int64{
+	componentUncertainty: gad.MaxUncertaintyCode,
+	componentOrientation: 179,
+	componentConfidence:  gad.MaxConfidence,
+	componentAngle:       179,
+}
+
+// writePoint writes the point encoded in the 6 first octets of b.
+func writePoint(e *per.Encoder, b []byte) {
+	// The latitude sign is the most significant bit, and the longitude is in two's complement on 24 bits as in
+	// degreesLongitude, whose lower bound is coded as 0.
+	e.WriteBool(b[0]&0x80 != 0)
+	e.WriteBits(uint64(b[0]&0x7f)<<16|uint64(b[1])<<8|uint64(b[2]), 23)
+	e.WriteBits((uint64(b[3])<<16|uint64(b[4])<<8|uint64(b[5]))^1<<23, 24)
+}
+
+// readLocationCoordinates reads LocationCoordinates as a shape.
+func readLocationCoordinates(d *per.Decoder) (gad.Shape, error) {
+	index, _, err := d.ReadChoice(len(coordinatesLayouts), true)
+	if err != nil {
+		return nil, err
+	}
+	if index >= len(coordinatesLayouts) {
+		return nil, fmt.Errorf("%w: location coordinates %d", ErrUnsupported, index)
+	}
+	b := []byte{coordinatesLayouts[index].code << 4}
+	if index == coordinatesPolygon {
+		points, err := d.ReadLength(gad.MinPolygonPoints, gad.MaxPolygonPoints)
+		if err != nil {
+			return nil, err
+		}
+		b[0] |= byte(points)
+		for i := 0; i < points; i++ {
+			if b, err = readPoint(d, b); err != nil {
+				return nil, err
+			}
+		}
+		return gad.Decode(b)
+	}
+	orientation := int32(-1)
+	for _, c := range coordinatesLayouts[index].components {
+		switch c {
+		case componentPoint:
+			b, err = readPoint(d, b)
+		case componentAltitude:
+			var altitude uint64
+			if altitude, err = d.ReadBits(16); err == nil {
+				b = append(b, byte(altitude>>8), byte(altitude))
+			}
+		case componentInnerRadius:
+			var radius uint64
+			if radius, err = d.ReadBits(16); err == nil {
+				b = append(b, byte(radius>>8), byte(radius))
+			}
+		default:
+			var v int64
+			if v, err = d.ReadConstrainedInt(0, componentMax[c]); err == nil {
+				if c == componentOrientation {
+					orientation = int32(v)
+					v /= 2
+				}
+				b = append(b, byte(v))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	s, err := gad.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+	// The orientation is restored with its resolution of 1 degree.
+	switch shape := s.(type) {
+	case gad.PointUncertaintyEllipse:
+		shape.UncertaintyEllipse.OrientationMajor = orientation
+		return shape, nil
+	case gad.PointAltitudeUncertainty:
+		shape.UncertaintyEllipse.OrientationMajor = orientation
+		return shape, nil
+	}
+	return s, nil
+}
+
+// readPoint reads a point and appends its binary encoding to b.
+func readPoint(d *per.Decoder, b []byte) ([]byte, error) {
+	latitude, err := d.ReadBits(24)
+	if err != nil {
+		return nil, err
+	}
+	longitude, err := d.ReadBits(24)
+	if err != nil {
+		return nil, err
+	}
+	longitude ^= 1 << 23
+	return append(b, byte(latitude>>16), byte(latitude>>8), byte(latitude),
+		byte(longitude>>16), byte(longitude>>8), byte(longitude)), nil
+}
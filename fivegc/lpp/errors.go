@@ -0,0 +1,119 @@
+package lpp
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// PositioningError is the error of a positioning method, caused by the location server or by the target device.
+type PositioningError struct {
+	TargetDevice bool
+	// Cause is one of the causes of the positioning method, for the location server or the target device.
+	Cause int
+	// NotPossible is the bit mask of the measurements which the target device reports as not possible, for E-CID and
+	// A-GNSS: ECIDMeasurementRSRP, ECIDMeasurementRSRQ and ECIDMeasurementUERxTx, or the AGNSS*NotPossible bits.
+	NotPossible uint8
+}
+
+// CauseUndefined is the undefined cause, common to the location server and the target device of all the positioning
+// methods.
+const CauseUndefined = 0
+
+// Causes of the E-CID errors of the target device.
+const (
+	ECIDCauseRequestedMeasurementNotAvailable = iota + 1
+	ECIDCauseNotAllRequestedMeasurementsPossible
+)
+
+// Causes of the A-GNSS errors of the location server.
+const (
+	AGNSSCauseUndeliveredAssistanceDataIsNotSupportedByServer = iota + 1
+	AGNSSCauseUndeliveredAssistanceDataIsSupportedButCurrentlyNotAvailableByServer
+	AGNSSCauseUndeliveredAssistanceDataIsPartlyNotSupportedAndPartlyNotAvailableByServer
+)
+
+// Causes of the A-GNSS errors of the target device.
+const (
+	AGNSSCauseThereWereNotEnoughSatellitesReceived = iota + 1
+	AGNSSCauseAssistanceDataMissing
+	AGNSSCauseNotAllRequestedMeasurementsPossible
+)
+
+// Measurements which the target device reports as not possible for A-GNSS.
+const (
+	AGNSSFineTimeAssistanceMeasurementsNotPossible = 1 << iota
+	AGNSSADRMeasurementsNotPossible
+	AGNSSMultiFrequencyMeasurementsNotPossible
+)
+
+// Causes of the OTDOA errors of the location server.
+const (
+	OTDOACauseAssistanceDataNotSupportedByServer = iota + 1
+	OTDOACauseAssistanceDataSupportButCurrentlyNotAvailableByServer
+)
+
+// Causes of the OTDOA errors of the target device.
+const (
+	OTDOACauseAssistanceDataMissing = iota + 1
+	OTDOACauseUnableToMeasureReferenceCell
+	OTDOACauseUnableToMeasureAnyNeighbourCell
+	OTDOACauseAttemptedButUnableToMeasureSomeNeighbourCells
+)
+
+// errorCauses are the numbers of root causes of the location server and of the target device for a positioning
+// method, and the number of measurements which the target device can report as not possible.
+type errorCauses struct {
+	server, target, notPossible int
+}
+
+var (
+	agnssErrorCauses = errorCauses{server: 4, target: 4, notPossible: 3}
+	otdoaErrorCauses = errorCauses{server: 3, target: 5}
+	ecidErrorCauses  = errorCauses{server: 1, target: 3, notPossible: 3}
+)
+
+// encode writes the error CHOICE of a positioning method.
+func (p *PositioningError) encode(e *per.Encoder, causes errorCauses) error {
+	index, count, notPossible := 0, causes.server, 0
+	if p.TargetDevice {
+		index, count, notPossible = 1, causes.target, causes.notPossible
+	}
+	return e.WriteChoice(index, 2, true, func(e *per.Encoder) error {
+		writeExtensible(e)
+		for i := 0; i < notPossible; i++ {
+			e.WriteBool(p.NotPossible&(1<<uint(i)) != 0)
+		}
+		return e.WriteEnumerated(p.Cause, count, true)
+	})
+}
+
+// decode reads the error CHOICE of a positioning method.
+func (p *PositioningError) decode(d *per.Decoder, causes errorCauses) error {
+	index, _, err := d.ReadChoice(2, true)
+	if err != nil {
+		return err
+	}
+	if index >= 2 {
+		return unsupported(nil, "positioning error")
+	}
+	count, notPossible := causes.server, 0
+	if p.TargetDevice = index == 1; p.TargetDevice {
+		count, notPossible = causes.target, causes.notPossible
+	}
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, notPossible)
+	if err != nil {
+		return err
+	}
+	for i, set := range present {
+		if set {
+			p.NotPossible |= 1 << uint(i)
+		}
+	}
+	if p.Cause, err = d.ReadEnumerated(count, true); err != nil {
+		return err
+	}
+	return skip()
+}
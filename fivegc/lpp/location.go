@@ -0,0 +1,1121 @@
+package lpp
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+)
+
+// RequestLocationInformation is the RequestLocationInformation message, sent by the location server to request
+// location information, either an estimate or measurements, for the positioning methods whose fields are not nil.
+type RequestLocationInformation struct {
+	CommonIEs *CommonIEsRequestLocationInformation
+	AGNSS     *AGNSSRequestLocationInformation
+	OTDOA     *OTDOARequestLocationInformation
+	ECID      *ECIDRequestLocationInformation
+}
+
+// LocationInformationType is the type of location information requested.
+type LocationInformationType int
+
+const (
+	LocationEstimateRequired LocationInformationType = iota
+	LocationMeasurementsRequired
+	LocationEstimatePreferred
+	LocationMeasurementsPreferred
+)
+
+// CommonIEsRequestLocationInformation are the common information elements of RequestLocationInformation.
+type CommonIEsRequestLocationInformation struct {
+	LocationInformationType LocationInformationType
+	TriggeredReporting      *TriggeredReportingCriteria
+	PeriodicalReporting     *PeriodicalReportingCriteria
+	AdditionalInformation   *AdditionalInformation
+	QoS                     *QoS
+	Environment             *Environment
+	LocationCoordinateTypes *LocationCoordinateTypes
+	VelocityTypes           *VelocityTypes
+}
+
+// TriggeredReportingCriteria requests a report on a change of primary cell, for E-CID.
+type TriggeredReportingCriteria struct {
+	CellChange bool
+	// ReportingDuration is the maximum duration of the triggered reporting in seconds, 0 for an unlimited duration.
+	ReportingDuration uint8
+}
+
+// ReportingAmount is the number of periodic reports.
+type ReportingAmount int
+
+const (
+	ReportingAmount1 ReportingAmount = iota
+	ReportingAmount2
+	ReportingAmount4
+	ReportingAmount8
+	ReportingAmount16
+	ReportingAmount32
+	ReportingAmount64
+	ReportingAmountInfinity
+	reportingAmounts
+)
+
+// ReportingInterval is the interval between periodic reports.
+type ReportingInterval int
+
+const (
+	NoPeriodicalReporting ReportingInterval = iota
+	ReportingInterval250ms
+	ReportingInterval500ms
+	ReportingInterval1s
+	ReportingInterval2s
+	ReportingInterval4s
+	ReportingInterval8s
+	ReportingInterval16s
+	ReportingInterval32s
+	ReportingInterval64s
+	reportingIntervals
+)
+
+// PeriodicalReportingCriteria requests periodic reports.
+type PeriodicalReportingCriteria struct {
+	ReportingAmount   ReportingAmount
+	ReportingInterval ReportingInterval
+}
+
+// AdditionalInformation allows or not the target device to return additional information.
+type AdditionalInformation int
+
+const (
+	OnlyReturnInformationRequested AdditionalInformation = iota
+	MayReturnAdditionalInformation
+)
+
+// Environment is the expected multipath and non line of sight environment.
+type Environment int
+
+const (
+	EnvironmentBadArea Environment = iota
+	EnvironmentNotBadArea
+	EnvironmentMixedArea
+)
+
+// Accuracy is a horizontal or vertical accuracy, with the uncertainty codes of TS 23.032 clause 6.2 for the horizontal
+// accuracy and clause 6.4 for the vertical one.
+type Accuracy struct {
+	Accuracy   uint8
+	Confidence int32
+}
+
+// QoS is the quality of service of the location information.
+type QoS struct {
+	HorizontalAccuracy        *Accuracy
+	VerticalCoordinateRequest bool
+	VerticalAccuracy          *Accuracy
+	// ResponseTime is the maximum response time in seconds, in [1, 128].
+	ResponseTime    *int32
+	VelocityRequest bool
+}
+
+// NewQoS returns the QoS of a LocationQoS of the Nlmf_Location service, with the confidence of the accuracies which is
+// not part of it. The response time class of the LocationQoS has no equivalent and is left to the caller.
+func NewQoS(q nlmfocation.LocationQoS, confidence int32) QoS {
+	qos := QoS{VerticalCoordinateRequest: q.GetVerticalRequested()}
+	if q.HasHAccuracy() {
+		qos.HorizontalAccuracy = &Accuracy{Accuracy: gad.UncertaintyCode(float64(q.GetHAccuracy())), Confidence: confidence}
+	}
+	if q.HasVAccuracy() {
+		qos.VerticalAccuracy = &Accuracy{Accuracy: gad.AltitudeUncertaintyCode(float64(q.GetVAccuracy())), Confidence: confidence}
+	}
+	return qos
+}
+
+// LocationCoordinateTypes are the shapes allowed in the location estimate.
+type LocationCoordinateTypes struct {
+	EllipsoidPoint                                    bool
+	EllipsoidPointWithUncertaintyCircle               bool
+	EllipsoidPointWithUncertaintyEllipse              bool
+	Polygon                                           bool
+	EllipsoidPointWithAltitude                        bool
+	EllipsoidPointWithAltitudeAndUncertaintyEllipsoid bool
+	EllipsoidArc                                      bool
+}
+
+func (t *LocationCoordinateTypes) fields() []*bool {
+	return []*bool{&t.EllipsoidPoint, &t.EllipsoidPointWithUncertaintyCircle, &t.EllipsoidPointWithUncertaintyEllipse,
+		&t.Polygon, &t.EllipsoidPointWithAltitude, &t.EllipsoidPointWithAltitudeAndUncertaintyEllipsoid, &t.EllipsoidArc}
+}
+
+// VelocityTypes are the velocity types allowed in the velocity estimate.
+type VelocityTypes struct {
+	HorizontalVelocity                           bool
+	HorizontalWithVerticalVelocity               bool
+	HorizontalVelocityWithUncertainty            bool
+	HorizontalWithVerticalVelocityAndUncertainty bool
+}
+
+func (t *VelocityTypes) fields() []*bool {
+	return []*bool{&t.HorizontalVelocity, &t.HorizontalWithVerticalVelocity, &t.HorizontalVelocityWithUncertainty,
+		&t.HorizontalWithVerticalVelocityAndUncertainty}
+}
+
+// GNSSIDs is the bit mask of GNSS.
+type GNSSIDs uint16
+
+const (
+	GNSSGPS GNSSIDs = 1 << iota
+	GNSSSBAS
+	GNSSQZSS
+	GNSSGalileo
+	GNSSGLONASS
+	GNSSBDS
+	GNSSNavIC
+)
+
+// AGNSSRequestLocationInformation requests A-GNSS location information.
+type AGNSSRequestLocationInformation struct {
+	GNSSMethods               GNSSIDs
+	FineTimeAssistanceMeasReq bool
+	ADRMeasReq                bool
+	MultiFreqMeasReq          bool
+	AssistanceAvailability    bool
+}
+
+// OTDOARequestLocationInformation requests OTDOA location information.
+type OTDOARequestLocationInformation struct {
+	AssistanceAvailability bool
+}
+
+// ECIDRequestLocationInformation requests E-CID measurements.
+type ECIDRequestLocationInformation struct {
+	RequestedMeasurements ECIDMeasurements
+}
+
+func (r *RequestLocationInformation) messageType() int {
+	return messageRequestLocationInformation
+}
+
+func (r *RequestLocationInformation) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(r.CommonIEs != nil)
+	e.WriteBool(r.AGNSS != nil)
+	e.WriteBool(r.OTDOA != nil)
+	e.WriteBool(r.ECID != nil)
+	e.WriteBool(false) // epdu-RequestLocationInformation
+	if r.CommonIEs != nil {
+		if err := r.CommonIEs.encode(e); err != nil {
+			return err
+		}
+	}
+	if r.AGNSS != nil {
+		writeExtensible(e)
+		writeExtensible(e)
+		writeExtensible(e)
+		if err := e.WriteBitString(namedBits(uint64(r.AGNSS.GNSSMethods), 1), 1, 16, false); err != nil {
+			return err
+		}
+		e.WriteBool(r.AGNSS.FineTimeAssistanceMeasReq)
+		e.WriteBool(r.AGNSS.ADRMeasReq)
+		e.WriteBool(r.AGNSS.MultiFreqMeasReq)
+		e.WriteBool(r.AGNSS.AssistanceAvailability)
+	}
+	if r.OTDOA != nil {
+		writeExtensible(e)
+		e.WriteBool(r.OTDOA.AssistanceAvailability)
+	}
+	if r.ECID != nil {
+		writeExtensible(e)
+		if err := e.WriteBitString(namedBits(uint64(r.ECID.RequestedMeasurements), 1), 1, 8, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CommonIEsRequestLocationInformation) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(c.TriggeredReporting != nil)
+	e.WriteBool(c.PeriodicalReporting != nil)
+	e.WriteBool(c.AdditionalInformation != nil)
+	e.WriteBool(c.QoS != nil)
+	e.WriteBool(c.Environment != nil)
+	e.WriteBool(c.LocationCoordinateTypes != nil)
+	e.WriteBool(c.VelocityTypes != nil)
+	if err := e.WriteEnumerated(int(c.LocationInformationType), 4, true); err != nil {
+		return err
+	}
+	if c.TriggeredReporting != nil {
+		writeExtensible(e)
+		e.WriteBool(c.TriggeredReporting.CellChange)
+		e.WriteBits(uint64(c.TriggeredReporting.ReportingDuration), 8)
+	}
+	if c.PeriodicalReporting != nil {
+		// The reporting amount is absent when it is its default value, ra-Infinity.
+		amount := c.PeriodicalReporting.ReportingAmount
+		e.WriteBool(amount != ReportingAmountInfinity)
+		if amount != ReportingAmountInfinity {
+			if err := e.WriteEnumerated(int(amount), int(reportingAmounts), false); err != nil {
+				return err
+			}
+		}
+		if err := e.WriteEnumerated(int(c.PeriodicalReporting.ReportingInterval), int(reportingIntervals), false); err != nil {
+			return err
+		}
+	}
+	if c.AdditionalInformation != nil {
+		if err := e.WriteEnumerated(int(*c.AdditionalInformation), 2, true); err != nil {
+			return err
+		}
+	}
+	if c.QoS != nil {
+		if err := c.QoS.encode(e); err != nil {
+			return err
+		}
+	}
+	if c.Environment != nil {
+		if err := e.WriteEnumerated(int(*c.Environment), 3, true); err != nil {
+			return err
+		}
+	}
+	if c.LocationCoordinateTypes != nil {
+		writeExtensible(e)
+		for _, b := range c.LocationCoordinateTypes.fields() {
+			e.WriteBool(*b)
+		}
+	}
+	if c.VelocityTypes != nil {
+		writeExtensible(e)
+		for _, b := range c.VelocityTypes.fields() {
+			e.WriteBool(*b)
+		}
+	}
+	return nil
+}
+
+func (q *QoS) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(q.HorizontalAccuracy != nil)
+	e.WriteBool(q.VerticalAccuracy != nil)
+	e.WriteBool(q.ResponseTime != nil)
+	if q.HorizontalAccuracy != nil {
+		if err := q.HorizontalAccuracy.encode(e); err != nil {
+			return err
+		}
+	}
+	e.WriteBool(q.VerticalCoordinateRequest)
+	if q.VerticalAccuracy != nil {
+		if err := q.VerticalAccuracy.encode(e); err != nil {
+			return err
+		}
+	}
+	if q.ResponseTime != nil {
+		writeExtensible(e)
+		if err := e.WriteConstrainedInt(int64(*q.ResponseTime), 1, 128); err != nil {
+			return err
+		}
+	}
+	e.WriteBool(q.VelocityRequest)
+	return nil
+}
+
+func (a *Accuracy) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	if err := e.WriteConstrainedInt(int64(a.Accuracy), 0, gad.MaxUncertaintyCode); err != nil {
+		return err
+	}
+	return e.WriteConstrainedInt(int64(a.Confidence), 0, gad.MaxConfidence)
+}
+
+func (r *RequestLocationInformation) decode(d *per.Decoder) error {
+	skipExtensions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 5)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		r.CommonIEs = &CommonIEsRequestLocationInformation{}
+		if err := r.CommonIEs.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		r.AGNSS = &AGNSSRequestLocationInformation{}
+		if err := r.AGNSS.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[2] {
+		r.OTDOA = &OTDOARequestLocationInformation{}
+		skip, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		if r.OTDOA.AssistanceAvailability, err = d.ReadBool(); err != nil {
+			return err
+		}
+		if err := skip(); err != nil {
+			return err
+		}
+	}
+	if present[3] {
+		measurements, err := readNamedBits(d)
+		if err != nil {
+			return err
+		}
+		r.ECID = &ECIDRequestLocationInformation{RequestedMeasurements: ECIDMeasurements(measurements)}
+	}
+	if present[4] {
+		return fmt.Errorf("%w: epdu-RequestLocationInformation", ErrUnsupported)
+	}
+	return skipExtensions()
+}
+
+func (a *AGNSSRequestLocationInformation) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	skipInstructions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	skipMethods, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	methods, err := d.ReadBitString(1, 16, false)
+	if err != nil {
+		return err
+	}
+	a.GNSSMethods = GNSSIDs(bitMask(methods))
+	if err := skipMethods(); err != nil {
+		return err
+	}
+	for _, b := range []*bool{&a.FineTimeAssistanceMeasReq, &a.ADRMeasReq, &a.MultiFreqMeasReq, &a.AssistanceAvailability} {
+		if *b, err = d.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if err := skipInstructions(); err != nil {
+		return err
+	}
+	return skip()
+}
+
+func (c *CommonIEsRequestLocationInformation) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 7)
+	if err != nil {
+		return err
+	}
+	locationInformationType, err := d.ReadEnumerated(4, true)
+	if err != nil {
+		return err
+	}
+	c.LocationInformationType = LocationInformationType(locationInformationType)
+	if present[0] {
+		c.TriggeredReporting = &TriggeredReportingCriteria{}
+		skipTriggered, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		if c.TriggeredReporting.CellChange, err = d.ReadBool(); err != nil {
+			return err
+		}
+		duration, err := d.ReadBits(8)
+		if err != nil {
+			return err
+		}
+		c.TriggeredReporting.ReportingDuration = uint8(duration)
+		if err := skipTriggered(); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		c.PeriodicalReporting = &PeriodicalReportingCriteria{ReportingAmount: ReportingAmountInfinity}
+		amount, err := d.ReadBool()
+		if err != nil {
+			return err
+		}
+		if amount {
+			index, err := d.ReadEnumerated(int(reportingAmounts), false)
+			if err != nil {
+				return err
+			}
+			c.PeriodicalReporting.ReportingAmount = ReportingAmount(index)
+		}
+		interval, err := d.ReadEnumerated(int(reportingIntervals), false)
+		if err != nil {
+			return err
+		}
+		c.PeriodicalReporting.ReportingInterval = ReportingInterval(interval)
+	}
+	if present[2] {
+		information, err := d.ReadEnumerated(2, true)
+		if err != nil {
+			return err
+		}
+		c.AdditionalInformation = (*AdditionalInformation)(&information)
+	}
+	if present[3] {
+		c.QoS = &QoS{}
+		if err := c.QoS.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[4] {
+		environment, err := d.ReadEnumerated(3, true)
+		if err != nil {
+			return err
+		}
+		c.Environment = (*Environment)(&environment)
+	}
+	if present[5] {
+		c.LocationCoordinateTypes = &LocationCoordinateTypes{}
+		if err := readBools(d, c.LocationCoordinateTypes.fields()); err != nil {
+			return err
+		}
+	}
+	if present[6] {
+		c.VelocityTypes = &VelocityTypes{}
+		if err := readBools(d, c.VelocityTypes.fields()); err != nil {
+			return err
+		}
+	}
+	return skip()
+}
+
+func (q *QoS) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 3)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		q.HorizontalAccuracy = &Accuracy{}
+		if err := q.HorizontalAccuracy.decode(d); err != nil {
+			return err
+		}
+	}
+	if q.VerticalCoordinateRequest, err = d.ReadBool(); err != nil {
+		return err
+	}
+	if present[1] {
+		q.VerticalAccuracy = &Accuracy{}
+		if err := q.VerticalAccuracy.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[2] {
+		skipTime, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		time, err := d.ReadConstrainedInt(1, 128)
+		if err != nil {
+			return err
+		}
+		q.ResponseTime = toInt32(int32(time))
+		if err := skipTime(); err != nil {
+			return err
+		}
+	}
+	if q.VelocityRequest, err = d.ReadBool(); err != nil {
+		return err
+	}
+	return skip()
+}
+
+func (a *Accuracy) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	accuracy, err := d.ReadConstrainedInt(0, gad.MaxUncertaintyCode)
+	if err != nil {
+		return err
+	}
+	confidence, err := d.ReadConstrainedInt(0, gad.MaxConfidence)
+	if err != nil {
+		return err
+	}
+	a.Accuracy, a.Confidence = uint8(accuracy), int32(confidence)
+	return skip()
+}
+
+// readBools decodes an extensible SEQUENCE of BOOLEAN components.
+func readBools(d *per.Decoder, fields []*bool) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	for _, b := range fields {
+		if *b, err = d.ReadBool(); err != nil {
+			return err
+		}
+	}
+	return skip()
+}
+
+// ProvideLocationInformation is the ProvideLocationInformation message, sent by the target device with its location
+// information.
+type ProvideLocationInformation struct {
+	CommonIEs *CommonIEsProvideLocationInformation
+	ECID      *ECIDProvideLocationInformation
+}
+
+// LocationFailureCause is the cause of a location error.
+type LocationFailureCause int
+
+const (
+	LocationFailureUndefined LocationFailureCause = iota
+	LocationFailureRequestedMethodNotSupported
+	LocationFailurePositionMethodFailure
+	LocationFailurePeriodicLocationMeasurementsNotAvailable
+)
+
+// CommonIEsProvideLocationInformation are the common information elements of ProvideLocationInformation.
+type CommonIEsProvideLocationInformation struct {
+	// LocationEstimate is the location estimate, local shapes are not supported.
+	LocationEstimate gad.Shape
+	VelocityEstimate *Velocity
+	LocationError    *LocationFailureCause
+}
+
+// LocationData returns the LocationData of the location estimate, to answer a DetermineLocation request.
+func (c CommonIEsProvideLocationInformation) LocationData() (nlmfocation.LocationData, error) {
+	if c.LocationEstimate == nil {
+		return nlmfocation.LocationData{}, fmt.Errorf("lpp: no location estimate")
+	}
+	area, err := gad.GeographicArea(c.LocationEstimate)
+	if err != nil {
+		return nlmfocation.LocationData{}, err
+	}
+	return nlmfocation.LocationData{LocationEstimate: area}, nil
+}
+
+// VerticalDirection is the direction of the vertical speed.
+type VerticalDirection int
+
+const (
+	Upward VerticalDirection = iota
+	Downward
+)
+
+// Velocity is a velocity estimate. The alternative of the Velocity CHOICE is selected by the vertical speed and the
+// horizontal uncertainty speed: the vertical uncertainty speed is required when both are set.
+type Velocity struct {
+	// Bearing is the direction of the horizontal speed in degrees clockwise from the north, in [0, 359].
+	Bearing int32
+	// HorizontalSpeed is in kilometers per hour, in [0, 2047].
+	HorizontalSpeed            int32
+	VerticalDirection          VerticalDirection
+	VerticalSpeed              *int32
+	HorizontalUncertaintySpeed *int32
+	VerticalUncertaintySpeed   *int32
+}
+
+// Indexes of the root alternatives of Velocity.
+const (
+	horizontalVelocity = iota
+	horizontalWithVerticalVelocity
+	horizontalVelocityWithUncertainty
+	horizontalWithVerticalVelocityAndUncertainty
+	velocityTypes
+)
+
+func (v *Velocity) encode(e *per.Encoder) error {
+	index := horizontalVelocity
+	switch {
+	case v.VerticalSpeed != nil && v.HorizontalUncertaintySpeed != nil:
+		if v.VerticalUncertaintySpeed == nil {
+			return fmt.Errorf("velocity without vertical uncertainty speed")
+		}
+		index = horizontalWithVerticalVelocityAndUncertainty
+	case v.VerticalSpeed != nil:
+		index = horizontalWithVerticalVelocity
+	case v.HorizontalUncertaintySpeed != nil:
+		index = horizontalVelocityWithUncertainty
+	}
+	return e.WriteChoice(index, velocityTypes, true, func(e *per.Encoder) error {
+		if err := e.WriteConstrainedInt(int64(v.Bearing), 0, 359); err != nil {
+			return err
+		}
+		if err := e.WriteConstrainedInt(int64(v.HorizontalSpeed), 0, 2047); err != nil {
+			return err
+		}
+		speeds := []*int32{v.HorizontalUncertaintySpeed}
+		if v.VerticalSpeed != nil {
+			if err := e.WriteEnumerated(int(v.VerticalDirection), 2, false); err != nil {
+				return err
+			}
+			speeds = []*int32{v.VerticalSpeed, v.HorizontalUncertaintySpeed, v.VerticalUncertaintySpeed}
+		}
+		for _, speed := range speeds {
+			if speed == nil {
+				continue
+			}
+			if err := e.WriteConstrainedInt(int64(*speed), 0, 255); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (v *Velocity) decode(d *per.Decoder) error {
+	index, _, err := d.ReadChoice(velocityTypes, true)
+	if err != nil {
+		return err
+	}
+	if index >= velocityTypes {
+		return fmt.Errorf("%w: velocity %d", ErrUnsupported, index)
+	}
+	bearing, err := d.ReadConstrainedInt(0, 359)
+	if err != nil {
+		return err
+	}
+	speed, err := d.ReadConstrainedInt(0, 2047)
+	if err != nil {
+		return err
+	}
+	v.Bearing, v.HorizontalSpeed = int32(bearing), int32(speed)
+	var speeds []**int32
+	switch index {
+	case horizontalWithVerticalVelocity:
+		speeds = []**int32{&v.VerticalSpeed}
+	case horizontalVelocityWithUncertainty:
+		speeds = []**int32{&v.HorizontalUncertaintySpeed}
+	case horizontalWithVerticalVelocityAndUncertainty:
+		speeds = []**int32{&v.VerticalSpeed, &v.HorizontalUncertaintySpeed, &v.VerticalUncertaintySpeed}
+	}
+	if index == horizontalWithVerticalVelocity || index == horizontalWithVerticalVelocityAndUncertainty {
+		direction, err := d.ReadEnumerated(2, false)
+		if err != nil {
+			return err
+		}
+		v.VerticalDirection = VerticalDirection(direction)
+	}
+	for _, s := range speeds {
+		speed, err := d.ReadConstrainedInt(0, 255)
+		if err != nil {
+			return err
+		}
+		*s = toInt32(int32(speed))
+	}
+	return nil
+}
+
+// ECIDProvideLocationInformation are the E-CID measurements of the target device, or the reason why they are missing.
+type ECIDProvideLocationInformation struct {
+	SignalMeasurementInformation *ECIDSignalMeasurementInformation
+	Error                        *PositioningError
+}
+
+// ECIDSignalMeasurementInformation are the E-CID measurements of the primary cell and of the measured cells.
+type ECIDSignalMeasurementInformation struct {
+	PrimaryCellMeasuredResults *MeasuredResults
+	// MeasuredResultsList has between 1 and 32 elements.
+	MeasuredResultsList []MeasuredResults
+}
+
+// MeasuredResults are the E-CID measurements of a cell.
+type MeasuredResults struct {
+	PhysCellID   int32
+	CellGlobalID *CellGlobalID
+	ARFCN        int32
+	// SystemFrameNumber is the 10 bits system frame number of the measurement.
+	SystemFrameNumber *uint16
+	// RSRPResult is in [0, 97] and RSRQResult in [0, 34], as reported by TS 36.133.
+	RSRPResult     *int32
+	RSRQResult     *int32
+	UERxTxTimeDiff *int32
+}
+
+// CellGlobalID is the global identity of an E-UTRA or UTRA cell.
+type CellGlobalID struct {
+	// MCC is the mobile country code of 3 digits, and MNC the mobile network code of 2 or 3 digits.
+	MCC string
+	MNC string
+	// CellIdentity is the E-UTRA cell identity of 28 bits, or the UTRA cell identity of 32 bits when UTRA is true.
+	CellIdentity uint32
+	UTRA         bool
+}
+
+// Maximum number of measured cells in an ECIDSignalMeasurementInformation.
+const maxMeasuredResults = 32
+
+func (p *ProvideLocationInformation) messageType() int {
+	return messageProvideLocationInformation
+}
+
+func (p *ProvideLocationInformation) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(p.CommonIEs != nil)
+	e.WriteBool(false) // a-gnss-ProvideLocationInformation
+	e.WriteBool(false) // otdoa-ProvideLocationInformation
+	e.WriteBool(p.ECID != nil)
+	e.WriteBool(false) // epdu-ProvideLocationInformation
+	if p.CommonIEs != nil {
+		if err := p.CommonIEs.encode(e); err != nil {
+			return err
+		}
+	}
+	if p.ECID != nil {
+		writeExtensible(e)
+		e.WriteBool(p.ECID.SignalMeasurementInformation != nil)
+		e.WriteBool(p.ECID.Error != nil)
+		if p.ECID.SignalMeasurementInformation != nil {
+			if err := p.ECID.SignalMeasurementInformation.encode(e); err != nil {
+				return err
+			}
+		}
+		if p.ECID.Error != nil {
+			if err := p.ECID.Error.encode(e, ecidErrorCauses); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *CommonIEsProvideLocationInformation) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(c.LocationEstimate != nil)
+	e.WriteBool(c.VelocityEstimate != nil)
+	e.WriteBool(c.LocationError != nil)
+	if c.LocationEstimate != nil {
+		if err := writeLocationCoordinates(e, c.LocationEstimate); err != nil {
+			return err
+		}
+	}
+	if c.VelocityEstimate != nil {
+		if err := c.VelocityEstimate.encode(e); err != nil {
+			return err
+		}
+	}
+	if c.LocationError != nil {
+		writeExtensible(e)
+		return e.WriteEnumerated(int(*c.LocationError), 4, true)
+	}
+	return nil
+}
+
+func (s *ECIDSignalMeasurementInformation) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(s.PrimaryCellMeasuredResults != nil)
+	if s.PrimaryCellMeasuredResults != nil {
+		if err := s.PrimaryCellMeasuredResults.encode(e); err != nil {
+			return err
+		}
+	}
+	if err := e.WriteLength(len(s.MeasuredResultsList), 1, maxMeasuredResults); err != nil {
+		return err
+	}
+	for i := range s.MeasuredResultsList {
+		if err := s.MeasuredResultsList[i].encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MeasuredResults) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	e.WriteBool(m.CellGlobalID != nil)
+	e.WriteBool(m.SystemFrameNumber != nil)
+	e.WriteBool(m.RSRPResult != nil)
+	e.WriteBool(m.RSRQResult != nil)
+	e.WriteBool(m.UERxTxTimeDiff != nil)
+	if err := e.WriteConstrainedInt(int64(m.PhysCellID), 0, 503); err != nil {
+		return err
+	}
+	if m.CellGlobalID != nil {
+		if err := m.CellGlobalID.encode(e); err != nil {
+			return err
+		}
+	}
+	if err := e.WriteConstrainedInt(int64(m.ARFCN), 0, 65535); err != nil {
+		return err
+	}
+	if m.SystemFrameNumber != nil {
+		if *m.SystemFrameNumber >= 1<<10 {
+			return fmt.Errorf("system frame number %d out of 10 bits", *m.SystemFrameNumber)
+		}
+		e.WriteBits(uint64(*m.SystemFrameNumber), 10)
+	}
+	for _, result := range []struct {
+		value *int32
+		ub    int64
+	}{{m.RSRPResult, 97}, {m.RSRQResult, 34}, {m.UERxTxTimeDiff, 4095}} {
+		if result.value == nil {
+			continue
+		}
+		if err := e.WriteConstrainedInt(int64(*result.value), 0, result.ub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CellGlobalID) encode(e *per.Encoder) error {
+	writeExtensible(e)
+	if len(c.MCC) != 3 {
+		return fmt.Errorf("MCC %q is not of 3 digits", c.MCC)
+	}
+	if err := writeDigits(e, c.MCC); err != nil {
+		return err
+	}
+	if err := e.WriteLength(len(c.MNC), 2, 3); err != nil {
+		return fmt.Errorf("MNC %q is not of 2 or 3 digits", c.MNC)
+	}
+	if err := writeDigits(e, c.MNC); err != nil {
+		return err
+	}
+	index, length := 0, 28
+	if c.UTRA {
+		index, length = 1, 32
+	}
+	if uint64(c.CellIdentity)>>uint(length) != 0 {
+		return fmt.Errorf("cell identity %d out of %d bits", c.CellIdentity, length)
+	}
+	return e.WriteChoice(index, 2, false, func(e *per.Encoder) error {
+		e.WriteBits(uint64(c.CellIdentity), length)
+		return nil
+	})
+}
+
+func (p *ProvideLocationInformation) decode(d *per.Decoder) error {
+	skipExtensions, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 5)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		p.CommonIEs = &CommonIEsProvideLocationInformation{}
+		if err := p.CommonIEs.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		return fmt.Errorf("%w: a-gnss-ProvideLocationInformation", ErrUnsupported)
+	}
+	if present[2] {
+		return fmt.Errorf("%w: otdoa-ProvideLocationInformation", ErrUnsupported)
+	}
+	if present[3] {
+		p.ECID = &ECIDProvideLocationInformation{}
+		skip, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		ecid, err := readPresence(d, 2)
+		if err != nil {
+			return err
+		}
+		if ecid[0] {
+			p.ECID.SignalMeasurementInformation = &ECIDSignalMeasurementInformation{}
+			if err := p.ECID.SignalMeasurementInformation.decode(d); err != nil {
+				return err
+			}
+		}
+		if ecid[1] {
+			p.ECID.Error = &PositioningError{}
+			if err := p.ECID.Error.decode(d, ecidErrorCauses); err != nil {
+				return err
+			}
+		}
+		if err := skip(); err != nil {
+			return err
+		}
+	}
+	if present[4] {
+		return fmt.Errorf("%w: epdu-ProvideLocationInformation", ErrUnsupported)
+	}
+	return skipExtensions()
+}
+
+func (c *CommonIEsProvideLocationInformation) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 3)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		if c.LocationEstimate, err = readLocationCoordinates(d); err != nil {
+			return err
+		}
+	}
+	if present[1] {
+		c.VelocityEstimate = &Velocity{}
+		if err := c.VelocityEstimate.decode(d); err != nil {
+			return err
+		}
+	}
+	if present[2] {
+		skipError, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		cause, err := d.ReadEnumerated(4, true)
+		if err != nil {
+			return err
+		}
+		c.LocationError = (*LocationFailureCause)(&cause)
+		if err := skipError(); err != nil {
+			return err
+		}
+	}
+	return skip()
+}
+
+func (s *ECIDSignalMeasurementInformation) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	primary, err := d.ReadBool()
+	if err != nil {
+		return err
+	}
+	if primary {
+		s.PrimaryCellMeasuredResults = &MeasuredResults{}
+		if err := s.PrimaryCellMeasuredResults.decode(d); err != nil {
+			return err
+		}
+	}
+	n, err := d.ReadLength(1, maxMeasuredResults)
+	if err != nil {
+		return err
+	}
+	s.MeasuredResultsList = make([]MeasuredResults, n)
+	for i := range s.MeasuredResultsList {
+		if err := s.MeasuredResultsList[i].decode(d); err != nil {
+			return err
+		}
+	}
+	return skip()
+}
+
+func (m *MeasuredResults) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 5)
+	if err != nil {
+		return err
+	}
+	physCellID, err := d.ReadConstrainedInt(0, 503)
+	if err != nil {
+		return err
+	}
+	m.PhysCellID = int32(physCellID)
+	if present[0] {
+		m.CellGlobalID = &CellGlobalID{}
+		if err := m.CellGlobalID.decode(d); err != nil {
+			return err
+		}
+	}
+	arfcn, err := d.ReadConstrainedInt(0, 65535)
+	if err != nil {
+		return err
+	}
+	m.ARFCN = int32(arfcn)
+	if present[1] {
+		frame, err := d.ReadBits(10)
+		if err != nil {
+			return err
+		}
+		m.SystemFrameNumber = toUint16(uint16(frame))
+	}
+	for i, result := range []struct {
+		value **int32
+		ub    int64
+	}{{&m.RSRPResult, 97}, {&m.RSRQResult, 34}, {&m.UERxTxTimeDiff, 4095}} {
+		if !present[2+i] {
+			continue
+		}
+		v, err := d.ReadConstrainedInt(0, result.ub)
+		if err != nil {
+			return err
+		}
+		*result.value = toInt32(int32(v))
+	}
+	return skip()
+}
+
+func (c *CellGlobalID) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	if c.MCC, err = readDigits(d, 3); err != nil {
+		return err
+	}
+	mnc, err := d.ReadLength(2, 3)
+	if err != nil {
+		return err
+	}
+	if c.MNC, err = readDigits(d, mnc); err != nil {
+		return err
+	}
+	index, _, err := d.ReadChoice(2, false)
+	if err != nil {
+		return err
+	}
+	length := 28
+	if c.UTRA = index == 1; c.UTRA {
+		length = 32
+	}
+	identity, err := d.ReadBits(length)
+	if err != nil {
+		return err
+	}
+	c.CellIdentity = uint32(identity)
+	return skip()
+}
+
+func writeDigits(e *per.Encoder, digits string) error {
+	for _, digit := range digits {
+		if err := e.WriteConstrainedInt(int64(digit-'0'), 0, 9); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readDigits(d *per.Decoder, n int) (string, error) {
+	digits := make([]byte, n)
+	for i := range digits {
+		digit, err := d.ReadConstrainedInt(0, 9)
+		if err != nil {
+			return "", err
+		}
+		digits[i] = byte('0' + digit)
+	}
+	return string(digits), nil
+}
+
+func toInt32(v int32) *int32 {
+	return &v
+}
+
+func toUint16(v uint16) *uint16 {
+	return &v
+}
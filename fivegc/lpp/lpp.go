@@ -0,0 +1,285 @@
+// Package lpp implements the LTE Positioning Protocol of TS 37.355, exchanged between the LMF and the UE in the
+// N1 LPP containers of the Namf_Communication service (TS 23.273 clause 6.11.1).
+//
+// Messages are encoded with the UNALIGNED variant of the Packed Encoding Rules, as required by TS 37.355 clause 6.1.
+// The package covers the messages used to determine the location of a UE:
+//   - RequestCapabilities and ProvideCapabilities, with the common, A-GNSS, OTDOA and E-CID capabilities, except the
+//     A-GNSS capabilities of the target device;
+//   - RequestLocationInformation and ProvideLocationInformation, with the common information elements, the A-GNSS,
+//     OTDOA and E-CID requests and the E-CID measurements;
+//   - ProvideAssistanceData, limited to the A-GNSS and OTDOA errors.
+//
+// Extension additions are never encoded and are skipped when decoding. Decoding a message or an information element
+// which is not covered, such as an EPDU, returns ErrUnsupported.
+package lpp
+
+import (
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// ErrUnsupported is returned when decoding a message or an information element not covered by the package.
+var ErrUnsupported = errors.New("lpp: unsupported message or information element")
+
+// Initiator is the initiator of an LPP transaction.
+type Initiator int
+
+const (
+	InitiatorLocationServer Initiator = iota
+	InitiatorTargetDevice
+)
+
+// TransactionID identifies an LPP transaction.
+type TransactionID struct {
+	Initiator         Initiator
+	TransactionNumber uint8
+}
+
+// Acknowledgement requests or carries the acknowledgement of an LPP message.
+type Acknowledgement struct {
+	AckRequested bool
+	AckIndicator *uint8
+}
+
+// Message is an LPP-Message.
+type Message struct {
+	TransactionID   *TransactionID
+	EndTransaction  bool
+	SequenceNumber  *uint8
+	Acknowledgement *Acknowledgement
+	// Body is the body of the message, nil for a message only carrying an acknowledgement.
+	Body Body
+}
+
+// Body is the body of an LPP message: *RequestCapabilities, *ProvideCapabilities, *RequestLocationInformation,
+// *ProvideLocationInformation or *ProvideAssistanceData.
+type Body interface {
+	// messageType returns the index of the message in the c1 choice of LPP-MessageBody.
+	messageType() int
+	encode(*per.Encoder) error
+}
+
+// Indexes of the messages in the c1 choice of LPP-MessageBody.
+const (
+	messageRequestCapabilities = iota
+	messageProvideCapabilities
+	messageRequestAssistanceData
+	messageProvideAssistanceData
+	messageRequestLocationInformation
+	messageProvideLocationInformation
+	messageAbort
+	messageError
+	messageTypes = 16
+)
+
+// Encode returns the encoding of an LPP message.
+func Encode(m Message) ([]byte, error) {
+	e := per.NewEncoder(false)
+	if err := m.encode(e); err != nil {
+		return nil, fmt.Errorf("lpp: %w", err)
+	}
+	return e.Bytes(), nil
+}
+
+// Decode decodes an LPP message.
+func Decode(b []byte) (Message, error) {
+	var m Message
+	if err := m.decode(per.NewDecoder(b, false)); err != nil {
+		if errors.Is(err, ErrUnsupported) {
+			return Message{}, err
+		}
+		return Message{}, fmt.Errorf("lpp: %w", err)
+	}
+	return m, nil
+}
+
+func (m Message) encode(e *per.Encoder) error {
+	e.WriteBool(m.TransactionID != nil)
+	e.WriteBool(m.SequenceNumber != nil)
+	e.WriteBool(m.Acknowledgement != nil)
+	e.WriteBool(m.Body != nil)
+	if m.TransactionID != nil {
+		e.WriteBool(false)
+		if err := e.WriteEnumerated(int(m.TransactionID.Initiator), 2, true); err != nil {
+			return err
+		}
+		e.WriteBits(uint64(m.TransactionID.TransactionNumber), 8)
+	}
+	e.WriteBool(m.EndTransaction)
+	if m.SequenceNumber != nil {
+		e.WriteBits(uint64(*m.SequenceNumber), 8)
+	}
+	if m.Acknowledgement != nil {
+		e.WriteBool(m.Acknowledgement.AckIndicator != nil)
+		e.WriteBool(m.Acknowledgement.AckRequested)
+		if m.Acknowledgement.AckIndicator != nil {
+			e.WriteBits(uint64(*m.Acknowledgement.AckIndicator), 8)
+		}
+	}
+	if m.Body == nil {
+		return nil
+	}
+	// The body is a c1 choice, then the message is a c1 choice of its critical extensions.
+	return e.WriteChoice(0, 2, false, func(e *per.Encoder) error {
+		return e.WriteChoice(m.Body.messageType(), messageTypes, false, func(e *per.Encoder) error {
+			return e.WriteChoice(0, 2, false, func(e *per.Encoder) error {
+				return e.WriteChoice(0, 4, false, m.Body.encode)
+			})
+		})
+	})
+}
+
+func (m *Message) decode(d *per.Decoder) error {
+	present, err := readPresence(d, 4)
+	if err != nil {
+		return err
+	}
+	if present[0] {
+		if _, err := d.ReadBool(); err != nil {
+			return err
+		}
+		initiator, err := d.ReadEnumerated(2, true)
+		if err != nil {
+			return err
+		}
+		number, err := d.ReadBits(8)
+		if err != nil {
+			return err
+		}
+		m.TransactionID = &TransactionID{Initiator: Initiator(initiator), TransactionNumber: uint8(number)}
+	}
+	if m.EndTransaction, err = d.ReadBool(); err != nil {
+		return err
+	}
+	if present[1] {
+		number, err := d.ReadBits(8)
+		if err != nil {
+			return err
+		}
+		m.SequenceNumber = toUint8(uint8(number))
+	}
+	if present[2] {
+		indicator, err := readPresence(d, 1)
+		if err != nil {
+			return err
+		}
+		m.Acknowledgement = &Acknowledgement{}
+		if m.Acknowledgement.AckRequested, err = d.ReadBool(); err != nil {
+			return err
+		}
+		if indicator[0] {
+			number, err := d.ReadBits(8)
+			if err != nil {
+				return err
+			}
+			m.Acknowledgement.AckIndicator = toUint8(uint8(number))
+		}
+	}
+	if !present[3] {
+		return nil
+	}
+	if class, _, err := d.ReadChoice(2, false); err != nil || class != 0 {
+		return unsupported(err, "messageClassExtension")
+	}
+	messageType, _, err := d.ReadChoice(messageTypes, false)
+	if err != nil {
+		return err
+	}
+	if extension, _, err := d.ReadChoice(2, false); err != nil || extension != 0 {
+		return unsupported(err, "criticalExtensionsFuture")
+	}
+	if release, _, err := d.ReadChoice(4, false); err != nil || release != 0 {
+		return unsupported(err, "critical extension spare")
+	}
+	var body interface {
+		Body
+		decode(*per.Decoder) error
+	}
+	switch messageType {
+	case messageRequestCapabilities:
+		body = &RequestCapabilities{}
+	case messageProvideCapabilities:
+		body = &ProvideCapabilities{}
+	case messageProvideAssistanceData:
+		body = &ProvideAssistanceData{}
+	case messageRequestLocationInformation:
+		body = &RequestLocationInformation{}
+	case messageProvideLocationInformation:
+		body = &ProvideLocationInformation{}
+	default:
+		return fmt.Errorf("%w: message type %d", ErrUnsupported, messageType)
+	}
+	if err := body.decode(d); err != nil {
+		return err
+	}
+	m.Body = body
+	return nil
+}
+
+// unsupported returns err, or ErrUnsupported for the component when err is nil.
+func unsupported(err error, component string) error {
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupported, component)
+}
+
+// readPresence reads the presence bits of n optional components.
+func readPresence(d *per.Decoder, n int) ([]bool, error) {
+	present := make([]bool, n)
+	for i := range present {
+		var err error
+		if present[i], err = d.ReadBool(); err != nil {
+			return nil, err
+		}
+	}
+	return present, nil
+}
+
+// writeExtensible writes the extension bit of an extensible type, whose extension additions are never encoded.
+func writeExtensible(e *per.Encoder) {
+	e.WriteBool(false)
+}
+
+// readExtensible reads the extension bit of an extensible type, and returns a function skipping the extension
+// additions to call after the root components.
+func readExtensible(d *per.Decoder) (func() error, error) {
+	extended, err := d.ReadBool()
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		if !extended {
+			return nil
+		}
+		_, err := d.ReadExtensions()
+		return err
+	}, nil
+}
+
+// namedBits converts a bit mask into a BIT STRING with named bits, bit i of the mask being the named bit i.
+func namedBits(mask uint64, lb int) per.BitString {
+	var set []int
+	for i := 0; i < 64; i++ {
+		if mask&(1<<uint(i)) != 0 {
+			set = append(set, i)
+		}
+	}
+	return per.NewBitString(64, set...).TrimNamed(lb)
+}
+
+// bitMask converts a BIT STRING with named bits into a bit mask, ignoring the bits above 63.
+func bitMask(b per.BitString) uint64 {
+	var mask uint64
+	for i := 0; i < b.Length && i < 64; i++ {
+		if b.At(i) {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask
+}
+
+func toUint8(v uint8) *uint8 {
+	return &v
+}
@@ -0,0 +1,262 @@
+package lpp
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"reflect"
+	"testing"
+)
+
+func TestEncoding(t *testing.T) {
+	// The location estimate is decoded from its binary encoding so that it survives the coding of the coordinates.
+	paris, err := gad.Decode([]byte{0x00, 0x45, 0x7c, 0x25, 0x01, 0xac, 0x34})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The expected encodings are derived by hand from the ASN.1 of TS 37.355.
+	tests := []struct {
+		name    string
+		message Message
+		encoded string
+	}{
+		{
+			name: "request capabilities",
+			message: Message{
+				TransactionID: &TransactionID{Initiator: InitiatorLocationServer, TransactionNumber: 1},
+				Body:          &RequestCapabilities{ECID: &ECIDRequestCapabilities{}},
+			},
+			// presence 1001, transactionID 0 00 00000001, endTransaction 0, c1 0, requestCapabilities 0000,
+			// c1 0, requestCapabilities-r9 00, extension 0, presence 00010, ecid extension 0.
+			encoded: "90020008",
+		},
+		{
+			name: "provide location information",
+			message: Message{
+				TransactionID:  &TransactionID{Initiator: InitiatorTargetDevice, TransactionNumber: 1},
+				EndTransaction: true,
+				Body:           &ProvideLocationInformation{CommonIEs: &CommonIEsProvideLocationInformation{LocationEstimate: paris}},
+			},
+			// presence 1001, transactionID 0 01 00000001, endTransaction 1, c1 0, provideLocationInformation 0101,
+			// c1 0, provideLocationInformation-r9 00, extension 0, presence 10000, common extension 0, presence 100,
+			// ellipsoidPoint 0 000, latitude 457c25, longitude 01ac34 offset by 2^23.
+			encoded: "920328410115f09606b0d0",
+		},
+		{
+			name: "acknowledgement",
+			message: Message{
+				SequenceNumber:  toUint8(7),
+				Acknowledgement: &Acknowledgement{AckIndicator: toUint8(3)},
+			},
+			// presence 0110, endTransaction 0, sequenceNumber 00000111, presence 1, ackRequested 0, ackIndicator 00000011.
+			encoded: "603c06",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := Encode(test.message)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded := hex.EncodeToString(b); encoded != test.encoded {
+				t.Errorf("encoded as %s, expected %s", encoded, test.encoded)
+			}
+			decoded, err := Decode(b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.message) {
+				t.Errorf("decoded as %+v, expected %+v", decoded, test.message)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ellipse, err := gad.Decode([]byte{0x30, 0x45, 0x7c, 0x25, 0x01, 0xac, 0x34, 0x30, 0x12, 0x00, 0x44})
+	if err != nil {
+		t.Fatal(err)
+	}
+	arc, err := gad.Decode([]byte{0xa0, 0x45, 0x7c, 0x25, 0x01, 0xac, 0x34, 0x00, 0x14, 0x20, 0x05, 0x0a, 0x40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ellipsoid, err := gad.Decode([]byte{0x90, 0xb0, 0x2c, 0x6a, 0x6b, 0x87, 0xe2, 0x80, 0x64, 0x20, 0x10, 0x00, 0x30, 0x50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The orientation is carried in degrees.
+	odd := ellipse.(gad.PointUncertaintyEllipse)
+	odd.UncertaintyEllipse.OrientationMajor = 45
+	additional, environment, cause := MayReturnAdditionalInformation, EnvironmentMixedArea, LocationFailurePositionMethodFailure
+	bodies := map[string]Body{
+		"request location information": &RequestLocationInformation{
+			CommonIEs: &CommonIEsRequestLocationInformation{
+				LocationInformationType: LocationEstimatePreferred,
+				TriggeredReporting:      &TriggeredReportingCriteria{CellChange: true, ReportingDuration: 60},
+				PeriodicalReporting:     &PeriodicalReportingCriteria{ReportingAmount: ReportingAmount8, ReportingInterval: ReportingInterval2s},
+				AdditionalInformation:   &additional,
+				QoS: &QoS{
+					HorizontalAccuracy:        &Accuracy{Accuracy: 20, Confidence: 68},
+					VerticalCoordinateRequest: true,
+					VerticalAccuracy:          &Accuracy{Accuracy: 10, Confidence: 68},
+					ResponseTime:              toInt32(128),
+					VelocityRequest:           true,
+				},
+				Environment:             &environment,
+				LocationCoordinateTypes: &LocationCoordinateTypes{EllipsoidPoint: true, EllipsoidArc: true},
+				VelocityTypes:           &VelocityTypes{HorizontalWithVerticalVelocityAndUncertainty: true},
+			},
+			AGNSS: &AGNSSRequestLocationInformation{GNSSMethods: GNSSGPS | GNSSGalileo | GNSSNavIC, AssistanceAvailability: true},
+			OTDOA: &OTDOARequestLocationInformation{AssistanceAvailability: true},
+			ECID:  &ECIDRequestLocationInformation{RequestedMeasurements: ECIDMeasurementRSRP | ECIDMeasurementUERxTx},
+		},
+		"periodical reporting without amount": &RequestLocationInformation{
+			CommonIEs: &CommonIEsRequestLocationInformation{
+				PeriodicalReporting: &PeriodicalReportingCriteria{ReportingAmount: ReportingAmountInfinity, ReportingInterval: ReportingInterval64s},
+			},
+		},
+		"location estimate with velocity": &ProvideLocationInformation{
+			CommonIEs: &CommonIEsProvideLocationInformation{
+				LocationEstimate: odd,
+				VelocityEstimate: &Velocity{
+					Bearing:                    359,
+					HorizontalSpeed:            2047,
+					VerticalDirection:          Downward,
+					VerticalSpeed:              toInt32(10),
+					HorizontalUncertaintySpeed: toInt32(5),
+					VerticalUncertaintySpeed:   toInt32(1),
+				},
+			},
+		},
+		"ellipsoid arc": &ProvideLocationInformation{
+			CommonIEs: &CommonIEsProvideLocationInformation{
+				LocationEstimate: arc,
+				VelocityEstimate: &Velocity{Bearing: 90, HorizontalSpeed: 30, HorizontalUncertaintySpeed: toInt32(2)},
+			},
+		},
+		"altitude and uncertainty ellipsoid": &ProvideLocationInformation{
+			CommonIEs: &CommonIEsProvideLocationInformation{LocationEstimate: ellipsoid},
+		},
+		"location error": &ProvideLocationInformation{
+			CommonIEs: &CommonIEsProvideLocationInformation{LocationError: &cause},
+			ECID: &ECIDProvideLocationInformation{
+				Error: &PositioningError{
+					TargetDevice: true,
+					Cause:        ECIDCauseNotAllRequestedMeasurementsPossible,
+					NotPossible:  uint8(ECIDMeasurementRSRQ),
+				},
+			},
+		},
+		"ecid measurements": &ProvideLocationInformation{
+			ECID: &ECIDProvideLocationInformation{
+				SignalMeasurementInformation: &ECIDSignalMeasurementInformation{
+					PrimaryCellMeasuredResults: &MeasuredResults{
+						PhysCellID:        503,
+						CellGlobalID:      &CellGlobalID{MCC: "208", MNC: "93", CellIdentity: 0xfffffff},
+						ARFCN:             6300,
+						SystemFrameNumber: toUint16(1023),
+						RSRPResult:        toInt32(97),
+						RSRQResult:        toInt32(34),
+						UERxTxTimeDiff:    toInt32(4095),
+					},
+					MeasuredResultsList: []MeasuredResults{
+						{PhysCellID: 1, ARFCN: 100, RSRPResult: toInt32(40)},
+						{PhysCellID: 2, CellGlobalID: &CellGlobalID{MCC: "001", MNC: "001", CellIdentity: 0xffffffff, UTRA: true}},
+					},
+				},
+			},
+		},
+		"capabilities": &ProvideCapabilities{
+			CommonIEs: &CommonIEsProvideCapabilities{},
+			OTDOA:     &OTDOAProvideCapabilities{OTDOAMode: OTDOAModeUEAssisted | OTDOAModeUEAssistedNBTDD},
+			ECID:      &ECIDProvideCapabilities{ECIDMeasSupported: ECIDMeasurementRSRP | ECIDMeasurementRSRQ | ECIDMeasurementNRSRQ},
+		},
+		"request all capabilities": &RequestCapabilities{
+			CommonIEs: &CommonIEsRequestCapabilities{},
+			AGNSS:     &AGNSSRequestCapabilities{GNSSSupportListReq: true, LocationVelocityTypesReq: true},
+			OTDOA:     &OTDOARequestCapabilities{},
+			ECID:      &ECIDRequestCapabilities{},
+		},
+		"assistance data errors": &ProvideAssistanceData{
+			AGNSSError: &PositioningError{
+				TargetDevice: true,
+				Cause:        AGNSSCauseNotAllRequestedMeasurementsPossible,
+				NotPossible:  AGNSSFineTimeAssistanceMeasurementsNotPossible | AGNSSMultiFrequencyMeasurementsNotPossible,
+			},
+			OTDOAError: &PositioningError{Cause: OTDOACauseAssistanceDataSupportButCurrentlyNotAvailableByServer},
+		},
+	}
+	for name, body := range bodies {
+		t.Run(name, func(t *testing.T) {
+			message := Message{TransactionID: &TransactionID{TransactionNumber: 255}, EndTransaction: true, Body: body}
+			b, err := Encode(message)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			decoded, err := Decode(b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, message) {
+				t.Errorf("decoded as %+v, expected %+v", decoded.Body, body)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		err     error
+	}{
+		// A RequestCapabilities with an EPDU.
+		{name: "epdu", encoded: "100020", err: ErrUnsupported},
+		// A RequestAssistanceData.
+		{name: "unsupported message", encoded: "1080", err: ErrUnsupported},
+		{name: "truncated", encoded: "9002", err: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(test.encoded)
+			_, err := Decode(b)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestExtensionAdditions(t *testing.T) {
+	// A RequestCapabilities with the ECID request, whose extension bit is set with an addition of one octet.
+	b, _ := hex.DecodeString("9002000a020300")
+	m, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, ok := m.Body.(*RequestCapabilities); !ok || body.ECID == nil {
+		t.Errorf("unexpected body %+v", m.Body)
+	}
+}
+
+func TestLocationData(t *testing.T) {
+	c := CommonIEsProvideLocationInformation{LocationEstimate: gad.PointUncertaintyCircle{Point: gad.Coordinates{Lat: 1, Lon: 2}, Uncertainty: 10}}
+	data, err := c.LocationData()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shape, err := gad.FromGeographicArea(data.LocationEstimate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(shape, c.LocationEstimate) {
+		t.Errorf("unexpected location estimate %+v", shape)
+	}
+	if _, err := (CommonIEsProvideLocationInformation{}).LocationData(); err == nil {
+		t.Error("expected an error without location estimate")
+	}
+}
@@ -0,0 +1,20 @@
+package namf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+type Client struct {
+	*CommunicationClient
+	*EventExposureClient
+	*LocationClient
+	*MTClient
+}
+
+// NewClient returns a new client for an NAMF service.
+func NewClient(config fivegc.ClientConfiguration) *Client {
+	return &Client{
+		CommunicationClient: NewCommunicationClient(config),
+		EventExposureClient: NewEventExposureClient(config),
+		LocationClient:      NewLocationClient(config),
+		MTClient:            NewMTClient(config),
+	}
+}
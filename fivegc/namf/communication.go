@@ -0,0 +1,860 @@
+package namf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+const (
+	communicationRouterGroup     = "/namf-comm/v1"
+	ueContextsResource           = "ue-contexts"
+	n1N2MessagesResource         = "n1-n2-messages"
+	releaseResource              = "release"
+	transferResource             = "transfer"
+	nonUEN2MessagesResource      = "non-ue-n2-messages"
+	ueContextIDParam             = "ueContextId"
+	subscriptionIDParam          = "subscriptionId"
+	n2NotifySubscriptionIDParam  = "n2NotifySubscriptionId"
+	ueContextEndpoint            = "/ue-contexts/{ueContextId}"
+	releaseUEContextEndpoint     = "/ue-contexts/{ueContextId}/release"
+	ueContextTransferEndpoint    = "/ue-contexts/{ueContextId}/transfer"
+	n1N2MessagesEndpoint         = "/ue-contexts/{ueContextId}/n1-n2-messages"
+	n1N2SubscriptionsEndpoint    = "/ue-contexts/{ueContextId}/n1-n2-messages/subscriptions"
+	n1N2SubscriptionEndpoint     = "/ue-contexts/{ueContextId}/n1-n2-messages/subscriptions/{subscriptionId}"
+	subscriptionsEndpoint        = "/subscriptions"
+	subscriptionEndpoint         = "/subscriptions/{subscriptionId}"
+	nonUEN2TransferEndpoint      = "/non-ue-n2-messages/transfer"
+	nonUEN2SubscriptionsEndpoint = "/non-ue-n2-messages/subscriptions"
+	nonUEN2SubscriptionEndpoint  = "/non-ue-n2-messages/subscriptions/{n2NotifySubscriptionId}"
+)
+
+// Causes of a N1N2MessageTransfer response, defined in TS 29.518 clause 6.1.6.3.5.
+const (
+	N1N2TransferCauseAttemptingToReachUE                = "ATTEMPTING_TO_REACH_UE"
+	N1N2TransferCauseTransferInitiated                  = "N1_N2_TRANSFER_INITIATED"
+	N1N2TransferCauseWaitingForAsynchronousTransfer     = "WAITING_FOR_ASYNCHRONOUS_TRANSFER"
+	N1N2TransferCauseUENotResponding                    = "UE_NOT_RESPONDING"
+	N1N2TransferCauseN1MsgNotTransferred                = "N1_MSG_NOT_TRANSFERRED"
+	N1N2TransferCauseN2MsgNotTransferred                = "N2_MSG_NOT_TRANSFERRED"
+	N1N2TransferCauseUENotReachableForSession           = "UE_NOT_REACHABLE_FOR_SESSION"
+	N1N2TransferCauseTemporaryRejectRegistrationOngoing = "TEMPORARY_REJECT_REGISTRATION_ONGOING"
+	N1N2TransferCauseTemporaryRejectHandoverOngoing     = "TEMPORARY_REJECT_HANDOVER_ONGOING"
+	N1N2TransferCauseRejectionDueToPagingRestriction    = "REJECTION_DUE_TO_PAGING_RESTRICTION"
+	N1N2TransferCauseANNotResponding                    = "AN_NOT_RESPONDING"
+	N1N2TransferCauseFailureCauseUnspecified            = "FAILURE_CAUSE_UNSPECIFIED"
+)
+
+// CreateUEContextError is the error of a CreateUEContext response carrying error data besides its ProblemDetails,
+// such as the 403 Forbidden response with the NGAP message of the target NG-RAN node rejecting the handover
+// (TS 29.518 clause 6.1.3.2.3.1). The error attribute of the error data is set from the ProblemDetails.
+type CreateUEContextError struct {
+	fivegc.ProblemDetailsError
+	ErrorData   namfcommunication.UeContextCreateError
+	BinaryParts []BinaryPart
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *CreateUEContextError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// N1N2MessageTransferError is the error of a N1N2MessageTransfer response carrying the details of the failure,
+// such as the 409 Conflict and 504 Gateway Timeout responses of TS 29.518 clause 6.1.3.5.3.1.
+type N1N2MessageTransferError struct {
+	fivegc.ProblemDetailsError
+	ErrInfo *namfcommunication.N1N2MsgTxfrErrDetail
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *N1N2MessageTransferError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// Communication is the interface that wraps the NAMF Communication service (TS 29.518 clause 5.2).
+// The UE context ID of the requests is the SUPI, the PEI or the 5G-GUTI of the UE, such as "imsi-208930000000001".
+type Communication interface {
+	fivegc.CommonInterface
+	// CreateUEContext creates the UE context in the target AMF of an inter-AMF handover.
+	CreateUEContext(context.Context, string, CreateUEContextRequest) (CreateUEContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateUEContextStatusCode)
+	// ReleaseUEContext releases the UE context created in the target AMF of a cancelled handover.
+	ReleaseUEContext(context.Context, string, namfcommunication.UEContextRelease) (openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleaseUEContextStatusCode)
+	// UEContextTransfer transfers the UE context to the new AMF of a registration.
+	UEContextTransfer(context.Context, string, UEContextTransferRequest) (UEContextTransferResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UEContextTransferStatusCode)
+	// N1N2MessageTransfer transfers N1 and N2 messages to the UE and the NG-RAN node serving it.
+	N1N2MessageTransfer(context.Context, string, N1N2MessageTransferRequest) (N1N2MessageTransferResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageTransferStatusCode)
+	// N1N2MessageSubscribe subscribes to the N1 messages and N2 information of a UE.
+	N1N2MessageSubscribe(context.Context, string, namfcommunication.UeN1N2InfoSubscriptionCreateData) (namfcommunication.UeN1N2InfoSubscriptionCreatedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageSubscribeStatusCode)
+	// N1N2MessageUnSubscribe removes the subscription, identified by its ID, to the N1 messages and N2 information of a UE.
+	N1N2MessageUnSubscribe(context.Context, string, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageUnSubscribeStatusCode)
+	// AMFStatusChangeSubscribe subscribes to the status changes of the AMF.
+	AMFStatusChangeSubscribe(context.Context, namfcommunication.SubscriptionData) (AMFStatusChangeSubscribeResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeSubscribeStatusCode)
+	// AMFStatusChangeSubscribeModify replaces the subscription, identified by its ID, to the status changes of the AMF.
+	AMFStatusChangeSubscribeModify(context.Context, string, namfcommunication.SubscriptionData) (namfcommunication.SubscriptionData, openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeSubscribeModifyStatusCode)
+	// AMFStatusChangeUnSubscribe removes the subscription, identified by its ID, to the status changes of the AMF.
+	AMFStatusChangeUnSubscribe(context.Context, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeUnSubscribeStatusCode)
+	// NonUeN2MessageTransfer transfers N2 information, not related to a UE, to the NG-RAN nodes.
+	NonUeN2MessageTransfer(context.Context, NonUeN2MessageTransferRequest) (namfcommunication.N2InformationTransferRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2MessageTransferStatusCode)
+	// NonUeN2InfoSubscribe subscribes to the N2 information, not related to a UE, sent by the NG-RAN nodes.
+	NonUeN2InfoSubscribe(context.Context, namfcommunication.NonUeN2InfoSubscriptionCreateData) (namfcommunication.NonUeN2InfoSubscriptionCreatedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2InfoSubscribeStatusCode)
+	// NonUeN2InfoUnSubscribe removes the subscription, identified by its ID, to the N2 information not related to a UE.
+	NonUeN2InfoUnSubscribe(context.Context, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2InfoUnSubscribeStatusCode)
+}
+
+// CommunicationService is an alternative to the Communication interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one.
+// Use NewCommunication to attach it to the NAMF Server.
+type CommunicationService interface {
+	fivegc.CommonInterface
+	// CreateUEContext creates the UE context in the target AMF of an inter-AMF handover.
+	// A *CreateUEContextError is answered along with its error data.
+	CreateUEContext(context.Context, string, CreateUEContextRequest) (*CreateUEContextResponse, error)
+	// ReleaseUEContext releases the UE context created in the target AMF of a cancelled handover.
+	ReleaseUEContext(context.Context, string, namfcommunication.UEContextRelease) error
+	// UEContextTransfer transfers the UE context to the new AMF of a registration.
+	UEContextTransfer(context.Context, string, UEContextTransferRequest) (*UEContextTransferResponse, error)
+	// N1N2MessageTransfer transfers N1 and N2 messages to the UE, the response is 202 Accepted when it has a Location.
+	// A *N1N2MessageTransferError is answered along with its error details.
+	N1N2MessageTransfer(context.Context, string, N1N2MessageTransferRequest) (*N1N2MessageTransferResponse, error)
+	// N1N2MessageSubscribe subscribes to the N1 messages and N2 information of a UE.
+	N1N2MessageSubscribe(context.Context, string, namfcommunication.UeN1N2InfoSubscriptionCreateData) (*namfcommunication.UeN1N2InfoSubscriptionCreatedData, error)
+	// N1N2MessageUnSubscribe removes the subscription, identified by its ID, to the N1 messages and N2 information of a UE.
+	N1N2MessageUnSubscribe(context.Context, string, string) error
+	// AMFStatusChangeSubscribe subscribes to the status changes of the AMF.
+	AMFStatusChangeSubscribe(context.Context, namfcommunication.SubscriptionData) (*AMFStatusChangeSubscribeResponse, error)
+	// AMFStatusChangeSubscribeModify replaces the subscription, identified by its ID, to the status changes of the AMF.
+	AMFStatusChangeSubscribeModify(context.Context, string, namfcommunication.SubscriptionData) (*namfcommunication.SubscriptionData, error)
+	// AMFStatusChangeUnSubscribe removes the subscription, identified by its ID, to the status changes of the AMF.
+	AMFStatusChangeUnSubscribe(context.Context, string) error
+	// NonUeN2MessageTransfer transfers N2 information, not related to a UE, to the NG-RAN nodes.
+	NonUeN2MessageTransfer(context.Context, NonUeN2MessageTransferRequest) (*namfcommunication.N2InformationTransferRspData, error)
+	// NonUeN2InfoSubscribe subscribes to the N2 information, not related to a UE, sent by the NG-RAN nodes.
+	NonUeN2InfoSubscribe(context.Context, namfcommunication.NonUeN2InfoSubscriptionCreateData) (*namfcommunication.NonUeN2InfoSubscriptionCreatedData, error)
+	// NonUeN2InfoUnSubscribe removes the subscription, identified by its ID, to the N2 information not related to a UE.
+	NonUeN2InfoUnSubscribe(context.Context, string) error
+}
+
+// N1N2MessageTransferResponse is the response of a N1N2MessageTransfer request.
+type N1N2MessageTransferResponse struct {
+	JSONData namfcommunication.N1N2MessageTransferRspData
+	// Location is the URI of the N1N2 message resource created when the AMF pages the UE, sent with 202 Accepted.
+	Location string
+	// ErrInfo are the details of a failure, such as a 409 Conflict, answered along with the ProblemDetails.
+	ErrInfo *namfcommunication.N1N2MsgTxfrErrDetail
+}
+
+// AMFStatusChangeSubscribeResponse is the response of an AMFStatusChangeSubscribe request.
+type AMFStatusChangeSubscribeResponse struct {
+	// SubscriptionID identifies the subscription resource created, in the Location of the response.
+	SubscriptionID string
+	JSONData       namfcommunication.SubscriptionData
+}
+
+// CreateUEContextStatusCode is the status code of a CreateUEContext response.
+type CreateUEContextStatusCode fivegc.StatusCode
+
+const (
+	// CreateUEContextStatusCreated is the status code for the response when the UE context is created.
+	CreateUEContextStatusCreated           CreateUEContextStatusCode = CreateUEContextStatusCode(fivegc.StatusCreated)
+	CreateUEContextStatusTemporaryRedirect CreateUEContextStatusCode = CreateUEContextStatusCode(fivegc.StatusTemporaryRedirect)
+	CreateUEContextStatusPermanentRedirect CreateUEContextStatusCode = CreateUEContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var createUEContextErrors = response.BodyErrors
+
+// ReleaseUEContextStatusCode is the status code of a ReleaseUEContext response.
+type ReleaseUEContextStatusCode fivegc.StatusCode
+
+const (
+	// ReleaseUEContextStatusNoContent is the status code for the response when the UE context is released.
+	ReleaseUEContextStatusNoContent         ReleaseUEContextStatusCode = ReleaseUEContextStatusCode(fivegc.StatusNoContent)
+	ReleaseUEContextStatusTemporaryRedirect ReleaseUEContextStatusCode = ReleaseUEContextStatusCode(fivegc.StatusTemporaryRedirect)
+	ReleaseUEContextStatusPermanentRedirect ReleaseUEContextStatusCode = ReleaseUEContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var releaseUEContextErrors = response.BodyErrors
+
+// UEContextTransferStatusCode is the status code of a UEContextTransfer response.
+type UEContextTransferStatusCode fivegc.StatusCode
+
+const (
+	// UEContextTransferStatusOK is the status code for a successful response.
+	UEContextTransferStatusOK                UEContextTransferStatusCode = UEContextTransferStatusCode(fivegc.StatusOK)
+	UEContextTransferStatusTemporaryRedirect UEContextTransferStatusCode = UEContextTransferStatusCode(fivegc.StatusTemporaryRedirect)
+	UEContextTransferStatusPermanentRedirect UEContextTransferStatusCode = UEContextTransferStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var ueContextTransferErrors = response.BodyErrors
+
+// N1N2MessageTransferStatusCode is the status code of a N1N2MessageTransfer response.
+// The operation may also fail with 409 Conflict.
+type N1N2MessageTransferStatusCode fivegc.StatusCode
+
+const (
+	// N1N2MessageTransferStatusOK is the status code for the response when the transfer is initiated.
+	N1N2MessageTransferStatusOK N1N2MessageTransferStatusCode = N1N2MessageTransferStatusCode(fivegc.StatusOK)
+	// N1N2MessageTransferStatusAccepted is the status code for the response when the AMF is paging the UE.
+	N1N2MessageTransferStatusAccepted          N1N2MessageTransferStatusCode = N1N2MessageTransferStatusCode(fivegc.StatusAccepted)
+	N1N2MessageTransferStatusTemporaryRedirect N1N2MessageTransferStatusCode = N1N2MessageTransferStatusCode(fivegc.StatusTemporaryRedirect)
+	N1N2MessageTransferStatusPermanentRedirect N1N2MessageTransferStatusCode = N1N2MessageTransferStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n1n2MessageTransferErrors = response.BodyErrors.With(fivegc.StatusConflict)
+
+// N1N2MessageSubscribeStatusCode is the status code of a N1N2MessageSubscribe response.
+type N1N2MessageSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// N1N2MessageSubscribeStatusCreated is the status code for the response when the subscription is created.
+	N1N2MessageSubscribeStatusCreated           N1N2MessageSubscribeStatusCode = N1N2MessageSubscribeStatusCode(fivegc.StatusCreated)
+	N1N2MessageSubscribeStatusTemporaryRedirect N1N2MessageSubscribeStatusCode = N1N2MessageSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	N1N2MessageSubscribeStatusPermanentRedirect N1N2MessageSubscribeStatusCode = N1N2MessageSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n1n2MessageSubscribeErrors = response.BodyErrors
+
+// N1N2MessageUnSubscribeStatusCode is the status code of a N1N2MessageUnSubscribe response.
+type N1N2MessageUnSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// N1N2MessageUnSubscribeStatusNoContent is the status code for the response when the subscription is removed.
+	N1N2MessageUnSubscribeStatusNoContent         N1N2MessageUnSubscribeStatusCode = N1N2MessageUnSubscribeStatusCode(fivegc.StatusNoContent)
+	N1N2MessageUnSubscribeStatusTemporaryRedirect N1N2MessageUnSubscribeStatusCode = N1N2MessageUnSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	N1N2MessageUnSubscribeStatusPermanentRedirect N1N2MessageUnSubscribeStatusCode = N1N2MessageUnSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n1n2MessageUnSubscribeErrors = response.DeletionErrors
+
+// AMFStatusChangeSubscribeStatusCode is the status code of an AMFStatusChangeSubscribe response.
+type AMFStatusChangeSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// AMFStatusChangeSubscribeStatusCreated is the status code for the response when the subscription is created.
+	AMFStatusChangeSubscribeStatusCreated           AMFStatusChangeSubscribeStatusCode = AMFStatusChangeSubscribeStatusCode(fivegc.StatusCreated)
+	AMFStatusChangeSubscribeStatusTemporaryRedirect AMFStatusChangeSubscribeStatusCode = AMFStatusChangeSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	AMFStatusChangeSubscribeStatusPermanentRedirect AMFStatusChangeSubscribeStatusCode = AMFStatusChangeSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var amfStatusChangeSubscribeErrors = response.BodyErrors
+
+// AMFStatusChangeSubscribeModifyStatusCode is the status code of an AMFStatusChangeSubscribeModify response.
+type AMFStatusChangeSubscribeModifyStatusCode fivegc.StatusCode
+
+const (
+	// AMFStatusChangeSubscribeModifyStatusOK is the status code for the response when the subscription is replaced.
+	AMFStatusChangeSubscribeModifyStatusOK                AMFStatusChangeSubscribeModifyStatusCode = AMFStatusChangeSubscribeModifyStatusCode(fivegc.StatusOK)
+	AMFStatusChangeSubscribeModifyStatusTemporaryRedirect AMFStatusChangeSubscribeModifyStatusCode = AMFStatusChangeSubscribeModifyStatusCode(fivegc.StatusTemporaryRedirect)
+	AMFStatusChangeSubscribeModifyStatusPermanentRedirect AMFStatusChangeSubscribeModifyStatusCode = AMFStatusChangeSubscribeModifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var amfStatusChangeSubscribeModifyErrors = response.BodyErrors
+
+// AMFStatusChangeUnSubscribeStatusCode is the status code of an AMFStatusChangeUnSubscribe response.
+type AMFStatusChangeUnSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// AMFStatusChangeUnSubscribeStatusNoContent is the status code for the response when the subscription is removed.
+	AMFStatusChangeUnSubscribeStatusNoContent         AMFStatusChangeUnSubscribeStatusCode = AMFStatusChangeUnSubscribeStatusCode(fivegc.StatusNoContent)
+	AMFStatusChangeUnSubscribeStatusTemporaryRedirect AMFStatusChangeUnSubscribeStatusCode = AMFStatusChangeUnSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	AMFStatusChangeUnSubscribeStatusPermanentRedirect AMFStatusChangeUnSubscribeStatusCode = AMFStatusChangeUnSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var amfStatusChangeUnSubscribeErrors = response.DeletionErrors
+
+// NonUeN2MessageTransferStatusCode is the status code of a NonUeN2MessageTransfer response.
+type NonUeN2MessageTransferStatusCode fivegc.StatusCode
+
+const (
+	// NonUeN2MessageTransferStatusOK is the status code for a successful response.
+	NonUeN2MessageTransferStatusOK                NonUeN2MessageTransferStatusCode = NonUeN2MessageTransferStatusCode(fivegc.StatusOK)
+	NonUeN2MessageTransferStatusTemporaryRedirect NonUeN2MessageTransferStatusCode = NonUeN2MessageTransferStatusCode(fivegc.StatusTemporaryRedirect)
+	NonUeN2MessageTransferStatusPermanentRedirect NonUeN2MessageTransferStatusCode = NonUeN2MessageTransferStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var nonUeN2MessageTransferErrors = response.BodyErrors
+
+// NonUeN2InfoSubscribeStatusCode is the status code of a NonUeN2InfoSubscribe response.
+type NonUeN2InfoSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// NonUeN2InfoSubscribeStatusCreated is the status code for the response when the subscription is created.
+	NonUeN2InfoSubscribeStatusCreated           NonUeN2InfoSubscribeStatusCode = NonUeN2InfoSubscribeStatusCode(fivegc.StatusCreated)
+	NonUeN2InfoSubscribeStatusTemporaryRedirect NonUeN2InfoSubscribeStatusCode = NonUeN2InfoSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	NonUeN2InfoSubscribeStatusPermanentRedirect NonUeN2InfoSubscribeStatusCode = NonUeN2InfoSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var nonUeN2InfoSubscribeErrors = response.BodyErrors
+
+// NonUeN2InfoUnSubscribeStatusCode is the status code of a NonUeN2InfoUnSubscribe response.
+type NonUeN2InfoUnSubscribeStatusCode fivegc.StatusCode
+
+const (
+	// NonUeN2InfoUnSubscribeStatusNoContent is the status code for the response when the subscription is removed.
+	NonUeN2InfoUnSubscribeStatusNoContent         NonUeN2InfoUnSubscribeStatusCode = NonUeN2InfoUnSubscribeStatusCode(fivegc.StatusNoContent)
+	NonUeN2InfoUnSubscribeStatusTemporaryRedirect NonUeN2InfoUnSubscribeStatusCode = NonUeN2InfoUnSubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	NonUeN2InfoUnSubscribeStatusPermanentRedirect NonUeN2InfoUnSubscribeStatusCode = NonUeN2InfoUnSubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var nonUeN2InfoUnSubscribeErrors = response.DeletionErrors
+
+func attachCommunicationHandler(r *gin.RouterGroup, cm Communication, logger *log.Logger) {
+	group := r.Group(communicationRouterGroup)
+	{
+		group.PUT(router.Path(ueContextEndpoint), func(c *gin.Context) {
+			var req CreateUEContextRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, createUEContextErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.CreateUEContext(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case CreateUEContextStatusCreated:
+				c.Header("Location", router.ResourceURI(c))
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case CreateUEContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CreateUEContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrorData != nil && createUEContextErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, res.ErrorData, &res.ErrorData.Error, res.BinaryParts)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, createUEContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(releaseUEContextEndpoint), func(c *gin.Context) {
+			var req namfcommunication.UEContextRelease
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, releaseUEContextErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := cm.ReleaseUEContext(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case ReleaseUEContextStatusNoContent:
+				c.JSON(int(status), nil)
+			case ReleaseUEContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ReleaseUEContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, releaseUEContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(ueContextTransferEndpoint), func(c *gin.Context) {
+			var req UEContextTransferRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, ueContextTransferErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.UEContextTransfer(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case UEContextTransferStatusOK:
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case UEContextTransferStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UEContextTransferStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, ueContextTransferErrors)
+			}
+			return
+		})
+		group.POST(router.Path(n1N2MessagesEndpoint), func(c *gin.Context) {
+			var req N1N2MessageTransferRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, n1n2MessageTransferErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.N1N2MessageTransfer(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case N1N2MessageTransferStatusOK:
+				c.JSON(int(status), res.JSONData)
+			case N1N2MessageTransferStatusAccepted:
+				c.Header("Location", res.Location)
+				c.JSON(int(status), res.JSONData)
+			case N1N2MessageTransferStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N1N2MessageTransferStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrInfo != nil && n1n2MessageTransferErrors.Allows(fivegc.StatusCode(status)) {
+					if problemDetails.Status == nil {
+						problemDetails.Status = fivegc.ToInt32(int32(status))
+					}
+					c.JSON(int(status), n1N2MessageTransferError{Error: problemDetails, ErrInfo: res.ErrInfo})
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n1n2MessageTransferErrors)
+			}
+			return
+		})
+		group.POST(router.Path(n1N2SubscriptionsEndpoint), func(c *gin.Context) {
+			var req namfcommunication.UeN1N2InfoSubscriptionCreateData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, n1n2MessageSubscribeErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.N1N2MessageSubscribe(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case N1N2MessageSubscribeStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.GetN1n2NotifySubscriptionId()))
+				c.JSON(int(status), res)
+			case N1N2MessageSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N1N2MessageSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n1n2MessageSubscribeErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(n1N2SubscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := cm.N1N2MessageUnSubscribe(c, c.Param(ueContextIDParam), c.Param(subscriptionIDParam))
+			switch status {
+			case N1N2MessageUnSubscribeStatusNoContent:
+				c.JSON(int(status), nil)
+			case N1N2MessageUnSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N1N2MessageUnSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n1n2MessageUnSubscribeErrors)
+			}
+			return
+		})
+		group.POST(router.Path(subscriptionsEndpoint), func(c *gin.Context) {
+			var req namfcommunication.SubscriptionData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, amfStatusChangeSubscribeErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.AMFStatusChangeSubscribe(c, req)
+			switch status {
+			case AMFStatusChangeSubscribeStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.SubscriptionID))
+				c.JSON(int(status), res.JSONData)
+			case AMFStatusChangeSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case AMFStatusChangeSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, amfStatusChangeSubscribeErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			var req namfcommunication.SubscriptionData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, amfStatusChangeSubscribeModifyErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.AMFStatusChangeSubscribeModify(c, c.Param(subscriptionIDParam), req)
+			switch status {
+			case AMFStatusChangeSubscribeModifyStatusOK:
+				c.JSON(int(status), res)
+			case AMFStatusChangeSubscribeModifyStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case AMFStatusChangeSubscribeModifyStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, amfStatusChangeSubscribeModifyErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := cm.AMFStatusChangeUnSubscribe(c, c.Param(subscriptionIDParam))
+			switch status {
+			case AMFStatusChangeUnSubscribeStatusNoContent:
+				c.JSON(int(status), nil)
+			case AMFStatusChangeUnSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case AMFStatusChangeUnSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, amfStatusChangeUnSubscribeErrors)
+			}
+			return
+		})
+		group.POST(router.Path(nonUEN2TransferEndpoint), func(c *gin.Context) {
+			var req NonUeN2MessageTransferRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, nonUeN2MessageTransferErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.NonUeN2MessageTransfer(c, req)
+			switch status {
+			case NonUeN2MessageTransferStatusOK:
+				c.JSON(int(status), res)
+			case NonUeN2MessageTransferStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case NonUeN2MessageTransferStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, nonUeN2MessageTransferErrors)
+			}
+			return
+		})
+		group.POST(router.Path(nonUEN2SubscriptionsEndpoint), func(c *gin.Context) {
+			var req namfcommunication.NonUeN2InfoSubscriptionCreateData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, cm, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, nonUeN2InfoSubscribeErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := cm.NonUeN2InfoSubscribe(c, req)
+			switch status {
+			case NonUeN2InfoSubscribeStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.GetN2NotifySubscriptionId()))
+				c.JSON(int(status), res)
+			case NonUeN2InfoSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case NonUeN2InfoSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, nonUeN2InfoSubscribeErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(nonUEN2SubscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := cm.NonUeN2InfoUnSubscribe(c, c.Param(n2NotifySubscriptionIDParam))
+			switch status {
+			case NonUeN2InfoUnSubscribeStatusNoContent:
+				c.JSON(int(status), nil)
+			case NonUeN2InfoUnSubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case NonUeN2InfoUnSubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, nonUeN2InfoUnSubscribeErrors)
+			}
+			return
+		})
+	}
+}
+
+// n1N2MessageTransferError is the body of a N1N2MessageTransfer failure with error details.
+type n1N2MessageTransferError struct {
+	Error   openapicommon.ProblemDetails            `json:"error"`
+	ErrInfo *namfcommunication.N1N2MsgTxfrErrDetail `json:"errInfo,omitempty"`
+}
+
+// NewCommunication adapts a CommunicationService to the Communication interface.
+func NewCommunication(s CommunicationService) Communication {
+	return communicationAdapter{service: s}
+}
+
+type communicationAdapter struct {
+	service CommunicationService
+}
+
+func (a communicationAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a communicationAdapter) CreateUEContext(ctx context.Context, ueContextID string, req CreateUEContextRequest) (CreateUEContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateUEContextStatusCode) {
+	res, err := a.service.CreateUEContext(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var createErr *CreateUEContextError
+		if errors.As(err, &createErr) {
+			return CreateUEContextResponse{ErrorData: &createErr.ErrorData, BinaryParts: createErr.BinaryParts}, problemDetails, redirectResponse, CreateUEContextStatusCode(status)
+		}
+		return CreateUEContextResponse{}, problemDetails, redirectResponse, CreateUEContextStatusCode(status)
+	}
+	if res == nil {
+		res = &CreateUEContextResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CreateUEContextStatusCreated
+}
+
+func (a communicationAdapter) ReleaseUEContext(ctx context.Context, ueContextID string, req namfcommunication.UEContextRelease) (openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleaseUEContextStatusCode) {
+	if err := a.service.ReleaseUEContext(ctx, ueContextID, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, ReleaseUEContextStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReleaseUEContextStatusNoContent
+}
+
+func (a communicationAdapter) UEContextTransfer(ctx context.Context, ueContextID string, req UEContextTransferRequest) (UEContextTransferResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UEContextTransferStatusCode) {
+	res, err := a.service.UEContextTransfer(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return UEContextTransferResponse{}, problemDetails, redirectResponse, UEContextTransferStatusCode(status)
+	}
+	if res == nil {
+		res = &UEContextTransferResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UEContextTransferStatusOK
+}
+
+func (a communicationAdapter) N1N2MessageTransfer(ctx context.Context, ueContextID string, req N1N2MessageTransferRequest) (N1N2MessageTransferResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageTransferStatusCode) {
+	res, err := a.service.N1N2MessageTransfer(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var transferErr *N1N2MessageTransferError
+		if errors.As(err, &transferErr) {
+			return N1N2MessageTransferResponse{ErrInfo: transferErr.ErrInfo}, problemDetails, redirectResponse, N1N2MessageTransferStatusCode(status)
+		}
+		return N1N2MessageTransferResponse{}, problemDetails, redirectResponse, N1N2MessageTransferStatusCode(status)
+	}
+	if res == nil {
+		res = &N1N2MessageTransferResponse{}
+	}
+	if res.Location != "" {
+		return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1N2MessageTransferStatusAccepted
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1N2MessageTransferStatusOK
+}
+
+func (a communicationAdapter) N1N2MessageSubscribe(ctx context.Context, ueContextID string, req namfcommunication.UeN1N2InfoSubscriptionCreateData) (namfcommunication.UeN1N2InfoSubscriptionCreatedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageSubscribeStatusCode) {
+	res, err := a.service.N1N2MessageSubscribe(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfcommunication.UeN1N2InfoSubscriptionCreatedData{}, problemDetails, redirectResponse, N1N2MessageSubscribeStatusCode(status)
+	}
+	if res == nil {
+		res = &namfcommunication.UeN1N2InfoSubscriptionCreatedData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1N2MessageSubscribeStatusCreated
+}
+
+func (a communicationAdapter) N1N2MessageUnSubscribe(ctx context.Context, ueContextID string, subscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2MessageUnSubscribeStatusCode) {
+	if err := a.service.N1N2MessageUnSubscribe(ctx, ueContextID, subscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, N1N2MessageUnSubscribeStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1N2MessageUnSubscribeStatusNoContent
+}
+
+func (a communicationAdapter) AMFStatusChangeSubscribe(ctx context.Context, req namfcommunication.SubscriptionData) (AMFStatusChangeSubscribeResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeSubscribeStatusCode) {
+	res, err := a.service.AMFStatusChangeSubscribe(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return AMFStatusChangeSubscribeResponse{}, problemDetails, redirectResponse, AMFStatusChangeSubscribeStatusCode(status)
+	}
+	if res == nil {
+		res = &AMFStatusChangeSubscribeResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, AMFStatusChangeSubscribeStatusCreated
+}
+
+func (a communicationAdapter) AMFStatusChangeSubscribeModify(ctx context.Context, subscriptionID string, req namfcommunication.SubscriptionData) (namfcommunication.SubscriptionData, openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeSubscribeModifyStatusCode) {
+	res, err := a.service.AMFStatusChangeSubscribeModify(ctx, subscriptionID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfcommunication.SubscriptionData{}, problemDetails, redirectResponse, AMFStatusChangeSubscribeModifyStatusCode(status)
+	}
+	if res == nil {
+		res = &namfcommunication.SubscriptionData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, AMFStatusChangeSubscribeModifyStatusOK
+}
+
+func (a communicationAdapter) AMFStatusChangeUnSubscribe(ctx context.Context, subscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, AMFStatusChangeUnSubscribeStatusCode) {
+	if err := a.service.AMFStatusChangeUnSubscribe(ctx, subscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, AMFStatusChangeUnSubscribeStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, AMFStatusChangeUnSubscribeStatusNoContent
+}
+
+func (a communicationAdapter) NonUeN2MessageTransfer(ctx context.Context, req NonUeN2MessageTransferRequest) (namfcommunication.N2InformationTransferRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2MessageTransferStatusCode) {
+	res, err := a.service.NonUeN2MessageTransfer(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfcommunication.N2InformationTransferRspData{}, problemDetails, redirectResponse, NonUeN2MessageTransferStatusCode(status)
+	}
+	if res == nil {
+		res = &namfcommunication.N2InformationTransferRspData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, NonUeN2MessageTransferStatusOK
+}
+
+func (a communicationAdapter) NonUeN2InfoSubscribe(ctx context.Context, req namfcommunication.NonUeN2InfoSubscriptionCreateData) (namfcommunication.NonUeN2InfoSubscriptionCreatedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2InfoSubscribeStatusCode) {
+	res, err := a.service.NonUeN2InfoSubscribe(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfcommunication.NonUeN2InfoSubscriptionCreatedData{}, problemDetails, redirectResponse, NonUeN2InfoSubscribeStatusCode(status)
+	}
+	if res == nil {
+		res = &namfcommunication.NonUeN2InfoSubscriptionCreatedData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, NonUeN2InfoSubscribeStatusCreated
+}
+
+func (a communicationAdapter) NonUeN2InfoUnSubscribe(ctx context.Context, n2NotifySubscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, NonUeN2InfoUnSubscribeStatusCode) {
+	if err := a.service.NonUeN2InfoUnSubscribe(ctx, n2NotifySubscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, NonUeN2InfoUnSubscribeStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, NonUeN2InfoUnSubscribeStatusNoContent
+}
+
+// NewCommunicationService adapts a Communication to the CommunicationService interface.
+func NewCommunicationService(cm Communication) CommunicationService {
+	return communicationServiceAdapter{communication: cm}
+}
+
+type communicationServiceAdapter struct {
+	communication Communication
+}
+
+func (a communicationServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.communication.Error(ctx, err)
+}
+
+func (a communicationServiceAdapter) CreateUEContext(ctx context.Context, ueContextID string, req CreateUEContextRequest) (*CreateUEContextResponse, error) {
+	res, problemDetails, redirectResponse, status := a.communication.CreateUEContext(ctx, ueContextID, req)
+	if status == CreateUEContextStatusCreated {
+		return &res, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrorData != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &CreateUEContextError{ProblemDetailsError: problemDetailsErr, ErrorData: *res.ErrorData, BinaryParts: res.BinaryParts}
+	}
+	return nil, err
+}
+
+func (a communicationServiceAdapter) ReleaseUEContext(ctx context.Context, ueContextID string, req namfcommunication.UEContextRelease) error {
+	problemDetails, redirectResponse, status := a.communication.ReleaseUEContext(ctx, ueContextID, req)
+	if status == ReleaseUEContextStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) UEContextTransfer(ctx context.Context, ueContextID string, req UEContextTransferRequest) (*UEContextTransferResponse, error) {
+	res, problemDetails, redirectResponse, status := a.communication.UEContextTransfer(ctx, ueContextID, req)
+	if status == UEContextTransferStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) N1N2MessageTransfer(ctx context.Context, ueContextID string, req N1N2MessageTransferRequest) (*N1N2MessageTransferResponse, error) {
+	res, problemDetails, redirectResponse, status := a.communication.N1N2MessageTransfer(ctx, ueContextID, req)
+	switch status {
+	case N1N2MessageTransferStatusOK, N1N2MessageTransferStatusAccepted:
+		return &res, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrInfo != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &N1N2MessageTransferError{ProblemDetailsError: problemDetailsErr, ErrInfo: res.ErrInfo}
+	}
+	return nil, err
+}
+
+func (a communicationServiceAdapter) N1N2MessageSubscribe(ctx context.Context, ueContextID string, req namfcommunication.UeN1N2InfoSubscriptionCreateData) (*namfcommunication.UeN1N2InfoSubscriptionCreatedData, error) {
+	res, problemDetails, redirectResponse, status := a.communication.N1N2MessageSubscribe(ctx, ueContextID, req)
+	if status == N1N2MessageSubscribeStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) N1N2MessageUnSubscribe(ctx context.Context, ueContextID string, subscriptionID string) error {
+	problemDetails, redirectResponse, status := a.communication.N1N2MessageUnSubscribe(ctx, ueContextID, subscriptionID)
+	if status == N1N2MessageUnSubscribeStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) AMFStatusChangeSubscribe(ctx context.Context, req namfcommunication.SubscriptionData) (*AMFStatusChangeSubscribeResponse, error) {
+	res, problemDetails, redirectResponse, status := a.communication.AMFStatusChangeSubscribe(ctx, req)
+	if status == AMFStatusChangeSubscribeStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) AMFStatusChangeSubscribeModify(ctx context.Context, subscriptionID string, req namfcommunication.SubscriptionData) (*namfcommunication.SubscriptionData, error) {
+	res, problemDetails, redirectResponse, status := a.communication.AMFStatusChangeSubscribeModify(ctx, subscriptionID, req)
+	if status == AMFStatusChangeSubscribeModifyStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) AMFStatusChangeUnSubscribe(ctx context.Context, subscriptionID string) error {
+	problemDetails, redirectResponse, status := a.communication.AMFStatusChangeUnSubscribe(ctx, subscriptionID)
+	if status == AMFStatusChangeUnSubscribeStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) NonUeN2MessageTransfer(ctx context.Context, req NonUeN2MessageTransferRequest) (*namfcommunication.N2InformationTransferRspData, error) {
+	res, problemDetails, redirectResponse, status := a.communication.NonUeN2MessageTransfer(ctx, req)
+	if status == NonUeN2MessageTransferStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) NonUeN2InfoSubscribe(ctx context.Context, req namfcommunication.NonUeN2InfoSubscriptionCreateData) (*namfcommunication.NonUeN2InfoSubscriptionCreatedData, error) {
+	res, problemDetails, redirectResponse, status := a.communication.NonUeN2InfoSubscribe(ctx, req)
+	if status == NonUeN2InfoSubscribeStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a communicationServiceAdapter) NonUeN2InfoUnSubscribe(ctx context.Context, n2NotifySubscriptionID string) error {
+	problemDetails, redirectResponse, status := a.communication.NonUeN2InfoUnSubscribe(ctx, n2NotifySubscriptionID)
+	if status == NonUeN2InfoUnSubscribeStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
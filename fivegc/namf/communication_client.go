@@ -0,0 +1,265 @@
+package namf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"net/http"
+)
+
+// CommunicationClient is a client for the NAMF Communication service.
+type CommunicationClient struct {
+	client  *namfcommunication.APIClient
+	request *request.Client
+}
+
+// NewCommunicationClient creates a new client for the NAMF Communication service.
+func NewCommunicationClient(cfg fivegc.ClientConfiguration) *CommunicationClient {
+	openapiCfg := &namfcommunication.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []namfcommunication.ServerConfiguration{},
+		OperationServers: make(map[string]namfcommunication.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := namfcommunication.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]namfcommunication.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = namfcommunication.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(namfcommunication.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = namfcommunication.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]namfcommunication.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = namfcommunication.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &CommunicationClient{
+		client:  namfcommunication.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, communicationRouterGroup),
+	}
+}
+
+// N1N2MessageTransfer transfers N1 and N2 messages to the UE and the NG-RAN node serving it. The request is sent as
+// a multipart/related body when it has binary parts, which the generated openapi client does not support.
+// The response is 200 OK when the transfer is initiated, or 202 Accepted with the Location of the N1N2 message
+// resource when the AMF is paging the UE. Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a
+// *N1N2MessageTransferError when they carry error details.
+func (c *CommunicationClient) N1N2MessageTransfer(ctx context.Context, ueContextID string, req N1N2MessageTransferRequest) (*namfcommunication.N1N2MessageTransferRspData, *http.Response, error) {
+	body, contentType, err := multipart.Encode(req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("n1n2 message transfer: %w", err)
+	}
+	uri := c.request.URL(ueContextsResource, ueContextID, n1N2MessagesResource)
+	resp, raw, err := c.request.Do(ctx, http.MethodPost, uri, body, contentType)
+	if err != nil {
+		return nil, resp, fmt.Errorf("n1n2 message transfer: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var transferErr namfcommunication.N1N2MessageTransferError
+		if json.Unmarshal(raw, &transferErr) == nil && transferErr.HasErrInfo() {
+			// The ProblemDetails of the openapi package is converted through its JSON encoding.
+			var problemDetails openapicommon.ProblemDetails
+			if b, err := json.Marshal(transferErr.GetError()); err == nil {
+				json.Unmarshal(b, &problemDetails)
+			}
+			if problemDetails.Status == nil {
+				problemDetails.Status = fivegc.ToInt32(int32(resp.StatusCode))
+			}
+			return nil, resp, fmt.Errorf("n1n2 message transfer: %w", &N1N2MessageTransferError{
+				ProblemDetailsError: fivegc.ProblemDetailsError(problemDetails),
+				ErrInfo:             transferErr.ErrInfo,
+			})
+		}
+		return nil, resp, fmt.Errorf("n1n2 message transfer: %w", request.Error(resp, raw))
+	}
+	var data namfcommunication.N1N2MessageTransferRspData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, resp, fmt.Errorf("n1n2 message transfer: %w", err)
+	}
+	return &data, resp, nil
+}
+
+// N1N2MessageSubscribe returns a request subscribing to the N1 messages and N2 information of a UE.
+func (c *CommunicationClient) N1N2MessageSubscribe(ctx context.Context, ueContextID string) namfcommunication.ApiN1N2MessageSubscribeRequest {
+	return c.client.N1N2SubscriptionsCollectionForIndividualUEContextsDocumentApi.N1N2MessageSubscribe(ctx, ueContextID)
+}
+
+// N1N2MessageSubscribeExecute executes a N1N2MessageSubscribe request.
+func (c *CommunicationClient) N1N2MessageSubscribeExecute(r namfcommunication.ApiN1N2MessageSubscribeRequest) (*namfcommunication.UeN1N2InfoSubscriptionCreatedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// N1N2MessageUnSubscribe returns a request removing a subscription to the N1 messages and N2 information of a UE.
+func (c *CommunicationClient) N1N2MessageUnSubscribe(ctx context.Context, ueContextID string, subscriptionID string) namfcommunication.ApiN1N2MessageUnSubscribeRequest {
+	return c.client.N1N2IndividualSubscriptionDocumentApi.N1N2MessageUnSubscribe(ctx, ueContextID, subscriptionID)
+}
+
+// N1N2MessageUnSubscribeExecute executes a N1N2MessageUnSubscribe request.
+func (c *CommunicationClient) N1N2MessageUnSubscribeExecute(r namfcommunication.ApiN1N2MessageUnSubscribeRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// CreateUEContext creates the UE context in the target AMF of an inter-AMF handover. The request and the response are
+// sent as multipart/related bodies when they have binary parts, which the generated openapi client does not support.
+// Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *CreateUEContextError when they carry
+// error data, or as a *fivegc.RedirectError for the redirections.
+func (c *CommunicationClient) CreateUEContext(ctx context.Context, ueContextID string, req CreateUEContextRequest) (*CreateUEContextResponse, *http.Response, error) {
+	body, contentType, err := multipart.Encode(req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create ue context: %w", err)
+	}
+	resp, raw, err := c.request.Do(ctx, http.MethodPut, c.request.URL(ueContextsResource, ueContextID), body, contentType)
+	if err != nil {
+		return nil, resp, fmt.Errorf("create ue context: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data namfcommunication.UeContextCreateError
+		if problemDetailsErr, parts, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return nil, resp, fmt.Errorf("create ue context: %w", &CreateUEContextError{ProblemDetailsError: problemDetailsErr, ErrorData: data, BinaryParts: parts})
+		}
+		return nil, resp, fmt.Errorf("create ue context: %w", request.Error(resp, raw))
+	}
+	var res CreateUEContextResponse
+	if res.BinaryParts, err = multipart.Decode(resp.Header.Get("Content-Type"), raw, &res.JSONData); err != nil {
+		return nil, resp, fmt.Errorf("create ue context: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// ReleaseUEContext returns a request releasing the UE context created in the target AMF of a cancelled handover.
+func (c *CommunicationClient) ReleaseUEContext(ctx context.Context, ueContextID string) namfcommunication.ApiReleaseUEContextRequest {
+	return c.client.IndividualUeContextDocumentApi.ReleaseUEContext(ctx, ueContextID)
+}
+
+// ReleaseUEContextExecute executes a ReleaseUEContext request.
+func (c *CommunicationClient) ReleaseUEContextExecute(r namfcommunication.ApiReleaseUEContextRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// UEContextTransfer transfers the UE context to the new AMF of a registration. The request and the response are sent
+// as multipart/related bodies when they have binary parts, which the generated openapi client does not support.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (c *CommunicationClient) UEContextTransfer(ctx context.Context, ueContextID string, req UEContextTransferRequest) (*UEContextTransferResponse, *http.Response, error) {
+	var res UEContextTransferResponse
+	resp, err := c.doMultipart(ctx, http.MethodPost, c.request.URL(ueContextsResource, ueContextID, transferResource), req.JSONData, req.BinaryParts, &res.JSONData, &res.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("ue context transfer: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// AMFStatusChangeSubscribe returns a request subscribing to the status changes of the AMF.
+func (c *CommunicationClient) AMFStatusChangeSubscribe(ctx context.Context) namfcommunication.ApiAMFStatusChangeSubscribeRequest {
+	return c.client.SubscriptionsCollectionCollectionApi.AMFStatusChangeSubscribe(ctx)
+}
+
+// AMFStatusChangeSubscribeExecute executes an AMFStatusChangeSubscribe request.
+func (c *CommunicationClient) AMFStatusChangeSubscribeExecute(r namfcommunication.ApiAMFStatusChangeSubscribeRequest) (*namfcommunication.SubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// AMFStatusChangeSubscribeModify returns a request replacing a subscription to the status changes of the AMF.
+func (c *CommunicationClient) AMFStatusChangeSubscribeModify(ctx context.Context, subscriptionID string) namfcommunication.ApiAMFStatusChangeSubscribeModfyRequest {
+	return c.client.IndividualSubscriptionDocumentApi.AMFStatusChangeSubscribeModfy(ctx, subscriptionID)
+}
+
+// AMFStatusChangeSubscribeModifyExecute executes an AMFStatusChangeSubscribeModify request.
+func (c *CommunicationClient) AMFStatusChangeSubscribeModifyExecute(r namfcommunication.ApiAMFStatusChangeSubscribeModfyRequest) (*namfcommunication.SubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// AMFStatusChangeUnSubscribe returns a request removing a subscription to the status changes of the AMF.
+func (c *CommunicationClient) AMFStatusChangeUnSubscribe(ctx context.Context, subscriptionID string) namfcommunication.ApiAMFStatusChangeUnSubscribeRequest {
+	return c.client.IndividualSubscriptionDocumentApi.AMFStatusChangeUnSubscribe(ctx, subscriptionID)
+}
+
+// AMFStatusChangeUnSubscribeExecute executes an AMFStatusChangeUnSubscribe request.
+func (c *CommunicationClient) AMFStatusChangeUnSubscribeExecute(r namfcommunication.ApiAMFStatusChangeUnSubscribeRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// NonUeN2MessageTransfer transfers N2 information, not related to a UE, to the NG-RAN nodes. The request is sent as a
+// multipart/related body when it has binary parts, which the generated openapi client does not support.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (c *CommunicationClient) NonUeN2MessageTransfer(ctx context.Context, req NonUeN2MessageTransferRequest) (*namfcommunication.N2InformationTransferRspData, *http.Response, error) {
+	var data namfcommunication.N2InformationTransferRspData
+	resp, err := c.doMultipart(ctx, http.MethodPost, c.request.URL(nonUEN2MessagesResource, transferResource), req.JSONData, req.BinaryParts, &data, nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("non ue n2 message transfer: %w", err)
+	}
+	return &data, resp, nil
+}
+
+// NonUeN2InfoSubscribe returns a request subscribing to the N2 information, not related to a UE, sent by the NG-RAN nodes.
+func (c *CommunicationClient) NonUeN2InfoSubscribe(ctx context.Context) namfcommunication.ApiNonUeN2InfoSubscribeRequest {
+	return c.client.NonUEN2MessagesSubscriptionsCollectionDocumentApi.NonUeN2InfoSubscribe(ctx)
+}
+
+// NonUeN2InfoSubscribeExecute executes a NonUeN2InfoSubscribe request.
+func (c *CommunicationClient) NonUeN2InfoSubscribeExecute(r namfcommunication.ApiNonUeN2InfoSubscribeRequest) (*namfcommunication.NonUeN2InfoSubscriptionCreatedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// NonUeN2InfoUnSubscribe returns a request removing a subscription to the N2 information not related to a UE.
+func (c *CommunicationClient) NonUeN2InfoUnSubscribe(ctx context.Context, n2NotifySubscriptionID string) namfcommunication.ApiNonUeN2InfoUnSubscribeRequest {
+	return c.client.NonUEN2MessageNotificationIndividualSubscriptionDocumentApi.NonUeN2InfoUnSubscribe(ctx, n2NotifySubscriptionID)
+}
+
+// NonUeN2InfoUnSubscribeExecute executes a NonUeN2InfoUnSubscribe request.
+func (c *CommunicationClient) NonUeN2InfoUnSubscribeExecute(r namfcommunication.ApiNonUeN2InfoUnSubscribeRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// doMultipart sends root and its binary parts to uri, and decodes the JSON or multipart/related response body into
+// resRoot and, when resParts is not nil, its binary parts.
+func (c *CommunicationClient) doMultipart(ctx context.Context, method, uri string, root interface{}, parts []BinaryPart, resRoot interface{}, resParts *[]BinaryPart) (*http.Response, error) {
+	body, contentType, err := multipart.Encode(root, parts)
+	if err != nil {
+		return nil, err
+	}
+	resp, raw, err := c.request.Do(ctx, method, uri, body, contentType)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, request.Error(resp, raw)
+	}
+	decoded, err := multipart.Decode(resp.Header.Get("Content-Type"), raw, resRoot)
+	if err != nil {
+		return resp, err
+	}
+	if resParts != nil {
+		*resParts = decoded
+	}
+	return resp, nil
+}
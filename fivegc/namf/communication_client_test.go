@@ -0,0 +1,78 @@
+package namf_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestN1N2MessageTransfer(t *testing.T) {
+	nrppa := []byte{0x00, 0x05, 0x00, 0x00, 0x01}
+	amf := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/namf-comm/v1/ue-contexts/imsi-208930000000001/n1-n2-messages" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var data namfcommunication.N1N2MessageTransferReqData
+		parts, err := multipart.Decode(r.Header.Get("Content-Type"), body, &data)
+		if err != nil || len(parts) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if data.GetLcsCorrelationId() == "busy" {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"status":409,"cause":"UE_IN_CM_IDLE_STATE"},"errInfo":{"retryAfter":5}}`))
+			return
+		}
+		container := data.GetN2InfoContainer()
+		info := container.GetNrppaInfo()
+		pdu := info.GetNrppaPdu()
+		ref := pdu.GetNgapData()
+		if parts[0].ContentID != ref.GetContentId() || parts[0].ContentType != namf.ContentTypeNGAP || string(parts[0].Body) != string(nrppa) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(namfcommunication.N1N2MessageTransferRspData{
+			Cause: namfcommunication.N1N2MessageTransferCause{String: fivegc.ToString(namf.N1N2TransferCauseTransferInitiated)},
+		})
+	}))
+	defer amf.Close()
+	client := namf.NewCommunicationClient(fivegc.ClientConfiguration{
+		Servers:    fivegc.ServerConfigurations{{URL: amf.URL + "/namf-comm/v1"}},
+		HTTPClient: amf.Client(),
+	})
+	ctx := context.Background()
+
+	res, _, err := client.N1N2MessageTransfer(ctx, "imsi-208930000000001", namf.NewNRPPaTransfer("lmf-1", "session-1", nrppa))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cause := res.GetCause(); cause.String == nil || *cause.String != namf.N1N2TransferCauseTransferInitiated {
+		t.Errorf("unexpected response %+v", res)
+	}
+
+	_, _, err = client.N1N2MessageTransfer(ctx, "imsi-208930000000001", namf.NewNRPPaTransfer("lmf-1", "busy", nrppa))
+	var transferErr *namf.N1N2MessageTransferError
+	if !errors.As(err, &transferErr) || transferErr.ErrInfo.GetRetryAfter() != 5 || *transferErr.Cause != "UE_IN_CM_IDLE_STATE" {
+		t.Errorf("unexpected error %v", err)
+	}
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != 409 {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	_, _, err = client.N1N2MessageTransfer(ctx, "imsi-208930000000002", namf.NewLPPTransfer("lmf-1", "session-1", []byte{0x92}))
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != 404 {
+		t.Errorf("unexpected error %v", err)
+	}
+}
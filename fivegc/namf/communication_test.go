@@ -0,0 +1,202 @@
+package namf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// targetAMF serves the communication requests of a single UE, paging it before any N1N2 message transfer.
+type targetAMF struct {
+	supi          string
+	subscriptions map[string]namfcommunication.SubscriptionData
+}
+
+func (a *targetAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *targetAMF) CreateUEContext(_ context.Context, ueContextID string, req CreateUEContextRequest) (*CreateUEContextResponse, error) {
+	if ueContextID != a.supi {
+		var data namfcommunication.UeContextCreateError
+		data.SetNgapCause(namfcommunication.NgApCause{Group: 0, Value: 26})
+		data.SetTargetToSourceFailureData(namfcommunication.N2InfoContent{NgapData: namfcommunication.RefToBinaryData{ContentId: n2ContentID}})
+		return nil, &CreateUEContextError{
+			ProblemDetailsError: fivegc.ProblemDetailsError(ErrHandoverFailure.ProblemDetails()),
+			ErrorData:           data,
+			BinaryParts:         []BinaryPart{{ContentID: n2ContentID, ContentType: ContentTypeNGAP, Body: []byte{0x40, 0x0c}}},
+		}
+	}
+	var res CreateUEContextResponse
+	res.JSONData.SetUeContext(req.JSONData.GetUeContext())
+	res.BinaryParts = req.BinaryParts
+	return &res, nil
+}
+
+func (a *targetAMF) ReleaseUEContext(_ context.Context, ueContextID string, _ namfcommunication.UEContextRelease) error {
+	if ueContextID != a.supi {
+		return ErrContextNotFound
+	}
+	return nil
+}
+
+func (a *targetAMF) UEContextTransfer(context.Context, string, UEContextTransferRequest) (*UEContextTransferResponse, error) {
+	return nil, ErrContextNotFound
+}
+
+func (a *targetAMF) N1N2MessageTransfer(_ context.Context, ueContextID string, _ N1N2MessageTransferRequest) (*N1N2MessageTransferResponse, error) {
+	if ueContextID == "imsi-208930000000003" {
+		return nil, &N1N2MessageTransferError{
+			ProblemDetailsError: fivegc.ProblemDetailsError(fivegc.NewApplicationError(fivegc.StatusNotAcceptable, "").ProblemDetails()),
+			ErrInfo:             &namfcommunication.N1N2MsgTxfrErrDetail{RetryAfter: fivegc.ToInt32(10)},
+		}
+	}
+	if ueContextID != a.supi {
+		return nil, &N1N2MessageTransferError{
+			ProblemDetailsError: fivegc.ProblemDetailsError(ErrTemporaryRejectHandoverOngoing.ProblemDetails()),
+			ErrInfo:             &namfcommunication.N1N2MsgTxfrErrDetail{RetryAfter: fivegc.ToInt32(10)},
+		}
+	}
+	res := &N1N2MessageTransferResponse{Location: "https://amf.example.com/namf-comm/v1/ue-contexts/" + ueContextID + "/n1-n2-messages/1"}
+	res.JSONData.Cause = namfcommunication.N1N2MessageTransferCause{String: fivegc.ToString(N1N2TransferCauseAttemptingToReachUE)}
+	return res, nil
+}
+
+func (a *targetAMF) N1N2MessageSubscribe(context.Context, string, namfcommunication.UeN1N2InfoSubscriptionCreateData) (*namfcommunication.UeN1N2InfoSubscriptionCreatedData, error) {
+	return &namfcommunication.UeN1N2InfoSubscriptionCreatedData{N1n2NotifySubscriptionId: "1"}, nil
+}
+
+func (a *targetAMF) N1N2MessageUnSubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (a *targetAMF) AMFStatusChangeSubscribe(_ context.Context, req namfcommunication.SubscriptionData) (*AMFStatusChangeSubscribeResponse, error) {
+	a.subscriptions["amf-status-1"] = req
+	return &AMFStatusChangeSubscribeResponse{SubscriptionID: "amf-status-1", JSONData: req}, nil
+}
+
+func (a *targetAMF) AMFStatusChangeSubscribeModify(_ context.Context, subscriptionID string, req namfcommunication.SubscriptionData) (*namfcommunication.SubscriptionData, error) {
+	if _, ok := a.subscriptions[subscriptionID]; !ok {
+		return nil, fivegc.ErrSubscriptionNotFound
+	}
+	a.subscriptions[subscriptionID] = req
+	return &req, nil
+}
+
+func (a *targetAMF) AMFStatusChangeUnSubscribe(_ context.Context, subscriptionID string) error {
+	if _, ok := a.subscriptions[subscriptionID]; !ok {
+		return fivegc.ErrSubscriptionNotFound
+	}
+	delete(a.subscriptions, subscriptionID)
+	return nil
+}
+
+func (a *targetAMF) NonUeN2MessageTransfer(context.Context, NonUeN2MessageTransferRequest) (*namfcommunication.N2InformationTransferRspData, error) {
+	return nil, nil
+}
+
+func (a *targetAMF) NonUeN2InfoSubscribe(context.Context, namfcommunication.NonUeN2InfoSubscriptionCreateData) (*namfcommunication.NonUeN2InfoSubscriptionCreatedData, error) {
+	return &namfcommunication.NonUeN2InfoSubscriptionCreatedData{N2NotifySubscriptionId: "n2-1"}, nil
+}
+
+func (a *targetAMF) NonUeN2InfoUnSubscribe(context.Context, string) error {
+	return nil
+}
+
+func TestCommunication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &targetAMF{supi: "imsi-208930000000001", subscriptions: map[string]namfcommunication.SubscriptionData{}}
+	attachCommunicationHandler(router.Group(""), NewCommunication(a), log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewCommunicationClient(fivegc.ClientConfiguration{Servers: fivegc.ServerConfigurations{{URL: server.URL + communicationRouterGroup}}, HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var createReq CreateUEContextRequest
+	createReq.JSONData.UeContext.SetSupi("imsi-208930000000001")
+	createReq.BinaryParts = []BinaryPart{{ContentID: n2ContentID, ContentType: ContentTypeNGAP, Body: []byte{0x00, 0x0c}}}
+	created, resp, err := client.CreateUEContext(ctx, "imsi-208930000000001", createReq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/ue-contexts/imsi-208930000000001") {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if created.JSONData.UeContext.GetSupi() != "imsi-208930000000001" || len(created.BinaryParts) != 1 || created.BinaryParts[0].ContentType != ContentTypeNGAP {
+		t.Errorf("unexpected ue context %+v", created)
+	}
+	_, _, err = client.CreateUEContext(ctx, "imsi-208930000000002", createReq)
+	var createErr *CreateUEContextError
+	if !errors.As(err, &createErr) || *createErr.Status != int32(fivegc.StatusForbidden) || *createErr.Cause != CauseHandoverFailure {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if createErr.ErrorData.GetNgapCause().Value != 26 || len(createErr.BinaryParts) != 1 || createErr.BinaryParts[0].Body[0] != 0x40 {
+		t.Errorf("unexpected error data %+v", createErr)
+	}
+	_, _, err = client.UEContextTransfer(ctx, "imsi-208930000000001", UEContextTransferRequest{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusNotFound) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	data, resp, err := client.N1N2MessageTransfer(ctx, "imsi-208930000000001", N1N2MessageTransferRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("Location") == "" || data.Cause.String == nil || *data.Cause.String != N1N2TransferCauseAttemptingToReachUE {
+		t.Errorf("unexpected response %d %+v", resp.StatusCode, data)
+	}
+	_, _, err = client.N1N2MessageTransfer(ctx, "imsi-208930000000002", N1N2MessageTransferRequest{})
+	var transferErr *N1N2MessageTransferError
+	if !errors.As(err, &transferErr) || transferErr.ErrInfo.GetRetryAfter() != 10 || *transferErr.Cause != CauseTemporaryRejectHandoverOngoing {
+		t.Errorf("unexpected error %v", err)
+	}
+	_, _, err = client.N1N2MessageTransfer(ctx, "imsi-208930000000003", N1N2MessageTransferRequest{})
+	if !errors.As(err, &problemDetailsErr) || errors.As(err, &transferErr) || *problemDetailsErr.Status != int32(fivegc.StatusInternalServerError) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	send := func(method, path, body string) *http.Response {
+		return apitest.Send(t, router, method, server.URL+communicationRouterGroup+path, nil, body).Result()
+	}
+	resp = send(http.MethodPost, "/subscriptions", `{"amfStatusUri":"https://smf.example.com/amf-status"}`)
+	if resp.StatusCode != http.StatusCreated || resp.Header.Get("Location") != server.URL+communicationRouterGroup+"/subscriptions/amf-status-1" {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if resp := send(http.MethodPut, "/subscriptions/amf-status-1", `{"amfStatusUri":"https://smf.example.com/status"}`); resp.StatusCode != http.StatusOK || a.subscriptions["amf-status-1"].AmfStatusUri != "https://smf.example.com/status" {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodDelete, "/subscriptions/amf-status-1", ""); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodDelete, "/subscriptions/amf-status-1", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	resp = send(http.MethodPost, "/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions", `{"n2NotifyCallbackUri":"https://lmf.example.com/n2"}`)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions/1") {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	resp = send(http.MethodPost, "/non-ue-n2-messages/subscriptions", `{}`)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/non-ue-n2-messages/subscriptions/n2-1") {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if resp := send(http.MethodDelete, "/non-ue-n2-messages/subscriptions/n2-1", ""); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodPost, "/ue-contexts/imsi-208930000000002/release", `{}`); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if _, resp, err := client.NonUeN2MessageTransfer(ctx, NonUeN2MessageTransferRequest{}); err != nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected error %v", err)
+	}
+}
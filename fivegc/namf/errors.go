@@ -0,0 +1,48 @@
+package namf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+// Application error causes of the NAMF services, defined in TS 29.518 clauses 6.1.7.3, 6.2.7.3, 6.3.7.3 and 6.4.7.3.
+const (
+	CauseHandoverFailure                    = "HANDOVER_FAILURE"
+	CauseContextNotFound                    = "CONTEXT_NOT_FOUND"
+	CausePositioningDenied                  = "POSITIONING_DENIED"
+	CauseUnspecified                        = "UNSPECIFIED"
+	CausePositioningFailed                  = "POSITIONING_FAILED"
+	CauseUENotReachable                     = "UE_NOT_REACHABLE"
+	CauseUENotServedByAMF                   = "UE_NOT_SERVED_BY_AMF"
+	CauseUEInNonAllowedArea                 = "UE_IN_NON_ALLOWED_AREA"
+	CauseHigherPriorityRequestOngoing       = "HIGHER_PRIORITY_REQUEST_ONGOING"
+	CauseTemporaryRejectRegistrationOngoing = "TEMPORARY_REJECT_REGISTRATION_ONGOING"
+	CauseTemporaryRejectHandoverOngoing     = "TEMPORARY_REJECT_HANDOVER_ONGOING"
+)
+
+// Application errors of the NAMF services, defined in TS 29.518 clauses 6.1.7.3, 6.2.7.3, 6.3.7.3 and 6.4.7.3.
+// The errors common to all the APIs, such as fivegc.ErrNfCongestion or fivegc.ErrInsufficientResources, can be used as well.
+var (
+	// ErrHandoverFailure is returned when the target NG-RAN node rejects the handover of an inter-AMF handover.
+	ErrHandoverFailure = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseHandoverFailure)
+	// ErrContextNotFound is returned when the UE context of the request does not exist in the AMF.
+	ErrContextNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseContextNotFound)
+	// ErrPositioningDenied is returned when the positioning procedure is denied.
+	ErrPositioningDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CausePositioningDenied)
+	// ErrUnspecified is returned when the request is rejected due to unspecified reasons.
+	ErrUnspecified = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseUnspecified)
+	// ErrPositioningFailed is returned when the positioning procedure failed.
+	ErrPositioningFailed = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CausePositioningFailed)
+	// ErrUENotReachable is returned when the UE cannot be reached, for a positioning procedure or a paging.
+	ErrUENotReachable = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CauseUENotReachable)
+	// ErrUENotServedByAMF is returned when an event subscription targets a UE which is not served by the AMF.
+	ErrUENotServedByAMF = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseUENotServedByAMF)
+	// ErrUEInNonAllowedArea is returned when the UE cannot be paged because it is in a non-allowed area.
+	ErrUEInNonAllowedArea = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseUEInNonAllowedArea)
+	// ErrHigherPriorityRequestOngoing is returned when a N1N2MessageTransfer collides with a paging of higher priority
+	// in progress.
+	ErrHigherPriorityRequestOngoing = fivegc.NewApplicationError(fivegc.StatusConflict, CauseHigherPriorityRequestOngoing)
+	// ErrTemporaryRejectRegistrationOngoing is returned when a N1N2MessageTransfer is rejected temporarily because
+	// of a registration procedure in progress.
+	ErrTemporaryRejectRegistrationOngoing = fivegc.NewApplicationError(fivegc.StatusConflict, CauseTemporaryRejectRegistrationOngoing)
+	// ErrTemporaryRejectHandoverOngoing is returned when a N1N2MessageTransfer is rejected temporarily because of a
+	// handover procedure in progress.
+	ErrTemporaryRejectHandoverOngoing = fivegc.NewApplicationError(fivegc.StatusConflict, CauseTemporaryRejectHandoverOngoing)
+)
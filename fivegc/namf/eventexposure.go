@@ -0,0 +1,405 @@
+package namf
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfeventexposure "github.com/5GCoreNet/openapi/openapi_Namf_EventExposure"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	eventExposureRouterGroup = "/namf-evts/v1"
+)
+
+// Types of the AMF events, defined in TS 29.518 clause 6.2.6.3.3.
+const (
+	EventTypeLocationReport                     = "LOCATION_REPORT"
+	EventTypePresenceInAOIReport                = "PRESENCE_IN_AOI_REPORT"
+	EventTypeTimezoneReport                     = "TIMEZONE_REPORT"
+	EventTypeAccessTypeReport                   = "ACCESS_TYPE_REPORT"
+	EventTypeRegistrationStateReport            = "REGISTRATION_STATE_REPORT"
+	EventTypeConnectivityStateReport            = "CONNECTIVITY_STATE_REPORT"
+	EventTypeReachabilityReport                 = "REACHABILITY_REPORT"
+	EventTypeCommunicationFailureReport         = "COMMUNICATION_FAILURE_REPORT"
+	EventTypeUEsInAreaReport                    = "UES_IN_AREA_REPORT"
+	EventTypeSubscriptionIDChange               = "SUBSCRIPTION_ID_CHANGE"
+	EventTypeSubscriptionIDAddition             = "SUBSCRIPTION_ID_ADDITION"
+	EventTypeLossOfConnectivity                 = "LOSS_OF_CONNECTIVITY"
+	EventType5GSUserStateReport                 = "5GS_USER_STATE_REPORT"
+	EventTypeAvailabilityForSMSOverNAS          = "AVAILABILITY_FOR_SMS_OVER_NAS"
+	EventTypeTypeAllocationCodeReport           = "TYPE_ALLOCATION_CODE_REPORT"
+	EventTypeFrequentMobilityRegistrationReport = "FREQUENT_MOBILITY_REGISTRATION_REPORT"
+)
+
+// EventExposure is the interface that wraps the NAMF EventExposure service (TS 29.518 clause 5.3).
+type EventExposure interface {
+	fivegc.CommonInterface
+	// CreateSubscription subscribes to the events of a UE, a group of UEs or any UE.
+	// The subscription ID of the created subscription is sent in the Location of the response.
+	CreateSubscription(context.Context, namfeventexposure.AmfCreateEventSubscription) (namfeventexposure.AmfCreatedEventSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSubscriptionStatusCode)
+	// ModifySubscription updates the events or the expiry of the subscription identified by its ID.
+	ModifySubscription(context.Context, string, namfeventexposure.ModifySubscriptionRequest) (namfeventexposure.AmfUpdatedEventSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, ModifySubscriptionStatusCode)
+	// DeleteSubscription removes the subscription identified by its ID.
+	DeleteSubscription(context.Context, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteSubscriptionStatusCode)
+}
+
+// EventExposureService is an alternative to the EventExposure interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one.
+// Use NewEventExposure to attach it to the NAMF Server.
+type EventExposureService interface {
+	fivegc.CommonInterface
+	// CreateSubscription subscribes to the events of a UE, a group of UEs or any UE.
+	CreateSubscription(context.Context, namfeventexposure.AmfCreateEventSubscription) (*namfeventexposure.AmfCreatedEventSubscription, error)
+	// ModifySubscription updates the events or the expiry of the subscription identified by its ID.
+	ModifySubscription(context.Context, string, namfeventexposure.ModifySubscriptionRequest) (*namfeventexposure.AmfUpdatedEventSubscription, error)
+	// DeleteSubscription removes the subscription identified by its ID.
+	DeleteSubscription(context.Context, string) error
+}
+
+// EventExposureNotification is the interface that wraps the Notify callback of the NAMF EventExposure service.
+// It is implemented by the consumers of the AMF events, such as the NWDAF or the NEF, to receive the event reports
+// sent by the AMF to the eventNotifyUri and the subsChangeNotifyUri of their subscriptions.
+type EventExposureNotification interface {
+	fivegc.CommonInterface
+	// EventExposureNotify receives the event reports of a subscription.
+	EventExposureNotify(context.Context, namfeventexposure.AmfEventNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventExposureNotifyStatusCode)
+}
+
+// CreateSubscriptionStatusCode is the status code of a CreateSubscription response.
+type CreateSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// CreateSubscriptionStatusCreated is the status code for the response when the subscription is created.
+	CreateSubscriptionStatusCreated           CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusCreated)
+	CreateSubscriptionStatusTemporaryRedirect CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	CreateSubscriptionStatusPermanentRedirect CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var createSubscriptionErrors = response.BodyErrors
+
+// ModifySubscriptionStatusCode is the status code of a ModifySubscription response.
+type ModifySubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// ModifySubscriptionStatusOK is the status code for the response when the subscription is updated.
+	ModifySubscriptionStatusOK                ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusOK)
+	ModifySubscriptionStatusTemporaryRedirect ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	ModifySubscriptionStatusPermanentRedirect ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var modifySubscriptionErrors = response.BodyErrors
+
+// DeleteSubscriptionStatusCode is the status code of a DeleteSubscription response.
+type DeleteSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// DeleteSubscriptionStatusOK is the status code for the response when the subscription is removed.
+	DeleteSubscriptionStatusOK                DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusOK)
+	DeleteSubscriptionStatusTemporaryRedirect DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	DeleteSubscriptionStatusPermanentRedirect DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deleteSubscriptionErrors = response.DeletionErrors
+
+// EventExposureNotifyStatusCode is the status code of an EventExposureNotify response.
+type EventExposureNotifyStatusCode fivegc.StatusCode
+
+const (
+	// EventExposureNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	EventExposureNotifyStatusNoContent         EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusNoContent)
+	EventExposureNotifyStatusTemporaryRedirect EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	EventExposureNotifyStatusPermanentRedirect EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var eventExposureNotifyErrors = response.BodyErrors
+
+func attachEventExposureHandler(r *gin.RouterGroup, e EventExposure, logger *log.Logger) {
+	group := r.Group(eventExposureRouterGroup)
+	{
+		group.POST(router.Path(subscriptionsEndpoint), func(c *gin.Context) {
+			var req namfeventexposure.AmfCreateEventSubscription
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, e, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, createSubscriptionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := e.CreateSubscription(c, req)
+			switch status {
+			case CreateSubscriptionStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.SubscriptionId))
+				c.JSON(int(status), res)
+			case CreateSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CreateSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, createSubscriptionErrors)
+			}
+			return
+		})
+		group.PATCH(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			var req namfeventexposure.ModifySubscriptionRequest
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, e, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, modifySubscriptionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := e.ModifySubscription(c, c.Param(subscriptionIDParam), req)
+			switch status {
+			case ModifySubscriptionStatusOK:
+				c.JSON(int(status), res)
+			case ModifySubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ModifySubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, modifySubscriptionErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := e.DeleteSubscription(c, c.Param(subscriptionIDParam))
+			switch status {
+			case DeleteSubscriptionStatusOK:
+				c.Status(int(status))
+			case DeleteSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeleteSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deleteSubscriptionErrors)
+			}
+			return
+		})
+	}
+}
+
+func attachEventExposureNotificationHandler(router *gin.RouterGroup, path string, n EventExposureNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req namfeventexposure.AmfEventNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, eventExposureNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.EventExposureNotify(c, req)
+		switch status {
+		case EventExposureNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case EventExposureNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case EventExposureNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, eventExposureNotifyErrors)
+		}
+		return
+	})
+}
+
+// NewEventExposure adapts an EventExposureService to the EventExposure interface.
+func NewEventExposure(s EventExposureService) EventExposure {
+	return eventExposureAdapter{service: s}
+}
+
+type eventExposureAdapter struct {
+	service EventExposureService
+}
+
+func (a eventExposureAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a eventExposureAdapter) CreateSubscription(ctx context.Context, req namfeventexposure.AmfCreateEventSubscription) (namfeventexposure.AmfCreatedEventSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSubscriptionStatusCode) {
+	res, err := a.service.CreateSubscription(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfeventexposure.AmfCreatedEventSubscription{}, problemDetails, redirectResponse, CreateSubscriptionStatusCode(status)
+	}
+	if res == nil {
+		res = &namfeventexposure.AmfCreatedEventSubscription{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CreateSubscriptionStatusCreated
+}
+
+func (a eventExposureAdapter) ModifySubscription(ctx context.Context, subscriptionID string, req namfeventexposure.ModifySubscriptionRequest) (namfeventexposure.AmfUpdatedEventSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, ModifySubscriptionStatusCode) {
+	res, err := a.service.ModifySubscription(ctx, subscriptionID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfeventexposure.AmfUpdatedEventSubscription{}, problemDetails, redirectResponse, ModifySubscriptionStatusCode(status)
+	}
+	if res == nil {
+		res = &namfeventexposure.AmfUpdatedEventSubscription{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ModifySubscriptionStatusOK
+}
+
+func (a eventExposureAdapter) DeleteSubscription(ctx context.Context, subscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteSubscriptionStatusCode) {
+	if err := a.service.DeleteSubscription(ctx, subscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, DeleteSubscriptionStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeleteSubscriptionStatusOK
+}
+
+// NewEventExposureService adapts an EventExposure to the EventExposureService interface.
+func NewEventExposureService(e EventExposure) EventExposureService {
+	return eventExposureServiceAdapter{eventExposure: e}
+}
+
+type eventExposureServiceAdapter struct {
+	eventExposure EventExposure
+}
+
+func (a eventExposureServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.eventExposure.Error(ctx, err)
+}
+
+func (a eventExposureServiceAdapter) CreateSubscription(ctx context.Context, req namfeventexposure.AmfCreateEventSubscription) (*namfeventexposure.AmfCreatedEventSubscription, error) {
+	res, problemDetails, redirectResponse, status := a.eventExposure.CreateSubscription(ctx, req)
+	if status == CreateSubscriptionStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a eventExposureServiceAdapter) ModifySubscription(ctx context.Context, subscriptionID string, req namfeventexposure.ModifySubscriptionRequest) (*namfeventexposure.AmfUpdatedEventSubscription, error) {
+	res, problemDetails, redirectResponse, status := a.eventExposure.ModifySubscription(ctx, subscriptionID, req)
+	if status == ModifySubscriptionStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a eventExposureServiceAdapter) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	problemDetails, redirectResponse, status := a.eventExposure.DeleteSubscription(ctx, subscriptionID)
+	if status == DeleteSubscriptionStatusOK {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// EventExposureClient is a client for the NAMF EventExposure service. It also sends the Notify callbacks.
+type EventExposureClient struct {
+	client  *namfeventexposure.APIClient
+	request *request.Client
+}
+
+// NewEventExposureClient creates a new client for the NAMF EventExposure service.
+func NewEventExposureClient(cfg fivegc.ClientConfiguration) *EventExposureClient {
+	openapiCfg := &namfeventexposure.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []namfeventexposure.ServerConfiguration{},
+		OperationServers: make(map[string]namfeventexposure.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := namfeventexposure.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]namfeventexposure.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = namfeventexposure.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(namfeventexposure.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = namfeventexposure.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]namfeventexposure.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = namfeventexposure.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &EventExposureClient{
+		client:  namfeventexposure.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, eventExposureRouterGroup),
+	}
+}
+
+// CreateSubscription returns a request subscribing to the AMF events.
+func (e *EventExposureClient) CreateSubscription(ctx context.Context) namfeventexposure.ApiCreateSubscriptionRequest {
+	return e.client.SubscriptionsCollectionCollectionApi.CreateSubscription(ctx)
+}
+
+// CreateSubscriptionExecute executes a CreateSubscription request.
+func (e *EventExposureClient) CreateSubscriptionExecute(r namfeventexposure.ApiCreateSubscriptionRequest) (*namfeventexposure.AmfCreatedEventSubscription, *http.Response, error) {
+	return r.Execute()
+}
+
+// ModifySubscription returns a request updating a subscription to the AMF events.
+func (e *EventExposureClient) ModifySubscription(ctx context.Context, subscriptionID string) namfeventexposure.ApiModifySubscriptionRequest {
+	return e.client.IndividualSubscriptionDocumentApi.ModifySubscription(ctx, subscriptionID)
+}
+
+// ModifySubscriptionExecute executes a ModifySubscription request.
+func (e *EventExposureClient) ModifySubscriptionExecute(r namfeventexposure.ApiModifySubscriptionRequest) (*namfeventexposure.AmfUpdatedEventSubscription, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeleteSubscription returns a request removing a subscription to the AMF events.
+func (e *EventExposureClient) DeleteSubscription(ctx context.Context, subscriptionID string) namfeventexposure.ApiDeleteSubscriptionRequest {
+	return e.client.IndividualSubscriptionDocumentApi.DeleteSubscription(ctx, subscriptionID)
+}
+
+// DeleteSubscriptionExecute executes a DeleteSubscription request.
+func (e *EventExposureClient) DeleteSubscriptionExecute(r namfeventexposure.ApiDeleteSubscriptionRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// EventExposureNotify sends event reports to the eventNotifyUri, or the subsChangeNotifyUri, of a subscription.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (e *EventExposureClient) EventExposureNotify(ctx context.Context, notifyURI string, data namfeventexposure.AmfEventNotification) error {
+	if notifyURI == "" {
+		return fmt.Errorf("event exposure notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("eventNotifyUri is missing"))
+	}
+	if err := e.request.PostJSON(ctx, notifyURI, data); err != nil {
+		return fmt.Errorf("event exposure notify: %w", err)
+	}
+	return nil
+}
+
+// NotifySubscription sends event reports to the eventNotifyUri of a subscription, along with its notifyCorrelationId.
+func (e *EventExposureClient) NotifySubscription(ctx context.Context, subscription namfeventexposure.AmfEventSubscription, reports ...namfeventexposure.AmfEventReport) error {
+	var data namfeventexposure.AmfEventNotification
+	data.SetNotifyCorrelationId(subscription.NotifyCorrelationId)
+	data.SetReportList(reports)
+	return e.EventExposureNotify(ctx, subscription.EventNotifyUri, data)
+}
@@ -0,0 +1,138 @@
+package namf
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfeventexposure "github.com/5GCoreNet/openapi/openapi_Namf_EventExposure"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// eventAMF keeps the event subscriptions of its consumers.
+type eventAMF struct {
+	subscriptions map[string]namfeventexposure.AmfEventSubscription
+}
+
+func (a *eventAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *eventAMF) CreateSubscription(_ context.Context, req namfeventexposure.AmfCreateEventSubscription) (*namfeventexposure.AmfCreatedEventSubscription, error) {
+	if req.Subscription.GetSupi() != "" && req.Subscription.GetSupi() != "imsi-208930000000001" {
+		return nil, ErrUENotServedByAMF
+	}
+	a.subscriptions["evt-1"] = req.Subscription
+	return &namfeventexposure.AmfCreatedEventSubscription{Subscription: req.Subscription, SubscriptionId: "evt-1"}, nil
+}
+
+func (a *eventAMF) ModifySubscription(_ context.Context, subscriptionID string, req namfeventexposure.ModifySubscriptionRequest) (*namfeventexposure.AmfUpdatedEventSubscription, error) {
+	subscription, ok := a.subscriptions[subscriptionID]
+	if !ok {
+		return nil, fivegc.ErrSubscriptionNotFound
+	}
+	if req.ArrayOfAmfUpdateEventOptionItem == nil {
+		return nil, fivegc.ErrModificationNotAllowed
+	}
+	if subscription.Options == nil {
+		subscription.Options = &namfeventexposure.AmfEventMode{}
+	}
+	for _, item := range *req.ArrayOfAmfUpdateEventOptionItem {
+		subscription.Options.SetExpiry(item.Value)
+	}
+	a.subscriptions[subscriptionID] = subscription
+	return &namfeventexposure.AmfUpdatedEventSubscription{Subscription: subscription}, nil
+}
+
+func (a *eventAMF) DeleteSubscription(_ context.Context, subscriptionID string) error {
+	if _, ok := a.subscriptions[subscriptionID]; !ok {
+		return fivegc.ErrSubscriptionNotFound
+	}
+	delete(a.subscriptions, subscriptionID)
+	return nil
+}
+
+// nwdaf receives the AMF event reports.
+type nwdaf struct {
+	notifications []namfeventexposure.AmfEventNotification
+}
+
+func (n *nwdaf) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (n *nwdaf) EventExposureNotify(_ context.Context, data namfeventexposure.AmfEventNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventExposureNotifyStatusCode) {
+	n.notifications = append(n.notifications, data)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EventExposureNotifyStatusNoContent
+}
+
+func TestEventExposure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &eventAMF{subscriptions: map[string]namfeventexposure.AmfEventSubscription{}}
+	attachEventExposureHandler(router.Group(""), NewEventExposure(a), log.Default())
+	send := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		return apitest.Send(t, router, method, "http://amf.example.com"+eventExposureRouterGroup+path, nil, body)
+	}
+
+	subscription := namfeventexposure.AmfEventSubscription{
+		EventList:           []namfeventexposure.AmfEvent{{Type: namfeventexposure.AmfEventType{String: fivegc.ToString(EventTypeReachabilityReport)}}},
+		EventNotifyUri:      "https://nwdaf.example.com/amf-events",
+		NotifyCorrelationId: "1",
+		NfId:                "nwdaf-1",
+	}
+	subscription.SetSupi("imsi-208930000000001")
+	w := send(http.MethodPost, "/subscriptions", namfeventexposure.AmfCreateEventSubscription{Subscription: subscription})
+	if w.Code != http.StatusCreated || w.Header().Get("Location") != "http://amf.example.com/namf-evts/v1/subscriptions/evt-1" {
+		t.Errorf("unexpected response %d %s", w.Code, w.Header().Get("Location"))
+	}
+	subscription.SetSupi("imsi-208930000000002")
+	if w := send(http.MethodPost, "/subscriptions", namfeventexposure.AmfCreateEventSubscription{Subscription: subscription}); w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), CauseUENotServedByAMF) {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	if w := send(http.MethodPatch, "/subscriptions/evt-1", json.RawMessage(`[{"op":"replace","path":"/options/expiry","value":"2026-01-01T00:00:00Z"}]`)); w.Code != http.StatusOK {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	if expiry := a.subscriptions["evt-1"].Options.GetExpiry(); !expiry.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected expiry %s", expiry)
+	}
+	if w := send(http.MethodDelete, "/subscriptions/evt-1", nil); w.Code != http.StatusOK {
+		t.Errorf("unexpected response %d", w.Code)
+	}
+	if w := send(http.MethodDelete, "/subscriptions/evt-1", nil); w.Code != http.StatusNotFound {
+		t.Errorf("unexpected response %d", w.Code)
+	}
+}
+
+func TestEventExposureNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	n := &nwdaf{}
+	attachEventExposureNotificationHandler(router.Group("/nwdaf"), "/amf-events", n, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewEventExposureClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+
+	subscription := namfeventexposure.AmfEventSubscription{EventNotifyUri: server.URL + "/nwdaf/amf-events", NotifyCorrelationId: "corr-1"}
+	report := namfeventexposure.AmfEventReport{
+		Type:      namfeventexposure.AmfEventType{String: fivegc.ToString(EventTypeReachabilityReport)},
+		State:     namfeventexposure.AmfEventState{Active: true},
+		TimeStamp: time.Now(),
+	}
+	if err := client.NotifySubscription(context.Background(), subscription, report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(n.notifications) != 1 || n.notifications[0].GetNotifyCorrelationId() != "corr-1" || len(n.notifications[0].ReportList) != 1 {
+		t.Errorf("unexpected notifications %+v", n.notifications)
+	}
+	if err := client.NotifySubscription(context.Background(), namfeventexposure.AmfEventSubscription{}, report); err == nil {
+		t.Error("expected an error for a subscription without eventNotifyUri")
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	namflocation "github.com/5GCoreNet/openapi/openapi_Namf_Location"
+	"log"
+)
+
+type MyLocation struct {
+}
+
+func (m MyLocation) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyLocation) ProvideLocationInfo(ctx context.Context, ueContextID string, data namflocation.RequestLocInfo) (namflocation.ProvidedLocInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, namf.ProvideLocationInfoStatusCode) {
+	// Your code here ...
+	return namflocation.ProvidedLocInfo{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, namf.ProvideLocationInfoStatusOK
+}
+
+func (m MyLocation) ProvidePositioningInfo(ctx context.Context, ueContextID string, data namflocation.RequestPosInfo) (namflocation.ProvidePosInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, namf.ProvidePositioningInfoStatusCode) {
+	// Your code here ...
+	if err := ctx.Err(); err != nil {
+		// Application errors carry their cause and status code, see fivegc.ErrNfCongestion, namf.ErrPositioningFailed...
+		problemDetails, status := fivegc.ProblemDetailsFromError(namf.ErrPositioningFailed.WithDetail("%s", err))
+		return namflocation.ProvidePosInfo{}, problemDetails, fivegc.RedirectResponse{}, namf.ProvidePositioningInfoStatusCode(status)
+	}
+	return namflocation.ProvidePosInfo{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, namf.ProvidePositioningInfoStatusOK
+}
+
+func (m MyLocation) CancelLocation(ctx context.Context, ueContextID string, data namflocation.CancelPosInfo) (openapicommon.ProblemDetails, fivegc.RedirectResponse, namf.CancelLocationStatusCode) {
+	// Your code here ...
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, namf.CancelLocationStatusNoContent
+}
+
+// MyCommunication implements the namf.CommunicationService interface, whose handlers return a result and an error.
+type MyCommunication struct {
+}
+
+func (m MyCommunication) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (m MyCommunication) CreateUEContext(ctx context.Context, ueContextID string, req namf.CreateUEContextRequest) (*namf.CreateUEContextResponse, error) {
+	// Your code here ...
+	return &namf.CreateUEContextResponse{JSONData: namfcommunication.UeContextCreatedData{UeContext: req.JSONData.UeContext}}, nil
+}
+
+func (m MyCommunication) ReleaseUEContext(ctx context.Context, ueContextID string, data namfcommunication.UEContextRelease) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyCommunication) UEContextTransfer(ctx context.Context, ueContextID string, req namf.UEContextTransferRequest) (*namf.UEContextTransferResponse, error) {
+	// Your code here ...
+	return nil, namf.ErrContextNotFound
+}
+
+func (m MyCommunication) N1N2MessageTransfer(ctx context.Context, ueContextID string, req namf.N1N2MessageTransferRequest) (*namf.N1N2MessageTransferResponse, error) {
+	// Your code here ...
+	res := &namf.N1N2MessageTransferResponse{}
+	res.JSONData.Cause = namfcommunication.N1N2MessageTransferCause{String: fivegc.ToString(namf.N1N2TransferCauseTransferInitiated)}
+	return res, nil
+}
+
+func (m MyCommunication) N1N2MessageSubscribe(ctx context.Context, ueContextID string, data namfcommunication.UeN1N2InfoSubscriptionCreateData) (*namfcommunication.UeN1N2InfoSubscriptionCreatedData, error) {
+	// Your code here ...
+	return &namfcommunication.UeN1N2InfoSubscriptionCreatedData{N1n2NotifySubscriptionId: "1"}, nil
+}
+
+func (m MyCommunication) N1N2MessageUnSubscribe(ctx context.Context, ueContextID string, subscriptionID string) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyCommunication) AMFStatusChangeSubscribe(ctx context.Context, data namfcommunication.SubscriptionData) (*namf.AMFStatusChangeSubscribeResponse, error) {
+	// Your code here ...
+	return &namf.AMFStatusChangeSubscribeResponse{SubscriptionID: "1", JSONData: data}, nil
+}
+
+func (m MyCommunication) AMFStatusChangeSubscribeModify(ctx context.Context, subscriptionID string, data namfcommunication.SubscriptionData) (*namfcommunication.SubscriptionData, error) {
+	// Your code here ...
+	return &data, nil
+}
+
+func (m MyCommunication) AMFStatusChangeUnSubscribe(ctx context.Context, subscriptionID string) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyCommunication) NonUeN2MessageTransfer(ctx context.Context, req namf.NonUeN2MessageTransferRequest) (*namfcommunication.N2InformationTransferRspData, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyCommunication) NonUeN2InfoSubscribe(ctx context.Context, data namfcommunication.NonUeN2InfoSubscriptionCreateData) (*namfcommunication.NonUeN2InfoSubscriptionCreatedData, error) {
+	// Your code here ...
+	return &namfcommunication.NonUeN2InfoSubscriptionCreatedData{N2NotifySubscriptionId: "1"}, nil
+}
+
+func (m MyCommunication) NonUeN2InfoUnSubscribe(ctx context.Context, n2NotifySubscriptionID string) error {
+	// Your code here ...
+	return nil
+}
+
+func main() {
+	m := MyLocation{}
+	namfServer := namf.NewServer(":8080", "/v1/", log.Default())
+	namfServer.AttachLocation(m)
+	namfServer.AttachCommunication(namf.NewCommunication(MyCommunication{}))
+	namfServer.Start()
+	// Your code here ...
+	namfServer.Stop()
+}
@@ -0,0 +1,402 @@
+package namf
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namflocation "github.com/5GCoreNet/openapi/openapi_Namf_Location"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	locationRouterGroup            = "/namf-loc/v1"
+	provideLocationInfoEndpoint    = "/{ueContextId}/provide-loc-info"
+	providePositioningInfoEndpoint = "/{ueContextId}/provide-pos-info"
+	cancelLocationEndpoint         = "/{ueContextId}/cancel-pos-info"
+)
+
+// Location events notified through the EventNotify callback, defined in TS 29.518 clause 6.4.6.3.3.
+const (
+	LocationEventEmergencyCallOrigination       = "EMERGENCY_CALL_ORIGINATION"
+	LocationEventEmergencyCallRelease           = "EMERGENCY_CALL_RELEASE"
+	LocationEventEmergencyCallHandover          = "EMERGENCY_CALL_HANDOVER"
+	LocationEventActivationOfDeferredLocation   = "ACTIVATION_OF_DEFERRED_LOCATION"
+	LocationEventUEMobilityForDeferredLocation  = "UE_MOBILITY_FOR_DEFERRED_LOCATION"
+	LocationEventCancellationOfDeferredLocation = "CANCELLATION_OF_DEFERRED_LOCATION"
+)
+
+// Location is the interface that wraps the NAMF Location service (TS 29.518 clause 5.5).
+// The UE context ID of the requests is the SUPI, the PEI or the GPSI of the UE, such as "imsi-208930000000001".
+type Location interface {
+	fivegc.CommonInterface
+	// ProvideLocationInfo provides the network provided location information of a UE.
+	ProvideLocationInfo(context.Context, string, namflocation.RequestLocInfo) (namflocation.ProvidedLocInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideLocationInfoStatusCode)
+	// ProvidePositioningInfo provides the location of a UE determined by the LMF, immediately or as deferred location reports.
+	ProvidePositioningInfo(context.Context, string, namflocation.RequestPosInfo) (namflocation.ProvidePosInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvidePositioningInfoStatusCode)
+	// CancelLocation cancels a deferred location request.
+	CancelLocation(context.Context, string, namflocation.CancelPosInfo) (openapicommon.ProblemDetails, fivegc.RedirectResponse, CancelLocationStatusCode)
+}
+
+// LocationService is an alternative to the Location interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). Use NewLocation to attach it to the NAMF Server.
+type LocationService interface {
+	fivegc.CommonInterface
+	// ProvideLocationInfo provides the network provided location information of a UE, a nil location info is answered
+	// as an empty one.
+	ProvideLocationInfo(context.Context, string, namflocation.RequestLocInfo) (*namflocation.ProvidedLocInfo, error)
+	// ProvidePositioningInfo provides the location of a UE, a nil positioning info is answered with 204 No Content.
+	ProvidePositioningInfo(context.Context, string, namflocation.RequestPosInfo) (*namflocation.ProvidePosInfo, error)
+	// CancelLocation cancels a deferred location request.
+	CancelLocation(context.Context, string, namflocation.CancelPosInfo) error
+}
+
+// LocationNotification is the interface that wraps the EventNotify callback of the NAMF Location service.
+// It is implemented by the GMLC to receive the location events of the UEs, such as the emergency calls or the
+// location reports of the deferred location requests, sent by the AMF to the locationNotificationUri.
+type LocationNotification interface {
+	fivegc.CommonInterface
+	// EventNotify receives a location event of a UE.
+	EventNotify(context.Context, namflocation.NotifiedPosInfo) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventNotifyStatusCode)
+}
+
+// ProvideLocationInfoStatusCode is the status code of a ProvideLocationInfo response.
+type ProvideLocationInfoStatusCode fivegc.StatusCode
+
+const (
+	// ProvideLocationInfoStatusOK is the status code for a successful response.
+	ProvideLocationInfoStatusOK                ProvideLocationInfoStatusCode = ProvideLocationInfoStatusCode(fivegc.StatusOK)
+	ProvideLocationInfoStatusTemporaryRedirect ProvideLocationInfoStatusCode = ProvideLocationInfoStatusCode(fivegc.StatusTemporaryRedirect)
+	ProvideLocationInfoStatusPermanentRedirect ProvideLocationInfoStatusCode = ProvideLocationInfoStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var provideLocationInfoErrors = response.BodyErrors
+
+// ProvidePositioningInfoStatusCode is the status code of a ProvidePositioningInfo response.
+type ProvidePositioningInfoStatusCode fivegc.StatusCode
+
+const (
+	// ProvidePositioningInfoStatusOK is the status code for a successful response.
+	ProvidePositioningInfoStatusOK                ProvidePositioningInfoStatusCode = ProvidePositioningInfoStatusCode(fivegc.StatusOK)
+	ProvidePositioningInfoStatusNoContent         ProvidePositioningInfoStatusCode = ProvidePositioningInfoStatusCode(fivegc.StatusNoContent)
+	ProvidePositioningInfoStatusTemporaryRedirect ProvidePositioningInfoStatusCode = ProvidePositioningInfoStatusCode(fivegc.StatusTemporaryRedirect)
+	ProvidePositioningInfoStatusPermanentRedirect ProvidePositioningInfoStatusCode = ProvidePositioningInfoStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var providePositioningInfoErrors = response.BodyErrors
+
+// CancelLocationStatusCode is the status code of a CancelLocation response.
+type CancelLocationStatusCode fivegc.StatusCode
+
+const (
+	// CancelLocationStatusNoContent is the status code for the response when the location request is successfully cancelled.
+	CancelLocationStatusNoContent         CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusNoContent)
+	CancelLocationStatusTemporaryRedirect CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusTemporaryRedirect)
+	CancelLocationStatusPermanentRedirect CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var cancelLocationErrors = response.BodyErrors
+
+// EventNotifyStatusCode is the status code of an EventNotify response.
+type EventNotifyStatusCode fivegc.StatusCode
+
+const (
+	// EventNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	EventNotifyStatusNoContent         EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusNoContent)
+	EventNotifyStatusTemporaryRedirect EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	EventNotifyStatusPermanentRedirect EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var eventNotifyErrors = response.BodyErrors
+
+func attachLocationHandler(r *gin.RouterGroup, l Location, logger *log.Logger) {
+	group := r.Group(locationRouterGroup)
+	{
+		group.POST(router.Path(provideLocationInfoEndpoint), func(c *gin.Context) {
+			var req namflocation.RequestLocInfo
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, provideLocationInfoErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := l.ProvideLocationInfo(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case ProvideLocationInfoStatusOK:
+				c.JSON(int(status), res)
+			case ProvideLocationInfoStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ProvideLocationInfoStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, provideLocationInfoErrors)
+			}
+			return
+		})
+		group.POST(router.Path(providePositioningInfoEndpoint), func(c *gin.Context) {
+			var req namflocation.RequestPosInfo
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, providePositioningInfoErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := l.ProvidePositioningInfo(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case ProvidePositioningInfoStatusOK:
+				c.JSON(int(status), res)
+			case ProvidePositioningInfoStatusNoContent:
+				c.JSON(int(status), nil)
+			case ProvidePositioningInfoStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ProvidePositioningInfoStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, providePositioningInfoErrors)
+			}
+			return
+		})
+		group.POST(router.Path(cancelLocationEndpoint), func(c *gin.Context) {
+			var req namflocation.CancelPosInfo
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, cancelLocationErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := l.CancelLocation(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case CancelLocationStatusNoContent:
+				c.JSON(int(status), nil)
+			case CancelLocationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CancelLocationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, cancelLocationErrors)
+			}
+			return
+		})
+	}
+}
+
+func attachLocationNotificationHandler(router *gin.RouterGroup, path string, n LocationNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req namflocation.NotifiedPosInfo
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, eventNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.EventNotify(c, req)
+		switch status {
+		case EventNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case EventNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case EventNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, eventNotifyErrors)
+		}
+		return
+	})
+}
+
+// NewLocation adapts a LocationService to the Location interface.
+func NewLocation(s LocationService) Location {
+	return locationAdapter{service: s}
+}
+
+type locationAdapter struct {
+	service LocationService
+}
+
+func (a locationAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a locationAdapter) ProvideLocationInfo(ctx context.Context, ueContextID string, req namflocation.RequestLocInfo) (namflocation.ProvidedLocInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideLocationInfoStatusCode) {
+	res, err := a.service.ProvideLocationInfo(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namflocation.ProvidedLocInfo{}, problemDetails, redirectResponse, ProvideLocationInfoStatusCode(status)
+	}
+	if res == nil {
+		res = &namflocation.ProvidedLocInfo{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvideLocationInfoStatusOK
+}
+
+func (a locationAdapter) ProvidePositioningInfo(ctx context.Context, ueContextID string, req namflocation.RequestPosInfo) (namflocation.ProvidePosInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvidePositioningInfoStatusCode) {
+	res, err := a.service.ProvidePositioningInfo(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namflocation.ProvidePosInfo{}, problemDetails, redirectResponse, ProvidePositioningInfoStatusCode(status)
+	}
+	if res == nil {
+		return namflocation.ProvidePosInfo{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvidePositioningInfoStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvidePositioningInfoStatusOK
+}
+
+func (a locationAdapter) CancelLocation(ctx context.Context, ueContextID string, req namflocation.CancelPosInfo) (openapicommon.ProblemDetails, fivegc.RedirectResponse, CancelLocationStatusCode) {
+	if err := a.service.CancelLocation(ctx, ueContextID, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, CancelLocationStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CancelLocationStatusNoContent
+}
+
+// NewLocationService adapts a Location to the LocationService interface.
+func NewLocationService(l Location) LocationService {
+	return locationServiceAdapter{location: l}
+}
+
+type locationServiceAdapter struct {
+	location Location
+}
+
+func (a locationServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.location.Error(ctx, err)
+}
+
+func (a locationServiceAdapter) ProvideLocationInfo(ctx context.Context, ueContextID string, req namflocation.RequestLocInfo) (*namflocation.ProvidedLocInfo, error) {
+	res, problemDetails, redirectResponse, status := a.location.ProvideLocationInfo(ctx, ueContextID, req)
+	if status == ProvideLocationInfoStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a locationServiceAdapter) ProvidePositioningInfo(ctx context.Context, ueContextID string, req namflocation.RequestPosInfo) (*namflocation.ProvidePosInfo, error) {
+	res, problemDetails, redirectResponse, status := a.location.ProvidePositioningInfo(ctx, ueContextID, req)
+	switch status {
+	case ProvidePositioningInfoStatusOK:
+		return &res, nil
+	case ProvidePositioningInfoStatusNoContent:
+		return nil, nil
+	default:
+		return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	}
+}
+
+func (a locationServiceAdapter) CancelLocation(ctx context.Context, ueContextID string, req namflocation.CancelPosInfo) error {
+	problemDetails, redirectResponse, status := a.location.CancelLocation(ctx, ueContextID, req)
+	if status == CancelLocationStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// LocationClient is a client for the NAMF Location service. It also sends the EventNotify callbacks.
+type LocationClient struct {
+	client  *namflocation.APIClient
+	request *request.Client
+}
+
+// NewLocationClient creates a new client for the NAMF Location service.
+func NewLocationClient(cfg fivegc.ClientConfiguration) *LocationClient {
+	openapiCfg := &namflocation.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []namflocation.ServerConfiguration{},
+		OperationServers: make(map[string]namflocation.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := namflocation.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]namflocation.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = namflocation.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(namflocation.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = namflocation.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]namflocation.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = namflocation.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &LocationClient{
+		client:  namflocation.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, locationRouterGroup),
+	}
+}
+
+// ProvideLocationInfo returns provide location info request
+func (l LocationClient) ProvideLocationInfo(ctx context.Context, ueContextID string) namflocation.ApiProvideLocationInfoRequest {
+	return l.client.IndividualUEContextDocumentApi.ProvideLocationInfo(ctx, ueContextID)
+}
+
+// ProvideLocationInfoExecute executes the provide location info request
+func (l LocationClient) ProvideLocationInfoExecute(r namflocation.ApiProvideLocationInfoRequest) (*namflocation.ProvidedLocInfo, *http.Response, error) {
+	return r.Execute()
+}
+
+// ProvidePositioningInfo returns provide positioning info request
+func (l LocationClient) ProvidePositioningInfo(ctx context.Context, ueContextID string) namflocation.ApiProvidePositioningInfoRequest {
+	return l.client.IndividualUEContextDocumentApi.ProvidePositioningInfo(ctx, ueContextID)
+}
+
+// ProvidePositioningInfoExecute executes the provide positioning info request
+func (l LocationClient) ProvidePositioningInfoExecute(r namflocation.ApiProvidePositioningInfoRequest) (*namflocation.ProvidePosInfo, *http.Response, error) {
+	return r.Execute()
+}
+
+// CancelLocation returns cancel location request
+func (l LocationClient) CancelLocation(ctx context.Context, ueContextID string) namflocation.ApiCancelLocationRequest {
+	return l.client.IndividualUEContextDocumentApi.CancelLocation(ctx, ueContextID)
+}
+
+// CancelLocationExecute executes the cancel location request
+func (l LocationClient) CancelLocationExecute(r namflocation.ApiCancelLocationRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// EventNotify sends a location event to the locationNotificationUri of the GMLC.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (l LocationClient) EventNotify(ctx context.Context, locationNotificationURI string, data namflocation.NotifiedPosInfo) error {
+	if locationNotificationURI == "" {
+		return fmt.Errorf("event notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("locationNotificationUri is missing"))
+	}
+	if err := l.request.PostJSON(ctx, locationNotificationURI, data); err != nil {
+		return fmt.Errorf("event notify: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package namf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namflocation "github.com/5GCoreNet/openapi/openapi_Namf_Location"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// amf serves the location requests of a single UE.
+type amf struct {
+	supi string
+}
+
+func (a amf) ProvideLocationInfo(_ context.Context, ueContextID string, _ namflocation.RequestLocInfo) (*namflocation.ProvidedLocInfo, error) {
+	if ueContextID != a.supi {
+		return nil, ErrContextNotFound
+	}
+	var info namflocation.ProvidedLocInfo
+	info.SetCurrentLoc(true)
+	return &info, nil
+}
+
+func (a amf) ProvidePositioningInfo(_ context.Context, ueContextID string, req namflocation.RequestPosInfo) (*namflocation.ProvidePosInfo, error) {
+	if ueContextID != a.supi {
+		return nil, ErrContextNotFound
+	}
+	if req.HasLdrReference() {
+		return nil, nil
+	}
+	return &namflocation.ProvidePosInfo{}, nil
+}
+
+func (a amf) CancelLocation(_ context.Context, ueContextID string, _ namflocation.CancelPosInfo) error {
+	if ueContextID != a.supi {
+		return ErrContextNotFound
+	}
+	return nil
+}
+
+func (a amf) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+// gmlc receives the location events of the UEs.
+type gmlc struct {
+	events []namflocation.NotifiedPosInfo
+}
+
+func (g *gmlc) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (g *gmlc) EventNotify(_ context.Context, data namflocation.NotifiedPosInfo) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventNotifyStatusCode) {
+	g.events = append(g.events, data)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EventNotifyStatusNoContent
+}
+
+func TestLocation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	attachLocationHandler(router.Group(""), NewLocation(amf{supi: "imsi-208930000000001"}), log.Default())
+	send := func(path string, body interface{}) *httptest.ResponseRecorder {
+		return apitest.Send(t, router, http.MethodPost, locationRouterGroup+path, nil, body)
+	}
+
+	w := send("/imsi-208930000000001/provide-loc-info", namflocation.RequestLocInfo{})
+	var info namflocation.ProvidedLocInfo
+	if w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &info) != nil || !info.GetCurrentLoc() {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	posInfo := namflocation.RequestPosInfo{
+		LcsClientType: namflocation.ExternalClientType{String: fivegc.ToString("VALUE_ADDED_SERVICES")},
+		LcsLocation:   namflocation.LocationType{String: fivegc.ToString("CURRENT_LOCATION")},
+	}
+	if w := send("/imsi-208930000000001/provide-pos-info", posInfo); w.Code != http.StatusOK {
+		t.Errorf("unexpected status %d", w.Code)
+	}
+	posInfo.SetLdrReference("ldr-1")
+	if w := send("/imsi-208930000000001/provide-pos-info", posInfo); w.Code != http.StatusNoContent {
+		t.Errorf("unexpected status %d", w.Code)
+	}
+	cancel := namflocation.CancelPosInfo{Supi: "imsi-208930000000001", HgmlcCallBackURI: "https://gmlc.example.com", LdrReference: "ldr-1"}
+	if w := send("/imsi-208930000000001/cancel-pos-info", cancel); w.Code != http.StatusNoContent {
+		t.Errorf("unexpected status %d", w.Code)
+	}
+	w = send("/imsi-208930000000002/cancel-pos-info", cancel)
+	var problemDetails openapicommon.ProblemDetails
+	if w.Code != http.StatusNotFound || json.Unmarshal(w.Body.Bytes(), &problemDetails) != nil || problemDetails.Cause == nil || *problemDetails.Cause != CauseContextNotFound {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+}
+
+func TestLocationNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	g := &gmlc{}
+	attachLocationNotificationHandler(router.Group("/gmlc"), "/location-events", g, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewLocationClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	event := namflocation.NotifiedPosInfo{LocationEvent: namflocation.LocationEvent{String: fivegc.ToString(LocationEventEmergencyCallOrigination)}}
+	event.SetSupi("imsi-208930000000001")
+	if err := client.EventNotify(ctx, server.URL+"/gmlc/location-events", event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(g.events) != 1 || g.events[0].GetSupi() != "imsi-208930000000001" {
+		t.Errorf("unexpected events %+v", g.events)
+	}
+	err := client.EventNotify(ctx, server.URL+"/gmlc/unknown", event)
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusNotFound) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package namf
+
+import (
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+)
+
+// Media types of the binary parts, defined in TS 29.518 clause 6.1.2.4.
+const (
+	ContentTypeNAS  = "application/vnd.3gpp.5gnas"
+	ContentTypeNGAP = "application/vnd.3gpp.ngap"
+)
+
+// N1 message classes and N2 information classes of the location services, defined in TS 29.518 clause 6.1.6.3.
+const (
+	N1MessageClassLPP       = "LPP"
+	N2InformationClassNRPPa = "NRPPa"
+	NgapIeTypeNRPPaPDU      = "NRPPA_PDU"
+)
+
+// Content IDs of the binary parts built by the SDK.
+const (
+	n1ContentID = "n1msg"
+	n2ContentID = "n2msg"
+)
+
+// ErrBinaryPart is returned when a binary part referenced by the JSON data is missing, or when the JSON data does
+// not reference the expected message.
+var ErrBinaryPart = errors.New("namf: missing binary part")
+
+// BinaryPart is a binary part of a multipart/related body, referenced from the JSON data by its content ID.
+type BinaryPart = multipart.Part
+
+// N1N2MessageTransferRequest is the body of a N1N2MessageTransfer request.
+type N1N2MessageTransferRequest struct {
+	JSONData    namfcommunication.N1N2MessageTransferReqData
+	BinaryParts []BinaryPart
+}
+
+// CreateUEContextRequest is the body of a CreateUEContext request.
+type CreateUEContextRequest struct {
+	JSONData    namfcommunication.UeContextCreateData
+	BinaryParts []BinaryPart
+}
+
+// CreateUEContextResponse is the body of a CreateUEContext response.
+type CreateUEContextResponse struct {
+	JSONData namfcommunication.UeContextCreatedData
+	// ErrorData is the error data, such as the NGAP message of the target NG-RAN node rejecting the handover, answered
+	// along with the ProblemDetails and the binary parts.
+	ErrorData   *namfcommunication.UeContextCreateError
+	BinaryParts []BinaryPart
+}
+
+// UEContextTransferRequest is the body of a UEContextTransfer request.
+type UEContextTransferRequest struct {
+	JSONData    namfcommunication.UeContextTransferReqData
+	BinaryParts []BinaryPart
+}
+
+// UEContextTransferResponse is the body of a UEContextTransfer response.
+type UEContextTransferResponse struct {
+	JSONData    namfcommunication.UeContextTransferRspData
+	BinaryParts []BinaryPart
+}
+
+// NonUeN2MessageTransferRequest is the body of a NonUeN2MessageTransfer request.
+type NonUeN2MessageTransferRequest struct {
+	JSONData    namfcommunication.N2InformationTransferReqData
+	BinaryParts []BinaryPart
+}
+
+// N1MessageNotifyRequest is the body of a N1MessageNotify request.
+type N1MessageNotifyRequest struct {
+	JSONData    namfcommunication.N1MessageNotification
+	BinaryParts []BinaryPart
+}
+
+// N2InfoNotifyRequest is the body of a N2InfoNotify request.
+type N2InfoNotifyRequest struct {
+	JSONData    namfcommunication.N2InformationNotification
+	BinaryParts []BinaryPart
+}
+
+// NewLPPTransfer returns the N1N2MessageTransfer request of an LPP message sent by the LMF nfID to the UE, in the
+// location session identified by the LCS correlation ID (TS 23.273 clause 6.11.1).
+func NewLPPTransfer(nfID, lcsCorrelationID string, lpp []byte) N1N2MessageTransferRequest {
+	var container namfcommunication.N1MessageContainer
+	container.SetN1MessageClass(namfcommunication.N1MessageClass{String: fivegc.ToString(N1MessageClassLPP)})
+	container.SetN1MessageContent(namfcommunication.RefToBinaryData{ContentId: n1ContentID})
+	container.SetNfId(nfID)
+	var data namfcommunication.N1N2MessageTransferReqData
+	data.SetN1MessageContainer(container)
+	data.SetLcsCorrelationId(lcsCorrelationID)
+	return N1N2MessageTransferRequest{
+		JSONData:    data,
+		BinaryParts: []BinaryPart{{ContentID: n1ContentID, ContentType: ContentTypeNAS, Body: lpp}},
+	}
+}
+
+// NewNRPPaTransfer returns the N1N2MessageTransfer request of an NRPPa PDU sent by the LMF nfID to the NG-RAN node
+// serving the UE, in the location session identified by the LCS correlation ID (TS 23.273 clause 6.11.2).
+func NewNRPPaTransfer(nfID, lcsCorrelationID string, nrppa []byte) N1N2MessageTransferRequest {
+	var content namfcommunication.N2InfoContent
+	content.SetNgapIeType(namfcommunication.NgapIeType{String: fivegc.ToString(NgapIeTypeNRPPaPDU)})
+	content.SetNgapData(namfcommunication.RefToBinaryData{ContentId: n2ContentID})
+	var info namfcommunication.NrppaInformation
+	info.SetNfId(nfID)
+	info.SetNrppaPdu(content)
+	var container namfcommunication.N2InfoContainer
+	container.SetN2InformationClass(namfcommunication.N2InformationClass{String: fivegc.ToString(N2InformationClassNRPPa)})
+	container.SetNrppaInfo(info)
+	var data namfcommunication.N1N2MessageTransferReqData
+	data.SetN2InfoContainer(container)
+	data.SetLcsCorrelationId(lcsCorrelationID)
+	return N1N2MessageTransferRequest{
+		JSONData:    data,
+		BinaryParts: []BinaryPart{{ContentID: n2ContentID, ContentType: ContentTypeNGAP, Body: nrppa}},
+	}
+}
+
+// LPP returns the LPP message of the notification.
+func (r N1MessageNotifyRequest) LPP() ([]byte, error) {
+	container := r.JSONData.GetN1MessageContainer()
+	if class := container.GetN1MessageClass(); class.String == nil || *class.String != N1MessageClassLPP {
+		return nil, fmt.Errorf("%w: no LPP message", ErrBinaryPart)
+	}
+	return findBinaryPart(r.BinaryParts, container.GetN1MessageContent())
+}
+
+// NRPPa returns the NRPPa PDU of the notification.
+func (r N2InfoNotifyRequest) NRPPa() ([]byte, error) {
+	container := r.JSONData.GetN2InfoContainer()
+	if class := container.GetN2InformationClass(); class.String == nil || *class.String != N2InformationClassNRPPa {
+		return nil, fmt.Errorf("%w: no NRPPa PDU", ErrBinaryPart)
+	}
+	info := container.GetNrppaInfo()
+	pdu := info.GetNrppaPdu()
+	return findBinaryPart(r.BinaryParts, pdu.GetNgapData())
+}
+
+func findBinaryPart(parts []BinaryPart, ref namfcommunication.RefToBinaryData) ([]byte, error) {
+	for _, part := range parts {
+		if part.ContentID == ref.GetContentId() {
+			return part.Body, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: content ID %q", ErrBinaryPart, ref.GetContentId())
+}
@@ -0,0 +1,466 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../communication.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	namf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Namf_Communication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCommunication is a mock of Communication interface.
+type MockCommunication struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommunicationMockRecorder
+}
+
+// MockCommunicationMockRecorder is the mock recorder for MockCommunication.
+type MockCommunicationMockRecorder struct {
+	mock *MockCommunication
+}
+
+// NewMockCommunication creates a new mock instance.
+func NewMockCommunication(ctrl *gomock.Controller) *MockCommunication {
+	mock := &MockCommunication{ctrl: ctrl}
+	mock.recorder = &MockCommunicationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommunication) EXPECT() *MockCommunicationMockRecorder {
+	return m.recorder
+}
+
+// AMFStatusChangeSubscribe mocks base method.
+func (m *MockCommunication) AMFStatusChangeSubscribe(arg0 context.Context, arg1 openapi_Namf_Communication.SubscriptionData) (namf.AMFStatusChangeSubscribeResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.AMFStatusChangeSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(namf.AMFStatusChangeSubscribeResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.AMFStatusChangeSubscribeStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// AMFStatusChangeSubscribe indicates an expected call of AMFStatusChangeSubscribe.
+func (mr *MockCommunicationMockRecorder) AMFStatusChangeSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeSubscribe", reflect.TypeOf((*MockCommunication)(nil).AMFStatusChangeSubscribe), arg0, arg1)
+}
+
+// AMFStatusChangeSubscribeModify mocks base method.
+func (m *MockCommunication) AMFStatusChangeSubscribeModify(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.SubscriptionData) (openapi_Namf_Communication.SubscriptionData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.AMFStatusChangeSubscribeModifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeSubscribeModify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_Communication.SubscriptionData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.AMFStatusChangeSubscribeModifyStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// AMFStatusChangeSubscribeModify indicates an expected call of AMFStatusChangeSubscribeModify.
+func (mr *MockCommunicationMockRecorder) AMFStatusChangeSubscribeModify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeSubscribeModify", reflect.TypeOf((*MockCommunication)(nil).AMFStatusChangeSubscribeModify), arg0, arg1, arg2)
+}
+
+// AMFStatusChangeUnSubscribe mocks base method.
+func (m *MockCommunication) AMFStatusChangeUnSubscribe(arg0 context.Context, arg1 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.AMFStatusChangeUnSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeUnSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.AMFStatusChangeUnSubscribeStatusCode)
+	return ret0, ret1, ret2
+}
+
+// AMFStatusChangeUnSubscribe indicates an expected call of AMFStatusChangeUnSubscribe.
+func (mr *MockCommunicationMockRecorder) AMFStatusChangeUnSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeUnSubscribe", reflect.TypeOf((*MockCommunication)(nil).AMFStatusChangeUnSubscribe), arg0, arg1)
+}
+
+// CreateUEContext mocks base method.
+func (m *MockCommunication) CreateUEContext(arg0 context.Context, arg1 string, arg2 namf.CreateUEContextRequest) (namf.CreateUEContextResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.CreateUEContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUEContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(namf.CreateUEContextResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.CreateUEContextStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreateUEContext indicates an expected call of CreateUEContext.
+func (mr *MockCommunicationMockRecorder) CreateUEContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUEContext", reflect.TypeOf((*MockCommunication)(nil).CreateUEContext), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockCommunication) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockCommunicationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockCommunication)(nil).Error), ctx, err)
+}
+
+// N1N2MessageSubscribe mocks base method.
+func (m *MockCommunication) N1N2MessageSubscribe(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.UeN1N2InfoSubscriptionCreateData) (openapi_Namf_Communication.UeN1N2InfoSubscriptionCreatedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N1N2MessageSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageSubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_Communication.UeN1N2InfoSubscriptionCreatedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.N1N2MessageSubscribeStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// N1N2MessageSubscribe indicates an expected call of N1N2MessageSubscribe.
+func (mr *MockCommunicationMockRecorder) N1N2MessageSubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageSubscribe", reflect.TypeOf((*MockCommunication)(nil).N1N2MessageSubscribe), arg0, arg1, arg2)
+}
+
+// N1N2MessageTransfer mocks base method.
+func (m *MockCommunication) N1N2MessageTransfer(arg0 context.Context, arg1 string, arg2 namf.N1N2MessageTransferRequest) (namf.N1N2MessageTransferResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N1N2MessageTransferStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(namf.N1N2MessageTransferResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.N1N2MessageTransferStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// N1N2MessageTransfer indicates an expected call of N1N2MessageTransfer.
+func (mr *MockCommunicationMockRecorder) N1N2MessageTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageTransfer", reflect.TypeOf((*MockCommunication)(nil).N1N2MessageTransfer), arg0, arg1, arg2)
+}
+
+// N1N2MessageUnSubscribe mocks base method.
+func (m *MockCommunication) N1N2MessageUnSubscribe(arg0 context.Context, arg1, arg2 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N1N2MessageUnSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageUnSubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.N1N2MessageUnSubscribeStatusCode)
+	return ret0, ret1, ret2
+}
+
+// N1N2MessageUnSubscribe indicates an expected call of N1N2MessageUnSubscribe.
+func (mr *MockCommunicationMockRecorder) N1N2MessageUnSubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageUnSubscribe", reflect.TypeOf((*MockCommunication)(nil).N1N2MessageUnSubscribe), arg0, arg1, arg2)
+}
+
+// NonUeN2InfoSubscribe mocks base method.
+func (m *MockCommunication) NonUeN2InfoSubscribe(arg0 context.Context, arg1 openapi_Namf_Communication.NonUeN2InfoSubscriptionCreateData) (openapi_Namf_Communication.NonUeN2InfoSubscriptionCreatedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.NonUeN2InfoSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2InfoSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Namf_Communication.NonUeN2InfoSubscriptionCreatedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.NonUeN2InfoSubscribeStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// NonUeN2InfoSubscribe indicates an expected call of NonUeN2InfoSubscribe.
+func (mr *MockCommunicationMockRecorder) NonUeN2InfoSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2InfoSubscribe", reflect.TypeOf((*MockCommunication)(nil).NonUeN2InfoSubscribe), arg0, arg1)
+}
+
+// NonUeN2InfoUnSubscribe mocks base method.
+func (m *MockCommunication) NonUeN2InfoUnSubscribe(arg0 context.Context, arg1 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.NonUeN2InfoUnSubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2InfoUnSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.NonUeN2InfoUnSubscribeStatusCode)
+	return ret0, ret1, ret2
+}
+
+// NonUeN2InfoUnSubscribe indicates an expected call of NonUeN2InfoUnSubscribe.
+func (mr *MockCommunicationMockRecorder) NonUeN2InfoUnSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2InfoUnSubscribe", reflect.TypeOf((*MockCommunication)(nil).NonUeN2InfoUnSubscribe), arg0, arg1)
+}
+
+// NonUeN2MessageTransfer mocks base method.
+func (m *MockCommunication) NonUeN2MessageTransfer(arg0 context.Context, arg1 namf.NonUeN2MessageTransferRequest) (openapi_Namf_Communication.N2InformationTransferRspData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.NonUeN2MessageTransferStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2MessageTransfer", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Namf_Communication.N2InformationTransferRspData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.NonUeN2MessageTransferStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// NonUeN2MessageTransfer indicates an expected call of NonUeN2MessageTransfer.
+func (mr *MockCommunicationMockRecorder) NonUeN2MessageTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2MessageTransfer", reflect.TypeOf((*MockCommunication)(nil).NonUeN2MessageTransfer), arg0, arg1)
+}
+
+// ReleaseUEContext mocks base method.
+func (m *MockCommunication) ReleaseUEContext(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.UEContextRelease) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.ReleaseUEContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUEContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.ReleaseUEContextStatusCode)
+	return ret0, ret1, ret2
+}
+
+// ReleaseUEContext indicates an expected call of ReleaseUEContext.
+func (mr *MockCommunicationMockRecorder) ReleaseUEContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUEContext", reflect.TypeOf((*MockCommunication)(nil).ReleaseUEContext), arg0, arg1, arg2)
+}
+
+// UEContextTransfer mocks base method.
+func (m *MockCommunication) UEContextTransfer(arg0 context.Context, arg1 string, arg2 namf.UEContextTransferRequest) (namf.UEContextTransferResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.UEContextTransferStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UEContextTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(namf.UEContextTransferResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.UEContextTransferStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// UEContextTransfer indicates an expected call of UEContextTransfer.
+func (mr *MockCommunicationMockRecorder) UEContextTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UEContextTransfer", reflect.TypeOf((*MockCommunication)(nil).UEContextTransfer), arg0, arg1, arg2)
+}
+
+// MockCommunicationService is a mock of CommunicationService interface.
+type MockCommunicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommunicationServiceMockRecorder
+}
+
+// MockCommunicationServiceMockRecorder is the mock recorder for MockCommunicationService.
+type MockCommunicationServiceMockRecorder struct {
+	mock *MockCommunicationService
+}
+
+// NewMockCommunicationService creates a new mock instance.
+func NewMockCommunicationService(ctrl *gomock.Controller) *MockCommunicationService {
+	mock := &MockCommunicationService{ctrl: ctrl}
+	mock.recorder = &MockCommunicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommunicationService) EXPECT() *MockCommunicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AMFStatusChangeSubscribe mocks base method.
+func (m *MockCommunicationService) AMFStatusChangeSubscribe(arg0 context.Context, arg1 openapi_Namf_Communication.SubscriptionData) (*namf.AMFStatusChangeSubscribeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(*namf.AMFStatusChangeSubscribeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AMFStatusChangeSubscribe indicates an expected call of AMFStatusChangeSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) AMFStatusChangeSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).AMFStatusChangeSubscribe), arg0, arg1)
+}
+
+// AMFStatusChangeSubscribeModify mocks base method.
+func (m *MockCommunicationService) AMFStatusChangeSubscribeModify(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.SubscriptionData) (*openapi_Namf_Communication.SubscriptionData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeSubscribeModify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_Communication.SubscriptionData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AMFStatusChangeSubscribeModify indicates an expected call of AMFStatusChangeSubscribeModify.
+func (mr *MockCommunicationServiceMockRecorder) AMFStatusChangeSubscribeModify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeSubscribeModify", reflect.TypeOf((*MockCommunicationService)(nil).AMFStatusChangeSubscribeModify), arg0, arg1, arg2)
+}
+
+// AMFStatusChangeUnSubscribe mocks base method.
+func (m *MockCommunicationService) AMFStatusChangeUnSubscribe(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMFStatusChangeUnSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AMFStatusChangeUnSubscribe indicates an expected call of AMFStatusChangeUnSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) AMFStatusChangeUnSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMFStatusChangeUnSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).AMFStatusChangeUnSubscribe), arg0, arg1)
+}
+
+// CreateUEContext mocks base method.
+func (m *MockCommunicationService) CreateUEContext(arg0 context.Context, arg1 string, arg2 namf.CreateUEContextRequest) (*namf.CreateUEContextResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUEContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*namf.CreateUEContextResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUEContext indicates an expected call of CreateUEContext.
+func (mr *MockCommunicationServiceMockRecorder) CreateUEContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUEContext", reflect.TypeOf((*MockCommunicationService)(nil).CreateUEContext), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockCommunicationService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockCommunicationServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockCommunicationService)(nil).Error), ctx, err)
+}
+
+// N1N2MessageSubscribe mocks base method.
+func (m *MockCommunicationService) N1N2MessageSubscribe(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.UeN1N2InfoSubscriptionCreateData) (*openapi_Namf_Communication.UeN1N2InfoSubscriptionCreatedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageSubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_Communication.UeN1N2InfoSubscriptionCreatedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// N1N2MessageSubscribe indicates an expected call of N1N2MessageSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) N1N2MessageSubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).N1N2MessageSubscribe), arg0, arg1, arg2)
+}
+
+// N1N2MessageTransfer mocks base method.
+func (m *MockCommunicationService) N1N2MessageTransfer(arg0 context.Context, arg1 string, arg2 namf.N1N2MessageTransferRequest) (*namf.N1N2MessageTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*namf.N1N2MessageTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// N1N2MessageTransfer indicates an expected call of N1N2MessageTransfer.
+func (mr *MockCommunicationServiceMockRecorder) N1N2MessageTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageTransfer", reflect.TypeOf((*MockCommunicationService)(nil).N1N2MessageTransfer), arg0, arg1, arg2)
+}
+
+// N1N2MessageUnSubscribe mocks base method.
+func (m *MockCommunicationService) N1N2MessageUnSubscribe(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2MessageUnSubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// N1N2MessageUnSubscribe indicates an expected call of N1N2MessageUnSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) N1N2MessageUnSubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2MessageUnSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).N1N2MessageUnSubscribe), arg0, arg1, arg2)
+}
+
+// NonUeN2InfoSubscribe mocks base method.
+func (m *MockCommunicationService) NonUeN2InfoSubscribe(arg0 context.Context, arg1 openapi_Namf_Communication.NonUeN2InfoSubscriptionCreateData) (*openapi_Namf_Communication.NonUeN2InfoSubscriptionCreatedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2InfoSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Namf_Communication.NonUeN2InfoSubscriptionCreatedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonUeN2InfoSubscribe indicates an expected call of NonUeN2InfoSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) NonUeN2InfoSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2InfoSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).NonUeN2InfoSubscribe), arg0, arg1)
+}
+
+// NonUeN2InfoUnSubscribe mocks base method.
+func (m *MockCommunicationService) NonUeN2InfoUnSubscribe(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2InfoUnSubscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NonUeN2InfoUnSubscribe indicates an expected call of NonUeN2InfoUnSubscribe.
+func (mr *MockCommunicationServiceMockRecorder) NonUeN2InfoUnSubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2InfoUnSubscribe", reflect.TypeOf((*MockCommunicationService)(nil).NonUeN2InfoUnSubscribe), arg0, arg1)
+}
+
+// NonUeN2MessageTransfer mocks base method.
+func (m *MockCommunicationService) NonUeN2MessageTransfer(arg0 context.Context, arg1 namf.NonUeN2MessageTransferRequest) (*openapi_Namf_Communication.N2InformationTransferRspData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonUeN2MessageTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Namf_Communication.N2InformationTransferRspData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonUeN2MessageTransfer indicates an expected call of NonUeN2MessageTransfer.
+func (mr *MockCommunicationServiceMockRecorder) NonUeN2MessageTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonUeN2MessageTransfer", reflect.TypeOf((*MockCommunicationService)(nil).NonUeN2MessageTransfer), arg0, arg1)
+}
+
+// ReleaseUEContext mocks base method.
+func (m *MockCommunicationService) ReleaseUEContext(arg0 context.Context, arg1 string, arg2 openapi_Namf_Communication.UEContextRelease) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUEContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseUEContext indicates an expected call of ReleaseUEContext.
+func (mr *MockCommunicationServiceMockRecorder) ReleaseUEContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUEContext", reflect.TypeOf((*MockCommunicationService)(nil).ReleaseUEContext), arg0, arg1, arg2)
+}
+
+// UEContextTransfer mocks base method.
+func (m *MockCommunicationService) UEContextTransfer(arg0 context.Context, arg1 string, arg2 namf.UEContextTransferRequest) (*namf.UEContextTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UEContextTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*namf.UEContextTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UEContextTransfer indicates an expected call of UEContextTransfer.
+func (mr *MockCommunicationServiceMockRecorder) UEContextTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UEContextTransfer", reflect.TypeOf((*MockCommunicationService)(nil).UEContextTransfer), arg0, arg1, arg2)
+}
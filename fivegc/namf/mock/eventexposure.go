@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../eventexposure.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	namf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Namf_EventExposure "github.com/5GCoreNet/openapi/openapi_Namf_EventExposure"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventExposure is a mock of EventExposure interface.
+type MockEventExposure struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventExposureMockRecorder
+}
+
+// MockEventExposureMockRecorder is the mock recorder for MockEventExposure.
+type MockEventExposureMockRecorder struct {
+	mock *MockEventExposure
+}
+
+// NewMockEventExposure creates a new mock instance.
+func NewMockEventExposure(ctrl *gomock.Controller) *MockEventExposure {
+	mock := &MockEventExposure{ctrl: ctrl}
+	mock.recorder = &MockEventExposureMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventExposure) EXPECT() *MockEventExposureMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockEventExposure) CreateSubscription(arg0 context.Context, arg1 openapi_Namf_EventExposure.AmfCreateEventSubscription) (openapi_Namf_EventExposure.AmfCreatedEventSubscription, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.CreateSubscriptionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Namf_EventExposure.AmfCreatedEventSubscription)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.CreateSubscriptionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockEventExposureMockRecorder) CreateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockEventExposure)(nil).CreateSubscription), arg0, arg1)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockEventExposure) DeleteSubscription(arg0 context.Context, arg1 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.DeleteSubscriptionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.DeleteSubscriptionStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockEventExposureMockRecorder) DeleteSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockEventExposure)(nil).DeleteSubscription), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockEventExposure) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockEventExposureMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockEventExposure)(nil).Error), ctx, err)
+}
+
+// ModifySubscription mocks base method.
+func (m *MockEventExposure) ModifySubscription(arg0 context.Context, arg1 string, arg2 openapi_Namf_EventExposure.ModifySubscriptionRequest) (openapi_Namf_EventExposure.AmfUpdatedEventSubscription, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.ModifySubscriptionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySubscription", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_EventExposure.AmfUpdatedEventSubscription)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.ModifySubscriptionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ModifySubscription indicates an expected call of ModifySubscription.
+func (mr *MockEventExposureMockRecorder) ModifySubscription(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySubscription", reflect.TypeOf((*MockEventExposure)(nil).ModifySubscription), arg0, arg1, arg2)
+}
+
+// MockEventExposureService is a mock of EventExposureService interface.
+type MockEventExposureService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventExposureServiceMockRecorder
+}
+
+// MockEventExposureServiceMockRecorder is the mock recorder for MockEventExposureService.
+type MockEventExposureServiceMockRecorder struct {
+	mock *MockEventExposureService
+}
+
+// NewMockEventExposureService creates a new mock instance.
+func NewMockEventExposureService(ctrl *gomock.Controller) *MockEventExposureService {
+	mock := &MockEventExposureService{ctrl: ctrl}
+	mock.recorder = &MockEventExposureServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventExposureService) EXPECT() *MockEventExposureServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockEventExposureService) CreateSubscription(arg0 context.Context, arg1 openapi_Namf_EventExposure.AmfCreateEventSubscription) (*openapi_Namf_EventExposure.AmfCreatedEventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Namf_EventExposure.AmfCreatedEventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockEventExposureServiceMockRecorder) CreateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockEventExposureService)(nil).CreateSubscription), arg0, arg1)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockEventExposureService) DeleteSubscription(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockEventExposureServiceMockRecorder) DeleteSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockEventExposureService)(nil).DeleteSubscription), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockEventExposureService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockEventExposureServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockEventExposureService)(nil).Error), ctx, err)
+}
+
+// ModifySubscription mocks base method.
+func (m *MockEventExposureService) ModifySubscription(arg0 context.Context, arg1 string, arg2 openapi_Namf_EventExposure.ModifySubscriptionRequest) (*openapi_Namf_EventExposure.AmfUpdatedEventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySubscription", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_EventExposure.AmfUpdatedEventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySubscription indicates an expected call of ModifySubscription.
+func (mr *MockEventExposureServiceMockRecorder) ModifySubscription(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySubscription", reflect.TypeOf((*MockEventExposureService)(nil).ModifySubscription), arg0, arg1, arg2)
+}
+
+// MockEventExposureNotification is a mock of EventExposureNotification interface.
+type MockEventExposureNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventExposureNotificationMockRecorder
+}
+
+// MockEventExposureNotificationMockRecorder is the mock recorder for MockEventExposureNotification.
+type MockEventExposureNotificationMockRecorder struct {
+	mock *MockEventExposureNotification
+}
+
+// NewMockEventExposureNotification creates a new mock instance.
+func NewMockEventExposureNotification(ctrl *gomock.Controller) *MockEventExposureNotification {
+	mock := &MockEventExposureNotification{ctrl: ctrl}
+	mock.recorder = &MockEventExposureNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventExposureNotification) EXPECT() *MockEventExposureNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockEventExposureNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockEventExposureNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockEventExposureNotification)(nil).Error), ctx, err)
+}
+
+// EventExposureNotify mocks base method.
+func (m *MockEventExposureNotification) EventExposureNotify(arg0 context.Context, arg1 openapi_Namf_EventExposure.AmfEventNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.EventExposureNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventExposureNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.EventExposureNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// EventExposureNotify indicates an expected call of EventExposureNotify.
+func (mr *MockEventExposureNotificationMockRecorder) EventExposureNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventExposureNotify", reflect.TypeOf((*MockEventExposureNotification)(nil).EventExposureNotify), arg0, arg1)
+}
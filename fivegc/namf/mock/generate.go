@@ -0,0 +1,7 @@
+package mock
+
+//go:generate mockgen -source=../communication.go -destination=communication.go -package=mock
+//go:generate mockgen -source=../eventexposure.go -destination=eventexposure.go -package=mock
+//go:generate mockgen -source=../location.go -destination=location.go -package=mock
+//go:generate mockgen -source=../mt.go -destination=mt.go -package=mock
+//go:generate mockgen -source=../notification.go -destination=notification.go -package=mock
@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../location.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	namf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Namf_Location "github.com/5GCoreNet/openapi/openapi_Namf_Location"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLocation is a mock of Location interface.
+type MockLocation struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationMockRecorder
+}
+
+// MockLocationMockRecorder is the mock recorder for MockLocation.
+type MockLocationMockRecorder struct {
+	mock *MockLocation
+}
+
+// NewMockLocation creates a new mock instance.
+func NewMockLocation(ctrl *gomock.Controller) *MockLocation {
+	mock := &MockLocation{ctrl: ctrl}
+	mock.recorder = &MockLocationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocation) EXPECT() *MockLocationMockRecorder {
+	return m.recorder
+}
+
+// CancelLocation mocks base method.
+func (m *MockLocation) CancelLocation(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.CancelPosInfo) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.CancelLocationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.CancelLocationStatusCode)
+	return ret0, ret1, ret2
+}
+
+// CancelLocation indicates an expected call of CancelLocation.
+func (mr *MockLocationMockRecorder) CancelLocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocation", reflect.TypeOf((*MockLocation)(nil).CancelLocation), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockLocation) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocation)(nil).Error), ctx, err)
+}
+
+// ProvideLocationInfo mocks base method.
+func (m *MockLocation) ProvideLocationInfo(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.RequestLocInfo) (openapi_Namf_Location.ProvidedLocInfo, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.ProvideLocationInfoStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideLocationInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_Location.ProvidedLocInfo)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.ProvideLocationInfoStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ProvideLocationInfo indicates an expected call of ProvideLocationInfo.
+func (mr *MockLocationMockRecorder) ProvideLocationInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideLocationInfo", reflect.TypeOf((*MockLocation)(nil).ProvideLocationInfo), arg0, arg1, arg2)
+}
+
+// ProvidePositioningInfo mocks base method.
+func (m *MockLocation) ProvidePositioningInfo(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.RequestPosInfo) (openapi_Namf_Location.ProvidePosInfo, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.ProvidePositioningInfoStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvidePositioningInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_Location.ProvidePosInfo)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.ProvidePositioningInfoStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ProvidePositioningInfo indicates an expected call of ProvidePositioningInfo.
+func (mr *MockLocationMockRecorder) ProvidePositioningInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvidePositioningInfo", reflect.TypeOf((*MockLocation)(nil).ProvidePositioningInfo), arg0, arg1, arg2)
+}
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelLocation mocks base method.
+func (m *MockLocationService) CancelLocation(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.CancelPosInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelLocation indicates an expected call of CancelLocation.
+func (mr *MockLocationServiceMockRecorder) CancelLocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocation", reflect.TypeOf((*MockLocationService)(nil).CancelLocation), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockLocationService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocationService)(nil).Error), ctx, err)
+}
+
+// ProvideLocationInfo mocks base method.
+func (m *MockLocationService) ProvideLocationInfo(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.RequestLocInfo) (*openapi_Namf_Location.ProvidedLocInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideLocationInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_Location.ProvidedLocInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvideLocationInfo indicates an expected call of ProvideLocationInfo.
+func (mr *MockLocationServiceMockRecorder) ProvideLocationInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideLocationInfo", reflect.TypeOf((*MockLocationService)(nil).ProvideLocationInfo), arg0, arg1, arg2)
+}
+
+// ProvidePositioningInfo mocks base method.
+func (m *MockLocationService) ProvidePositioningInfo(arg0 context.Context, arg1 string, arg2 openapi_Namf_Location.RequestPosInfo) (*openapi_Namf_Location.ProvidePosInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvidePositioningInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_Location.ProvidePosInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvidePositioningInfo indicates an expected call of ProvidePositioningInfo.
+func (mr *MockLocationServiceMockRecorder) ProvidePositioningInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvidePositioningInfo", reflect.TypeOf((*MockLocationService)(nil).ProvidePositioningInfo), arg0, arg1, arg2)
+}
+
+// MockLocationNotification is a mock of LocationNotification interface.
+type MockLocationNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationNotificationMockRecorder
+}
+
+// MockLocationNotificationMockRecorder is the mock recorder for MockLocationNotification.
+type MockLocationNotificationMockRecorder struct {
+	mock *MockLocationNotification
+}
+
+// NewMockLocationNotification creates a new mock instance.
+func NewMockLocationNotification(ctrl *gomock.Controller) *MockLocationNotification {
+	mock := &MockLocationNotification{ctrl: ctrl}
+	mock.recorder = &MockLocationNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationNotification) EXPECT() *MockLocationNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockLocationNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocationNotification)(nil).Error), ctx, err)
+}
+
+// EventNotify mocks base method.
+func (m *MockLocationNotification) EventNotify(arg0 context.Context, arg1 openapi_Namf_Location.NotifiedPosInfo) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.EventNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.EventNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// EventNotify indicates an expected call of EventNotify.
+func (mr *MockLocationNotificationMockRecorder) EventNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventNotify", reflect.TypeOf((*MockLocationNotification)(nil).EventNotify), arg0, arg1)
+}
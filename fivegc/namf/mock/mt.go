@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../mt.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	namf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Namf_MT "github.com/5GCoreNet/openapi/openapi_Namf_MT"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMT is a mock of MT interface.
+type MockMT struct {
+	ctrl     *gomock.Controller
+	recorder *MockMTMockRecorder
+}
+
+// MockMTMockRecorder is the mock recorder for MockMT.
+type MockMTMockRecorder struct {
+	mock *MockMT
+}
+
+// NewMockMT creates a new mock instance.
+func NewMockMT(ctrl *gomock.Controller) *MockMT {
+	mock := &MockMT{ctrl: ctrl}
+	mock.recorder = &MockMTMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMT) EXPECT() *MockMTMockRecorder {
+	return m.recorder
+}
+
+// EnableGroupReachability mocks base method.
+func (m *MockMT) EnableGroupReachability(arg0 context.Context, arg1 openapi_Namf_MT.EnableGroupReachabilityReqData) (openapi_Namf_MT.EnableGroupReachabilityRspData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.EnableGroupReachabilityStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableGroupReachability", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Namf_MT.EnableGroupReachabilityRspData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.EnableGroupReachabilityStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// EnableGroupReachability indicates an expected call of EnableGroupReachability.
+func (mr *MockMTMockRecorder) EnableGroupReachability(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableGroupReachability", reflect.TypeOf((*MockMT)(nil).EnableGroupReachability), arg0, arg1)
+}
+
+// EnableUEReachability mocks base method.
+func (m *MockMT) EnableUEReachability(arg0 context.Context, arg1 string, arg2 openapi_Namf_MT.EnableUeReachabilityReqData) (openapi_Namf_MT.EnableUeReachabilityRspData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.EnableUEReachabilityStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUEReachability", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_MT.EnableUeReachabilityRspData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.EnableUEReachabilityStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// EnableUEReachability indicates an expected call of EnableUEReachability.
+func (mr *MockMTMockRecorder) EnableUEReachability(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUEReachability", reflect.TypeOf((*MockMT)(nil).EnableUEReachability), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockMT) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockMTMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockMT)(nil).Error), ctx, err)
+}
+
+// ProvideDomainSelectionInfo mocks base method.
+func (m *MockMT) ProvideDomainSelectionInfo(arg0 context.Context, arg1 string, arg2 namf.ProvideDomainSelectionInfoRequest) (openapi_Namf_MT.UeContextInfo, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.ProvideDomainSelectionInfoStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideDomainSelectionInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Namf_MT.UeContextInfo)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(namf.ProvideDomainSelectionInfoStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ProvideDomainSelectionInfo indicates an expected call of ProvideDomainSelectionInfo.
+func (mr *MockMTMockRecorder) ProvideDomainSelectionInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideDomainSelectionInfo", reflect.TypeOf((*MockMT)(nil).ProvideDomainSelectionInfo), arg0, arg1, arg2)
+}
+
+// MockMTService is a mock of MTService interface.
+type MockMTService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMTServiceMockRecorder
+}
+
+// MockMTServiceMockRecorder is the mock recorder for MockMTService.
+type MockMTServiceMockRecorder struct {
+	mock *MockMTService
+}
+
+// NewMockMTService creates a new mock instance.
+func NewMockMTService(ctrl *gomock.Controller) *MockMTService {
+	mock := &MockMTService{ctrl: ctrl}
+	mock.recorder = &MockMTServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMTService) EXPECT() *MockMTServiceMockRecorder {
+	return m.recorder
+}
+
+// EnableGroupReachability mocks base method.
+func (m *MockMTService) EnableGroupReachability(arg0 context.Context, arg1 openapi_Namf_MT.EnableGroupReachabilityReqData) (*openapi_Namf_MT.EnableGroupReachabilityRspData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableGroupReachability", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Namf_MT.EnableGroupReachabilityRspData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableGroupReachability indicates an expected call of EnableGroupReachability.
+func (mr *MockMTServiceMockRecorder) EnableGroupReachability(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableGroupReachability", reflect.TypeOf((*MockMTService)(nil).EnableGroupReachability), arg0, arg1)
+}
+
+// EnableUEReachability mocks base method.
+func (m *MockMTService) EnableUEReachability(arg0 context.Context, arg1 string, arg2 openapi_Namf_MT.EnableUeReachabilityReqData) (*openapi_Namf_MT.EnableUeReachabilityRspData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUEReachability", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_MT.EnableUeReachabilityRspData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUEReachability indicates an expected call of EnableUEReachability.
+func (mr *MockMTServiceMockRecorder) EnableUEReachability(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUEReachability", reflect.TypeOf((*MockMTService)(nil).EnableUEReachability), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockMTService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockMTServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockMTService)(nil).Error), ctx, err)
+}
+
+// ProvideDomainSelectionInfo mocks base method.
+func (m *MockMTService) ProvideDomainSelectionInfo(arg0 context.Context, arg1 string, arg2 namf.ProvideDomainSelectionInfoRequest) (*openapi_Namf_MT.UeContextInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideDomainSelectionInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Namf_MT.UeContextInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvideDomainSelectionInfo indicates an expected call of ProvideDomainSelectionInfo.
+func (mr *MockMTServiceMockRecorder) ProvideDomainSelectionInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideDomainSelectionInfo", reflect.TypeOf((*MockMTService)(nil).ProvideDomainSelectionInfo), arg0, arg1, arg2)
+}
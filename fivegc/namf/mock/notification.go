@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../notification.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	namf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/namf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Namf_Communication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockN1N2Notification is a mock of N1N2Notification interface.
+type MockN1N2Notification struct {
+	ctrl     *gomock.Controller
+	recorder *MockN1N2NotificationMockRecorder
+}
+
+// MockN1N2NotificationMockRecorder is the mock recorder for MockN1N2Notification.
+type MockN1N2NotificationMockRecorder struct {
+	mock *MockN1N2Notification
+}
+
+// NewMockN1N2Notification creates a new mock instance.
+func NewMockN1N2Notification(ctrl *gomock.Controller) *MockN1N2Notification {
+	mock := &MockN1N2Notification{ctrl: ctrl}
+	mock.recorder = &MockN1N2NotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockN1N2Notification) EXPECT() *MockN1N2NotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockN1N2Notification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockN1N2NotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockN1N2Notification)(nil).Error), ctx, err)
+}
+
+// N1MessageNotify mocks base method.
+func (m *MockN1N2Notification) N1MessageNotify(arg0 context.Context, arg1 namf.N1MessageNotifyRequest) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N1MessageNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1MessageNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.N1MessageNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// N1MessageNotify indicates an expected call of N1MessageNotify.
+func (mr *MockN1N2NotificationMockRecorder) N1MessageNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1MessageNotify", reflect.TypeOf((*MockN1N2Notification)(nil).N1MessageNotify), arg0, arg1)
+}
+
+// N1N2TransferFailureNotification mocks base method.
+func (m *MockN1N2Notification) N1N2TransferFailureNotification(arg0 context.Context, arg1 openapi_Namf_Communication.N1N2MsgTxfrFailureNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N1N2TransferFailureNotificationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N1N2TransferFailureNotification", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.N1N2TransferFailureNotificationStatusCode)
+	return ret0, ret1, ret2
+}
+
+// N1N2TransferFailureNotification indicates an expected call of N1N2TransferFailureNotification.
+func (mr *MockN1N2NotificationMockRecorder) N1N2TransferFailureNotification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N1N2TransferFailureNotification", reflect.TypeOf((*MockN1N2Notification)(nil).N1N2TransferFailureNotification), arg0, arg1)
+}
+
+// N2InfoNotify mocks base method.
+func (m *MockN1N2Notification) N2InfoNotify(arg0 context.Context, arg1 namf.N2InfoNotifyRequest) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, namf.N2InfoNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "N2InfoNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(namf.N2InfoNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// N2InfoNotify indicates an expected call of N2InfoNotify.
+func (mr *MockN1N2NotificationMockRecorder) N2InfoNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "N2InfoNotify", reflect.TypeOf((*MockN1N2Notification)(nil).N2InfoNotify), arg0, arg1)
+}
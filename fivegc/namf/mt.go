@@ -0,0 +1,357 @@
+package namf
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfmt "github.com/5GCoreNet/openapi/openapi_Namf_MT"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	mtRouterGroup                   = "/namf-mt/v1"
+	ueReachIndEndpoint              = "/ue-contexts/{ueContextId}/ue-reachind"
+	enableGroupReachabilityEndpoint = "/ue-contexts/enable-group-reachability"
+	infoClassQuery                  = "info-class"
+	supportedFeaturesQuery          = "supported-features"
+	oldGuamiQuery                   = "old-guami"
+)
+
+// Information class of a ProvideDomainSelectionInfo request, defined in TS 29.518 clause 6.3.6.3.3.
+const (
+	InfoClassTADS = "TADS"
+)
+
+// Reachability of the UE, defined in TS 29.518 clause 6.3.6.3.4.
+const (
+	UEReachabilityUnreachable    = "UNREACHABLE"
+	UEReachabilityReachable      = "REACHABLE"
+	UEReachabilityRegulatoryOnly = "REGULATORY_ONLY"
+)
+
+// ProvideDomainSelectionInfoRequest holds the query parameters of a ProvideDomainSelectionInfo request.
+type ProvideDomainSelectionInfoRequest struct {
+	InfoClass         string
+	SupportedFeatures string
+	OldGuami          *namfmt.Guami
+}
+
+// MT is the interface that wraps the NAMF MT service (TS 29.518 clause 5.4).
+// The UE context ID of the requests is the SUPI of the UE, such as "imsi-208930000000001".
+type MT interface {
+	fivegc.CommonInterface
+	// ProvideDomainSelectionInfo provides the information used by the UDM and the IMS to select the access domain of
+	// the UE, such as the support of IMS voice over PS sessions.
+	ProvideDomainSelectionInfo(context.Context, string, ProvideDomainSelectionInfoRequest) (namfmt.UeContextInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideDomainSelectionInfoStatusCode)
+	// EnableUEReachability pages the UE, to make it reachable for the downlink signalling or data.
+	EnableUEReachability(context.Context, string, namfmt.EnableUeReachabilityReqData) (namfmt.EnableUeReachabilityRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, EnableUEReachabilityStatusCode)
+	// EnableGroupReachability pages a group of UEs, for the delivery of a multicast MBS session.
+	EnableGroupReachability(context.Context, namfmt.EnableGroupReachabilityReqData) (namfmt.EnableGroupReachabilityRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, EnableGroupReachabilityStatusCode)
+}
+
+// MTService is an alternative to the MT interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one.
+// Use NewMT to attach it to the NAMF Server.
+type MTService interface {
+	fivegc.CommonInterface
+	// ProvideDomainSelectionInfo provides the information used to select the access domain of the UE.
+	ProvideDomainSelectionInfo(context.Context, string, ProvideDomainSelectionInfoRequest) (*namfmt.UeContextInfo, error)
+	// EnableUEReachability pages the UE, to make it reachable for the downlink signalling or data.
+	EnableUEReachability(context.Context, string, namfmt.EnableUeReachabilityReqData) (*namfmt.EnableUeReachabilityRspData, error)
+	// EnableGroupReachability pages a group of UEs, for the delivery of a multicast MBS session.
+	EnableGroupReachability(context.Context, namfmt.EnableGroupReachabilityReqData) (*namfmt.EnableGroupReachabilityRspData, error)
+}
+
+// ProvideDomainSelectionInfoStatusCode is the status code of a ProvideDomainSelectionInfo response.
+type ProvideDomainSelectionInfoStatusCode fivegc.StatusCode
+
+const (
+	// ProvideDomainSelectionInfoStatusOK is the status code for a successful response.
+	ProvideDomainSelectionInfoStatusOK                ProvideDomainSelectionInfoStatusCode = ProvideDomainSelectionInfoStatusCode(fivegc.StatusOK)
+	ProvideDomainSelectionInfoStatusTemporaryRedirect ProvideDomainSelectionInfoStatusCode = ProvideDomainSelectionInfoStatusCode(fivegc.StatusTemporaryRedirect)
+	ProvideDomainSelectionInfoStatusPermanentRedirect ProvideDomainSelectionInfoStatusCode = ProvideDomainSelectionInfoStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var provideDomainSelectionInfoErrors = response.QueryErrors
+
+// EnableUEReachabilityStatusCode is the status code of an EnableUEReachability response.
+type EnableUEReachabilityStatusCode fivegc.StatusCode
+
+const (
+	// EnableUEReachabilityStatusOK is the status code for the response when the UE is reachable.
+	EnableUEReachabilityStatusOK                EnableUEReachabilityStatusCode = EnableUEReachabilityStatusCode(fivegc.StatusOK)
+	EnableUEReachabilityStatusTemporaryRedirect EnableUEReachabilityStatusCode = EnableUEReachabilityStatusCode(fivegc.StatusTemporaryRedirect)
+	EnableUEReachabilityStatusPermanentRedirect EnableUEReachabilityStatusCode = EnableUEReachabilityStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var enableUEReachabilityErrors = response.BodyErrors
+
+// EnableGroupReachabilityStatusCode is the status code of an EnableGroupReachability response.
+type EnableGroupReachabilityStatusCode fivegc.StatusCode
+
+const (
+	// EnableGroupReachabilityStatusOK is the status code for a successful response.
+	EnableGroupReachabilityStatusOK                EnableGroupReachabilityStatusCode = EnableGroupReachabilityStatusCode(fivegc.StatusOK)
+	EnableGroupReachabilityStatusTemporaryRedirect EnableGroupReachabilityStatusCode = EnableGroupReachabilityStatusCode(fivegc.StatusTemporaryRedirect)
+	EnableGroupReachabilityStatusPermanentRedirect EnableGroupReachabilityStatusCode = EnableGroupReachabilityStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var enableGroupReachabilityErrors = response.BodyErrors
+
+func attachMTHandler(r *gin.RouterGroup, m MT, logger *log.Logger) {
+	group := r.Group(mtRouterGroup)
+	{
+		group.GET(router.Path(ueContextEndpoint), func(c *gin.Context) {
+			req := ProvideDomainSelectionInfoRequest{
+				InfoClass:         c.Query(infoClassQuery),
+				SupportedFeatures: c.Query(supportedFeaturesQuery),
+			}
+			var oldGuami namfmt.Guami
+			found, err := router.JSONQuery(c, oldGuamiQuery, &oldGuami, false)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, m, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, provideDomainSelectionInfoErrors)
+				return
+			}
+			if found {
+				req.OldGuami = &oldGuami
+			}
+			res, problemDetails, redirectResponse, status := m.ProvideDomainSelectionInfo(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case ProvideDomainSelectionInfoStatusOK:
+				c.JSON(int(status), res)
+			case ProvideDomainSelectionInfoStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ProvideDomainSelectionInfoStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, provideDomainSelectionInfoErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(ueReachIndEndpoint), func(c *gin.Context) {
+			var req namfmt.EnableUeReachabilityReqData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, m, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, enableUEReachabilityErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := m.EnableUEReachability(c, c.Param(ueContextIDParam), req)
+			switch status {
+			case EnableUEReachabilityStatusOK:
+				c.JSON(int(status), res)
+			case EnableUEReachabilityStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case EnableUEReachabilityStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, enableUEReachabilityErrors)
+			}
+			return
+		})
+		group.POST(router.Path(enableGroupReachabilityEndpoint), func(c *gin.Context) {
+			var req namfmt.EnableGroupReachabilityReqData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, m, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, enableGroupReachabilityErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := m.EnableGroupReachability(c, req)
+			switch status {
+			case EnableGroupReachabilityStatusOK:
+				c.JSON(int(status), res)
+			case EnableGroupReachabilityStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case EnableGroupReachabilityStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, enableGroupReachabilityErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewMT adapts a MTService to the MT interface.
+func NewMT(s MTService) MT {
+	return mtAdapter{service: s}
+}
+
+type mtAdapter struct {
+	service MTService
+}
+
+func (a mtAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a mtAdapter) ProvideDomainSelectionInfo(ctx context.Context, ueContextID string, req ProvideDomainSelectionInfoRequest) (namfmt.UeContextInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideDomainSelectionInfoStatusCode) {
+	res, err := a.service.ProvideDomainSelectionInfo(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfmt.UeContextInfo{}, problemDetails, redirectResponse, ProvideDomainSelectionInfoStatusCode(status)
+	}
+	if res == nil {
+		res = &namfmt.UeContextInfo{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvideDomainSelectionInfoStatusOK
+}
+
+func (a mtAdapter) EnableUEReachability(ctx context.Context, ueContextID string, req namfmt.EnableUeReachabilityReqData) (namfmt.EnableUeReachabilityRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, EnableUEReachabilityStatusCode) {
+	res, err := a.service.EnableUEReachability(ctx, ueContextID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfmt.EnableUeReachabilityRspData{}, problemDetails, redirectResponse, EnableUEReachabilityStatusCode(status)
+	}
+	if res == nil {
+		res = &namfmt.EnableUeReachabilityRspData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EnableUEReachabilityStatusOK
+}
+
+func (a mtAdapter) EnableGroupReachability(ctx context.Context, req namfmt.EnableGroupReachabilityReqData) (namfmt.EnableGroupReachabilityRspData, openapicommon.ProblemDetails, fivegc.RedirectResponse, EnableGroupReachabilityStatusCode) {
+	res, err := a.service.EnableGroupReachability(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return namfmt.EnableGroupReachabilityRspData{}, problemDetails, redirectResponse, EnableGroupReachabilityStatusCode(status)
+	}
+	if res == nil {
+		res = &namfmt.EnableGroupReachabilityRspData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EnableGroupReachabilityStatusOK
+}
+
+// NewMTService adapts a MT to the MTService interface.
+func NewMTService(m MT) MTService {
+	return mtServiceAdapter{mt: m}
+}
+
+type mtServiceAdapter struct {
+	mt MT
+}
+
+func (a mtServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.mt.Error(ctx, err)
+}
+
+func (a mtServiceAdapter) ProvideDomainSelectionInfo(ctx context.Context, ueContextID string, req ProvideDomainSelectionInfoRequest) (*namfmt.UeContextInfo, error) {
+	res, problemDetails, redirectResponse, status := a.mt.ProvideDomainSelectionInfo(ctx, ueContextID, req)
+	if status == ProvideDomainSelectionInfoStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a mtServiceAdapter) EnableUEReachability(ctx context.Context, ueContextID string, req namfmt.EnableUeReachabilityReqData) (*namfmt.EnableUeReachabilityRspData, error) {
+	res, problemDetails, redirectResponse, status := a.mt.EnableUEReachability(ctx, ueContextID, req)
+	if status == EnableUEReachabilityStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a mtServiceAdapter) EnableGroupReachability(ctx context.Context, req namfmt.EnableGroupReachabilityReqData) (*namfmt.EnableGroupReachabilityRspData, error) {
+	res, problemDetails, redirectResponse, status := a.mt.EnableGroupReachability(ctx, req)
+	if status == EnableGroupReachabilityStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// MTClient is a client for the NAMF MT service.
+type MTClient struct {
+	client *namfmt.APIClient
+}
+
+// NewMTClient creates a new client for the NAMF MT service.
+func NewMTClient(cfg fivegc.ClientConfiguration) *MTClient {
+	openapiCfg := &namfmt.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []namfmt.ServerConfiguration{},
+		OperationServers: make(map[string]namfmt.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := namfmt.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]namfmt.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = namfmt.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(namfmt.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = namfmt.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]namfmt.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = namfmt.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &MTClient{
+		client: namfmt.NewAPIClient(openapiCfg),
+	}
+}
+
+// ProvideDomainSelectionInfo returns a request for the domain selection information of a UE.
+func (m *MTClient) ProvideDomainSelectionInfo(ctx context.Context, ueContextID string) namfmt.ApiProvideDomainSelectionInfoRequest {
+	return m.client.UeContextDocumentApi.ProvideDomainSelectionInfo(ctx, ueContextID)
+}
+
+// ProvideDomainSelectionInfoExecute executes a ProvideDomainSelectionInfo request.
+func (m *MTClient) ProvideDomainSelectionInfoExecute(r namfmt.ApiProvideDomainSelectionInfoRequest) (*namfmt.UeContextInfo, *http.Response, error) {
+	return r.Execute()
+}
+
+// EnableUEReachability returns a request paging a UE.
+func (m *MTClient) EnableUEReachability(ctx context.Context, ueContextID string) namfmt.ApiEnableUeReachabilityRequest {
+	return m.client.UeReachIndDocumentApi.EnableUeReachability(ctx, ueContextID)
+}
+
+// EnableUEReachabilityExecute executes an EnableUEReachability request.
+func (m *MTClient) EnableUEReachabilityExecute(r namfmt.ApiEnableUeReachabilityRequest) (*namfmt.EnableUeReachabilityRspData, *http.Response, error) {
+	return r.Execute()
+}
+
+// EnableGroupReachability returns a request paging a group of UEs.
+func (m *MTClient) EnableGroupReachability(ctx context.Context) namfmt.ApiEnableGroupReachabilityRequest {
+	return m.client.UEContextsCollectionApi.EnableGroupReachability(ctx)
+}
+
+// EnableGroupReachabilityExecute executes an EnableGroupReachability request.
+func (m *MTClient) EnableGroupReachabilityExecute(r namfmt.ApiEnableGroupReachabilityRequest) (*namfmt.EnableGroupReachabilityRspData, *http.Response, error) {
+	return r.Execute()
+}
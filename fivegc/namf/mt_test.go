@@ -0,0 +1,88 @@
+package namf
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfmt "github.com/5GCoreNet/openapi/openapi_Namf_MT"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// pagingAMF serves a single UE, which is in a non-allowed area when not reachable.
+type pagingAMF struct {
+	supi      string
+	reachable bool
+	request   ProvideDomainSelectionInfoRequest
+}
+
+func (a *pagingAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *pagingAMF) ProvideDomainSelectionInfo(_ context.Context, ueContextID string, req ProvideDomainSelectionInfoRequest) (*namfmt.UeContextInfo, error) {
+	if ueContextID != a.supi {
+		return nil, ErrContextNotFound
+	}
+	a.request = req
+	var info namfmt.UeContextInfo
+	info.SetSupportVoPS(true)
+	return &info, nil
+}
+
+func (a *pagingAMF) EnableUEReachability(_ context.Context, ueContextID string, req namfmt.EnableUeReachabilityReqData) (*namfmt.EnableUeReachabilityRspData, error) {
+	if ueContextID != a.supi {
+		return nil, ErrContextNotFound
+	}
+	if !a.reachable {
+		return nil, ErrUEInNonAllowedArea
+	}
+	return &namfmt.EnableUeReachabilityRspData{Reachability: req.Reachability}, nil
+}
+
+func (a *pagingAMF) EnableGroupReachability(_ context.Context, req namfmt.EnableGroupReachabilityReqData) (*namfmt.EnableGroupReachabilityRspData, error) {
+	return nil, nil
+}
+
+func TestMT(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &pagingAMF{supi: "imsi-208930000000001"}
+	attachMTHandler(router.Group(""), NewMT(a), log.Default())
+	send := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		return apitest.Send(t, router, method, mtRouterGroup+path, nil, body)
+	}
+
+	query := url.Values{infoClassQuery: {InfoClassTADS}, oldGuamiQuery: {`{"plmnId":{"mcc":"208","mnc":"93"},"amfId":"cafe00"}`}}
+	w := send(http.MethodGet, "/ue-contexts/imsi-208930000000001?"+query.Encode(), nil)
+	var info namfmt.UeContextInfo
+	if w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &info) != nil || !info.GetSupportVoPS() {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	if a.request.InfoClass != InfoClassTADS || a.request.OldGuami == nil || a.request.OldGuami.AmfId != "cafe00" {
+		t.Errorf("unexpected request %+v", a.request)
+	}
+	w = send(http.MethodGet, "/ue-contexts/imsi-208930000000001?old-guami=cafe00", nil)
+	var problemDetails openapicommon.ProblemDetails
+	if w.Code != http.StatusBadRequest || json.Unmarshal(w.Body.Bytes(), &problemDetails) != nil || *problemDetails.Cause != fivegc.CauseOptionalQueryParamIncorrect {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	reachInd := namfmt.EnableUeReachabilityReqData{Reachability: namfmt.UeReachability{String: fivegc.ToString(UEReachabilityReachable)}}
+	if w := send(http.MethodPut, "/ue-contexts/imsi-208930000000001/ue-reachind", reachInd); w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), CauseUEInNonAllowedArea) {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	a.reachable = true
+	if w := send(http.MethodPut, "/ue-contexts/imsi-208930000000001/ue-reachind", reachInd); w.Code != http.StatusOK {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+	if w := send(http.MethodPost, "/ue-contexts/enable-group-reachability", namfmt.EnableGroupReachabilityReqData{Tmgi: namfmt.Tmgi{MbsServiceId: "000001", PlmnId: namfmt.PlmnId{Mcc: "208", Mnc: "93"}}}); w.Code != http.StatusOK {
+		t.Errorf("unexpected response %d %s", w.Code, w.Body)
+	}
+}
@@ -0,0 +1,152 @@
+package namf
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// N1N2Notification is the interface that wraps the N1N2 notifications of the NAMF Communication service
+// (TS 29.518 clause 6.1.5). It is implemented by the NF consumers receiving the N1 messages and N2 information of the
+// UEs, such as the LMF receiving the LPP messages and the NRPPa PDUs of its location sessions, and the failures of
+// their N1N2MessageTransfer requests.
+type N1N2Notification interface {
+	fivegc.CommonInterface
+	// N1MessageNotify receives an N1 message from the UE.
+	N1MessageNotify(context.Context, N1MessageNotifyRequest) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1MessageNotifyStatusCode)
+	// N2InfoNotify receives N2 information from the NG-RAN node.
+	N2InfoNotify(context.Context, N2InfoNotifyRequest) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N2InfoNotifyStatusCode)
+	// N1N2TransferFailureNotification receives the failure of a N1N2MessageTransfer request to a UE being paged.
+	N1N2TransferFailureNotification(context.Context, namfcommunication.N1N2MsgTxfrFailureNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2TransferFailureNotificationStatusCode)
+}
+
+// N1N2NotificationPaths are the paths, relative to the API root, of the callback URIs of the N1N2 notifications.
+// The notifications whose path is empty are not attached.
+type N1N2NotificationPaths struct {
+	// N1MessageNotify is the path of the n1NotifyCallbackUri, registered in the NF profile or in a subscription.
+	N1MessageNotify string
+	// N2InfoNotify is the path of the n2NotifyCallbackUri of a subscription.
+	N2InfoNotify string
+	// N1N2TransferFailureNotification is the path of the n1n2FailureTxfNotifURI of the transfer requests.
+	N1N2TransferFailureNotification string
+}
+
+// N1MessageNotifyStatusCode is the status code of a N1MessageNotify response.
+type N1MessageNotifyStatusCode fivegc.StatusCode
+
+const (
+	// N1MessageNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	N1MessageNotifyStatusNoContent         N1MessageNotifyStatusCode = N1MessageNotifyStatusCode(fivegc.StatusNoContent)
+	N1MessageNotifyStatusTemporaryRedirect N1MessageNotifyStatusCode = N1MessageNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	N1MessageNotifyStatusPermanentRedirect N1MessageNotifyStatusCode = N1MessageNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n1MessageNotifyErrors = response.BodyErrors
+
+// N2InfoNotifyStatusCode is the status code of a N2InfoNotify response.
+type N2InfoNotifyStatusCode fivegc.StatusCode
+
+const (
+	// N2InfoNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	N2InfoNotifyStatusNoContent         N2InfoNotifyStatusCode = N2InfoNotifyStatusCode(fivegc.StatusNoContent)
+	N2InfoNotifyStatusTemporaryRedirect N2InfoNotifyStatusCode = N2InfoNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	N2InfoNotifyStatusPermanentRedirect N2InfoNotifyStatusCode = N2InfoNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n2InfoNotifyErrors = response.BodyErrors
+
+// N1N2TransferFailureNotificationStatusCode is the status code of a N1N2TransferFailureNotification response.
+type N1N2TransferFailureNotificationStatusCode fivegc.StatusCode
+
+const (
+	// N1N2TransferFailureNotificationStatusNoContent is the status code for the response when the notification is successfully received.
+	N1N2TransferFailureNotificationStatusNoContent         N1N2TransferFailureNotificationStatusCode = N1N2TransferFailureNotificationStatusCode(fivegc.StatusNoContent)
+	N1N2TransferFailureNotificationStatusTemporaryRedirect N1N2TransferFailureNotificationStatusCode = N1N2TransferFailureNotificationStatusCode(fivegc.StatusTemporaryRedirect)
+	N1N2TransferFailureNotificationStatusPermanentRedirect N1N2TransferFailureNotificationStatusCode = N1N2TransferFailureNotificationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var n1n2TransferFailureNotificationErrors = response.BodyErrors
+
+func attachN1N2NotificationHandler(router *gin.RouterGroup, paths N1N2NotificationPaths, n N1N2Notification, logger *log.Logger) {
+	if paths.N1MessageNotify != "" {
+		router.POST(paths.N1MessageNotify, func(c *gin.Context) {
+			var req N1MessageNotifyRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, n, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, n1MessageNotifyErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := n.N1MessageNotify(c, req)
+			switch status {
+			case N1MessageNotifyStatusNoContent:
+				c.JSON(int(status), nil)
+			case N1MessageNotifyStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N1MessageNotifyStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n1MessageNotifyErrors)
+			}
+			return
+		})
+	}
+	if paths.N2InfoNotify != "" {
+		router.POST(paths.N2InfoNotify, func(c *gin.Context) {
+			var req N2InfoNotifyRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, n, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, n2InfoNotifyErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := n.N2InfoNotify(c, req)
+			switch status {
+			case N2InfoNotifyStatusNoContent:
+				c.JSON(int(status), nil)
+			case N2InfoNotifyStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N2InfoNotifyStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n2InfoNotifyErrors)
+			}
+			return
+		})
+	}
+	if paths.N1N2TransferFailureNotification != "" {
+		router.POST(paths.N1N2TransferFailureNotification, func(c *gin.Context) {
+			var req namfcommunication.N1N2MsgTxfrFailureNotification
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, n, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, n1n2TransferFailureNotificationErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := n.N1N2TransferFailureNotification(c, req)
+			switch status {
+			case N1N2TransferFailureNotificationStatusNoContent:
+				c.JSON(int(status), nil)
+			case N1N2TransferFailureNotificationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case N1N2TransferFailureNotificationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, n1n2TransferFailureNotificationErrors)
+			}
+			return
+		})
+	}
+}
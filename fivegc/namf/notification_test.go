@@ -0,0 +1,82 @@
+package namf
+
+import (
+	"bytes"
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	namfcommunication "github.com/5GCoreNet/openapi/openapi_Namf_Communication"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"testing"
+)
+
+// lmf receives the N1N2 notifications of a location session.
+type lmf struct {
+	lpp, nrppa []byte
+}
+
+func (l *lmf) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (l *lmf) N1MessageNotify(_ context.Context, req N1MessageNotifyRequest) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1MessageNotifyStatusCode) {
+	var err error
+	if l.lpp, err = req.LPP(); err != nil {
+		return fivegc.ErrMandatoryIeMissing.ProblemDetails(), fivegc.RedirectResponse{}, N1MessageNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1MessageNotifyStatusNoContent
+}
+
+func (l *lmf) N2InfoNotify(_ context.Context, req N2InfoNotifyRequest) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N2InfoNotifyStatusCode) {
+	var err error
+	if l.nrppa, err = req.NRPPa(); err != nil {
+		return fivegc.ErrMandatoryIeMissing.ProblemDetails(), fivegc.RedirectResponse{}, N2InfoNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N2InfoNotifyStatusNoContent
+}
+
+func (l *lmf) N1N2TransferFailureNotification(context.Context, namfcommunication.N1N2MsgTxfrFailureNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, N1N2TransferFailureNotificationStatusCode) {
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, N1N2TransferFailureNotificationStatusNoContent
+}
+
+func TestN1N2Notification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	l := &lmf{}
+	paths := N1N2NotificationPaths{N1MessageNotify: "/n1", N2InfoNotify: "/n2", N1N2TransferFailureNotification: "/failure"}
+	attachN1N2NotificationHandler(router.Group("/lmf"), paths, l, log.Default())
+	send := func(path string, root interface{}, parts []multipart.Part) int {
+		body, contentType, err := multipart.Encode(root, parts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return apitest.Send(t, router, http.MethodPost, "/lmf"+path, http.Header{"Content-Type": {contentType}}, body).Code
+	}
+
+	lpp := NewLPPTransfer("lmf-1", "session-1", []byte{0x92, 0x03})
+	var n1 namfcommunication.N1MessageNotification
+	n1.SetN1MessageContainer(lpp.JSONData.GetN1MessageContainer())
+	if status := send("/n1", n1, lpp.BinaryParts); status != http.StatusNoContent || !bytes.Equal(l.lpp, []byte{0x92, 0x03}) {
+		t.Errorf("unexpected response %d, LPP %x", status, l.lpp)
+	}
+	if status := send("/n1", n1, nil); status != http.StatusBadRequest {
+		t.Errorf("notification without binary part answered with %d", status)
+	}
+
+	nrppa := NewNRPPaTransfer("lmf-1", "session-1", []byte{0x00, 0x05})
+	var n2 namfcommunication.N2InformationNotification
+	n2.SetN2NotifySubscriptionId("subscription-1")
+	n2.SetN2InfoContainer(nrppa.JSONData.GetN2InfoContainer())
+	if status := send("/n2", n2, nrppa.BinaryParts); status != http.StatusNoContent || !bytes.Equal(l.nrppa, []byte{0x00, 0x05}) {
+		t.Errorf("unexpected response %d, NRPPa %x", status, l.nrppa)
+	}
+
+	failure := namfcommunication.N1N2MsgTxfrFailureNotification{N1n2MsgDataUri: "https://amf.example.com/n1-n2-messages/1"}
+	if status := send("/failure", failure, nil); status != http.StatusNoContent {
+		t.Errorf("unexpected response %d", status)
+	}
+}
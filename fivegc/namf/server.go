@@ -0,0 +1,115 @@
+package namf
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Server represents a NAMF server.
+type Server struct {
+	address                       string // IP:PORT
+	apiRoot                       string
+	communication                 Communication
+	eventExposure                 EventExposure
+	eventExposureNotification     EventExposureNotification
+	eventExposureNotificationPath string
+	location                      Location
+	locationNotification          LocationNotification
+	locationNotificationPath      string
+	n1n2Notification              N1N2Notification
+	n1n2NotificationPaths         N1N2NotificationPaths
+	mt                            MT
+	logger                        *log.Logger
+	router                        *gin.Engine
+	stop                          chan bool
+}
+
+// NewServer creates a new Server NAMF server instance.
+// The address is the IP:PORT of the NAMF server.
+// The apiRoot, such as https://amf.example.com/prefix or /prefix, is the one of the URIs of the created resources.
+func NewServer(address string, apiRoot string, logger *log.Logger) *Server {
+	return &Server{
+		address: address,
+		apiRoot: apiRoot,
+		logger:  logger,
+		stop:    make(chan bool),
+	}
+}
+
+// AttachCommunication attaches a Communication handler to the NAMF Server.
+func (n *Server) AttachCommunication(cm Communication) {
+	n.communication = cm
+}
+
+// AttachEventExposure attaches an EventExposure handler to the NAMF Server.
+func (n *Server) AttachEventExposure(e EventExposure) {
+	n.eventExposure = e
+}
+
+// AttachEventExposureNotification attaches an EventExposureNotification handler to the NAMF Server.
+// The path, relative to the API root, is the one of the eventNotifyUri given in the subscriptions.
+func (n *Server) AttachEventExposureNotification(path string, notification EventExposureNotification) {
+	n.eventExposureNotificationPath = path
+	n.eventExposureNotification = notification
+}
+
+// AttachLocation attaches a Location handler to the NAMF Server.
+func (n *Server) AttachLocation(l Location) {
+	n.location = l
+}
+
+// AttachLocationNotification attaches a LocationNotification handler to the NAMF Server.
+// The path, relative to the API root, is the one of the locationNotificationUri given in the positioning requests.
+func (n *Server) AttachLocationNotification(path string, notification LocationNotification) {
+	n.locationNotificationPath = path
+	n.locationNotification = notification
+}
+
+// AttachMT attaches a MT handler to the NAMF Server.
+func (n *Server) AttachMT(m MT) {
+	n.mt = m
+}
+
+// AttachN1N2Notification attaches a N1N2Notification handler to the NAMF Server, on the paths of its callback URIs.
+func (n *Server) AttachN1N2Notification(paths N1N2NotificationPaths, notification N1N2Notification) {
+	n.n1n2NotificationPaths = paths
+	n.n1n2Notification = notification
+}
+
+// Start starts the NAMF Server.
+func (n *Server) Start() {
+	n.router = gin.Default()
+	n.router.Use(gin.Recovery())
+	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
+	root := router.Root(n.router, n.apiRoot)
+	if n.communication != nil {
+		attachCommunicationHandler(root, n.communication, n.logger)
+	}
+	if n.eventExposure != nil {
+		attachEventExposureHandler(root, n.eventExposure, n.logger)
+	}
+	if n.eventExposureNotification != nil {
+		attachEventExposureNotificationHandler(root, n.eventExposureNotificationPath, n.eventExposureNotification, n.logger)
+	}
+	if n.location != nil {
+		attachLocationHandler(root, n.location, n.logger)
+	}
+	if n.locationNotification != nil {
+		attachLocationNotificationHandler(root, n.locationNotificationPath, n.locationNotification, n.logger)
+	}
+	if n.mt != nil {
+		attachMTHandler(root, n.mt, n.logger)
+	}
+	if n.n1n2Notification != nil {
+		attachN1N2NotificationHandler(root, n.n1n2NotificationPaths, n.n1n2Notification, n.logger)
+	}
+	go n.router.Run(n.address)
+	<-n.stop
+	return
+}
+
+// Stop stops the NAMF Server.
+func (n *Server) Stop() {
+	n.stop <- true
+}
@@ -0,0 +1,14 @@
+package nausf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+type Client struct {
+	*UEAuthenticationClient
+}
+
+// NewClient returns a new client for an NAUSF service.
+func NewClient(config fivegc.ClientConfiguration) *Client {
+	return &Client{
+		UEAuthenticationClient: NewUEAuthenticationClient(config),
+	}
+}
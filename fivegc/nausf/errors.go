@@ -0,0 +1,25 @@
+package nausf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+// Application error causes of the NAUSF UEAuthentication service, defined in TS 29.509 clause 6.1.7.3.
+const (
+	CauseAuthenticationRejected      = "AUTHENTICATION_REJECTED"
+	CauseServingNetworkNotAuthorized = "SERVING_NETWORK_NOT_AUTHORIZED"
+	CauseContextNotFound             = "CONTEXT_NOT_FOUND"
+	CauseUserNotFound                = "USER_NOT_FOUND"
+)
+
+// Application errors of the NAUSF UEAuthentication service, defined in TS 29.509 clause 6.1.7.3.
+// The errors common to all the APIs, such as fivegc.ErrNfCongestion, can be used as well.
+var (
+	// ErrAuthenticationRejected is returned when the UE can not be authenticated, such as when its SUCI can not be
+	// deconcealed.
+	ErrAuthenticationRejected = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseAuthenticationRejected)
+	// ErrServingNetworkNotAuthorized is returned when the serving network is not authorized to authenticate the UE.
+	ErrServingNetworkNotAuthorized = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseServingNetworkNotAuthorized)
+	// ErrContextNotFound is returned when the authentication context of the request is not found.
+	ErrContextNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseContextNotFound)
+	// ErrUserNotFound is returned when the UE of the request has no subscription in the UDM.
+	ErrUserNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseUserNotFound)
+)
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nausf"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/security"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	"log"
+)
+
+// MyUEAuthentication implements the nausf.UEAuthenticationService interface, whose handlers return a result and an error.
+type MyUEAuthentication struct {
+}
+
+func (m MyUEAuthentication) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyUEAuthentication) Authenticate(ctx context.Context, info nausfueauth.AuthenticationInfo) (*nausf.AuthenticateResponse, error) {
+	// Your code here ...
+	// Retrieve the 5G HE AV from the UDM with a nudm.UEAUClient, and store it in the authentication context.
+	var heav nudmueau.AuthenticationVector
+	av, _, err := security.NewAv5gAka(heav, info.ServingNetworkName)
+	if err != nil {
+		return nil, err
+	}
+	res := &nausf.AuthenticateResponse{AuthCtxID: "1"}
+	res.JSONData.AuthType = nausf.AuthType5GAKA
+	res.JSONData.Var5gAuthData = av
+	// The link to the 5G AKA confirmation is added by the SDK.
+	return res, nil
+}
+
+func (m MyUEAuthentication) Confirm5GAKA(ctx context.Context, authCtxID string, data nausfueauth.ConfirmationData) (*nausfueauth.ConfirmationDataResponse, error) {
+	// Your code here ...
+	if authCtxID != "1" {
+		return nil, nausf.ErrContextNotFound
+	}
+	// Compare the RES* with the XRES* of the 5G HE AV of the authentication context.
+	var heav nudmueau.AuthenticationVector
+	if !security.ConfirmResStar(heav, data) {
+		return &nausfueauth.ConfirmationDataResponse{AuthResult: nausf.AuthResultFailure}, nil
+	}
+	res := &nausfueauth.ConfirmationDataResponse{AuthResult: nausf.AuthResultSuccess}
+	// Send the KSEAF returned by security.NewAv5gAka.
+	res.SetKseaf("")
+	return res, nil
+}
+
+func (m MyUEAuthentication) EAPSession(ctx context.Context, authCtxID string, session nausfueauth.EapSession) (*nausfueauth.EapSession, error) {
+	// Your code here ...
+	return nil, nausf.ErrContextNotFound
+}
+
+func main() {
+	nausfServer := nausf.NewServer(":8080", "/v1/", log.Default())
+	nausfServer.AttachUEAuthentication(nausf.NewUEAuthentication(MyUEAuthentication{}))
+	nausfServer.Start()
+	// Your code here ...
+	nausfServer.Stop()
+}
@@ -0,0 +1,13 @@
+package nausf
+
+import nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+
+// AuthenticateResponse is the body of an Authenticate response.
+type AuthenticateResponse struct {
+	// AuthCtxID identifies the authentication context created, in the Location of the response and in the links to
+	// the 5G AKA confirmation or the EAP session.
+	AuthCtxID string
+	// JSONData is the authentication context. When it has no links, the link to the 5G AKA confirmation or to the EAP
+	// session of its authentication type is added.
+	JSONData nausfueauth.UEAuthenticationCtx
+}
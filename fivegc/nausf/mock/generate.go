@@ -0,0 +1,3 @@
+package mock
+
+//go:generate mockgen -source=../ueauthentication.go -destination=ueauthentication.go -package=mock
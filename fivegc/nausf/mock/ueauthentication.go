@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ueauthentication.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nausf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nausf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nausf_UEAuthentication "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUEAuthentication is a mock of UEAuthentication interface.
+type MockUEAuthentication struct {
+	ctrl     *gomock.Controller
+	recorder *MockUEAuthenticationMockRecorder
+}
+
+// MockUEAuthenticationMockRecorder is the mock recorder for MockUEAuthentication.
+type MockUEAuthenticationMockRecorder struct {
+	mock *MockUEAuthentication
+}
+
+// NewMockUEAuthentication creates a new mock instance.
+func NewMockUEAuthentication(ctrl *gomock.Controller) *MockUEAuthentication {
+	mock := &MockUEAuthentication{ctrl: ctrl}
+	mock.recorder = &MockUEAuthenticationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUEAuthentication) EXPECT() *MockUEAuthenticationMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockUEAuthentication) Authenticate(arg0 context.Context, arg1 openapi_Nausf_UEAuthentication.AuthenticationInfo) (nausf.AuthenticateResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nausf.AuthenticateStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(nausf.AuthenticateResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nausf.AuthenticateStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUEAuthenticationMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUEAuthentication)(nil).Authenticate), arg0, arg1)
+}
+
+// Confirm5GAKA mocks base method.
+func (m *MockUEAuthentication) Confirm5GAKA(arg0 context.Context, arg1 string, arg2 openapi_Nausf_UEAuthentication.ConfirmationData) (openapi_Nausf_UEAuthentication.ConfirmationDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nausf.Confirm5GAKAStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm5GAKA", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nausf_UEAuthentication.ConfirmationDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nausf.Confirm5GAKAStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// Confirm5GAKA indicates an expected call of Confirm5GAKA.
+func (mr *MockUEAuthenticationMockRecorder) Confirm5GAKA(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm5GAKA", reflect.TypeOf((*MockUEAuthentication)(nil).Confirm5GAKA), arg0, arg1, arg2)
+}
+
+// EAPSession mocks base method.
+func (m *MockUEAuthentication) EAPSession(arg0 context.Context, arg1 string, arg2 openapi_Nausf_UEAuthentication.EapSession) (openapi_Nausf_UEAuthentication.EapSession, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nausf.EAPSessionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EAPSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nausf_UEAuthentication.EapSession)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nausf.EAPSessionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// EAPSession indicates an expected call of EAPSession.
+func (mr *MockUEAuthenticationMockRecorder) EAPSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EAPSession", reflect.TypeOf((*MockUEAuthentication)(nil).EAPSession), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockUEAuthentication) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUEAuthenticationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUEAuthentication)(nil).Error), ctx, err)
+}
+
+// MockUEAuthenticationService is a mock of UEAuthenticationService interface.
+type MockUEAuthenticationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUEAuthenticationServiceMockRecorder
+}
+
+// MockUEAuthenticationServiceMockRecorder is the mock recorder for MockUEAuthenticationService.
+type MockUEAuthenticationServiceMockRecorder struct {
+	mock *MockUEAuthenticationService
+}
+
+// NewMockUEAuthenticationService creates a new mock instance.
+func NewMockUEAuthenticationService(ctrl *gomock.Controller) *MockUEAuthenticationService {
+	mock := &MockUEAuthenticationService{ctrl: ctrl}
+	mock.recorder = &MockUEAuthenticationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUEAuthenticationService) EXPECT() *MockUEAuthenticationServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockUEAuthenticationService) Authenticate(arg0 context.Context, arg1 openapi_Nausf_UEAuthentication.AuthenticationInfo) (*nausf.AuthenticateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*nausf.AuthenticateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUEAuthenticationServiceMockRecorder) Authenticate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUEAuthenticationService)(nil).Authenticate), arg0, arg1)
+}
+
+// Confirm5GAKA mocks base method.
+func (m *MockUEAuthenticationService) Confirm5GAKA(arg0 context.Context, arg1 string, arg2 openapi_Nausf_UEAuthentication.ConfirmationData) (*openapi_Nausf_UEAuthentication.ConfirmationDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm5GAKA", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nausf_UEAuthentication.ConfirmationDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm5GAKA indicates an expected call of Confirm5GAKA.
+func (mr *MockUEAuthenticationServiceMockRecorder) Confirm5GAKA(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm5GAKA", reflect.TypeOf((*MockUEAuthenticationService)(nil).Confirm5GAKA), arg0, arg1, arg2)
+}
+
+// EAPSession mocks base method.
+func (m *MockUEAuthenticationService) EAPSession(arg0 context.Context, arg1 string, arg2 openapi_Nausf_UEAuthentication.EapSession) (*openapi_Nausf_UEAuthentication.EapSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EAPSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nausf_UEAuthentication.EapSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EAPSession indicates an expected call of EAPSession.
+func (mr *MockUEAuthenticationServiceMockRecorder) EAPSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EAPSession", reflect.TypeOf((*MockUEAuthenticationService)(nil).EAPSession), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockUEAuthenticationService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUEAuthenticationServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUEAuthenticationService)(nil).Error), ctx, err)
+}
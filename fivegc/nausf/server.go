@@ -0,0 +1,53 @@
+package nausf
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Server represents a NAUSF server.
+type Server struct {
+	address          string // IP:PORT
+	apiRoot          string
+	ueAuthentication UEAuthentication
+	logger           *log.Logger
+	router           *gin.Engine
+	stop             chan bool
+}
+
+// NewServer creates a new Server NAUSF server instance.
+// The address is the IP:PORT of the NAUSF server.
+// The apiRoot, such as https://ausf.example.com/prefix or /prefix, is the one of the URIs of the created resources.
+func NewServer(address string, apiRoot string, logger *log.Logger) *Server {
+	return &Server{
+		address: address,
+		apiRoot: apiRoot,
+		logger:  logger,
+		stop:    make(chan bool),
+	}
+}
+
+// AttachUEAuthentication attaches an UEAuthentication handler to the NAUSF Server.
+func (n *Server) AttachUEAuthentication(u UEAuthentication) {
+	n.ueAuthentication = u
+}
+
+// Start starts the NAUSF Server.
+func (n *Server) Start() {
+	n.router = gin.Default()
+	n.router.Use(gin.Recovery())
+	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
+	root := router.Root(n.router, n.apiRoot)
+	if n.ueAuthentication != nil {
+		attachUEAuthenticationHandler(root, n.ueAuthentication, n.logger)
+	}
+	go n.router.Run(n.address)
+	<-n.stop
+	return
+}
+
+// Stop stops the NAUSF Server.
+func (n *Server) Stop() {
+	n.stop <- true
+}
@@ -0,0 +1,372 @@
+package nausf
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	ueAuthenticationRouterGroup = "/nausf-auth/v1"
+	authCtxIDParam              = "authCtxId"
+	ueAuthenticationsEndpoint   = "/ue-authentications"
+	confirmationEndpoint        = "/ue-authentications/{authCtxId}/5g-aka-confirmation"
+	eapSessionEndpoint          = "/ue-authentications/{authCtxId}/eap-session"
+)
+
+// Authentication types, defined in TS 29.509 clause 6.1.6.3.3.
+const (
+	AuthType5GAKA       = "5G_AKA"
+	AuthTypeEAPAKAPrime = "EAP_AKA_PRIME"
+	AuthTypeEAPTLS      = "EAP_TLS"
+	AuthTypeEAPTTLS     = "EAP_TTLS"
+)
+
+// Authentication results, defined in TS 29.509 clause 6.1.6.3.2.
+const (
+	AuthResultSuccess = "AUTHENTICATION_SUCCESS"
+	AuthResultFailure = "AUTHENTICATION_FAILURE"
+	AuthResultOngoing = "AUTHENTICATION_ONGOING"
+)
+
+// Relations of the links of an authentication context, defined in TS 29.509 clause 6.1.6.2.2.
+const (
+	LinkRelation5GAKA      = "5g-aka"
+	LinkRelationEAPSession = "eap-session"
+)
+
+// UEAuthentication is the interface that wraps the NAUSF UEAuthentication service (TS 29.509 clause 5.2).
+// It is implemented by the AUSF to authenticate the UEs on behalf of the AMF, with 5G AKA or with EAP-AKA'.
+type UEAuthentication interface {
+	fivegc.CommonInterface
+	// Authenticate starts the authentication of the UE, identified by its SUPI or its SUCI, for the serving network.
+	// The authentication vector is retrieved from the UDM, and the authentication context is created.
+	Authenticate(context.Context, nausfueauth.AuthenticationInfo) (AuthenticateResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, AuthenticateStatusCode)
+	// Confirm5GAKA verifies the RES* received from the UE in a 5G AKA authentication, identified by its context ID.
+	// The SUPI and the KSEAF are returned when the authentication succeeds.
+	Confirm5GAKA(context.Context, string, nausfueauth.ConfirmationData) (nausfueauth.ConfirmationDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, Confirm5GAKAStatusCode)
+	// EAPSession forwards an EAP packet received from the UE in an EAP authentication, identified by its context ID,
+	// and returns the next EAP packet or the result of the authentication.
+	EAPSession(context.Context, string, nausfueauth.EapSession) (nausfueauth.EapSession, openapicommon.ProblemDetails, fivegc.RedirectResponse, EAPSessionStatusCode)
+}
+
+// UEAuthenticationService is an alternative to the UEAuthentication interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one.
+// Use NewUEAuthentication to attach it to the NAUSF Server.
+type UEAuthenticationService interface {
+	fivegc.CommonInterface
+	// Authenticate starts the authentication of the UE, identified by its SUPI or its SUCI, for the serving network.
+	Authenticate(context.Context, nausfueauth.AuthenticationInfo) (*AuthenticateResponse, error)
+	// Confirm5GAKA verifies the RES* received from the UE in a 5G AKA authentication, identified by its context ID.
+	Confirm5GAKA(context.Context, string, nausfueauth.ConfirmationData) (*nausfueauth.ConfirmationDataResponse, error)
+	// EAPSession forwards an EAP packet received from the UE in an EAP authentication, identified by its context ID.
+	EAPSession(context.Context, string, nausfueauth.EapSession) (*nausfueauth.EapSession, error)
+}
+
+// AuthenticateStatusCode is the status code of an Authenticate response.
+type AuthenticateStatusCode fivegc.StatusCode
+
+const (
+	// AuthenticateStatusCreated is the status code for the response when the authentication context is created.
+	AuthenticateStatusCreated           AuthenticateStatusCode = AuthenticateStatusCode(fivegc.StatusCreated)
+	AuthenticateStatusTemporaryRedirect AuthenticateStatusCode = AuthenticateStatusCode(fivegc.StatusTemporaryRedirect)
+	AuthenticateStatusPermanentRedirect AuthenticateStatusCode = AuthenticateStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var authenticateErrors = response.BodyErrors
+
+// Confirm5GAKAStatusCode is the status code of a Confirm5GAKA response.
+type Confirm5GAKAStatusCode fivegc.StatusCode
+
+const (
+	// Confirm5GAKAStatusOK is the status code for the response carrying the result of the authentication.
+	Confirm5GAKAStatusOK                Confirm5GAKAStatusCode = Confirm5GAKAStatusCode(fivegc.StatusOK)
+	Confirm5GAKAStatusTemporaryRedirect Confirm5GAKAStatusCode = Confirm5GAKAStatusCode(fivegc.StatusTemporaryRedirect)
+	Confirm5GAKAStatusPermanentRedirect Confirm5GAKAStatusCode = Confirm5GAKAStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var confirm5GAKAErrors = response.BodyErrors
+
+// EAPSessionStatusCode is the status code of an EAPSession response.
+type EAPSessionStatusCode fivegc.StatusCode
+
+const (
+	// EAPSessionStatusOK is the status code for the response carrying the next EAP packet or the result of the authentication.
+	EAPSessionStatusOK                EAPSessionStatusCode = EAPSessionStatusCode(fivegc.StatusOK)
+	EAPSessionStatusTemporaryRedirect EAPSessionStatusCode = EAPSessionStatusCode(fivegc.StatusTemporaryRedirect)
+	EAPSessionStatusPermanentRedirect EAPSessionStatusCode = EAPSessionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var eapSessionErrors = response.BodyErrors
+
+func attachUEAuthenticationHandler(r *gin.RouterGroup, u UEAuthentication, logger *log.Logger) {
+	group := r.Group(ueAuthenticationRouterGroup)
+	{
+		group.POST(router.Path(ueAuthenticationsEndpoint), func(c *gin.Context) {
+			var req nausfueauth.AuthenticationInfo
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, authenticateErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.Authenticate(c, req)
+			switch status {
+			case AuthenticateStatusCreated:
+				uri := router.ResourceURI(c, res.AuthCtxID)
+				if len(res.JSONData.Links) == 0 {
+					res.JSONData.Links = authenticationLinks(uri, string(res.JSONData.AuthType))
+				}
+				c.Header("Location", uri)
+				c.JSON(int(status), res.JSONData)
+			case AuthenticateStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case AuthenticateStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, authenticateErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(confirmationEndpoint), func(c *gin.Context) {
+			var req nausfueauth.ConfirmationData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, confirm5GAKAErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.Confirm5GAKA(c, c.Param(authCtxIDParam), req)
+			switch status {
+			case Confirm5GAKAStatusOK:
+				c.JSON(int(status), res)
+			case Confirm5GAKAStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case Confirm5GAKAStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, confirm5GAKAErrors)
+			}
+			return
+		})
+		group.POST(router.Path(eapSessionEndpoint), func(c *gin.Context) {
+			var req nausfueauth.EapSession
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, eapSessionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.EAPSession(c, c.Param(authCtxIDParam), req)
+			switch status {
+			case EAPSessionStatusOK:
+				c.JSON(int(status), res)
+			case EAPSessionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case EAPSessionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, eapSessionErrors)
+			}
+			return
+		})
+	}
+}
+
+// authenticationLinks returns the link to the 5G AKA confirmation or to the EAP session of an authentication context,
+// identified by its URI.
+func authenticationLinks(uri string, authType string) map[string]nausfueauth.LinksValueSchema {
+	var link nausfueauth.LinksValueSchema
+	switch authType {
+	case AuthType5GAKA:
+		link.SetHref(uri + "/5g-aka-confirmation")
+		return map[string]nausfueauth.LinksValueSchema{LinkRelation5GAKA: link}
+	case AuthTypeEAPAKAPrime, AuthTypeEAPTLS, AuthTypeEAPTTLS:
+		link.SetHref(uri + "/eap-session")
+		return map[string]nausfueauth.LinksValueSchema{LinkRelationEAPSession: link}
+	}
+	return nil
+}
+
+// NewUEAuthentication adapts an UEAuthenticationService to the UEAuthentication interface.
+func NewUEAuthentication(s UEAuthenticationService) UEAuthentication {
+	return ueAuthenticationAdapter{service: s}
+}
+
+type ueAuthenticationAdapter struct {
+	service UEAuthenticationService
+}
+
+func (a ueAuthenticationAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a ueAuthenticationAdapter) Authenticate(ctx context.Context, req nausfueauth.AuthenticationInfo) (AuthenticateResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, AuthenticateStatusCode) {
+	res, err := a.service.Authenticate(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return AuthenticateResponse{}, problemDetails, redirectResponse, AuthenticateStatusCode(status)
+	}
+	if res == nil {
+		res = &AuthenticateResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, AuthenticateStatusCreated
+}
+
+func (a ueAuthenticationAdapter) Confirm5GAKA(ctx context.Context, authCtxID string, req nausfueauth.ConfirmationData) (nausfueauth.ConfirmationDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, Confirm5GAKAStatusCode) {
+	res, err := a.service.Confirm5GAKA(ctx, authCtxID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nausfueauth.ConfirmationDataResponse{}, problemDetails, redirectResponse, Confirm5GAKAStatusCode(status)
+	}
+	if res == nil {
+		res = &nausfueauth.ConfirmationDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, Confirm5GAKAStatusOK
+}
+
+func (a ueAuthenticationAdapter) EAPSession(ctx context.Context, authCtxID string, req nausfueauth.EapSession) (nausfueauth.EapSession, openapicommon.ProblemDetails, fivegc.RedirectResponse, EAPSessionStatusCode) {
+	res, err := a.service.EAPSession(ctx, authCtxID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nausfueauth.EapSession{}, problemDetails, redirectResponse, EAPSessionStatusCode(status)
+	}
+	if res == nil {
+		res = &nausfueauth.EapSession{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EAPSessionStatusOK
+}
+
+// NewUEAuthenticationService adapts an UEAuthentication to the UEAuthenticationService interface.
+func NewUEAuthenticationService(u UEAuthentication) UEAuthenticationService {
+	return ueAuthenticationServiceAdapter{ueAuthentication: u}
+}
+
+type ueAuthenticationServiceAdapter struct {
+	ueAuthentication UEAuthentication
+}
+
+func (a ueAuthenticationServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.ueAuthentication.Error(ctx, err)
+}
+
+func (a ueAuthenticationServiceAdapter) Authenticate(ctx context.Context, req nausfueauth.AuthenticationInfo) (*AuthenticateResponse, error) {
+	res, problemDetails, redirectResponse, status := a.ueAuthentication.Authenticate(ctx, req)
+	if status == AuthenticateStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a ueAuthenticationServiceAdapter) Confirm5GAKA(ctx context.Context, authCtxID string, req nausfueauth.ConfirmationData) (*nausfueauth.ConfirmationDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.ueAuthentication.Confirm5GAKA(ctx, authCtxID, req)
+	if status == Confirm5GAKAStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a ueAuthenticationServiceAdapter) EAPSession(ctx context.Context, authCtxID string, req nausfueauth.EapSession) (*nausfueauth.EapSession, error) {
+	res, problemDetails, redirectResponse, status := a.ueAuthentication.EAPSession(ctx, authCtxID, req)
+	if status == EAPSessionStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// UEAuthenticationClient is a client for the NAUSF UEAuthentication service.
+type UEAuthenticationClient struct {
+	client *nausfueauth.APIClient
+}
+
+// NewUEAuthenticationClient creates a new client for the NAUSF UEAuthentication service.
+func NewUEAuthenticationClient(cfg fivegc.ClientConfiguration) *UEAuthenticationClient {
+	openapiCfg := &nausfueauth.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nausfueauth.ServerConfiguration{},
+		OperationServers: make(map[string]nausfueauth.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nausfueauth.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nausfueauth.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nausfueauth.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nausfueauth.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nausfueauth.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nausfueauth.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nausfueauth.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &UEAuthenticationClient{
+		client: nausfueauth.NewAPIClient(openapiCfg),
+	}
+}
+
+// Authenticate returns a request starting the authentication of a UE.
+func (u *UEAuthenticationClient) Authenticate(ctx context.Context) nausfueauth.ApiUeAuthenticationsPostRequest {
+	return u.client.DefaultApi.UeAuthenticationsPost(ctx)
+}
+
+// AuthenticateExecute executes an Authenticate request.
+func (u *UEAuthenticationClient) AuthenticateExecute(r nausfueauth.ApiUeAuthenticationsPostRequest) (*nausfueauth.UEAuthenticationCtx, *http.Response, error) {
+	return r.Execute()
+}
+
+// Confirm5GAKA returns a request confirming a 5G AKA authentication with the RES* received from the UE.
+func (u *UEAuthenticationClient) Confirm5GAKA(ctx context.Context, authCtxID string) nausfueauth.ApiUeAuthenticationsAuthCtxId5gAkaConfirmationPutRequest {
+	return u.client.DefaultApi.UeAuthenticationsAuthCtxId5gAkaConfirmationPut(ctx, authCtxID)
+}
+
+// Confirm5GAKAExecute executes a Confirm5GAKA request.
+func (u *UEAuthenticationClient) Confirm5GAKAExecute(r nausfueauth.ApiUeAuthenticationsAuthCtxId5gAkaConfirmationPutRequest) (*nausfueauth.ConfirmationDataResponse, *http.Response, error) {
+	return r.Execute()
+}
+
+// EAPSession returns a request forwarding an EAP packet received from the UE.
+func (u *UEAuthenticationClient) EAPSession(ctx context.Context, authCtxID string) nausfueauth.ApiEapAuthMethodRequest {
+	return u.client.DefaultApi.EapAuthMethod(ctx, authCtxID)
+}
+
+// EAPSessionExecute executes an EAPSession request.
+func (u *UEAuthenticationClient) EAPSessionExecute(r nausfueauth.ApiEapAuthMethodRequest) (*nausfueauth.EapSession, *http.Response, error) {
+	return r.Execute()
+}
@@ -0,0 +1,129 @@
+package nausf
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// akaAUSF authenticates its UEs with 5G AKA, expecting the same RES* for all of them, and with EAP-AKA' for the SUCIs
+// starting with "suci-eap".
+type akaAUSF struct {
+	xresStar string
+	contexts map[string]string
+}
+
+func (a *akaAUSF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *akaAUSF) Authenticate(_ context.Context, req nausfueauth.AuthenticationInfo) (*AuthenticateResponse, error) {
+	if !strings.HasPrefix(req.ServingNetworkName, "5G:") {
+		return nil, ErrServingNetworkNotAuthorized
+	}
+	id := req.SupiOrSuci
+	a.contexts[id] = "imsi-208930000000001"
+	res := &AuthenticateResponse{AuthCtxID: id}
+	if strings.HasPrefix(id, "suci-eap") {
+		res.JSONData.AuthType = AuthTypeEAPAKAPrime
+		res.JSONData.Var5gAuthData = "AQEAAA=="
+		return res, nil
+	}
+	res.JSONData.AuthType = AuthType5GAKA
+	res.JSONData.Var5gAuthData = nausfueauth.Av5gAka{Rand: "00", HxresStar: "00", Autn: "00"}
+	return res, nil
+}
+
+func (a *akaAUSF) Confirm5GAKA(_ context.Context, authCtxID string, req nausfueauth.ConfirmationData) (*nausfueauth.ConfirmationDataResponse, error) {
+	supi, ok := a.contexts[authCtxID]
+	if !ok {
+		return nil, ErrContextNotFound
+	}
+	if req.ResStar != a.xresStar {
+		return &nausfueauth.ConfirmationDataResponse{AuthResult: AuthResultFailure}, nil
+	}
+	res := &nausfueauth.ConfirmationDataResponse{AuthResult: AuthResultSuccess}
+	res.SetSupi(supi)
+	res.SetKseaf("kseaf")
+	return res, nil
+}
+
+func (a *akaAUSF) EAPSession(_ context.Context, authCtxID string, req nausfueauth.EapSession) (*nausfueauth.EapSession, error) {
+	if _, ok := a.contexts[authCtxID]; !ok {
+		return nil, ErrContextNotFound
+	}
+	res := &nausfueauth.EapSession{EapPayload: "AwEABA=="}
+	res.SetAuthResult(AuthResultSuccess)
+	return res, nil
+}
+
+func TestUEAuthentication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &akaAUSF{xresStar: "0123456789abcdef0123456789abcdef", contexts: map[string]string{}}
+	attachUEAuthenticationHandler(router.Group(""), NewUEAuthentication(a), log.Default())
+
+	send := func(method, path string, body interface{}) (*http.Response, []byte) {
+		w := apitest.Send(t, router, method, "http://ausf.example.com"+ueAuthenticationRouterGroup+path, nil, body)
+		return w.Result(), w.Body.Bytes()
+	}
+
+	info := nausfueauth.AuthenticationInfo{SupiOrSuci: "suci-0-208-93-0-0-0-00000001", ServingNetworkName: "5G:mnc093.mcc208.3gppnetwork.org"}
+	resp, raw := send(http.MethodPost, "/ue-authentications", info)
+	var authCtx nausfueauth.UEAuthenticationCtx
+	if err := json.Unmarshal(raw, &authCtx); err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	location := resp.Header.Get("Location")
+	link, ok := authCtx.Links[LinkRelation5GAKA]
+	if !strings.HasSuffix(location, "/ue-authentications/suci-0-208-93-0-0-0-00000001") || !ok || link.GetHref() != location+"/5g-aka-confirmation" {
+		t.Errorf("unexpected authentication context %s %+v", location, authCtx)
+	}
+
+	confirmation := nausfueauth.ConfirmationData{ResStar: "0123456789abcdef0123456789abcdef"}
+	resp, raw = send(http.MethodPut, "/ue-authentications/suci-0-208-93-0-0-0-00000001/5g-aka-confirmation", confirmation)
+	var result nausfueauth.ConfirmationDataResponse
+	if err := json.Unmarshal(raw, &result); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if result.AuthResult != AuthResultSuccess || result.GetSupi() != "imsi-208930000000001" || result.GetKseaf() == "" {
+		t.Errorf("unexpected confirmation %+v", result)
+	}
+	confirmation.ResStar = "00"
+	resp, raw = send(http.MethodPut, "/ue-authentications/suci-0-208-93-0-0-0-00000001/5g-aka-confirmation", confirmation)
+	if err := json.Unmarshal(raw, &result); err != nil || result.AuthResult != AuthResultFailure {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if resp, _ := send(http.MethodPut, "/ue-authentications/unknown/5g-aka-confirmation", confirmation); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	info.SupiOrSuci = "suci-eap-1"
+	resp, raw = send(http.MethodPost, "/ue-authentications", info)
+	if err := json.Unmarshal(raw, &authCtx); err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if _, ok := authCtx.Links[LinkRelationEAPSession]; !ok {
+		t.Errorf("unexpected authentication context %+v", authCtx)
+	}
+	resp, raw = send(http.MethodPost, "/ue-authentications/suci-eap-1/eap-session", nausfueauth.EapSession{EapPayload: "AgEABA=="})
+	var session nausfueauth.EapSession
+	if err := json.Unmarshal(raw, &session); err != nil || resp.StatusCode != http.StatusOK || session.GetAuthResult() != AuthResultSuccess {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+
+	info.ServingNetworkName = "mnc093.mcc208"
+	if resp, _ := send(http.MethodPost, "/ue-authentications", info); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodPost, "/ue-authentications", "suci-0-208-93-0-0-0-00000001"); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+}
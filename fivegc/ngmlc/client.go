@@ -0,0 +1,16 @@
+package ngmlc
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+type Client struct {
+	*LocationClient
+	*NotificationClient
+}
+
+// NewClient returns a new client for an NGMLC service.
+func NewClient(config fivegc.ClientConfiguration) *Client {
+	return &Client{
+		LocationClient:     NewLocationClient(config),
+		NotificationClient: NewNotificationClient(config),
+	}
+}
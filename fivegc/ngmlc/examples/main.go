@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/ngmlc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	ngmlclocation "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	"log"
+)
+
+type MyLocation struct {
+}
+
+func (m MyLocation) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyLocation) ProvideLocation(ctx context.Context, data ngmlclocation.InputData) (ngmlclocation.LocationOutputData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ngmlc.ProvideLocationStatusCode) {
+	// Your code here ...
+	if err := ctx.Err(); err != nil {
+		// Application errors carry their cause and status code, see fivegc.ErrNfCongestion, fivegc.ErrTimedOutRequest...
+		problemDetails, status := fivegc.ProblemDetailsFromError(fivegc.ErrTimedOutRequest.WithDetail("%s", err))
+		return ngmlclocation.LocationOutputData{}, problemDetails, fivegc.RedirectResponse{}, ngmlc.ProvideLocationStatusCode(status)
+	}
+	return ngmlclocation.LocationOutputData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ngmlc.ProvideLocationStatusOK
+}
+
+func (m MyLocation) CancelLocation(ctx context.Context, data ngmlclocation.CancelLocData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, ngmlc.CancelLocationStatusCode) {
+	// Your code here ...
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ngmlc.CancelLocationStatusNoContent
+}
+
+func main() {
+	m := MyLocation{}
+	ngmlcServer := ngmlc.NewServer(":8080", "/v1/", log.Default())
+	ngmlcServer.AttachLocation(m)
+	ngmlcServer.Start()
+	// Your code here ...
+	ngmlcServer.Stop()
+}
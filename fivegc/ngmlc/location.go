@@ -0,0 +1,258 @@
+package ngmlc
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	ngmlclocation "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	locationRouterGroup     = "/ngmlc-loc/v1"
+	provideLocationEndpoint = "/provide-location"
+	cancelLocationEndpoint  = "/cancel-location"
+)
+
+// Location is the interface that wraps the NGMLC Location service (TS 29.515 clause 5.2).
+type Location interface {
+	fivegc.CommonInterface
+	// ProvideLocation provides the location of a UE, immediately or as deferred location reports.
+	ProvideLocation(context.Context, ngmlclocation.InputData) (ngmlclocation.LocationOutputData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideLocationStatusCode)
+	// CancelLocation cancels a deferred location request.
+	CancelLocation(context.Context, ngmlclocation.CancelLocData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, CancelLocationStatusCode)
+}
+
+// LocationService is an alternative to the Location interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). Use NewLocation to attach it to the NGMLC Server.
+type LocationService interface {
+	fivegc.CommonInterface
+	// ProvideLocation provides the location of a UE, a nil location output data is answered with 204 No Content.
+	ProvideLocation(context.Context, ngmlclocation.InputData) (*ngmlclocation.LocationOutputData, error)
+	// CancelLocation cancels a deferred location request.
+	CancelLocation(context.Context, ngmlclocation.CancelLocData) error
+}
+
+// ProvideLocationStatusCode is the status code of a ProvideLocation response.
+type ProvideLocationStatusCode fivegc.StatusCode
+
+const (
+	// ProvideLocationStatusOK is the status code for a successful response.
+	ProvideLocationStatusOK                ProvideLocationStatusCode = ProvideLocationStatusCode(fivegc.StatusOK)
+	ProvideLocationStatusNoContent         ProvideLocationStatusCode = ProvideLocationStatusCode(fivegc.StatusNoContent)
+	ProvideLocationStatusTemporaryRedirect ProvideLocationStatusCode = ProvideLocationStatusCode(fivegc.StatusTemporaryRedirect)
+	ProvideLocationStatusPermanentRedirect ProvideLocationStatusCode = ProvideLocationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var provideLocationErrors = response.BodyErrors
+
+// CancelLocationStatusCode is the status code of a CancelLocation response.
+type CancelLocationStatusCode fivegc.StatusCode
+
+const (
+	// CancelLocationStatusNoContent is the status code for the response when the location request is successfully cancelled.
+	CancelLocationStatusNoContent         CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusNoContent)
+	CancelLocationStatusTemporaryRedirect CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusTemporaryRedirect)
+	CancelLocationStatusPermanentRedirect CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var cancelLocationErrors = response.BodyErrors
+
+func attachLocationHandler(router *gin.RouterGroup, l Location, logger *log.Logger) {
+	group := router.Group(locationRouterGroup)
+	{
+		group.POST(provideLocationEndpoint, func(c *gin.Context) {
+			var req ngmlclocation.InputData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, provideLocationErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := l.ProvideLocation(c, req)
+			switch status {
+			case ProvideLocationStatusOK:
+				c.JSON(int(status), res)
+			case ProvideLocationStatusNoContent:
+				c.JSON(int(status), nil)
+			case ProvideLocationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ProvideLocationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, provideLocationErrors)
+			}
+			return
+		})
+		group.POST(cancelLocationEndpoint, func(c *gin.Context) {
+			var req ngmlclocation.CancelLocData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, cancelLocationErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := l.CancelLocation(c, req)
+			switch status {
+			case CancelLocationStatusNoContent:
+				c.JSON(int(status), nil)
+			case CancelLocationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CancelLocationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, cancelLocationErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewLocation adapts a LocationService to the Location interface.
+func NewLocation(s LocationService) Location {
+	return locationAdapter{service: s}
+}
+
+type locationAdapter struct {
+	service LocationService
+}
+
+func (a locationAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a locationAdapter) ProvideLocation(ctx context.Context, req ngmlclocation.InputData) (ngmlclocation.LocationOutputData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ProvideLocationStatusCode) {
+	res, err := a.service.ProvideLocation(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return ngmlclocation.LocationOutputData{}, problemDetails, redirectResponse, ProvideLocationStatusCode(status)
+	}
+	if res == nil {
+		return ngmlclocation.LocationOutputData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvideLocationStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ProvideLocationStatusOK
+}
+
+func (a locationAdapter) CancelLocation(ctx context.Context, req ngmlclocation.CancelLocData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, CancelLocationStatusCode) {
+	if err := a.service.CancelLocation(ctx, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, CancelLocationStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CancelLocationStatusNoContent
+}
+
+// NewLocationService adapts a Location to the LocationService interface.
+func NewLocationService(l Location) LocationService {
+	return locationServiceAdapter{location: l}
+}
+
+type locationServiceAdapter struct {
+	location Location
+}
+
+func (a locationServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.location.Error(ctx, err)
+}
+
+func (a locationServiceAdapter) ProvideLocation(ctx context.Context, req ngmlclocation.InputData) (*ngmlclocation.LocationOutputData, error) {
+	res, problemDetails, redirectResponse, status := a.location.ProvideLocation(ctx, req)
+	switch status {
+	case ProvideLocationStatusOK:
+		return &res, nil
+	case ProvideLocationStatusNoContent:
+		return nil, nil
+	default:
+		return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	}
+}
+
+func (a locationServiceAdapter) CancelLocation(ctx context.Context, req ngmlclocation.CancelLocData) error {
+	problemDetails, redirectResponse, status := a.location.CancelLocation(ctx, req)
+	if status == CancelLocationStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// LocationClient is a client for the NGMLC Location service.
+type LocationClient struct {
+	client *ngmlclocation.APIClient
+}
+
+// NewLocationClient creates a new client for the NGMLC Location service.
+func NewLocationClient(cfg fivegc.ClientConfiguration) *LocationClient {
+	openapiCfg := &ngmlclocation.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []ngmlclocation.ServerConfiguration{},
+		OperationServers: make(map[string]ngmlclocation.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := ngmlclocation.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]ngmlclocation.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = ngmlclocation.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(ngmlclocation.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = ngmlclocation.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]ngmlclocation.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = ngmlclocation.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &LocationClient{
+		client: ngmlclocation.NewAPIClient(openapiCfg),
+	}
+}
+
+// ProvideLocation returns provide location request
+func (l LocationClient) ProvideLocation(ctx context.Context) ngmlclocation.ApiProvideLocationRequest {
+	return l.client.ProvideLocationApi.ProvideLocation(ctx)
+}
+
+// ProvideLocationExecute executes the provide location request
+func (l LocationClient) ProvideLocationExecute(r ngmlclocation.ApiProvideLocationRequest) (*ngmlclocation.LocationOutputData, *http.Response, error) {
+	return r.Execute()
+}
+
+// CancelLocation returns cancel location request
+func (l LocationClient) CancelLocation(ctx context.Context) ngmlclocation.ApiCancelLocationRequest {
+	return l.client.CancelLocationApi.CancelLocation(ctx)
+}
+
+// CancelLocationExecute executes the cancel location request
+func (l LocationClient) CancelLocationExecute(r ngmlclocation.ApiCancelLocationRequest) (*http.Response, error) {
+	return r.Execute()
+}
@@ -0,0 +1,62 @@
+package ngmlc_test
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/ngmlc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/ngmlc/mock"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	ngmlclocation "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+func TestNewLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mock.NewMockLocationService(ctrl)
+	location := ngmlc.NewLocation(service)
+	ctx := context.Background()
+
+	service.EXPECT().ProvideLocation(ctx, gomock.Any()).Return(&ngmlclocation.LocationOutputData{}, nil)
+	if _, _, _, status := location.ProvideLocation(ctx, ngmlclocation.InputData{}); status != ngmlc.ProvideLocationStatusOK {
+		t.Errorf("unexpected status %d", status)
+	}
+
+	service.EXPECT().ProvideLocation(ctx, gomock.Any()).Return(nil, nil)
+	if _, _, _, status := location.ProvideLocation(ctx, ngmlclocation.InputData{}); status != ngmlc.ProvideLocationStatusNoContent {
+		t.Errorf("unexpected status %d", status)
+	}
+
+	service.EXPECT().ProvideLocation(ctx, gomock.Any()).Return(nil, fivegc.ErrTimedOutRequest)
+	_, problemDetails, _, status := location.ProvideLocation(ctx, ngmlclocation.InputData{})
+	if status != ngmlc.ProvideLocationStatusCode(fivegc.StatusGatewayTimeout) || *problemDetails.Cause != fivegc.CauseTimedOutRequest {
+		t.Errorf("unexpected response %d %v", status, problemDetails)
+	}
+
+	redirect := fivegc.RedirectResponse{RedirectHeader: fivegc.RedirectHeader{Location: "https://gmlc2.example.com"}}
+	service.EXPECT().CancelLocation(ctx, gomock.Any()).Return(fivegc.NewPermanentRedirectError(redirect))
+	_, redirectResponse, cancelStatus := location.CancelLocation(ctx, ngmlclocation.CancelLocData{})
+	if cancelStatus != ngmlc.CancelLocationStatusPermanentRedirect || redirectResponse != redirect {
+		t.Errorf("unexpected response %d %v", cancelStatus, redirectResponse)
+	}
+}
+
+func TestNewLocationService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	location := mock.NewMockLocation(ctrl)
+	service := ngmlc.NewLocationService(location)
+	ctx := context.Background()
+
+	location.EXPECT().CancelLocation(ctx, gomock.Any()).Return(openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ngmlc.CancelLocationStatusNoContent)
+	if err := service.CancelLocation(ctx, ngmlclocation.CancelLocData{}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	location.EXPECT().ProvideLocation(ctx, gomock.Any()).Return(ngmlclocation.LocationOutputData{}, fivegc.ErrNfCongestion.ProblemDetails(), fivegc.RedirectResponse{}, ngmlc.ProvideLocationStatusCode(fivegc.StatusServiceUnavailable))
+	_, err := service.ProvideLocation(ctx, ngmlclocation.InputData{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Cause != fivegc.CauseNfCongestion {
+		t.Errorf("unexpected error %v", err)
+	}
+}
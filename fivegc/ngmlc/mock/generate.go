@@ -0,0 +1,4 @@
+package mock
+
+//go:generate mockgen -source=../location.go -destination=location.go -package=mock
+//go:generate mockgen -source=../notification.go -destination=notification.go -package=mock
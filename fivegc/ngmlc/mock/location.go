@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../location.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	ngmlc "github.com/5GCoreNet/5GCoreNetSDK/fivegc/ngmlc"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Ngmlc_Location "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLocation is a mock of Location interface.
+type MockLocation struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationMockRecorder
+}
+
+// MockLocationMockRecorder is the mock recorder for MockLocation.
+type MockLocationMockRecorder struct {
+	mock *MockLocation
+}
+
+// NewMockLocation creates a new mock instance.
+func NewMockLocation(ctrl *gomock.Controller) *MockLocation {
+	mock := &MockLocation{ctrl: ctrl}
+	mock.recorder = &MockLocationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocation) EXPECT() *MockLocationMockRecorder {
+	return m.recorder
+}
+
+// CancelLocation mocks base method.
+func (m *MockLocation) CancelLocation(arg0 context.Context, arg1 openapi_Ngmlc_Location.CancelLocData) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, ngmlc.CancelLocationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocation", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(ngmlc.CancelLocationStatusCode)
+	return ret0, ret1, ret2
+}
+
+// CancelLocation indicates an expected call of CancelLocation.
+func (mr *MockLocationMockRecorder) CancelLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocation", reflect.TypeOf((*MockLocation)(nil).CancelLocation), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockLocation) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocation)(nil).Error), ctx, err)
+}
+
+// ProvideLocation mocks base method.
+func (m *MockLocation) ProvideLocation(arg0 context.Context, arg1 openapi_Ngmlc_Location.InputData) (openapi_Ngmlc_Location.LocationOutputData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, ngmlc.ProvideLocationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideLocation", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Ngmlc_Location.LocationOutputData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(ngmlc.ProvideLocationStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ProvideLocation indicates an expected call of ProvideLocation.
+func (mr *MockLocationMockRecorder) ProvideLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideLocation", reflect.TypeOf((*MockLocation)(nil).ProvideLocation), arg0, arg1)
+}
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelLocation mocks base method.
+func (m *MockLocationService) CancelLocation(arg0 context.Context, arg1 openapi_Ngmlc_Location.CancelLocData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelLocation indicates an expected call of CancelLocation.
+func (mr *MockLocationServiceMockRecorder) CancelLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocation", reflect.TypeOf((*MockLocationService)(nil).CancelLocation), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockLocationService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocationService)(nil).Error), ctx, err)
+}
+
+// ProvideLocation mocks base method.
+func (m *MockLocationService) ProvideLocation(arg0 context.Context, arg1 openapi_Ngmlc_Location.InputData) (*openapi_Ngmlc_Location.LocationOutputData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvideLocation", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Ngmlc_Location.LocationOutputData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvideLocation indicates an expected call of ProvideLocation.
+func (mr *MockLocationServiceMockRecorder) ProvideLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvideLocation", reflect.TypeOf((*MockLocationService)(nil).ProvideLocation), arg0, arg1)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../notification.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	ngmlc "github.com/5GCoreNet/5GCoreNetSDK/fivegc/ngmlc"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Ngmlc_Location "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventNotification is a mock of EventNotification interface.
+type MockEventNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventNotificationMockRecorder
+}
+
+// MockEventNotificationMockRecorder is the mock recorder for MockEventNotification.
+type MockEventNotificationMockRecorder struct {
+	mock *MockEventNotification
+}
+
+// NewMockEventNotification creates a new mock instance.
+func NewMockEventNotification(ctrl *gomock.Controller) *MockEventNotification {
+	mock := &MockEventNotification{ctrl: ctrl}
+	mock.recorder = &MockEventNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventNotification) EXPECT() *MockEventNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockEventNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockEventNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockEventNotification)(nil).Error), ctx, err)
+}
+
+// EventNotify mocks base method.
+func (m *MockEventNotification) EventNotify(arg0 context.Context, arg1 openapi_Ngmlc_Location.EventNotifyData) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, ngmlc.EventNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(ngmlc.EventNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// EventNotify indicates an expected call of EventNotify.
+func (mr *MockEventNotificationMockRecorder) EventNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventNotify", reflect.TypeOf((*MockEventNotification)(nil).EventNotify), arg0, arg1)
+}
@@ -0,0 +1,97 @@
+package ngmlc
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	ngmlclocation "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// Event types reported through the EventNotify callback, defined in TS 29.515 clause 6.1.6.3.
+const (
+	ReportedEventTypePeriodic                  = "PERIODIC_EVENT"
+	ReportedEventTypeEnteringArea              = "ENTERING_AREA_EVENT"
+	ReportedEventTypeLeavingArea               = "LEAVING_AREA_EVENT"
+	ReportedEventTypeBeingInsideArea           = "BEING_INSIDE_AREA_EVENT"
+	ReportedEventTypeMotion                    = "MOTION_EVENT"
+	ReportedEventTypeMaximumIntervalExpiration = "MAXIMUM_INTERVAL_EXPIRATION_EVENT"
+	ReportedEventTypeLocationCancellation      = "LOCATION_CANCELLATION_EVENT"
+)
+
+// EventNotification is the interface that wraps the EventNotify callback of the NGMLC Location service.
+// It is implemented by the consumer of a deferred location request (NEF, AF or another GMLC) to receive the location
+// reports sent by the GMLC to the locationNotificationUri of the request.
+type EventNotification interface {
+	fivegc.CommonInterface
+	// EventNotify receives a location report of a deferred location request.
+	EventNotify(context.Context, ngmlclocation.EventNotifyData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventNotifyStatusCode)
+}
+
+// EventNotifyStatusCode is the status code of an EventNotify response.
+type EventNotifyStatusCode fivegc.StatusCode
+
+const (
+	// EventNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	EventNotifyStatusNoContent         EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusNoContent)
+	EventNotifyStatusTemporaryRedirect EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	EventNotifyStatusPermanentRedirect EventNotifyStatusCode = EventNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var eventNotifyErrors = response.BodyErrors
+
+func attachEventNotificationHandler(router *gin.RouterGroup, path string, e EventNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req ngmlclocation.EventNotifyData
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, e, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, eventNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := e.EventNotify(c, req)
+		switch status {
+		case EventNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case EventNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case EventNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, eventNotifyErrors)
+		}
+		return
+	})
+}
+
+// NotificationClient is a client sending the EventNotify callbacks of the NGMLC Location service.
+type NotificationClient struct {
+	request *request.Client
+}
+
+// NewNotificationClient creates a new client for the NGMLC Location callbacks.
+// Only the HTTP client, the default header and the user agent of the configuration are used.
+func NewNotificationClient(cfg fivegc.ClientConfiguration) *NotificationClient {
+	return &NotificationClient{
+		request: request.NewClient(cfg, locationRouterGroup),
+	}
+}
+
+// EventNotify sends a location report to the locationNotificationUri of a deferred location request.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (n *NotificationClient) EventNotify(ctx context.Context, locationNotificationURI string, data ngmlclocation.EventNotifyData) error {
+	if locationNotificationURI == "" {
+		return fmt.Errorf("event notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("locationNotificationUri is missing"))
+	}
+	if err := n.request.PostJSON(ctx, locationNotificationURI, data); err != nil {
+		return fmt.Errorf("event notify: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package ngmlc
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	ngmlclocation "github.com/5GCoreNet/openapi/openapi_Ngmlc_Location"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http/httptest"
+	"testing"
+)
+
+// af receives the location reports of its deferred location requests.
+type af struct {
+	reports []ngmlclocation.EventNotifyData
+}
+
+func (a *af) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *af) EventNotify(_ context.Context, data ngmlclocation.EventNotifyData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventNotifyStatusCode) {
+	if data.GetLdrReference() == "" {
+		return fivegc.ErrMandatoryIeMissing.ProblemDetails(), fivegc.RedirectResponse{}, EventNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	a.reports = append(a.reports, data)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EventNotifyStatusNoContent
+}
+
+func TestEventNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &af{}
+	attachEventNotificationHandler(router.Group("/af"), "/location-reports", a, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewNotificationClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	report := ngmlclocation.EventNotifyData{ReportedEventType: ngmlclocation.ReportedEventType{String: fivegc.ToString(ReportedEventTypePeriodic)}}
+	report.SetLdrReference("ldr-1")
+	if err := client.EventNotify(ctx, server.URL+"/af/location-reports", report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.reports) != 1 || a.reports[0].GetLdrReference() != "ldr-1" {
+		t.Errorf("unexpected reports %+v", a.reports)
+	}
+
+	err := client.EventNotify(ctx, server.URL+"/af/location-reports", ngmlclocation.EventNotifyData{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusBadRequest) {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := client.EventNotify(ctx, "", report); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
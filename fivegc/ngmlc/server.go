@@ -0,0 +1,64 @@
+package ngmlc
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Server represents a NGMLC server.
+type Server struct {
+	address               string // IP:PORT
+	apiRoot               string
+	location              Location
+	eventNotification     EventNotification
+	eventNotificationPath string
+	logger                *log.Logger
+	router                *gin.Engine
+	stop                  chan bool
+}
+
+// NewServer creates a new Server NGMLC server instance.
+// The address is the IP:PORT of the NGMLC server.
+func NewServer(address string, apiRoot string, logger *log.Logger) *Server {
+	return &Server{
+		address: address,
+		apiRoot: apiRoot,
+		logger:  logger,
+		stop:    make(chan bool),
+	}
+}
+
+// AttachLocation attaches a Location handler to the NGMLC Server.
+func (n *Server) AttachLocation(l Location) {
+	n.location = l
+}
+
+// AttachEventNotification attaches an EventNotification handler to the NGMLC Server.
+// The path, relative to the API root, is the one of the locationNotificationUri given in the deferred location requests.
+func (n *Server) AttachEventNotification(path string, e EventNotification) {
+	n.eventNotificationPath = path
+	n.eventNotification = e
+}
+
+// Start starts the NGMLC Server.
+func (n *Server) Start() {
+	n.router = gin.Default()
+	n.router.Use(gin.Recovery())
+	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
+	root := router.Root(n.router, n.apiRoot)
+	if n.location != nil {
+		attachLocationHandler(root, n.location, n.logger)
+	}
+	if n.eventNotification != nil {
+		attachEventNotificationHandler(root, n.eventNotificationPath, n.eventNotification, n.logger)
+	}
+	go n.router.Run(n.address)
+	<-n.stop
+	return
+}
+
+// Stop stops the NGMLC Server.
+func (n *Server) Stop() {
+	n.stop <- true
+}
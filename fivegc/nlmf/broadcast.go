@@ -3,10 +3,14 @@ package nlmf
 import (
 	"context"
 	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/schema"
 	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
 	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
 	openapinlmfbroadcast "github.com/5GCoreNet/openapi/openapi_Nlmf_Broadcast"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
 )
 
 const (
@@ -20,6 +24,15 @@ type Broadcast interface {
 	CipherKeyData(context.Context, openapinlmfbroadcast.CipherRequestData) (openapinlmfbroadcast.CipherResponseData, openapicommon.ProblemDetails, fivegc.RedirectResponse, CypherResponseStatusCode)
 }
 
+// BroadcastService is an alternative to the Broadcast interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). Use NewBroadcast to attach it to the NLMF Server.
+type BroadcastService interface {
+	fivegc.CommonInterface
+	CipherKeyData(context.Context, openapinlmfbroadcast.CipherRequestData) (openapinlmfbroadcast.CipherResponseData, error)
+}
+
+// CypherResponseStatusCode is the status code of a CipherKeyData response.
 type CypherResponseStatusCode fivegc.StatusCode
 
 const (
@@ -29,14 +42,16 @@ const (
 	CypherResponseStatusPermanentRedirect CypherResponseStatusCode = CypherResponseStatusCode(fivegc.StatusPermanentRedirect)
 )
 
-func attachBroadcastHandler(router *gin.RouterGroup, b Broadcast) {
+var cypherResponseErrors = response.BodyErrors
+
+func attachBroadcastHandler(router *gin.RouterGroup, b Broadcast, logger *log.Logger) {
 	group := router.Group(broadcastRouterGroup)
 	{
 		group.POST(cypherKeyEndpoint, func(c *gin.Context) {
 			var req openapinlmfbroadcast.CipherRequestData
-			if err := c.ShouldBindJSON(&req); err != nil {
-				problemDetails := b.Error(c, err)
-				c.JSON(int(*problemDetails.Status), problemDetails)
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, b, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, cypherResponseErrors)
 				return
 			}
 			res, problemDetails, redirectResponse, status := b.CipherKeyData(c, req)
@@ -50,13 +65,56 @@ func attachBroadcastHandler(router *gin.RouterGroup, b Broadcast) {
 				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
 				c.JSON(int(status), redirectResponse)
 			default:
-				c.JSON(int(status), problemDetails)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, cypherResponseErrors)
 			}
 			return
 		})
 	}
 }
 
+// NewBroadcast adapts a BroadcastService to the Broadcast interface.
+func NewBroadcast(s BroadcastService) Broadcast {
+	return broadcastAdapter{service: s}
+}
+
+type broadcastAdapter struct {
+	service BroadcastService
+}
+
+func (a broadcastAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a broadcastAdapter) CipherKeyData(ctx context.Context, req openapinlmfbroadcast.CipherRequestData) (openapinlmfbroadcast.CipherResponseData, openapicommon.ProblemDetails, fivegc.RedirectResponse, CypherResponseStatusCode) {
+	res, err := a.service.CipherKeyData(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return openapinlmfbroadcast.CipherResponseData{}, problemDetails, redirectResponse, CypherResponseStatusCode(status)
+	}
+	return res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CypherResponseStatusCodeOK
+}
+
+// NewBroadcastService adapts a Broadcast to the BroadcastService interface.
+func NewBroadcastService(b Broadcast) BroadcastService {
+	return broadcastServiceAdapter{broadcast: b}
+}
+
+type broadcastServiceAdapter struct {
+	broadcast Broadcast
+}
+
+func (a broadcastServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.broadcast.Error(ctx, err)
+}
+
+func (a broadcastServiceAdapter) CipherKeyData(ctx context.Context, req openapinlmfbroadcast.CipherRequestData) (openapinlmfbroadcast.CipherResponseData, error) {
+	res, problemDetails, redirectResponse, status := a.broadcast.CipherKeyData(ctx, req)
+	if status != CypherResponseStatusCodeOK {
+		return openapinlmfbroadcast.CipherResponseData{}, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	}
+	return res, nil
+}
+
 // BroadcastClient is a client for the NLMF Broadcast service.
 type BroadcastClient struct {
 	client *openapinlmfbroadcast.APIClient
@@ -107,6 +165,9 @@ func NewBroadcastClient(cfg fivegc.ClientConfiguration) *BroadcastClient {
 		}
 		openapiCfg.OperationServers[name] = openapiServers
 	}
+	if cfg.Validation {
+		openapiCfg.HTTPClient = schema.NewHTTPClient(cfg.HTTPClient, requestSchemas, responseSchemas)
+	}
 	return &BroadcastClient{
 		client: openapinlmfbroadcast.NewAPIClient(openapiCfg),
 	}
@@ -0,0 +1,205 @@
+package nlmf
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapinlmfbroadcast "github.com/5GCoreNet/openapi/openapi_Nlmf_Broadcast"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default parameters of a CipherKeyStore.
+const (
+	DefaultCipheringKeyValidity = 24 * time.Hour
+	DefaultCipheringKeysAhead   = 1
+)
+
+// cipheringKeyLength is the length in octets of the ciphering keys and of the C0 values (TS 37.355 clause 6.5).
+const cipheringKeyLength = 16
+
+// maxCipheringKeyValidity is the longest validity duration of a ciphering data set, whose validityDuration is a
+// number of minutes up to 65535.
+const maxCipheringKeyValidity = 65535 * time.Minute
+
+// CipherKeyStore is a reference BroadcastService managing the ciphering keys of the positioning assistance data
+// broadcast in the posSIBs (TS 23.273 clause 6.14.2). Keys are generated per TAI list: each CipherKeyData request gets
+// the ciphering data set valid at the time of the request, followed by the Ahead next ones, so that the AMF can
+// distribute them to the UEs before the NG-RAN switches to them. Keys whose validity ended are discarded and replaced.
+// Use NewBroadcast to attach it to the NLMF Server. It is safe for concurrent use.
+type CipherKeyStore struct {
+	// Validity is the validity duration of the ciphering data sets generated by the store, a whole number of minutes
+	// between 1 and 65535 minutes.
+	Validity time.Duration
+	// Ahead is the number of ciphering data sets returned after the current one, 0 or more.
+	Ahead int
+
+	path string
+	mu   sync.Mutex
+	keys map[string]*cipheringKeys
+}
+
+// cipheringKeys are the ciphering data sets of a TAI list, in order of validity.
+type cipheringKeys struct {
+	TaiList   []openapinlmfbroadcast.Tai `json:"taiList"`
+	NextSetID int32                      `json:"nextSetId"`
+	Sets      []cipheringSet             `json:"sets"`
+}
+
+type cipheringSet struct {
+	ID       int32         `json:"id"`
+	Key      []byte        `json:"key"`
+	C0       []byte        `json:"c0"`
+	Start    time.Time     `json:"start"`
+	Validity time.Duration `json:"validity"`
+}
+
+func (s cipheringSet) end() time.Time {
+	return s.Start.Add(s.Validity)
+}
+
+// NewCipherKeyStore creates a key store persisting its keys in the file at path, and loads the keys saved there
+// before a restart. With an empty path, the keys are only kept in memory.
+func NewCipherKeyStore(path string) (*CipherKeyStore, error) {
+	s := &CipherKeyStore{
+		Validity: DefaultCipheringKeyValidity,
+		Ahead:    DefaultCipheringKeysAhead,
+		path:     path,
+		keys:     make(map[string]*cipheringKeys),
+	}
+	if path == "" {
+		return s, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load ciphering keys: %w", err)
+	}
+	if err := json.Unmarshal(b, &s.keys); err != nil {
+		return nil, fmt.Errorf("load ciphering keys: %w", err)
+	}
+	return s, nil
+}
+
+// Error implements the fivegc.CommonInterface.
+func (s *CipherKeyStore) Error(_ context.Context, err error) openapicommon.ProblemDetails {
+	problemDetails, _ := fivegc.ProblemDetailsFromError(err)
+	return problemDetails
+}
+
+// CipherKeyData returns the ciphering data sets of the TAI list of the request.
+func (s *CipherKeyStore) CipherKeyData(_ context.Context, req openapinlmfbroadcast.CipherRequestData) (openapinlmfbroadcast.CipherResponseData, error) {
+	sets, err := s.DataSets(req.TaiList, time.Now())
+	if err != nil {
+		return openapinlmfbroadcast.CipherResponseData{}, err
+	}
+	return openapinlmfbroadcast.CipherResponseData{CipheringDataSet: sets}, nil
+}
+
+// DataSets returns the ciphering data sets of a TAI list valid at a given time, then the Ahead next ones, generating
+// and saving the missing ones. The order of the TAIs in the list does not matter.
+// A SYSTEM_FAILURE is returned when the Validity or Ahead of the store are out of range.
+func (s *CipherKeyStore) DataSets(taiList []openapinlmfbroadcast.Tai, at time.Time) ([]openapinlmfbroadcast.CipheringDataSet, error) {
+	if s.Validity < time.Minute || s.Validity > maxCipheringKeyValidity || s.Validity%time.Minute != 0 {
+		return nil, fivegc.ErrSystemFailure.WithDetail("ciphering key validity %s is not a whole number of minutes between 1 and 65535", s.Validity)
+	}
+	if s.Ahead < 0 {
+		return nil, fivegc.ErrSystemFailure.WithDetail("negative number of ciphering data sets ahead %d", s.Ahead)
+	}
+	if len(taiList) == 0 {
+		return nil, fivegc.ErrMandatoryIeMissing.WithDetail("empty TAI list").WithInvalidParams(openapicommon.InvalidParam{Param: "/taiList"})
+	}
+	id := taiListID(taiList)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys, ok := s.keys[id]
+	if !ok {
+		keys = &cipheringKeys{TaiList: append([]openapinlmfbroadcast.Tai(nil), taiList...)}
+		s.keys[id] = keys
+	}
+	changed := !ok
+	for len(keys.Sets) > 0 && !keys.Sets[0].end().After(at) {
+		keys.Sets = keys.Sets[1:]
+		changed = true
+	}
+	for len(keys.Sets) < s.Ahead+1 {
+		start := at
+		if n := len(keys.Sets); n > 0 {
+			start = keys.Sets[n-1].end()
+		}
+		set := cipheringSet{
+			ID:       keys.NextSetID,
+			Key:      make([]byte, cipheringKeyLength),
+			C0:       make([]byte, cipheringKeyLength),
+			Start:    start,
+			Validity: s.Validity,
+		}
+		if _, err := rand.Read(set.Key); err != nil {
+			return nil, fivegc.ErrSystemFailure.WithDetail("generate ciphering key: %s", err)
+		}
+		if _, err := rand.Read(set.C0); err != nil {
+			return nil, fivegc.ErrSystemFailure.WithDetail("generate ciphering key: %s", err)
+		}
+		// The ciphering key IDs are 16 bits long, and wrap around.
+		keys.NextSetID = (keys.NextSetID + 1) % 65536
+		keys.Sets = append(keys.Sets, set)
+		changed = true
+	}
+	if changed {
+		if err := s.save(); err != nil {
+			return nil, fivegc.ErrSystemFailure.WithDetail("%s", err)
+		}
+	}
+	sets := make([]openapinlmfbroadcast.CipheringDataSet, 0, s.Ahead+1)
+	for _, set := range keys.Sets[:s.Ahead+1] {
+		var data openapinlmfbroadcast.CipheringDataSet
+		data.SetCipheringSetID(set.ID)
+		data.SetCipheringKey(hex.EncodeToString(set.Key))
+		data.SetC0(hex.EncodeToString(set.C0))
+		data.SetValidityStartTime(set.Start)
+		// The validity duration is in minutes.
+		data.SetValidityDuration(int32(set.Validity / time.Minute))
+		data.SetTaiList(keys.TaiList)
+		sets = append(sets, data)
+	}
+	return sets, nil
+}
+
+// save writes the keys to the file of the store, through a temporary file so that a crash does not lose them.
+func (s *CipherKeyStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	b, err := json.Marshal(s.keys)
+	if err != nil {
+		return fmt.Errorf("save ciphering keys: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return fmt.Errorf("save ciphering keys: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("save ciphering keys: %w", err)
+	}
+	return nil
+}
+
+// taiListID returns an identifier of a TAI list independent of the order of its TAIs.
+func taiListID(taiList []openapinlmfbroadcast.Tai) string {
+	ids := make([]string, len(taiList))
+	for i, tai := range taiList {
+		ids[i] = strings.Join([]string{tai.PlmnId.Mcc, tai.PlmnId.Mnc, strings.ToLower(tai.Tac), tai.GetNid()}, "-")
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
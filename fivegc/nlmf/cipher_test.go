@@ -0,0 +1,119 @@
+package nlmf_test
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	nlmfbroadcast "github.com/5GCoreNet/openapi/openapi_Nlmf_Broadcast"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCipherKeyStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := nlmf.NewCipherKeyStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store.Validity = time.Hour
+	plmn := nlmfbroadcast.PlmnId{Mcc: "208", Mnc: "93"}
+	taiList := []nlmfbroadcast.Tai{{PlmnId: plmn, Tac: "000001"}, {PlmnId: plmn, Tac: "000002"}}
+	start := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	sets, err := store.DataSets(taiList, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sets) != 2 || sets[0].CipheringSetID != 0 || sets[1].CipheringSetID != 1 {
+		t.Fatalf("unexpected ciphering data sets %+v", sets)
+	}
+	if !sets[1].GetValidityStartTime().Equal(start.Add(time.Hour)) || sets[0].GetValidityDuration() != 60 {
+		t.Errorf("unexpected validity %v %d", sets[1].GetValidityStartTime(), sets[0].GetValidityDuration())
+	}
+	if len(sets[0].CipheringKey) != 32 || len(sets[0].C0) != 32 || sets[0].CipheringKey == sets[1].CipheringKey {
+		t.Errorf("unexpected ciphering keys %s %s", sets[0].CipheringKey, sets[1].CipheringKey)
+	}
+
+	reversed := []nlmfbroadcast.Tai{taiList[1], taiList[0]}
+	same, err := store.DataSets(reversed, start.Add(59*time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(same, sets) {
+		t.Errorf("unexpected ciphering data sets %+v, expected %+v", same, sets)
+	}
+
+	rotated, err := store.DataSets(taiList, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(rotated[0], sets[1]) || rotated[1].CipheringSetID != 2 {
+		t.Errorf("unexpected rotated ciphering data sets %+v", rotated)
+	}
+
+	other, err := store.DataSets(taiList[:1], start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if other[0].CipheringSetID != 0 || other[0].CipheringKey == sets[0].CipheringKey {
+		t.Errorf("unexpected ciphering data sets of another TAI list %+v", other)
+	}
+
+	restarted, err := nlmf.NewCipherKeyStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restarted.Validity = time.Hour
+	loaded, err := restarted.DataSets(taiList, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := range loaded {
+		if loaded[i].CipheringKey != rotated[i].CipheringKey || loaded[i].CipheringSetID != rotated[i].CipheringSetID {
+			t.Errorf("unexpected ciphering data set %+v after a restart, expected %+v", loaded[i], rotated[i])
+		}
+	}
+
+	if _, err := store.DataSets(nil, start); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+	for _, validity := range []time.Duration{0, time.Second, 90 * time.Second, 65536 * time.Minute} {
+		store.Validity = validity
+		if _, err := store.DataSets(taiList, start); !errors.Is(err, fivegc.ErrSystemFailure) {
+			t.Errorf("unexpected error %v with a validity of %s", err, validity)
+		}
+	}
+	store.Validity, store.Ahead = time.Hour, -2
+	if _, err := store.DataSets(taiList, start); !errors.Is(err, fivegc.ErrSystemFailure) {
+		t.Errorf("unexpected error %v with %d data sets ahead", err, store.Ahead)
+	}
+}
+
+func TestCipherKeyStoreBroadcast(t *testing.T) {
+	store, err := nlmf.NewCipherKeyStore("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	broadcast := nlmf.NewBroadcast(store)
+	ctx := context.Background()
+	req := nlmfbroadcast.CipherRequestData{TaiList: []nlmfbroadcast.Tai{{PlmnId: nlmfbroadcast.PlmnId{Mcc: "001", Mnc: "01"}, Tac: "0001"}}}
+	res, _, _, status := broadcast.CipherKeyData(ctx, req)
+	if status != nlmf.CypherResponseStatusCodeOK || len(res.CipheringDataSet) != nlmf.DefaultCipheringKeysAhead+1 {
+		t.Errorf("unexpected response %d %+v", status, res)
+	}
+	if validity := res.CipheringDataSet[0].GetValidityDuration(); validity != 1440 {
+		t.Errorf("unexpected validity duration %d", validity)
+	}
+	_, problemDetails, _, status := broadcast.CipherKeyData(ctx, nlmfbroadcast.CipherRequestData{})
+	if status != nlmf.CypherResponseStatusCode(fivegc.StatusBadRequest) || *problemDetails.Cause != fivegc.CauseMandatoryIeMissing {
+		t.Errorf("unexpected response %d %v", status, problemDetails)
+	}
+	store.Ahead = -1
+	_, problemDetails, _, status = broadcast.CipherKeyData(ctx, req)
+	if status != nlmf.CypherResponseStatusCode(fivegc.StatusInternalServerError) || *problemDetails.Cause != fivegc.CauseSystemFailure {
+		t.Errorf("unexpected response %d %v", status, problemDetails)
+	}
+}
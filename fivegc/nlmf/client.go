@@ -5,12 +5,14 @@ import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
 type Client struct {
 	*BroadcastClient
 	*LocationClient
+	*NotificationClient
 }
 
 // NewClient returns a new client for an NLMF service.
 func NewClient(config fivegc.ClientConfiguration) *Client {
 	return &Client{
-		BroadcastClient: NewBroadcastClient(config),
-		LocationClient:  NewLocationClient(config),
+		BroadcastClient:    NewBroadcastClient(config),
+		LocationClient:     NewLocationClient(config),
+		NotificationClient: NewNotificationClient(config),
 	}
 }
@@ -0,0 +1,89 @@
+package nlmf
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"sync"
+)
+
+// DeferredLocations keeps track of the deferred location requests accepted by DetermineLocation (TS 23.273 clause 6.3).
+// It correlates the location reports sent later through the EventNotify callback with the request which created them,
+// and forgets the request on CancelLocation. It is safe for concurrent use.
+type DeferredLocations struct {
+	client   *NotificationClient
+	mu       sync.RWMutex
+	requests map[string]nlmfocation.InputData
+}
+
+// NewDeferredLocations creates a new registry of deferred location requests, sending the reports with client.
+func NewDeferredLocations(client *NotificationClient) *DeferredLocations {
+	return &DeferredLocations{
+		client:   client,
+		requests: make(map[string]nlmfocation.InputData),
+	}
+}
+
+// Add registers a deferred location request, which must carry an LDR reference and a callback URI.
+func (d *DeferredLocations) Add(req nlmfocation.InputData) error {
+	var invalidParams []openapicommon.InvalidParam
+	if req.GetLdrReference() == "" {
+		invalidParams = append(invalidParams, openapicommon.InvalidParam{Param: "/ldrReference"})
+	}
+	if req.GetHgmlcCallBackURI() == "" {
+		invalidParams = append(invalidParams, openapicommon.InvalidParam{Param: "/hgmlcCallBackURI"})
+	}
+	if len(invalidParams) > 0 {
+		return fivegc.ErrMandatoryIeMissing.WithDetail("deferred location request without LDR reference or callback URI").WithInvalidParams(invalidParams...)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests[req.GetLdrReference()] = req
+	return nil
+}
+
+// Request returns the deferred location request matching the LDR reference.
+func (d *DeferredLocations) Request(ldrReference string) (nlmfocation.InputData, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	req, ok := d.requests[ldrReference]
+	return req, ok
+}
+
+// Notify sends a location report of the deferred location request matching the LDR reference.
+// The callback URI, the LDR reference and the UE identities of the request are set in data before sending it.
+func (d *DeferredLocations) Notify(ctx context.Context, ldrReference string, data nlmfocation.EventNotifyData) error {
+	req, ok := d.Request(ldrReference)
+	if !ok {
+		return ErrUnknownLdrReference.WithDetail("notify %s", ldrReference)
+	}
+	data.SetHgmlcCallBackURI(req.GetHgmlcCallBackURI())
+	data.SetLdrReference(req.GetLdrReference())
+	if req.HasSupi() && !data.HasSupi() {
+		data.SetSupi(req.GetSupi())
+	}
+	if req.HasGpsi() && !data.HasGpsi() {
+		data.SetGpsi(req.GetGpsi())
+	}
+	return d.client.EventNotify(ctx, data)
+}
+
+// Cancel forgets the deferred location request matching the cancellation and returns it.
+func (d *DeferredLocations) Cancel(cancel nlmfocation.CancelLocData) (nlmfocation.InputData, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	req, ok := d.requests[cancel.GetLdrReference()]
+	if !ok || req.GetHgmlcCallBackURI() != cancel.GetHgmlcCallBackURI() {
+		return nlmfocation.InputData{}, ErrUnknownLdrReference.WithDetail("cancel %s", cancel.GetLdrReference())
+	}
+	delete(d.requests, cancel.GetLdrReference())
+	return req, nil
+}
+
+// Remove forgets the deferred location request matching the LDR reference, e.g. once its last report has been sent.
+func (d *DeferredLocations) Remove(ldrReference string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.requests, ldrReference)
+}
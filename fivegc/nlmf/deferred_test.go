@@ -0,0 +1,63 @@
+package nlmf_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeferredLocations(t *testing.T) {
+	notifications := make(chan nlmfocation.EventNotifyData, 1)
+	gmlc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data nlmfocation.EventNotifyData
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		notifications <- data
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer gmlc.Close()
+	deferred := nlmf.NewDeferredLocations(nlmf.NewNotificationClient(fivegc.ClientConfiguration{HTTPClient: gmlc.Client()}))
+	ctx := context.Background()
+
+	var req nlmfocation.InputData
+	req.SetSupi("imsi-208930000000001")
+	if err := deferred.Add(req); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("request without LDR reference should be rejected, got %v", err)
+	}
+	req.SetLdrReference("ldr-1")
+	req.SetHgmlcCallBackURI(gmlc.URL + "/event-notify")
+	if err := deferred.Add(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	report := nlmfocation.EventNotifyData{ReportedEventType: nlmfocation.ReportedEventType{String: fivegc.ToString(nlmf.ReportedEventTypePeriodic)}}
+	if err := deferred.Notify(ctx, "ldr-1", report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := <-notifications
+	if data.GetLdrReference() != "ldr-1" || data.GetSupi() != "imsi-208930000000001" {
+		t.Errorf("notification is not correlated with the request: %+v", data)
+	}
+
+	var cancel nlmfocation.CancelLocData
+	cancel.SetLdrReference("ldr-1")
+	cancel.SetHgmlcCallBackURI(gmlc.URL + "/event-notify")
+	if _, err := deferred.Cancel(cancel); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := deferred.Notify(ctx, "ldr-1", report)
+	if !errors.Is(err, nlmf.ErrUnknownLdrReference) {
+		t.Errorf("cancelled request should not be notified, got %v", err)
+	}
+	if _, status := fivegc.ProblemDetailsFromError(err); status != fivegc.StatusNotFound {
+		t.Errorf("unexpected status %d", status)
+	}
+}
@@ -0,0 +1,21 @@
+package nlmf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+// Application error causes of the NLMF Location service, defined in TS 29.572 clause 6.1.7.3.
+const (
+	CausePositioningDenied = "POSITIONING_DENIED"
+	CauseUnspecified       = "UNSPECIFIED"
+	CauseContextNotFound   = "CONTEXT_NOT_FOUND"
+)
+
+// Application errors of the NLMF Location service, defined in TS 29.572 clause 6.1.7.3.
+// The errors common to all the APIs, such as fivegc.ErrNfCongestion or fivegc.ErrInsufficientResources, can be used as well.
+var (
+	// ErrPositioningDenied is returned when the positioning procedure is denied.
+	ErrPositioningDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CausePositioningDenied)
+	// ErrUnspecified is returned when the request is rejected due to unspecified reasons.
+	ErrUnspecified = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseUnspecified)
+	// ErrUnknownLdrReference is returned when no deferred location request or session matches an LDR reference.
+	ErrUnknownLdrReference = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseContextNotFound)
+)
@@ -13,23 +13,19 @@ type MyBroadcast struct {
 }
 
 func (m MyBroadcast) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
 	return openapicommon.ProblemDetails{
-		Type:               fivegc.ToString("error"),
-		Title:              fivegc.ToString("error"),
-		Status:             fivegc.ToInt32(int32(fivegc.StatusInternalServerError)),
-		Detail:             fivegc.ToString(err.Error()),
-		Instance:           fivegc.ToString("fake_instance"),
-		Cause:              fivegc.ToString("unknown"),
-		InvalidParams:      nil,
-		SupportedFeatures:  fivegc.ToString(""),
-		AccessTokenError:   &openapicommon.AccessTokenErr{},
-		AccessTokenRequest: &openapicommon.AccessTokenReq{},
-		NrfId:              fivegc.ToString("1234567890"),
+		Instance: fivegc.ToString("fake_instance"),
 	}
 }
 
 func (m MyBroadcast) CipherKeyData(ctx context.Context, data nlmfbroadcast.CipherRequestData) (nlmfbroadcast.CipherResponseData, openapicommon.ProblemDetails, fivegc.RedirectResponse, nlmf.CypherResponseStatusCode) {
 	// Your code here ...
+	if err := ctx.Err(); err != nil {
+		// Application errors carry their cause and status code, see fivegc.ErrNfCongestion, nlmf.ErrPositioningDenied...
+		problemDetails, status := fivegc.ProblemDetailsFromError(fivegc.ErrTimedOutRequest.WithDetail("%s", err))
+		return nlmfbroadcast.CipherResponseData{}, problemDetails, fivegc.RedirectResponse{}, nlmf.CypherResponseStatusCode(status)
+	}
 	return nlmfbroadcast.CipherResponseData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, nlmf.CypherResponseStatusCodeOK
 }
 
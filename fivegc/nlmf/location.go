@@ -3,10 +3,14 @@ package nlmf
 import (
 	"context"
 	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/schema"
 	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
 	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
 	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
 	"net/http"
 )
 
@@ -27,6 +31,20 @@ type Location interface {
 	LocationContextTransfer(context.Context, nlmfocation.LocContextData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, LocationContextTransferStatusCode)
 }
 
+// LocationService is an alternative to the Location interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). Use NewLocation to attach it to the NLMF Server.
+type LocationService interface {
+	fivegc.CommonInterface
+	// CancelLocation cancels a location request.
+	CancelLocation(context.Context, nlmfocation.CancelLocData) error
+	// DetermineLocation determines the location of a UE, a nil location data is answered with 204 No Content.
+	DetermineLocation(context.Context, nlmfocation.InputData) (*nlmfocation.LocationData, error)
+	// LocationContextTransfer transfers the location context of a UE.
+	LocationContextTransfer(context.Context, nlmfocation.LocContextData) error
+}
+
+// CancelLocationStatusCode is the status code of a CancelLocation response.
 type CancelLocationStatusCode fivegc.StatusCode
 
 const (
@@ -36,6 +54,9 @@ const (
 	CancelLocationStatusPermanentRedirect CancelLocationStatusCode = CancelLocationStatusCode(fivegc.StatusPermanentRedirect)
 )
 
+var cancelLocationErrors = response.BodyErrors
+
+// DetermineLocationStatusCode is the status code of a DetermineLocation response.
 type DetermineLocationStatusCode fivegc.StatusCode
 
 const (
@@ -46,6 +67,9 @@ const (
 	DetermineLocationStatusPermanentRedirect DetermineLocationStatusCode = DetermineLocationStatusCode(fivegc.StatusPermanentRedirect)
 )
 
+var determineLocationErrors = response.BodyErrors
+
+// LocationContextTransferStatusCode is the status code of a LocationContextTransfer response.
 type LocationContextTransferStatusCode fivegc.StatusCode
 
 const (
@@ -55,14 +79,16 @@ const (
 	LocationContextTransferStatusPermanentRedirect LocationContextTransferStatusCode = LocationContextTransferStatusCode(fivegc.StatusPermanentRedirect)
 )
 
-func attachLocationHandler(router *gin.RouterGroup, l Location) {
+var locationContextTransferErrors = response.BodyErrors
+
+func attachLocationHandler(router *gin.RouterGroup, l Location, logger *log.Logger) {
 	group := router.Group(locationRouterGroup)
 	{
 		group.POST(cancelLocationEndpoint, func(c *gin.Context) {
 			var req nlmfocation.CancelLocData
-			if err := c.ShouldBindJSON(&req); err != nil {
-				problemDetails := l.Error(c, err)
-				c.JSON(int(*problemDetails.Status), problemDetails)
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, cancelLocationErrors)
 				return
 			}
 			problemDetails, redirectResponse, status := l.CancelLocation(c, req)
@@ -76,15 +102,15 @@ func attachLocationHandler(router *gin.RouterGroup, l Location) {
 				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
 				c.JSON(int(status), redirectResponse)
 			default:
-				c.JSON(int(status), problemDetails)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, cancelLocationErrors)
 			}
 			return
 		})
 		group.POST(determineLocationEndpoint, func(c *gin.Context) {
 			var req nlmfocation.InputData
-			if err := c.ShouldBindJSON(&req); err != nil {
-				problemDetails := l.Error(c, err)
-				c.JSON(int(*problemDetails.Status), problemDetails)
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, determineLocationErrors)
 				return
 			}
 			res, problemDetails, redirectResponse, status := l.DetermineLocation(c, req)
@@ -100,15 +126,15 @@ func attachLocationHandler(router *gin.RouterGroup, l Location) {
 				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
 				c.JSON(int(status), redirectResponse)
 			default:
-				c.JSON(int(status), problemDetails)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, determineLocationErrors)
 			}
 			return
 		})
 		group.POST(locationContextTransferEndpoint, func(c *gin.Context) {
 			var req nlmfocation.LocContextData
-			if err := c.ShouldBindJSON(&req); err != nil {
-				problemDetails := l.Error(c, err)
-				c.JSON(int(*problemDetails.Status), problemDetails)
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, l, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, locationContextTransferErrors)
 				return
 			}
 			problemDetails, redirectResponse, status := l.LocationContextTransfer(c, req)
@@ -122,13 +148,95 @@ func attachLocationHandler(router *gin.RouterGroup, l Location) {
 				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
 				c.JSON(int(status), redirectResponse)
 			default:
-				c.JSON(int(status), problemDetails)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, locationContextTransferErrors)
 			}
 			return
 		})
 	}
 }
 
+// NewLocation adapts a LocationService to the Location interface.
+func NewLocation(s LocationService) Location {
+	return locationAdapter{service: s}
+}
+
+type locationAdapter struct {
+	service LocationService
+}
+
+func (a locationAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a locationAdapter) CancelLocation(ctx context.Context, req nlmfocation.CancelLocData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, CancelLocationStatusCode) {
+	if err := a.service.CancelLocation(ctx, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, CancelLocationStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CancelLocationStatusNoContent
+}
+
+func (a locationAdapter) DetermineLocation(ctx context.Context, req nlmfocation.InputData) (nlmfocation.LocationData, openapicommon.ProblemDetails, fivegc.RedirectResponse, DetermineLocationStatusCode) {
+	res, err := a.service.DetermineLocation(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nlmfocation.LocationData{}, problemDetails, redirectResponse, DetermineLocationStatusCode(status)
+	}
+	if res == nil {
+		return nlmfocation.LocationData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DetermineLocationStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DetermineLocationStatusOK
+}
+
+func (a locationAdapter) LocationContextTransfer(ctx context.Context, req nlmfocation.LocContextData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, LocationContextTransferStatusCode) {
+	if err := a.service.LocationContextTransfer(ctx, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, LocationContextTransferStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, LocationContextTransferStatusNoContent
+}
+
+// NewLocationService adapts a Location to the LocationService interface.
+func NewLocationService(l Location) LocationService {
+	return locationServiceAdapter{location: l}
+}
+
+type locationServiceAdapter struct {
+	location Location
+}
+
+func (a locationServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.location.Error(ctx, err)
+}
+
+func (a locationServiceAdapter) CancelLocation(ctx context.Context, req nlmfocation.CancelLocData) error {
+	problemDetails, redirectResponse, status := a.location.CancelLocation(ctx, req)
+	if status == CancelLocationStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a locationServiceAdapter) DetermineLocation(ctx context.Context, req nlmfocation.InputData) (*nlmfocation.LocationData, error) {
+	res, problemDetails, redirectResponse, status := a.location.DetermineLocation(ctx, req)
+	switch status {
+	case DetermineLocationStatusOK:
+		return &res, nil
+	case DetermineLocationStatusNoContent:
+		return nil, nil
+	default:
+		return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	}
+}
+
+func (a locationServiceAdapter) LocationContextTransfer(ctx context.Context, req nlmfocation.LocContextData) error {
+	problemDetails, redirectResponse, status := a.location.LocationContextTransfer(ctx, req)
+	if status == LocationContextTransferStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
 // LocationClient is a client for the NLMF Location service.
 type LocationClient struct {
 	client *nlmfocation.APIClient
@@ -179,6 +287,9 @@ func NewLocationClient(cfg fivegc.ClientConfiguration) *LocationClient {
 		}
 		openapiCfg.OperationServers[name] = openapiServers
 	}
+	if cfg.Validation {
+		openapiCfg.HTTPClient = schema.NewHTTPClient(cfg.HTTPClient, requestSchemas, responseSchemas)
+	}
 	return &LocationClient{
 		client: nlmfocation.NewAPIClient(openapiCfg),
 	}
@@ -0,0 +1,62 @@
+package nlmf_test
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf/mock"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+func TestNewLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mock.NewMockLocationService(ctrl)
+	location := nlmf.NewLocation(service)
+	ctx := context.Background()
+
+	service.EXPECT().DetermineLocation(ctx, gomock.Any()).Return(&nlmfocation.LocationData{}, nil)
+	if _, _, _, status := location.DetermineLocation(ctx, nlmfocation.InputData{}); status != nlmf.DetermineLocationStatusOK {
+		t.Errorf("unexpected status %d", status)
+	}
+
+	service.EXPECT().DetermineLocation(ctx, gomock.Any()).Return(nil, nil)
+	if _, _, _, status := location.DetermineLocation(ctx, nlmfocation.InputData{}); status != nlmf.DetermineLocationStatusNoContent {
+		t.Errorf("unexpected status %d", status)
+	}
+
+	service.EXPECT().DetermineLocation(ctx, gomock.Any()).Return(nil, nlmf.ErrPositioningDenied)
+	_, problemDetails, _, status := location.DetermineLocation(ctx, nlmfocation.InputData{})
+	if status != nlmf.DetermineLocationStatusCode(fivegc.StatusForbidden) || *problemDetails.Cause != nlmf.CausePositioningDenied {
+		t.Errorf("unexpected response %d %v", status, problemDetails)
+	}
+
+	redirect := fivegc.RedirectResponse{RedirectHeader: fivegc.RedirectHeader{Location: "https://lmf2.example.com"}}
+	service.EXPECT().CancelLocation(ctx, gomock.Any()).Return(fivegc.NewPermanentRedirectError(redirect))
+	_, redirectResponse, cancelStatus := location.CancelLocation(ctx, nlmfocation.CancelLocData{})
+	if cancelStatus != nlmf.CancelLocationStatusPermanentRedirect || redirectResponse != redirect {
+		t.Errorf("unexpected response %d %v", cancelStatus, redirectResponse)
+	}
+}
+
+func TestNewLocationService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	location := mock.NewMockLocation(ctrl)
+	service := nlmf.NewLocationService(location)
+	ctx := context.Background()
+
+	location.EXPECT().LocationContextTransfer(ctx, gomock.Any()).Return(openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, nlmf.LocationContextTransferStatusNoContent)
+	if err := service.LocationContextTransfer(ctx, nlmfocation.LocContextData{}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	location.EXPECT().LocationContextTransfer(ctx, gomock.Any()).Return(fivegc.ErrNfCongestion.ProblemDetails(), fivegc.RedirectResponse{}, nlmf.LocationContextTransferStatusCode(fivegc.StatusServiceUnavailable))
+	err := service.LocationContextTransfer(ctx, nlmfocation.LocContextData{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Cause != fivegc.CauseNfCongestion {
+		t.Errorf("unexpected error %v", err)
+	}
+}
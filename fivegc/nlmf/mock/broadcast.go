@@ -68,3 +68,55 @@ func (mr *MockBroadcastMockRecorder) Error(ctx, err interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockBroadcast)(nil).Error), ctx, err)
 }
+
+// MockBroadcastService is a mock of BroadcastService interface.
+type MockBroadcastService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBroadcastServiceMockRecorder
+}
+
+// MockBroadcastServiceMockRecorder is the mock recorder for MockBroadcastService.
+type MockBroadcastServiceMockRecorder struct {
+	mock *MockBroadcastService
+}
+
+// NewMockBroadcastService creates a new mock instance.
+func NewMockBroadcastService(ctrl *gomock.Controller) *MockBroadcastService {
+	mock := &MockBroadcastService{ctrl: ctrl}
+	mock.recorder = &MockBroadcastServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroadcastService) EXPECT() *MockBroadcastServiceMockRecorder {
+	return m.recorder
+}
+
+// CipherKeyData mocks base method.
+func (m *MockBroadcastService) CipherKeyData(arg0 context.Context, arg1 openapi_Nlmf_Broadcast.CipherRequestData) (openapi_Nlmf_Broadcast.CipherResponseData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CipherKeyData", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Nlmf_Broadcast.CipherResponseData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CipherKeyData indicates an expected call of CipherKeyData.
+func (mr *MockBroadcastServiceMockRecorder) CipherKeyData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CipherKeyData", reflect.TypeOf((*MockBroadcastService)(nil).CipherKeyData), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockBroadcastService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockBroadcastServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockBroadcastService)(nil).Error), ctx, err)
+}
@@ -2,3 +2,6 @@ package mock
 
 //go:generate mockgen -source=../broadcast.go -destination=broadcast.go -package=mock
 //go:generate mockgen -source=../location.go -destination=location.go -package=mock
+//go:generate mockgen -source=../notification.go -destination=notification.go -package=mock
+//go:generate mockgen -source=../positioning.go -destination=positioning.go -package=mock
+//go:generate mockgen -source=../session.go -destination=session.go -package=mock
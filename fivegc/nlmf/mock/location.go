@@ -100,3 +100,83 @@ func (mr *MockLocationMockRecorder) LocationContextTransfer(arg0, arg1 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocationContextTransfer", reflect.TypeOf((*MockLocation)(nil).LocationContextTransfer), arg0, arg1)
 }
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelLocation mocks base method.
+func (m *MockLocationService) CancelLocation(arg0 context.Context, arg1 openapi_Nlmf_Location.CancelLocData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelLocation indicates an expected call of CancelLocation.
+func (mr *MockLocationServiceMockRecorder) CancelLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocation", reflect.TypeOf((*MockLocationService)(nil).CancelLocation), arg0, arg1)
+}
+
+// DetermineLocation mocks base method.
+func (m *MockLocationService) DetermineLocation(arg0 context.Context, arg1 openapi_Nlmf_Location.InputData) (*openapi_Nlmf_Location.LocationData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetermineLocation", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Nlmf_Location.LocationData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetermineLocation indicates an expected call of DetermineLocation.
+func (mr *MockLocationServiceMockRecorder) DetermineLocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetermineLocation", reflect.TypeOf((*MockLocationService)(nil).DetermineLocation), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockLocationService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLocationServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLocationService)(nil).Error), ctx, err)
+}
+
+// LocationContextTransfer mocks base method.
+func (m *MockLocationService) LocationContextTransfer(arg0 context.Context, arg1 openapi_Nlmf_Location.LocContextData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocationContextTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LocationContextTransfer indicates an expected call of LocationContextTransfer.
+func (mr *MockLocationServiceMockRecorder) LocationContextTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocationContextTransfer", reflect.TypeOf((*MockLocationService)(nil).LocationContextTransfer), arg0, arg1)
+}
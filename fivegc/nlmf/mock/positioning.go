@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../positioning.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gad "github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	nlmf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	openapi_Nlmf_Location "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPositioningMethod is a mock of PositioningMethod interface.
+type MockPositioningMethod struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositioningMethodMockRecorder
+}
+
+// MockPositioningMethodMockRecorder is the mock recorder for MockPositioningMethod.
+type MockPositioningMethodMockRecorder struct {
+	mock *MockPositioningMethod
+}
+
+// NewMockPositioningMethod creates a new mock instance.
+func NewMockPositioningMethod(ctrl *gomock.Controller) *MockPositioningMethod {
+	mock := &MockPositioningMethod{ctrl: ctrl}
+	mock.recorder = &MockPositioningMethodMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositioningMethod) EXPECT() *MockPositioningMethodMockRecorder {
+	return m.recorder
+}
+
+// Locate mocks base method.
+func (m *MockPositioningMethod) Locate(ctx context.Context, req openapi_Nlmf_Location.InputData) (gad.Shape, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locate", ctx, req)
+	ret0, _ := ret[0].(gad.Shape)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Locate indicates an expected call of Locate.
+func (mr *MockPositioningMethodMockRecorder) Locate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locate", reflect.TypeOf((*MockPositioningMethod)(nil).Locate), ctx, req)
+}
+
+// Method mocks base method.
+func (m *MockPositioningMethod) Method() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Method")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Method indicates an expected call of Method.
+func (mr *MockPositioningMethodMockRecorder) Method() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Method", reflect.TypeOf((*MockPositioningMethod)(nil).Method))
+}
+
+// Profile mocks base method.
+func (m *MockPositioningMethod) Profile() nlmf.MethodProfile {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Profile")
+	ret0, _ := ret[0].(nlmf.MethodProfile)
+	return ret0
+}
+
+// Profile indicates an expected call of Profile.
+func (mr *MockPositioningMethodMockRecorder) Profile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Profile", reflect.TypeOf((*MockPositioningMethod)(nil).Profile))
+}
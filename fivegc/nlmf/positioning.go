@@ -0,0 +1,310 @@
+package nlmf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Positioning methods, defined in TS 29.572 clause 6.1.6.3.6.
+const (
+	PositioningMethodCellID             = "CELLID"
+	PositioningMethodECID               = "ECID"
+	PositioningMethodOTDOA              = "OTDOA"
+	PositioningMethodBarometricPressure = "BAROMETRIC_PRESSURE"
+	PositioningMethodWLAN               = "WLAN"
+	PositioningMethodBluetooth          = "BLUETOOTH"
+	PositioningMethodMBS                = "MBS"
+	PositioningMethodMotionSensor       = "MOTION_SENSOR"
+	PositioningMethodDLTDOA             = "DL_TDOA"
+	PositioningMethodDLAOD              = "DL_AOD"
+	PositioningMethodMultiRTT           = "MULTI-RTT"
+	PositioningMethodNRECID             = "NR_ECID"
+	PositioningMethodULTDOA             = "UL_TDOA"
+	PositioningMethodULAOA              = "UL_AOA"
+	PositioningMethodNetworkSpecific    = "NETWORK_SPECIFIC"
+	// PositioningMethodAGNSS is the name used by the SDK for the A-GNSS methods, which are reported per GNSS in the
+	// gnssPositioningDataList rather than in the positioningDataList.
+	PositioningMethodAGNSS = "A-GNSS"
+)
+
+// Positioning modes, defined in TS 29.572 clause 6.1.6.3.7.
+const (
+	PositioningModeUEBased      = "UE_BASED"
+	PositioningModeUEAssisted   = "UE_ASSISTED"
+	PositioningModeConventional = "CONVENTIONAL"
+)
+
+// Usages of the positioning methods, defined in TS 29.572 clause 6.1.6.3.9.
+const (
+	UsageUnsuccess                            = "UNSUCCESS"
+	UsageSuccessResultsNotUsed                = "SUCCESS_RESULTS_NOT_USED"
+	UsageSuccessResultsUsedToVerifyLocation   = "SUCCESS_RESULTS_USED_TO_VERIFY_LOCATION"
+	UsageSuccessResultsUsedToGenerateLocation = "SUCCESS_RESULTS_USED_TO_GENERATE_LOCATION"
+	UsageSuccessMethodNotDetermined           = "SUCCESS_METHOD_NOT_DETERMINED"
+)
+
+// GNSS identifiers, defined in TS 29.572 clause 6.1.6.3.10.
+const (
+	GnssIDGPS           = "GPS"
+	GnssIDGalileo       = "GALILEO"
+	GnssIDSBAS          = "SBAS"
+	GnssIDModernizedGPS = "MODERNIZED_GPS"
+	GnssIDQZSS          = "QZSS"
+	GnssIDGLONASS       = "GLONASS"
+	GnssIDBDS           = "BDS"
+	GnssIDNavIC         = "NAVIC"
+)
+
+// Values of the QoS of a location request, defined in TS 29.572 clause 6.1.6.3.
+const (
+	ResponseTimeNoDelay       = "NO_DELAY"
+	ResponseTimeLowDelay      = "LOW_DELAY"
+	ResponseTimeDelayTolerant = "DELAY_TOLERANT"
+
+	LcsQosClassBestEffort  = "BEST_EFFORT"
+	LcsQosClassAssured     = "ASSURED"
+	LcsQosClassMultipleQos = "MULTIPLE_QOS"
+
+	AccuracyFulfilled    = "REQUESTED_ACCURACY_FULFILLED"
+	AccuracyNotFulfilled = "REQUESTED_ACCURACY_NOT_FULFILLED"
+)
+
+// DefaultLowDelay is the default maximum response time of the methods selected for a LOW_DELAY request.
+const DefaultLowDelay = 2 * time.Second
+
+// PositioningMethod is a positioning method plugged in a Positioning engine.
+type PositioningMethod interface {
+	// Method returns the positioning method, such as PositioningMethodECID, matched against the UE capabilities.
+	Method() string
+	// Profile returns the expected performance of the method, matched against the QoS of the requests.
+	Profile() MethodProfile
+	// Locate positions the UE of a request. A nil shape fails the method like an error does.
+	Locate(ctx context.Context, req nlmfocation.InputData) (gad.Shape, error)
+}
+
+// MethodProfile is the expected performance of a positioning method.
+type MethodProfile struct {
+	// Mode is the positioning mode of the method, such as PositioningModeUEAssisted.
+	Mode string
+	// HorizontalAccuracy is the expected horizontal accuracy of the method, in meters.
+	HorizontalAccuracy float32
+	// VerticalAccuracy is the expected vertical accuracy of the method, in meters, or zero when the method does not
+	// provide the altitude.
+	VerticalAccuracy float32
+	// ResponseTime is the expected response time of the method.
+	ResponseTime time.Duration
+	// UEIndependent is true for the methods which do not depend on the UE capabilities, such as the Cell ID method
+	// or the network based methods relying on NRPPa.
+	UEIndependent bool
+	// GNSS are the GNSS used by an A-GNSS method, reported in the gnssPositioningDataList.
+	GNSS []string
+}
+
+// CapabilitiesFunc returns the positioning methods supported by the UE of a request, e.g. from its LPP capabilities.
+type CapabilitiesFunc func(ctx context.Context, req nlmfocation.InputData) ([]string, error)
+
+// Positioning is a DetermineLocation engine: it selects the positioning methods registered for a request according
+// to its QoS and to the UE capabilities, and falls back from one method to the next until one locates the UE.
+//
+// The methods meeting the requested accuracy are tried first, in their registration order, or by response time for
+// NO_DELAY and LOW_DELAY requests. Unless the QoS class is ASSURED, the other methods are then tried from the most
+// accurate, their location estimate being reported as not fulfilling the requested accuracy. NO_DELAY requests only
+// try the fastest method. For ASSURED requests, location estimates whose uncertainty exceeds the requested accuracy
+// are discarded.
+type Positioning struct {
+	capabilities CapabilitiesFunc
+	mu           sync.RWMutex
+	methods      []PositioningMethod
+	// LowDelay is the maximum response time of the methods selected for a LOW_DELAY request, DefaultLowDelay by default.
+	LowDelay time.Duration
+}
+
+// NewPositioning creates a positioning engine. A nil capabilities function considers every method as supported by
+// the UEs.
+func NewPositioning(capabilities CapabilitiesFunc) *Positioning {
+	return &Positioning{capabilities: capabilities, LowDelay: DefaultLowDelay}
+}
+
+// Register registers a positioning method. The registration order is the order of preference of the methods.
+func (p *Positioning) Register(m PositioningMethod) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.methods = append(p.methods, m)
+}
+
+// Select returns the positioning methods to try for a request, in order.
+// ErrPositioningDenied is returned when no method can serve the request.
+func (p *Positioning) Select(ctx context.Context, req nlmfocation.InputData) ([]PositioningMethod, error) {
+	var supported map[string]bool
+	if p.capabilities != nil {
+		methods, err := p.capabilities(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		supported = make(map[string]bool, len(methods))
+		for _, method := range methods {
+			supported[method] = true
+		}
+	}
+	qos := req.GetLocationQoS()
+	responseTime := qos.GetResponseTime()
+	class := qos.GetLcsQosClass()
+	delay := responseTime.String != nil && (*responseTime.String == ResponseTimeNoDelay || *responseTime.String == ResponseTimeLowDelay)
+	var fulfilling, others []PositioningMethod
+	p.mu.RLock()
+	for _, m := range p.methods {
+		profile := m.Profile()
+		if supported != nil && !profile.UEIndependent && !supported[m.Method()] {
+			continue
+		}
+		if delay && p.LowDelay > 0 && profile.ResponseTime > p.LowDelay {
+			continue
+		}
+		if fulfils(qos, profile.HorizontalAccuracy, profile.VerticalAccuracy) {
+			fulfilling = append(fulfilling, m)
+		} else {
+			others = append(others, m)
+		}
+	}
+	p.mu.RUnlock()
+	if delay {
+		sort.SliceStable(fulfilling, func(i, j int) bool {
+			return fulfilling[i].Profile().ResponseTime < fulfilling[j].Profile().ResponseTime
+		})
+	}
+	selected := fulfilling
+	if class.String == nil || *class.String != LcsQosClassAssured {
+		sort.SliceStable(others, func(i, j int) bool {
+			return others[i].Profile().HorizontalAccuracy < others[j].Profile().HorizontalAccuracy
+		})
+		selected = append(selected, others...)
+	}
+	if len(selected) == 0 {
+		return nil, ErrPositioningDenied.WithDetail("no positioning method supported by the UE meets the QoS")
+	}
+	if responseTime.String != nil && *responseTime.String == ResponseTimeNoDelay {
+		return selected[:1], nil
+	}
+	return selected, nil
+}
+
+// DetermineLocation locates the UE of a request with the selected methods, and returns its location data with the
+// methods tried in the positioningDataList and the gnssPositioningDataList. It can serve the DetermineLocation
+// operation of a LocationService.
+// ErrUnspecified is returned when every method fails, with their errors in its detail.
+func (p *Positioning) DetermineLocation(ctx context.Context, req nlmfocation.InputData) (*nlmfocation.LocationData, error) {
+	methods, err := p.Select(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	qos := req.GetLocationQoS()
+	class := qos.GetLcsQosClass()
+	assured := class.String != nil && *class.String == LcsQosClassAssured
+	var data nlmfocation.LocationData
+	var failures []string
+	for _, m := range methods {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		shape, err := m.Locate(ctx, req)
+		if err == nil && shape == nil {
+			err = errors.New("no location estimate")
+		} else if err == nil {
+			err = shape.Validate()
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", m.Method(), err))
+			appendUsage(&data, m, UsageUnsuccess)
+			continue
+		}
+		horizontal, vertical := shapeAccuracy(shape)
+		fulfilled := fulfils(qos, horizontal, vertical)
+		if assured && !fulfilled {
+			failures = append(failures, fmt.Sprintf("%s: requested accuracy not fulfilled", m.Method()))
+			appendUsage(&data, m, UsageSuccessResultsNotUsed)
+			continue
+		}
+		area, err := gad.GeographicArea(shape)
+		if err != nil {
+			return nil, err
+		}
+		data.LocationEstimate = area
+		if qos.HasHAccuracy() || qos.HasVAccuracy() {
+			indicator := AccuracyNotFulfilled
+			if fulfilled {
+				indicator = AccuracyFulfilled
+			}
+			data.SetAccuracyFulfilmentIndicator(nlmfocation.AccuracyFulfilmentIndicator{String: fivegc.ToString(indicator)})
+		}
+		data.SetTimestampOfLocationEstimate(time.Now())
+		appendUsage(&data, m, UsageSuccessResultsUsedToGenerateLocation)
+		return &data, nil
+	}
+	return nil, ErrUnspecified.WithDetail("positioning failed: %s", strings.Join(failures, "; "))
+}
+
+// appendUsage reports the usage of a method in the positioningDataList, or per GNSS in the gnssPositioningDataList.
+func appendUsage(data *nlmfocation.LocationData, m PositioningMethod, usage string) {
+	profile := m.Profile()
+	mode := nlmfocation.PositioningMode{String: fivegc.ToString(profile.Mode)}
+	if len(profile.GNSS) > 0 {
+		for _, gnss := range profile.GNSS {
+			data.GnssPositioningDataList = append(data.GnssPositioningDataList, nlmfocation.GnssPositioningMethodAndUsage{
+				Mode:  mode,
+				Gnss:  nlmfocation.GnssId{String: fivegc.ToString(gnss)},
+				Usage: nlmfocation.Usage{String: fivegc.ToString(usage)},
+			})
+		}
+		return
+	}
+	data.PositioningDataList = append(data.PositioningDataList, nlmfocation.PositioningMethodAndUsage{
+		Method: nlmfocation.PositioningMethod{String: fivegc.ToString(m.Method())},
+		Mode:   mode,
+		Usage:  nlmfocation.Usage{String: fivegc.ToString(usage)},
+	})
+}
+
+// fulfils reports whether horizontal and vertical accuracies, in meters, meet the QoS. A zero accuracy is unknown,
+// which only meets a QoS without the corresponding accuracy.
+func fulfils(qos nlmfocation.LocationQoS, horizontal, vertical float32) bool {
+	if qos.HasHAccuracy() && (horizontal <= 0 || horizontal > qos.GetHAccuracy()) {
+		return false
+	}
+	if qos.GetVerticalRequested() {
+		if vertical <= 0 {
+			return false
+		}
+		if qos.HasVAccuracy() && vertical > qos.GetVAccuracy() {
+			return false
+		}
+	}
+	return true
+}
+
+// shapeAccuracy returns the horizontal and vertical uncertainties of a location estimate, in meters, zero when the
+// shape does not provide them.
+func shapeAccuracy(s gad.Shape) (float32, float32) {
+	switch s := s.(type) {
+	case gad.PointUncertaintyCircle:
+		return float32(s.Uncertainty), 0
+	case gad.PointUncertaintyEllipse:
+		return float32(s.UncertaintyEllipse.SemiMajor), 0
+	case gad.PointAltitudeUncertainty:
+		return float32(s.UncertaintyEllipse.SemiMajor), float32(s.UncertaintyAltitude)
+	case gad.EllipsoidArc:
+		return float32(s.UncertaintyRadius), 0
+	case gad.Local2dPointUncertaintyEllipse:
+		return float32(s.UncertaintyEllipse.SemiMajor), 0
+	case gad.Local3dPointUncertaintyEllipsoid:
+		return float32(s.UncertaintyEllipsoid.SemiMajor), float32(s.UncertaintyEllipsoid.Vertical)
+	}
+	return 0, 0
+}
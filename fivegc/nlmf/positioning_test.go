@@ -0,0 +1,152 @@
+package nlmf_test
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf/mock"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"github.com/golang/mock/gomock"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// method is a positioning method returning a fixed location estimate, or an error when the estimate is nil.
+type method struct {
+	name    string
+	profile nlmf.MethodProfile
+	shape   gad.Shape
+}
+
+func (m method) Method() string              { return m.name }
+func (m method) Profile() nlmf.MethodProfile { return m.profile }
+func (m method) Locate(context.Context, nlmfocation.InputData) (gad.Shape, error) {
+	if m.shape == nil {
+		return nil, errors.New("no measurement")
+	}
+	return m.shape, nil
+}
+
+func TestPositioningSelect(t *testing.T) {
+	paris := gad.Coordinates{Lat: 48.85, Lon: 2.35}
+	cellID := method{name: nlmf.PositioningMethodCellID, profile: nlmf.MethodProfile{HorizontalAccuracy: 500, ResponseTime: 100 * time.Millisecond, UEIndependent: true}}
+	ecid := method{name: nlmf.PositioningMethodECID, profile: nlmf.MethodProfile{HorizontalAccuracy: 100, ResponseTime: 500 * time.Millisecond}}
+	otdoa := method{name: nlmf.PositioningMethodOTDOA, profile: nlmf.MethodProfile{HorizontalAccuracy: 50, ResponseTime: 3 * time.Second}}
+	agnss := method{
+		name:    nlmf.PositioningMethodAGNSS,
+		profile: nlmf.MethodProfile{HorizontalAccuracy: 10, VerticalAccuracy: 15, ResponseTime: 10 * time.Second, GNSS: []string{nlmf.GnssIDGPS}},
+		shape:   gad.PointUncertaintyCircle{Point: paris, Uncertainty: 8},
+	}
+	positioning := nlmf.NewPositioning(func(context.Context, nlmfocation.InputData) ([]string, error) {
+		return []string{nlmf.PositioningMethodECID, nlmf.PositioningMethodAGNSS}, nil
+	})
+	for _, m := range []method{cellID, ecid, otdoa, agnss} {
+		positioning.Register(m)
+	}
+	tests := []struct {
+		name     string
+		qos      nlmfocation.LocationQoS
+		expected []nlmf.PositioningMethod
+	}{
+		{name: "no QoS", expected: []nlmf.PositioningMethod{cellID, ecid, agnss}},
+		{name: "accuracy", qos: nlmfocation.LocationQoS{HAccuracy: fivegc.ToFloat32(100)}, expected: []nlmf.PositioningMethod{ecid, agnss, cellID}},
+		{
+			name:     "low delay",
+			qos:      nlmfocation.LocationQoS{HAccuracy: fivegc.ToFloat32(500), ResponseTime: &nlmfocation.ResponseTime{String: fivegc.ToString(nlmf.ResponseTimeLowDelay)}},
+			expected: []nlmf.PositioningMethod{cellID, ecid},
+		},
+		{
+			name:     "no delay",
+			qos:      nlmfocation.LocationQoS{HAccuracy: fivegc.ToFloat32(100), ResponseTime: &nlmfocation.ResponseTime{String: fivegc.ToString(nlmf.ResponseTimeNoDelay)}},
+			expected: []nlmf.PositioningMethod{ecid},
+		},
+		{
+			name: "assured vertical",
+			qos: nlmfocation.LocationQoS{
+				VerticalRequested: toBool(true),
+				VAccuracy:         fivegc.ToFloat32(20),
+				LcsQosClass:       &nlmfocation.LcsQosClass{String: fivegc.ToString(nlmf.LcsQosClassAssured)},
+			},
+			expected: []nlmf.PositioningMethod{agnss},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := nlmfocation.InputData{LocationQoS: &test.qos}
+			selected, err := positioning.Select(context.Background(), req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(selected, test.expected) {
+				t.Errorf("selected %v, expected %v", selected, test.expected)
+			}
+		})
+	}
+
+	qos := nlmfocation.LocationQoS{HAccuracy: fivegc.ToFloat32(100), LcsQosClass: &nlmfocation.LcsQosClass{String: fivegc.ToString(nlmf.LcsQosClassAssured)}}
+	data, err := positioning.DetermineLocation(context.Background(), nlmfocation.InputData{LocationQoS: &qos})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if indicator := data.GetAccuracyFulfilmentIndicator(); *indicator.String != nlmf.AccuracyFulfilled {
+		t.Errorf("unexpected accuracy fulfilment %s", *indicator.String)
+	}
+	if len(data.PositioningDataList) != 1 || *data.PositioningDataList[0].Usage.String != nlmf.UsageUnsuccess {
+		t.Errorf("unexpected positioning data %+v", data.PositioningDataList)
+	}
+	if len(data.GnssPositioningDataList) != 1 || *data.GnssPositioningDataList[0].Usage.String != nlmf.UsageSuccessResultsUsedToGenerateLocation {
+		t.Errorf("unexpected GNSS positioning data %+v", data.GnssPositioningDataList)
+	}
+	if shape, err := gad.FromGeographicArea(data.LocationEstimate); err != nil || !reflect.DeepEqual(shape, agnss.shape) {
+		t.Errorf("unexpected location estimate %v", shape)
+	}
+
+	qos.HAccuracy = fivegc.ToFloat32(5)
+	if _, err := positioning.DetermineLocation(context.Background(), nlmfocation.InputData{LocationQoS: &qos}); !errors.Is(err, nlmf.ErrPositioningDenied) {
+		t.Errorf("unexpected error %v", err)
+	}
+	qos.LcsQosClass = nil
+	data, err = positioning.DetermineLocation(context.Background(), nlmfocation.InputData{LocationQoS: &qos})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if indicator := data.GetAccuracyFulfilmentIndicator(); *indicator.String != nlmf.AccuracyNotFulfilled {
+		t.Errorf("unexpected accuracy fulfilment %s", *indicator.String)
+	}
+
+	qos = nlmfocation.LocationQoS{HAccuracy: fivegc.ToFloat32(1000)}
+	if _, err := nlmf.NewPositioning(nil).DetermineLocation(context.Background(), nlmfocation.InputData{LocationQoS: &qos}); !errors.Is(err, nlmf.ErrPositioningDenied) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestPositioningNoEstimate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	ecid := mock.NewMockPositioningMethod(ctrl)
+	ecid.EXPECT().Method().Return(nlmf.PositioningMethodECID).AnyTimes()
+	ecid.EXPECT().Profile().Return(nlmf.MethodProfile{HorizontalAccuracy: 100}).AnyTimes()
+	ecid.EXPECT().Locate(ctx, gomock.Any()).Return(nil, nil)
+	cellID := mock.NewMockPositioningMethod(ctrl)
+	cellID.EXPECT().Method().Return(nlmf.PositioningMethodCellID).AnyTimes()
+	cellID.EXPECT().Profile().Return(nlmf.MethodProfile{HorizontalAccuracy: 500, UEIndependent: true}).AnyTimes()
+	cellID.EXPECT().Locate(ctx, gomock.Any()).Return(gad.PointUncertaintyCircle{Point: gad.Coordinates{Lat: 48.85, Lon: 2.35}, Uncertainty: 400}, nil)
+	positioning := nlmf.NewPositioning(nil)
+	positioning.Register(ecid)
+	positioning.Register(cellID)
+
+	data, err := positioning.DetermineLocation(ctx, nlmfocation.InputData{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.PositioningDataList) != 2 || *data.PositioningDataList[0].Usage.String != nlmf.UsageUnsuccess {
+		t.Errorf("unexpected positioning data %+v", data.PositioningDataList)
+	}
+}
+
+func toBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,202 @@
+package nlmf
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/schema"
+)
+
+// Schemas of the NLMF Location service defined in TS 29.572 clause 6.1.6.
+var (
+	correlationIDSchema = &schema.Schema{Type: schema.TypeString, MinLength: fivegc.ToInt(1), MaxLength: fivegc.ToInt(255)}
+	accuracySchema      = &schema.Schema{Type: schema.TypeNumber, Minimum: fivegc.ToFloat64(0)}
+	reportingSchema     = &schema.Schema{Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(1), Maximum: fivegc.ToFloat64(8639999)}
+	locationQoSSchema   = &schema.Schema{
+		Type: schema.TypeObject,
+		Properties: map[string]*schema.Schema{
+			"hAccuracy":         accuracySchema,
+			"vAccuracy":         accuracySchema,
+			"verticalRequested": {Type: schema.TypeBoolean},
+			"responseTime":      {Type: schema.TypeString},
+			"lcsQosClass":       {Type: schema.TypeString},
+			"minorLocQoses": {
+				Type:     schema.TypeArray,
+				MaxItems: fivegc.ToInt(2),
+				Items: &schema.Schema{
+					Type: schema.TypeObject,
+					Properties: map[string]*schema.Schema{
+						"hAccuracy": accuracySchema,
+						"vAccuracy": accuracySchema,
+					},
+				},
+			},
+		},
+	}
+	periodicEventInfoSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"reportingAmount", "reportingInterval"},
+		Properties: map[string]*schema.Schema{
+			"reportingAmount":      reportingSchema,
+			"reportingInterval":    reportingSchema,
+			"reportingInfiniteInd": {Type: schema.TypeBoolean},
+		},
+	}
+	motionEventInfoSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"linearDistance"},
+		Properties: map[string]*schema.Schema{
+			"linearDistance": {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(1), Maximum: fivegc.ToFloat64(10000)},
+		},
+	}
+	intervalSchema      = &schema.Schema{Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(1), Maximum: fivegc.ToFloat64(32767)}
+	areaEventInfoSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"areaDefinition", "areaEventType"},
+		Properties: map[string]*schema.Schema{
+			"areaEventType": {
+				Type: schema.TypeString,
+				Enum: []string{LdrTypeEnteringIntoArea, LdrTypeLeavingFromArea, LdrTypeBeingInsideArea},
+			},
+			"areaDefinition": {
+				Type:     schema.TypeArray,
+				MinItems: fivegc.ToInt(1),
+				Items: &schema.Schema{
+					Type:     schema.TypeObject,
+					Required: []string{"areaType"},
+					Properties: map[string]*schema.Schema{
+						"areaType": {Type: schema.TypeString},
+						"tais":     {Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: schema.Tai},
+						"ecgis":    {Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: schema.Ecgi},
+						"ncgis":    {Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: schema.Ncgi},
+					},
+				},
+			},
+			"occurrenceInfo":       {Type: schema.TypeString},
+			"minimumInterval":      intervalSchema,
+			"maximumInterval":      intervalSchema,
+			"samplingInterval":     intervalSchema,
+			"reportingDuration":    {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(1), Maximum: fivegc.ToFloat64(8640000)},
+			"reportingLocationReq": {Type: schema.TypeBoolean},
+		},
+	}
+	supportedGADShapesSchema = &schema.Schema{Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: &schema.Schema{Type: schema.TypeString}}
+	inputDataSchema          = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"externalClientType"},
+		Properties: map[string]*schema.Schema{
+			"externalClientType": {Type: schema.TypeString},
+			"correlationID":      correlationIDSchema,
+			"amfId":              schema.NfInstanceId,
+			"locationQoS":        locationQoSSchema,
+			"supportedGADShapes": supportedGADShapesSchema,
+			"supi":               schema.Supi,
+			"gpsi":               schema.Gpsi,
+			"pei":                schema.Pei,
+			"ecgi":               schema.Ecgi,
+			"ncgi":               schema.Ncgi,
+			"priority":           {Type: schema.TypeString},
+			"velocityRequested":  {Type: schema.TypeString},
+			"lcsServiceType":     {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(127)},
+			"ldrType":            {Type: schema.TypeString},
+			"hgmlcCallBackURI":   schema.Uri,
+			"ldrReference":       {Type: schema.TypeString},
+			"periodicEventInfo":  periodicEventInfoSchema,
+			"areaEventInfo":      areaEventInfoSchema,
+			"motionEventInfo":    motionEventInfoSchema,
+			"supportedFeatures":  schema.SupportedFeatures,
+		},
+	}
+	locationDataSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"locationEstimate"},
+		Properties: map[string]*schema.Schema{
+			"locationEstimate":            schema.GeographicArea,
+			"accuracyFulfilmentIndicator": {Type: schema.TypeString},
+			"ageOfLocationEstimate":       {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(32767)},
+			"timestampOfLocationEstimate": schema.DateTime,
+			"positioningDataList":         {Type: schema.TypeArray, MinItems: fivegc.ToInt(1)},
+			"gnssPositioningDataList":     {Type: schema.TypeArray, MinItems: fivegc.ToInt(1)},
+			"ecgi":                        schema.Ecgi,
+			"ncgi":                        schema.Ncgi,
+			"altitude":                    schema.Altitude,
+			"barometricPressure":          {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(30000), Maximum: fivegc.ToFloat64(115000)},
+			"supportedFeatures":           schema.SupportedFeatures,
+		},
+	}
+	cancelLocDataSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"hgmlcCallBackURI", "ldrReference"},
+		Properties: map[string]*schema.Schema{
+			"hgmlcCallBackURI":         schema.Uri,
+			"ldrReference":             {Type: schema.TypeString},
+			"servingLMFIdentification": {Type: schema.TypeString},
+		},
+	}
+	locContextDataSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"amfId", "locationQoS", "ldrType", "hgmlcCallBackURI", "ldrReference"},
+		Properties: map[string]*schema.Schema{
+			"amfId":              schema.NfInstanceId,
+			"locationQoS":        locationQoSSchema,
+			"supportedGADShapes": supportedGADShapesSchema,
+			"supi":               schema.Supi,
+			"gpsi":               schema.Gpsi,
+			"ldrType":            {Type: schema.TypeString},
+			"hgmlcCallBackURI":   schema.Uri,
+			"ldrReference":       {Type: schema.TypeString},
+			"periodicEventInfo":  periodicEventInfoSchema,
+			"areaEventInfo":      areaEventInfoSchema,
+			"motionEventInfo":    motionEventInfoSchema,
+			"ecgi":               schema.Ecgi,
+			"ncgi":               schema.Ncgi,
+		},
+	}
+)
+
+// Schemas of the NLMF Broadcast service defined in TS 29.572 clause 7.1.6.
+var (
+	taiListSchema           = &schema.Schema{Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: schema.Tai}
+	cipherRequestDataSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"taiList"},
+		Properties: map[string]*schema.Schema{
+			"taiList":           taiListSchema,
+			"supportedFeatures": schema.SupportedFeatures,
+		},
+	}
+	cipheringDataSetSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"cipheringSetID", "cipheringKey", "c0"},
+		Properties: map[string]*schema.Schema{
+			"cipheringSetID":    {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(65535)},
+			"cipheringKey":      {Type: schema.TypeString},
+			"c0":                {Type: schema.TypeString},
+			"ltePosSibTypes":    {Type: schema.TypeString},
+			"nrPosSibTypes":     {Type: schema.TypeString},
+			"validityStartTime": schema.DateTime,
+			"validityDuration":  {Type: schema.TypeInteger, Minimum: fivegc.ToFloat64(1), Maximum: fivegc.ToFloat64(65535)},
+			"taiList":           taiListSchema,
+		},
+	}
+	cipherResponseDataSchema = &schema.Schema{
+		Type:     schema.TypeObject,
+		Required: []string{"cipheringDataSet"},
+		Properties: map[string]*schema.Schema{
+			"cipheringDataSet":  {Type: schema.TypeArray, MinItems: fivegc.ToInt(1), Items: cipheringDataSetSchema},
+			"supportedFeatures": schema.SupportedFeatures,
+		},
+	}
+)
+
+// requestSchemas are the schemas of the request bodies, indexed by endpoint path.
+var requestSchemas = map[string]*schema.Schema{
+	locationRouterGroup + cancelLocationEndpoint:          cancelLocDataSchema,
+	locationRouterGroup + determineLocationEndpoint:       inputDataSchema,
+	locationRouterGroup + locationContextTransferEndpoint: locContextDataSchema,
+	broadcastRouterGroup + cypherKeyEndpoint:              cipherRequestDataSchema,
+}
+
+// responseSchemas are the schemas of the successful response bodies, indexed by endpoint path.
+var responseSchemas = map[string]*schema.Schema{
+	locationRouterGroup + determineLocationEndpoint: locationDataSchema,
+	broadcastRouterGroup + cypherKeyEndpoint:        cipherResponseDataSchema,
+}
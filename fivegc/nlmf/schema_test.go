@@ -0,0 +1,93 @@
+package nlmf
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSchemas(t *testing.T) {
+	tai := `{"plmnId":{"mcc":"208","mnc":"93"},"tac":"000001"}`
+	tests := []struct {
+		name     string
+		path     string
+		response bool
+		body     string
+		pointers []string
+	}{
+		{
+			name: "cipher request",
+			path: broadcastRouterGroup + cypherKeyEndpoint,
+			body: `{"taiList":[` + tai + `]}`,
+		},
+		{
+			name:     "cipher request without TAI",
+			path:     broadcastRouterGroup + cypherKeyEndpoint,
+			body:     `{"taiList":[],"supportedFeatures":"0"}`,
+			pointers: []string{"/taiList"},
+		},
+		{
+			name:     "cipher request with an invalid TAI",
+			path:     broadcastRouterGroup + cypherKeyEndpoint,
+			body:     `{"taiList":[{"plmnId":{"mcc":"208"},"tac":"000001"}]}`,
+			pointers: []string{"/taiList/0/plmnId/mnc"},
+		},
+		{
+			name:     "cipher response",
+			path:     broadcastRouterGroup + cypherKeyEndpoint,
+			response: true,
+			body:     `{"cipheringDataSet":[{"cipheringSetID":1,"cipheringKey":"00","c0":"00","validityDuration":1440}]}`,
+		},
+		{
+			name:     "cipher response with invalid data sets",
+			path:     broadcastRouterGroup + cypherKeyEndpoint,
+			response: true,
+			body:     `{"cipheringDataSet":[{"cipheringSetID":1,"c0":"00","validityDuration":86400}]}`,
+			pointers: []string{"/cipheringDataSet/0/cipheringKey", "/cipheringDataSet/0/validityDuration"},
+		},
+		{
+			name:     "cipher response without data sets",
+			path:     broadcastRouterGroup + cypherKeyEndpoint,
+			response: true,
+			body:     `{}`,
+			pointers: []string{"/cipheringDataSet"},
+		},
+		{
+			name: "area event",
+			path: locationRouterGroup + determineLocationEndpoint,
+			body: `{"externalClientType":"EMERGENCY_SERVICES","areaEventInfo":{"areaEventType":"LEAVING_FROM_AREA","areaDefinition":[{"areaType":"TAI","tais":[` + tai + `]}]}}`,
+		},
+		{
+			name:     "invalid area event",
+			path:     locationRouterGroup + determineLocationEndpoint,
+			body:     `{"externalClientType":"EMERGENCY_SERVICES","areaEventInfo":{"areaEventType":"UE_AVAILABLE","areaDefinition":[{"tais":[]}],"minimumInterval":0}}`,
+			pointers: []string{"/areaEventInfo/areaDefinition/0/areaType", "/areaEventInfo/areaDefinition/0/tais", "/areaEventInfo/areaEventType", "/areaEventInfo/minimumInterval"},
+		},
+		{
+			name:     "area event without type",
+			path:     locationRouterGroup + determineLocationEndpoint,
+			body:     `{"externalClientType":"EMERGENCY_SERVICES","areaEventInfo":{"areaDefinition":[{"areaType":"TAI","tais":[` + tai + `]}]}}`,
+			pointers: []string{"/areaEventInfo/areaEventType"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemas := requestSchemas
+			if tt.response {
+				schemas = responseSchemas
+			}
+			violations, err := schemas[tt.path].Validate([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var pointers []string
+			for _, violation := range violations {
+				pointers = append(pointers, violation.Pointer)
+			}
+			sort.Strings(pointers)
+			if !reflect.DeepEqual(pointers, tt.pointers) {
+				t.Errorf("unexpected violations %v, expected %v", violations, tt.pointers)
+			}
+		})
+	}
+}
@@ -1,19 +1,24 @@
 package nlmf
 
 import (
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/schema"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
 	"github.com/gin-gonic/gin"
 	"log"
 )
 
 // Server represents a NLMF server.
 type Server struct {
-	address   string // IP:PORT
-	apiRoot   string
-	location  Location
-	broadcast Broadcast
-	logger    *log.Logger
-	router    *gin.Engine
-	stop      chan bool
+	address               string // IP:PORT
+	apiRoot               string
+	location              Location
+	broadcast             Broadcast
+	eventNotification     EventNotification
+	eventNotificationPath string
+	logger                *log.Logger
+	router                *gin.Engine
+	stop                  chan bool
+	validation            bool
 }
 
 // NewServer creates a new Server NLMF server instance.
@@ -37,17 +42,36 @@ func (n *Server) AttachBroadcast(b Broadcast) {
 	n.broadcast = b
 }
 
+// AttachEventNotification attaches an EventNotification handler to the NLMF Server.
+// The path, relative to the API root, is the one of the callback URI given in the deferred location requests.
+func (n *Server) AttachEventNotification(path string, e EventNotification) {
+	n.eventNotificationPath = path
+	n.eventNotification = e
+}
+
+// EnableValidation enables the validation of the request bodies against the 3GPP OpenAPI schemas.
+// Invalid requests are answered with a 400 ProblemDetails listing the invalid attributes, without reaching the handlers.
+func (n *Server) EnableValidation() {
+	n.validation = true
+}
+
 // Start starts the NLMF Server.
 func (n *Server) Start() {
 	n.router = gin.Default()
 	n.router.Use(gin.Recovery())
 	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
-	root := n.router.Group(n.apiRoot)
+	root := router.Root(n.router, n.apiRoot)
+	if n.validation {
+		root.Use(schema.Middleware(requestSchemas))
+	}
 	if n.location != nil {
-		attachLocationHandler(root, n.location)
+		attachLocationHandler(root, n.location, n.logger)
 	}
 	if n.broadcast != nil {
-		attachBroadcastHandler(root, n.broadcast)
+		attachBroadcastHandler(root, n.broadcast, n.logger)
+	}
+	if n.eventNotification != nil {
+		attachEventNotificationHandler(root, n.eventNotificationPath, n.eventNotification, n.logger)
 	}
 	go n.router.Run(n.address)
 	<-n.stop
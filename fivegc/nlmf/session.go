@@ -0,0 +1,373 @@
+package nlmf
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"log"
+	"sync"
+	"time"
+)
+
+// DefaultSamplingInterval is the interval at which the triggers of an area or motion event session are evaluated
+// when the request gives neither a sampling interval nor a minimum interval.
+const DefaultSamplingInterval = time.Minute
+
+// OccurrenceInfo values of the area and motion event information, defined in TS 29.572 clause 6.1.6.3.
+const (
+	OccurrenceInfoOneTimeEvent      = "ONE_TIME_EVENT"
+	OccurrenceInfoMultipleTimeEvent = "MULTIPLE_TIME_EVENT"
+)
+
+// Reporter determines the location reports of the deferred location sessions.
+type Reporter interface {
+	// Report returns the report of a session, or nil when no event has to be reported (e.g. the UE did not enter the area).
+	// The callback URI, the LDR reference and the UE identities are set by the SessionManager, as well as the event type
+	// of the periodic reports.
+	Report(ctx context.Context, session Session) (*nlmfocation.EventNotifyData, error)
+}
+
+// ReporterFunc is an adapter to use an ordinary function as a Reporter.
+type ReporterFunc func(ctx context.Context, session Session) (*nlmfocation.EventNotifyData, error)
+
+// Report calls f(ctx, session).
+func (f ReporterFunc) Report(ctx context.Context, session Session) (*nlmfocation.EventNotifyData, error) {
+	return f(ctx, session)
+}
+
+// Session is the state of a deferred location session, created by a DetermineLocation request carrying
+// periodic, area or motion event information (TS 23.273 clause 6.3).
+type Session struct {
+	// Request is the DetermineLocation request which created the session.
+	Request nlmfocation.InputData
+	// StartTime is the time at which the session started on the first LMF.
+	StartTime time.Time
+	// ReportCount is the number of reports sent.
+	ReportCount int32
+	// LastReportTime is the time at which the last report has been sent.
+	LastReportTime time.Time
+	// LastReport is the last report sent.
+	LastReport *nlmfocation.EventNotifyData
+}
+
+// LdrReference returns the LDR reference identifying the session.
+func (s Session) LdrReference() string {
+	return s.Request.GetLdrReference()
+}
+
+// RemainingReports returns the number of periodic reports still to send; false when the reporting is infinite
+// or the session is not periodic.
+func (s Session) RemainingReports() (int32, bool) {
+	if !s.Request.HasPeriodicEventInfo() {
+		return 0, false
+	}
+	info := s.Request.GetPeriodicEventInfo()
+	if info.GetReportingInfiniteInd() {
+		return 0, false
+	}
+	if remaining := info.GetReportingAmount() - s.ReportCount; remaining > 0 {
+		return remaining, true
+	}
+	return 0, true
+}
+
+// IsDeferredRequest reports whether a DetermineLocation request creates a deferred location session.
+func IsDeferredRequest(req nlmfocation.InputData) bool {
+	return req.HasPeriodicEventInfo() || req.HasAreaEventInfo() || req.HasMotionEventInfo()
+}
+
+// SessionManager runs the deferred location sessions of an LMF: it schedules their reports according to the periodic
+// or triggered event information of the request, sends them to the callback URI of the request, and stops them on
+// CancelLocation or when they are transferred to another LMF. It is safe for concurrent use.
+type SessionManager struct {
+	deferred *DeferredLocations
+	reporter Reporter
+	logger   *log.Logger
+	mu       sync.Mutex
+	sessions map[string]*activeSession
+}
+
+type activeSession struct {
+	mu      sync.Mutex
+	session Session
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewSessionManager creates a new session manager sending the reports determined by reporter with client.
+func NewSessionManager(client *NotificationClient, reporter Reporter, logger *log.Logger) *SessionManager {
+	return &SessionManager{
+		deferred: NewDeferredLocations(client),
+		reporter: reporter,
+		logger:   logger,
+		sessions: make(map[string]*activeSession),
+	}
+}
+
+// Start starts the deferred location session created by a DetermineLocation request.
+// It is typically called by DetermineLocation, which then answers with 204 No Content.
+func (m *SessionManager) Start(req nlmfocation.InputData) error {
+	if !IsDeferredRequest(req) {
+		return fivegc.ErrMandatoryIeMissing.WithDetail("request without periodic, area or motion event information")
+	}
+	return m.Import(Session{Request: req, StartTime: time.Now()})
+}
+
+// Import resumes a session, e.g. a session transferred from another LMF by LocationContextTransfer.
+// A session already running with the same LDR reference is replaced.
+func (m *SessionManager) Import(session Session) error {
+	if err := checkInterval(session.Request); err != nil {
+		return err
+	}
+	m.stop(session.LdrReference())
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &activeSession{
+		session: session,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.deferred.Add(session.Request); err != nil {
+		cancel()
+		return err
+	}
+	m.sessions[session.LdrReference()] = s
+	go m.run(ctx, s)
+	return nil
+}
+
+// Cancel stops the session matching a CancelLocation request.
+func (m *SessionManager) Cancel(cancel nlmfocation.CancelLocData) error {
+	if _, err := m.deferred.Cancel(cancel); err != nil {
+		return err
+	}
+	m.stop(cancel.GetLdrReference())
+	return nil
+}
+
+// Export returns the state of the session matching the LDR reference.
+func (m *SessionManager) Export(ldrReference string) (Session, bool) {
+	m.mu.Lock()
+	s, ok := m.sessions[ldrReference]
+	m.mu.Unlock()
+	if !ok {
+		return Session{}, false
+	}
+	return s.state(), true
+}
+
+// Sessions returns the state of all the active sessions.
+func (m *SessionManager) Sessions() []Session {
+	m.mu.Lock()
+	active := make([]*activeSession, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		active = append(active, s)
+	}
+	m.mu.Unlock()
+	sessions := make([]Session, len(active))
+	for i, s := range active {
+		sessions[i] = s.state()
+	}
+	return sessions
+}
+
+// Transfer stops the session matching the LDR reference and returns its state, so it can be resumed by another LMF.
+func (m *SessionManager) Transfer(ldrReference string) (Session, error) {
+	session, ok := m.stop(ldrReference)
+	if !ok {
+		return Session{}, ErrUnknownLdrReference.WithDetail("transfer %s", ldrReference)
+	}
+	m.deferred.Remove(ldrReference)
+	return session, nil
+}
+
+// Stop stops all the sessions.
+func (m *SessionManager) Stop() {
+	for _, session := range m.Sessions() {
+		m.stop(session.LdrReference())
+		m.deferred.Remove(session.LdrReference())
+	}
+}
+
+// stop stops the session matching the LDR reference and returns its last state.
+func (m *SessionManager) stop(ldrReference string) (Session, bool) {
+	m.mu.Lock()
+	s, ok := m.sessions[ldrReference]
+	delete(m.sessions, ldrReference)
+	m.mu.Unlock()
+	if !ok {
+		return Session{}, false
+	}
+	s.cancel()
+	<-s.done
+	return s.state(), true
+}
+
+// run schedules the reports of a session until it is stopped or completed.
+// A report being sent is not interrupted by stop, so that the state returned by stop accounts for it.
+func (m *SessionManager) run(ctx context.Context, s *activeSession) {
+	defer close(s.done)
+	session := s.state()
+	ticker := time.NewTicker(interval(session.Request))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if completed := m.tick(context.Background(), s, now); completed {
+				m.mu.Lock()
+				if m.sessions[session.LdrReference()] == s {
+					delete(m.sessions, session.LdrReference())
+					m.deferred.Remove(session.LdrReference())
+				}
+				m.mu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+// tick evaluates a session and sends its report, it returns true when the session is completed.
+func (m *SessionManager) tick(ctx context.Context, s *activeSession, now time.Time) bool {
+	session := s.state()
+	req := session.Request
+	if req.HasPeriodicEventInfo() {
+		if remaining, ok := session.RemainingReports(); ok && remaining == 0 {
+			return true
+		}
+		report, err := m.reporter.Report(ctx, session)
+		if err != nil {
+			m.logf(session, "no periodic report: %v", err)
+			return false
+		}
+		if report == nil {
+			return false
+		}
+		if report.ReportedEventType.String == nil {
+			report.ReportedEventType = nlmfocation.ReportedEventType{String: fivegc.ToString(ReportedEventTypePeriodic)}
+		}
+		m.send(ctx, s, *report, now)
+		remaining, ok := s.state().RemainingReports()
+		return ok && remaining == 0
+	}
+	trigger := triggerOf(req)
+	if trigger.reportingDuration > 0 && now.Sub(session.StartTime) >= trigger.reportingDuration {
+		return true
+	}
+	report, err := m.reporter.Report(ctx, session)
+	if err != nil {
+		m.logf(session, "no triggered report: %v", err)
+		return false
+	}
+	lastReportTime := session.LastReportTime
+	if lastReportTime.IsZero() {
+		lastReportTime = session.StartTime
+	}
+	switch {
+	case report != nil && now.Sub(lastReportTime) >= trigger.minimumInterval:
+		m.send(ctx, s, *report, now)
+		return trigger.oneTime
+	case trigger.maximumInterval > 0 && now.Sub(lastReportTime) >= trigger.maximumInterval:
+		m.send(ctx, s, nlmfocation.EventNotifyData{
+			ReportedEventType: nlmfocation.ReportedEventType{String: fivegc.ToString(ReportedEventTypeMaximumIntervalExpiration)},
+		}, now)
+	}
+	return false
+}
+
+func (m *SessionManager) send(ctx context.Context, s *activeSession, report nlmfocation.EventNotifyData, now time.Time) {
+	if err := m.deferred.Notify(ctx, s.state().LdrReference(), report); err != nil {
+		m.logf(s.state(), "report not sent: %v", err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session.ReportCount++
+	s.session.LastReportTime = now
+	s.session.LastReport = &report
+}
+
+func (m *SessionManager) logf(session Session, format string, v ...interface{}) {
+	if m.logger != nil {
+		m.logger.Printf("deferred location %s: %s", session.LdrReference(), fmt.Sprintf(format, v...))
+	}
+}
+
+func (s *activeSession) state() Session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.session
+}
+
+// trigger holds the parameters of an area or motion event session.
+type trigger struct {
+	minimumInterval   time.Duration
+	maximumInterval   time.Duration
+	samplingInterval  time.Duration
+	reportingDuration time.Duration
+	oneTime           bool
+}
+
+func triggerOf(req nlmfocation.InputData) trigger {
+	var t trigger
+	var occurrence nlmfocation.OccurrenceInfo
+	switch {
+	case req.HasAreaEventInfo():
+		info := req.GetAreaEventInfo()
+		t.minimumInterval = seconds(info.GetMinimumInterval())
+		t.maximumInterval = seconds(info.GetMaximumInterval())
+		t.samplingInterval = seconds(info.GetSamplingInterval())
+		t.reportingDuration = seconds(info.GetReportingDuration())
+		occurrence = info.GetOccurrenceInfo()
+	case req.HasMotionEventInfo():
+		info := req.GetMotionEventInfo()
+		t.minimumInterval = seconds(info.GetMinimumInterval())
+		t.maximumInterval = seconds(info.GetMaximumInterval())
+		t.samplingInterval = seconds(info.GetSamplingInterval())
+		t.reportingDuration = seconds(info.GetReportingDuration())
+		occurrence = info.GetOccurrenceInfo()
+	}
+	// The occurrence defaults to a one time event (TS 29.572 clause 6.1.6.2.5).
+	t.oneTime = occurrence.String == nil || *occurrence.String == OccurrenceInfoOneTimeEvent
+	return t
+}
+
+// interval returns the interval at which a session is evaluated.
+func interval(req nlmfocation.InputData) time.Duration {
+	if req.HasPeriodicEventInfo() {
+		info := req.GetPeriodicEventInfo()
+		if info.HasReportingIntervalMs() {
+			return time.Duration(info.GetReportingIntervalMs()) * time.Millisecond
+		}
+		return seconds(info.GetReportingInterval())
+	}
+	t := triggerOf(req)
+	switch {
+	case t.samplingInterval > 0:
+		return t.samplingInterval
+	case t.minimumInterval > 0:
+		return t.minimumInterval
+	default:
+		return DefaultSamplingInterval
+	}
+}
+
+// checkInterval rejects a periodic event information with neither a positive reporting interval nor a positive
+// reporting interval in milliseconds, whose reports cannot be scheduled.
+func checkInterval(req nlmfocation.InputData) error {
+	if interval(req) > 0 {
+		return nil
+	}
+	return fivegc.ErrMandatoryIeIncorrect.WithDetail("periodic event information without reporting interval").WithInvalidParams(openapicommon.InvalidParam{
+		Param:  "/periodicEventInfo",
+		Reason: fivegc.ToString("reportingInterval or reportingIntervalMs must be positive"),
+	})
+}
+
+func seconds(s int32) time.Duration {
+	return time.Duration(s) * time.Second
+}
@@ -0,0 +1,122 @@
+package nlmf_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSessionManager(t *testing.T) {
+	notifications := make(chan nlmfocation.EventNotifyData, 10)
+	gmlc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data nlmfocation.EventNotifyData
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		notifications <- data
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer gmlc.Close()
+	reporter := nlmf.ReporterFunc(func(ctx context.Context, session nlmf.Session) (*nlmfocation.EventNotifyData, error) {
+		return &nlmfocation.EventNotifyData{}, nil
+	})
+	manager := nlmf.NewSessionManager(nlmf.NewNotificationClient(fivegc.ClientConfiguration{HTTPClient: gmlc.Client()}), reporter, nil)
+	defer manager.Stop()
+
+	var req nlmfocation.InputData
+	req.SetSupi("imsi-208930000000001")
+	req.SetLdrReference("ldr-1")
+	req.SetHgmlcCallBackURI(gmlc.URL + "/event-notify")
+	if err := manager.Start(req); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("request without event information should be rejected, got %v", err)
+	}
+	var periodic nlmfocation.PeriodicEventInfo
+	periodic.SetReportingAmount(2)
+	periodic.SetReportingInterval(1)
+	periodic.SetReportingIntervalMs(10)
+	req.SetPeriodicEventInfo(periodic)
+	if err := manager.Start(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		data := <-notifications
+		if data.GetLdrReference() != "ldr-1" || *data.ReportedEventType.String != nlmf.ReportedEventTypePeriodic {
+			t.Errorf("unexpected report %+v", data)
+		}
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, ok := manager.Export("ldr-1"); !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("session should be completed after its last report")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	periodic.SetReportingInfiniteInd(true)
+	req.SetPeriodicEventInfo(periodic)
+	if err := manager.Start(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-notifications
+	session, err := manager.Transfer("ldr-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.ReportCount == 0 || session.LastReport == nil {
+		t.Errorf("transferred session should keep its reporting state: %+v", session)
+	}
+	if err := manager.Import(session); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var cancel nlmfocation.CancelLocData
+	cancel.SetLdrReference("ldr-1")
+	cancel.SetHgmlcCallBackURI(gmlc.URL + "/event-notify")
+	if err := manager.Cancel(cancel); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := manager.Export("ldr-1"); ok {
+		t.Errorf("cancelled session should be stopped")
+	}
+	if err := manager.Cancel(cancel); !errors.Is(err, nlmf.ErrUnknownLdrReference) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSessionManagerWithoutInterval(t *testing.T) {
+	manager := nlmf.NewSessionManager(nlmf.NewNotificationClient(fivegc.ClientConfiguration{}), nlmf.ReporterFunc(
+		func(ctx context.Context, session nlmf.Session) (*nlmfocation.EventNotifyData, error) {
+			return nil, nil
+		}), nil)
+	defer manager.Stop()
+
+	var req nlmfocation.InputData
+	req.SetLdrReference("ldr-1")
+	req.SetHgmlcCallBackURI("http://gmlc.example.com/event-notify")
+	var periodic nlmfocation.PeriodicEventInfo
+	periodic.SetReportingAmount(2)
+	req.SetPeriodicEventInfo(periodic)
+	err := manager.Start(req)
+	if !errors.Is(err, fivegc.ErrMandatoryIeIncorrect) {
+		t.Fatalf("request without reporting interval should be rejected, got %v", err)
+	}
+	if problemDetails, _ := fivegc.ProblemDetailsFromError(err); len(problemDetails.InvalidParams) != 1 || problemDetails.InvalidParams[0].Param != "/periodicEventInfo" {
+		t.Errorf("unexpected problem details %+v", problemDetails)
+	}
+	if err := manager.Import(nlmf.Session{Request: req}); !errors.Is(err, fivegc.ErrMandatoryIeIncorrect) {
+		t.Errorf("session without reporting interval should be rejected, got %v", err)
+	}
+	if _, ok := manager.Export("ldr-1"); ok {
+		t.Errorf("rejected session should not be started")
+	}
+}
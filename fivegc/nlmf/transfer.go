@@ -0,0 +1,158 @@
+package nlmf
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"time"
+)
+
+// LDR types of a deferred location request, defined in TS 29.572 clause 6.1.6.3.
+const (
+	LdrTypeUeAvailable      = "UE_AVAILABLE"
+	LdrTypePeriodic         = "PERIODIC"
+	LdrTypeEnteringIntoArea = "ENTERING_INTO_AREA"
+	LdrTypeLeavingFromArea  = "LEAVING_FROM_AREA"
+	LdrTypeBeingInsideArea  = "BEING_INSIDE_AREA"
+	LdrTypeMotion           = "MOTION"
+)
+
+// NewLocContextData snapshots a deferred location session into the LocContextData of a LocationContextTransfer
+// request, sent to the target LMF on AMF change (TS 23.273 clause 6.3.2).
+// The event reporting status carries the number of reports sent and the duration of the session so far, so the
+// target LMF only sends the remaining reports. The last reported location, if any, is sent as the UE location.
+// An area event information without area event type is rejected, as the LDR type cannot be derived from it.
+func NewLocContextData(session Session, now time.Time) (nlmfocation.LocContextData, error) {
+	req := session.Request
+	var data nlmfocation.LocContextData
+	typ, err := ldrType(req)
+	if err != nil {
+		return nlmfocation.LocContextData{}, err
+	}
+	data.SetAmfId(req.GetAmfId())
+	data.SetLocationQoS(req.GetLocationQoS())
+	if req.HasSupportedGADShapes() {
+		data.SetSupportedGADShapes(req.GetSupportedGADShapes())
+	}
+	if req.HasSupi() {
+		data.SetSupi(req.GetSupi())
+	}
+	if req.HasGpsi() {
+		data.SetGpsi(req.GetGpsi())
+	}
+	data.SetLdrType(typ)
+	data.SetHgmlcCallBackURI(req.GetHgmlcCallBackURI())
+	data.SetLdrReference(req.GetLdrReference())
+	if req.HasPeriodicEventInfo() {
+		data.SetPeriodicEventInfo(req.GetPeriodicEventInfo())
+	}
+	if req.HasAreaEventInfo() {
+		data.SetAreaEventInfo(req.GetAreaEventInfo())
+	}
+	if req.HasMotionEventInfo() {
+		data.SetMotionEventInfo(req.GetMotionEventInfo())
+	}
+	if req.HasEcgi() {
+		data.SetEcgi(req.GetEcgi())
+	}
+	if req.HasNcgi() {
+		data.SetNcgi(req.GetNcgi())
+	}
+	var status nlmfocation.EventReportingStatus
+	status.SetEventReportCounter(session.ReportCount)
+	status.SetEventReportDuration(int32(now.Sub(session.StartTime) / time.Second))
+	data.SetEventReportingStatus(status)
+	if report := session.LastReport; report != nil && report.HasLocationEstimate() {
+		var info nlmfocation.UeLocationInfo
+		info.SetLocationEstimate(report.GetLocationEstimate())
+		// The age of the location estimate is in minutes.
+		info.SetAgeOfLocationEstimate(report.GetAgeOfLocationEstimate() + int32(now.Sub(session.LastReportTime)/time.Minute))
+		data.SetUeLocationInfo(info)
+	}
+	return data, nil
+}
+
+// SessionFromLocContextData restores on the target LMF the deferred location session transferred by a
+// LocationContextTransfer request, so it can be resumed with SessionManager.Import.
+// The intervals between reports restart at now. The parameters of the original request which are not part of
+// LocContextData, such as the external client type, are left unset.
+// A periodic event information without reporting interval is rejected, as SessionManager.Import would.
+func SessionFromLocContextData(data nlmfocation.LocContextData, now time.Time) (Session, error) {
+	var req nlmfocation.InputData
+	req.SetAmfId(data.GetAmfId())
+	req.SetLocationQoS(data.GetLocationQoS())
+	if data.HasSupportedGADShapes() {
+		req.SetSupportedGADShapes(data.GetSupportedGADShapes())
+	}
+	if data.HasSupi() {
+		req.SetSupi(data.GetSupi())
+	}
+	if data.HasGpsi() {
+		req.SetGpsi(data.GetGpsi())
+	}
+	req.SetLdrType(data.GetLdrType())
+	req.SetHgmlcCallBackURI(data.GetHgmlcCallBackURI())
+	req.SetLdrReference(data.GetLdrReference())
+	if data.HasPeriodicEventInfo() {
+		req.SetPeriodicEventInfo(data.GetPeriodicEventInfo())
+	}
+	if data.HasAreaEventInfo() {
+		req.SetAreaEventInfo(data.GetAreaEventInfo())
+	}
+	if data.HasMotionEventInfo() {
+		req.SetMotionEventInfo(data.GetMotionEventInfo())
+	}
+	if data.HasEcgi() {
+		req.SetEcgi(data.GetEcgi())
+	}
+	if data.HasNcgi() {
+		req.SetNcgi(data.GetNcgi())
+	}
+	status := data.GetEventReportingStatus()
+	session := Session{
+		Request:        req,
+		StartTime:      now.Add(-time.Duration(status.GetEventReportDuration()) * time.Second),
+		ReportCount:    status.GetEventReportCounter(),
+		LastReportTime: now,
+	}
+	if data.HasUeLocationInfo() {
+		info := data.GetUeLocationInfo()
+		var report nlmfocation.EventNotifyData
+		if info.HasLocationEstimate() {
+			report.SetLocationEstimate(info.GetLocationEstimate())
+		}
+		if info.HasAgeOfLocationEstimate() {
+			report.SetAgeOfLocationEstimate(info.GetAgeOfLocationEstimate())
+		}
+		session.LastReport = &report
+	}
+	if err := checkInterval(req); err != nil {
+		return Session{}, err
+	}
+	return session, nil
+}
+
+// ldrType returns the LDR type of a request, derived from its event information when it is not given: the LDR type
+// of an area event is its area event type.
+func ldrType(req nlmfocation.InputData) (nlmfocation.LdrType, error) {
+	if req.HasLdrType() {
+		return req.GetLdrType(), nil
+	}
+	switch {
+	case req.HasPeriodicEventInfo():
+		return nlmfocation.LdrType{String: fivegc.ToString(LdrTypePeriodic)}, nil
+	case req.HasMotionEventInfo():
+		return nlmfocation.LdrType{String: fivegc.ToString(LdrTypeMotion)}, nil
+	case req.HasAreaEventInfo():
+		info := req.GetAreaEventInfo()
+		if !info.HasAreaEventType() {
+			return nlmfocation.LdrType{}, fivegc.ErrMandatoryIeMissing.WithDetail("area event information without area event type").WithInvalidParams(openapicommon.InvalidParam{
+				Param:  "/areaEventInfo/areaEventType",
+				Reason: fivegc.ToString("mandatory attribute is missing"),
+			})
+		}
+		return nlmfocation.LdrType{String: info.GetAreaEventType().String}, nil
+	default:
+		return nlmfocation.LdrType{String: fivegc.ToString(LdrTypeUeAvailable)}, nil
+	}
+}
@@ -0,0 +1,142 @@
+package nlmf_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nlmf"
+	nlmfocation "github.com/5GCoreNet/openapi/openapi_Nlmf_Location"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocContextDataRoundTrip(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	var estimate nlmfocation.GeographicArea
+	if err := json.Unmarshal([]byte(`{"shape":"POINT","point":{"lon":2.35,"lat":48.85}}`), &estimate); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var periodic nlmfocation.PeriodicEventInfo
+	periodic.SetReportingAmount(5)
+	periodic.SetReportingInterval(60)
+	var area nlmfocation.ReportingArea
+	area.SetAreaType("TRACKING_AREA_IDENTITY")
+	area.SetTais([]nlmfocation.Tai{{PlmnId: nlmfocation.PlmnId{Mcc: "208", Mnc: "93"}, Tac: "000001"}})
+	var areaEventInfo nlmfocation.AreaEventInfo
+	areaEventInfo.SetAreaDefinition([]nlmfocation.ReportingArea{area})
+	areaEventInfo.SetOccurrenceInfo(nlmfocation.OccurrenceInfo{String: fivegc.ToString(nlmf.OccurrenceInfoMultipleTimeEvent)})
+	areaEventInfo.SetMinimumInterval(30)
+	areaEventInfo.SetMaximumInterval(600)
+
+	areaEvent := func(areaEventType string) func(*nlmfocation.InputData) {
+		return func(req *nlmfocation.InputData) {
+			info := areaEventInfo
+			info.SetAreaEventType(nlmfocation.AreaEventType{String: fivegc.ToString(areaEventType)})
+			req.SetAreaEventInfo(info)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		event   func(*nlmfocation.InputData)
+		ldrType string
+		count   int32
+		remain  int32
+	}{
+		{name: "periodic", event: func(req *nlmfocation.InputData) { req.SetPeriodicEventInfo(periodic) }, ldrType: nlmf.LdrTypePeriodic, count: 2, remain: 3},
+		{name: "leaving from area", event: areaEvent(nlmf.LdrTypeLeavingFromArea), ldrType: nlmf.LdrTypeLeavingFromArea, count: 1},
+		{name: "being inside area", event: areaEvent(nlmf.LdrTypeBeingInsideArea), ldrType: nlmf.LdrTypeBeingInsideArea, count: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var req nlmfocation.InputData
+			req.SetAmfId("amf-1")
+			req.SetSupi("imsi-208930000000001")
+			req.SetHgmlcCallBackURI("https://gmlc.example.com/event-notify")
+			req.SetLdrReference("ldr-1")
+			test.event(&req)
+			var report nlmfocation.EventNotifyData
+			report.SetLocationEstimate(estimate)
+			report.SetAgeOfLocationEstimate(1)
+			session := nlmf.Session{
+				Request:        req,
+				StartTime:      now.Add(-150 * time.Second),
+				ReportCount:    test.count,
+				LastReportTime: now.Add(-2 * time.Minute),
+				LastReport:     &report,
+			}
+
+			transferred, err := nlmf.NewLocContextData(session, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			raw, err := json.Marshal(transferred)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var data nlmfocation.LocContextData
+			if err := json.Unmarshal(raw, &data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ldrType := data.GetLdrType(); ldrType.String == nil || *ldrType.String != test.ldrType {
+				t.Errorf("unexpected LDR type %v, expected %s", ldrType, test.ldrType)
+			}
+			if status := data.GetEventReportingStatus(); status.GetEventReportCounter() != test.count || status.GetEventReportDuration() != 150 {
+				t.Errorf("unexpected event reporting status %+v", status)
+			}
+			if info := data.GetUeLocationInfo(); info.GetAgeOfLocationEstimate() != 3 {
+				t.Errorf("unexpected age of location estimate %d", info.GetAgeOfLocationEstimate())
+			}
+
+			later := now.Add(time.Minute)
+			restored, err := nlmf.SessionFromLocContextData(data, later)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if restored.LdrReference() != "ldr-1" || restored.ReportCount != test.count {
+				t.Errorf("unexpected session %+v", restored)
+			}
+			if !restored.StartTime.Equal(later.Add(-150 * time.Second)) {
+				t.Errorf("unexpected start time %v", restored.StartTime)
+			}
+			if remaining, _ := restored.RemainingReports(); remaining != test.remain {
+				t.Errorf("unexpected remaining reports %d", remaining)
+			}
+			if !reflect.DeepEqual(restored.Request.GetAreaEventInfo(), req.GetAreaEventInfo()) {
+				t.Errorf("area event information is not restored: %+v", restored.Request.GetAreaEventInfo())
+			}
+			if !reflect.DeepEqual(restored.Request.GetLdrType(), data.GetLdrType()) {
+				t.Errorf("unexpected LDR type %v", restored.Request.GetLdrType())
+			}
+			if !reflect.DeepEqual(restored.LastReport.GetLocationEstimate(), estimate) {
+				t.Errorf("location estimate is not restored: %+v", restored.LastReport.GetLocationEstimate())
+			}
+			if data, err := nlmf.NewLocContextData(restored, later); err != nil || !reflect.DeepEqual(data, transferred) {
+				t.Errorf("LocContextData should survive a round trip")
+			}
+		})
+	}
+}
+
+func TestNewLocContextDataWithoutAreaEventType(t *testing.T) {
+	var req nlmfocation.InputData
+	req.SetLdrReference("ldr-1")
+	req.SetAreaEventInfo(nlmfocation.AreaEventInfo{})
+	if _, err := nlmf.NewLocContextData(nlmf.Session{Request: req}, time.Now()); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("area event information without area event type should be rejected, got %v", err)
+	}
+}
+
+func TestSessionFromLocContextDataWithoutInterval(t *testing.T) {
+	var periodic nlmfocation.PeriodicEventInfo
+	periodic.SetReportingAmount(5)
+	var data nlmfocation.LocContextData
+	data.SetLdrReference("ldr-1")
+	data.SetLdrType(nlmfocation.LdrType{String: fivegc.ToString(nlmf.LdrTypePeriodic)})
+	data.SetPeriodicEventInfo(periodic)
+	if _, err := nlmf.SessionFromLocContextData(data, time.Now()); !errors.Is(err, fivegc.ErrMandatoryIeIncorrect) {
+		t.Errorf("session without reporting interval should be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,327 @@
+package nrppa
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// ECIDMeasurementResult is the E-CID measurement result of a UE.
+type ECIDMeasurementResult struct {
+	ServingCellID CGI
+	// ServingCellTAC is the tracking area code of 24 bits of the serving cell.
+	ServingCellTAC uint32
+	// AccessPointPosition is the position of the antenna of the serving cell.
+	AccessPointPosition *gad.PointAltitudeUncertainty
+	// MeasuredResults has between 1 and 64 results.
+	MeasuredResults []MeasuredResult
+}
+
+// MeasuredResult is an E-CID measured result, only one of its fields is set.
+type MeasuredResult struct {
+	// AngleOfArrival is in [0, 719], in steps of 0.5 degree.
+	AngleOfArrival *int32
+	// TimingAdvanceType1 and TimingAdvanceType2 are in [0, 7690].
+	TimingAdvanceType1 *int32
+	TimingAdvanceType2 *int32
+	RSRP               []ResultEUTRA
+	RSRQ               []ResultEUTRA
+	// Extension is a result of the choice extension, such as an NR result, in its single container.
+	Extension *IE
+}
+
+// ResultEUTRA is the E-UTRA RSRP or RSRQ of a cell.
+type ResultEUTRA struct {
+	PCI    int32
+	EARFCN int32
+	CGI    *CGI
+	// Value is the RSRP in [0, 97] or the RSRQ in [0, 34], as reported by TS 36.133.
+	Value int32
+}
+
+// Indexes of the alternatives of MeasuredResultsValue.
+const (
+	resultAngleOfArrival = iota
+	resultTimingAdvanceType1
+	resultTimingAdvanceType2
+	resultRSRP
+	resultRSRQ
+	resultExtension
+	measuredResultsValues
+)
+
+// maxCellReport is the maximum number of cells of an E-UTRA result.
+const maxCellReport = 9
+
+func (v *ECIDMeasurementResult) encode(e *per.Encoder) error {
+	e.WriteBool(false)
+	e.WriteBool(v.AccessPointPosition != nil)
+	e.WriteBool(v.MeasuredResults != nil)
+	e.WriteBool(false)
+	if err := v.ServingCellID.encodeNGRAN(e); err != nil {
+		return err
+	}
+	if v.ServingCellTAC>>24 != 0 {
+		return fmt.Errorf("TAC %d out of 24 bits", v.ServingCellTAC)
+	}
+	tac := []byte{byte(v.ServingCellTAC >> 16), byte(v.ServingCellTAC >> 8), byte(v.ServingCellTAC)}
+	if err := e.WriteOctetString(tac, 3, 3, false); err != nil {
+		return err
+	}
+	if v.AccessPointPosition != nil {
+		if err := writeAccessPointPosition(e, *v.AccessPointPosition); err != nil {
+			return err
+		}
+	}
+	if v.MeasuredResults != nil {
+		if err := e.WriteLength(len(v.MeasuredResults), 1, maxNoMeas); err != nil {
+			return err
+		}
+		for i := range v.MeasuredResults {
+			if err := v.MeasuredResults[i].encode(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ECIDMeasurementResult) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, 3)
+	if err != nil {
+		return err
+	}
+	if err := v.ServingCellID.decodeNGRAN(d); err != nil {
+		return err
+	}
+	tac, err := d.ReadOctetString(3, 3, false)
+	if err != nil {
+		return err
+	}
+	v.ServingCellTAC = uint32(tac[0])<<16 | uint32(tac[1])<<8 | uint32(tac[2])
+	if present[0] {
+		position, err := readAccessPointPosition(d)
+		if err != nil {
+			return err
+		}
+		v.AccessPointPosition = &position
+	}
+	if present[1] {
+		n, err := d.ReadLength(1, maxNoMeas)
+		if err != nil {
+			return err
+		}
+		v.MeasuredResults = make([]MeasuredResult, n)
+		for i := range v.MeasuredResults {
+			if err := v.MeasuredResults[i].decode(d); err != nil {
+				return err
+			}
+		}
+	}
+	if err := skipIEExtensions(d, present[2]); err != nil {
+		return err
+	}
+	return skip()
+}
+
+func (r *MeasuredResult) encode(e *per.Encoder) error {
+	var index int
+	var value func(e *per.Encoder) error
+	integer := func(v *int32, ub int64) func(e *per.Encoder) error {
+		return func(e *per.Encoder) error { return e.WriteConstrainedInt(int64(*v), 0, ub) }
+	}
+	switch {
+	case r.AngleOfArrival != nil:
+		index, value = resultAngleOfArrival, integer(r.AngleOfArrival, 719)
+	case r.TimingAdvanceType1 != nil:
+		index, value = resultTimingAdvanceType1, integer(r.TimingAdvanceType1, 7690)
+	case r.TimingAdvanceType2 != nil:
+		index, value = resultTimingAdvanceType2, integer(r.TimingAdvanceType2, 7690)
+	case r.RSRP != nil:
+		index, value = resultRSRP, func(e *per.Encoder) error { return writeResultsEUTRA(e, r.RSRP, 97) }
+	case r.RSRQ != nil:
+		index, value = resultRSRQ, func(e *per.Encoder) error { return writeResultsEUTRA(e, r.RSRQ, 34) }
+	case r.Extension != nil:
+		index, value = resultExtension, func(e *per.Encoder) error { return writeField(e, *r.Extension) }
+	default:
+		return fmt.Errorf("empty measured result")
+	}
+	return e.WriteChoice(index, measuredResultsValues, false, value)
+}
+
+func (r *MeasuredResult) decode(d *per.Decoder) error {
+	index, _, err := d.ReadChoice(measuredResultsValues, false)
+	if err != nil {
+		return err
+	}
+	integer := func(v **int32, ub int64) error {
+		n, err := d.ReadConstrainedInt(0, ub)
+		*v = toInt32(int32(n))
+		return err
+	}
+	switch index {
+	case resultAngleOfArrival:
+		return integer(&r.AngleOfArrival, 719)
+	case resultTimingAdvanceType1:
+		return integer(&r.TimingAdvanceType1, 7690)
+	case resultTimingAdvanceType2:
+		return integer(&r.TimingAdvanceType2, 7690)
+	case resultRSRP:
+		r.RSRP, err = readResultsEUTRA(d, 97)
+	case resultRSRQ:
+		r.RSRQ, err = readResultsEUTRA(d, 34)
+	default:
+		var extension IE
+		extension, err = readField(d)
+		r.Extension = &extension
+	}
+	return err
+}
+
+// writeResultsEUTRA writes a ResultRSRP-EUTRA or a ResultRSRQ-EUTRA, whose values are in [0, ub].
+func writeResultsEUTRA(e *per.Encoder, results []ResultEUTRA, ub int64) error {
+	if err := e.WriteLength(len(results), 1, maxCellReport); err != nil {
+		return err
+	}
+	for _, result := range results {
+		e.WriteBool(false)
+		e.WriteBool(result.CGI != nil)
+		e.WriteBool(false)
+		if err := e.WriteExtensibleInt(int64(result.PCI), 0, 503); err != nil {
+			return err
+		}
+		if err := e.WriteExtensibleInt(int64(result.EARFCN), 0, 262143); err != nil {
+			return err
+		}
+		if result.CGI != nil {
+			if err := result.CGI.encodeEUTRA(e); err != nil {
+				return err
+			}
+		}
+		if err := e.WriteExtensibleInt(int64(result.Value), 0, ub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readResultsEUTRA(d *per.Decoder, ub int64) ([]ResultEUTRA, error) {
+	n, err := d.ReadLength(1, maxCellReport)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]ResultEUTRA, n)
+	for i := range results {
+		skip, err := readExtensible(d)
+		if err != nil {
+			return nil, err
+		}
+		present, err := readPresence(d, 2)
+		if err != nil {
+			return nil, err
+		}
+		pci, err := d.ReadExtensibleInt(0, 503)
+		if err != nil {
+			return nil, err
+		}
+		earfcn, err := d.ReadExtensibleInt(0, 262143)
+		if err != nil {
+			return nil, err
+		}
+		results[i].PCI, results[i].EARFCN = int32(pci), int32(earfcn)
+		if present[0] {
+			results[i].CGI = &CGI{}
+			if err := results[i].CGI.decodeEUTRA(d); err != nil {
+				return nil, err
+			}
+		}
+		value, err := d.ReadExtensibleInt(0, ub)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Value = int32(value)
+		if err := skipIEExtensions(d, present[1]); err != nil {
+			return nil, err
+		}
+		if err := skip(); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// writeAccessPointPosition writes an NG-RANAccessPointPosition, whose components are the fields of the binary encoding
+// of the shape (TS 23.032 clause 7.3.6) except the orientation of the major axis, which is in degrees.
+func writeAccessPointPosition(e *per.Encoder, p gad.PointAltitudeUncertainty) error {
+	b, err := gad.Encode(p)
+	if err != nil {
+		return err
+	}
+	e.WriteBool(false)
+	e.WriteBool(false)
+	fields := []struct {
+		value  int64
+		lb, ub int64
+	}{
+		{int64(b[1] >> 7), 0, 1},
+		{int64(b[1]&0x7f)<<16 | int64(b[2])<<8 | int64(b[3]), 0, 8388607},
+		// The longitude is in two's complement on 24 bits.
+		{int64(int32(uint32(b[4])<<24|uint32(b[5])<<16|uint32(b[6])<<8) >> 8), -8388608, 8388607},
+		{int64(b[7] >> 7), 0, 1},
+		{int64(b[7]&0x7f)<<8 | int64(b[8]), 0, gad.MaxAltitude},
+		{int64(b[9]), 0, gad.MaxUncertaintyCode},
+		{int64(b[10]), 0, gad.MaxUncertaintyCode},
+		{int64(p.UncertaintyEllipse.OrientationMajor % 180), 0, 179},
+		{int64(b[12]), 0, gad.MaxUncertaintyCode},
+		{int64(b[13]), 0, gad.MaxConfidence},
+	}
+	for _, field := range fields {
+		if err := e.WriteConstrainedInt(field.value, field.lb, field.ub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readAccessPointPosition(d *per.Decoder) (gad.PointAltitudeUncertainty, error) {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return gad.PointAltitudeUncertainty{}, err
+	}
+	extensions, err := d.ReadBool()
+	if err != nil {
+		return gad.PointAltitudeUncertainty{}, err
+	}
+	bounds := [][2]int64{{0, 1}, {0, 8388607}, {-8388608, 8388607}, {0, 1}, {0, gad.MaxAltitude},
+		{0, gad.MaxUncertaintyCode}, {0, gad.MaxUncertaintyCode}, {0, 179}, {0, gad.MaxUncertaintyCode}, {0, gad.MaxConfidence}}
+	values := make([]int64, len(bounds))
+	for i, bound := range bounds {
+		if values[i], err = d.ReadConstrainedInt(bound[0], bound[1]); err != nil {
+			return gad.PointAltitudeUncertainty{}, err
+		}
+	}
+	latitude, longitude, altitude := values[0]<<23|values[1], uint32(values[2]), values[3]<<15|values[4]
+	b := []byte{0x90,
+		byte(latitude >> 16), byte(latitude >> 8), byte(latitude),
+		byte(longitude >> 16), byte(longitude >> 8), byte(longitude),
+		byte(altitude >> 8), byte(altitude),
+		byte(values[5]), byte(values[6]), byte(values[7] / 2), byte(values[8]), byte(values[9])}
+	s, err := gad.Decode(b)
+	if err != nil {
+		return gad.PointAltitudeUncertainty{}, err
+	}
+	p := s.(gad.PointAltitudeUncertainty)
+	p.UncertaintyEllipse.OrientationMajor = int32(values[7])
+	if err := skipIEExtensions(d, extensions); err != nil {
+		return gad.PointAltitudeUncertainty{}, err
+	}
+	return p, skip()
+}
+
+func toInt32(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,416 @@
+package nrppa
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// UEMeasurementID is the LMF or RAN UE measurement ID of the E-CID procedures, in [1, 256].
+type UEMeasurementID int32
+
+func (v *UEMeasurementID) encode(e *per.Encoder) error {
+	if *v < 1 || *v > 256 {
+		return fmt.Errorf("UE measurement ID %d out of [1, 256]", *v)
+	}
+	return e.WriteExtensibleInt(int64(*v), 1, 15)
+}
+
+func (v *UEMeasurementID) decode(d *per.Decoder) error {
+	id, err := d.ReadExtensibleInt(1, 15)
+	*v = UEMeasurementID(id)
+	return err
+}
+
+// MeasurementID is the LMF or RAN measurement ID of the measurement procedures, in [1, 65536].
+type MeasurementID int32
+
+func (v *MeasurementID) encode(e *per.Encoder) error {
+	return e.WriteExtensibleInt(int64(*v), 1, 65536)
+}
+
+func (v *MeasurementID) decode(d *per.Decoder) error {
+	id, err := d.ReadExtensibleInt(1, 65536)
+	*v = MeasurementID(id)
+	return err
+}
+
+// ReportCharacteristics requests a report on demand or periodic reports.
+type ReportCharacteristics int
+
+const (
+	ReportOnDemand ReportCharacteristics = iota
+	ReportPeriodic
+)
+
+func (v *ReportCharacteristics) encode(e *per.Encoder) error {
+	return e.WriteEnumerated(int(*v), 2, true)
+}
+
+func (v *ReportCharacteristics) decode(d *per.Decoder) error {
+	index, err := d.ReadEnumerated(2, true)
+	*v = ReportCharacteristics(index)
+	return err
+}
+
+// MeasurementPeriodicity is the periodicity of the periodic reports.
+type MeasurementPeriodicity int
+
+const (
+	Periodicity120ms MeasurementPeriodicity = iota
+	Periodicity240ms
+	Periodicity480ms
+	Periodicity640ms
+	Periodicity1024ms
+	Periodicity2048ms
+	Periodicity5120ms
+	Periodicity10240ms
+	Periodicity1min
+	Periodicity6min
+	Periodicity12min
+	Periodicity30min
+	Periodicity60min
+	// The periodicities below are extension values.
+	Periodicity20480ms
+	Periodicity40960ms
+	measurementPeriodicities = Periodicity20480ms
+)
+
+func (v *MeasurementPeriodicity) encode(e *per.Encoder) error {
+	return e.WriteEnumerated(int(*v), int(measurementPeriodicities), true)
+}
+
+func (v *MeasurementPeriodicity) decode(d *per.Decoder) error {
+	index, err := d.ReadEnumerated(int(measurementPeriodicities), true)
+	*v = MeasurementPeriodicity(index)
+	return err
+}
+
+// MeasurementQuantity is an E-CID measurement quantity.
+type MeasurementQuantity int
+
+const (
+	QuantityCellID MeasurementQuantity = iota
+	QuantityAngleOfArrival
+	QuantityTimingAdvanceType1
+	QuantityTimingAdvanceType2
+	QuantityRSRP
+	QuantityRSRQ
+	// The quantities below are extension values.
+	QuantitySSRSRP
+	QuantitySSRSRQ
+	QuantityCSIRSRP
+	QuantityCSIRSRQ
+	QuantityAngleOfArrivalNR
+	QuantityTimingAdvanceNR
+	measurementQuantities = QuantitySSRSRP
+)
+
+// MeasurementQuantities are the E-CID measurement quantities requested, between 1 and 64.
+type MeasurementQuantities []MeasurementQuantity
+
+// maxNoMeas is the maximum number of E-CID measurement quantities and results.
+const maxNoMeas = 64
+
+func (v *MeasurementQuantities) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoMeas); err != nil {
+		return err
+	}
+	for _, quantity := range *v {
+		// Each item is a single container of MeasurementQuantities-Item, an extensible SEQUENCE with its iE-Extensions.
+		item := per.NewEncoder(true)
+		item.WriteBool(false)
+		item.WriteBool(false)
+		if err := item.WriteEnumerated(int(quantity), int(measurementQuantities), true); err != nil {
+			return err
+		}
+		if err := writeField(e, IE{ID: IDMeasurementQuantitiesItem, Criticality: Reject, Value: item.Bytes()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *MeasurementQuantities) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoMeas)
+	if err != nil {
+		return err
+	}
+	*v = make(MeasurementQuantities, n)
+	for i := range *v {
+		field, err := readField(d)
+		if err != nil {
+			return err
+		}
+		item := d.Sub(field.Value)
+		skip, err := readExtensible(item)
+		if err != nil {
+			return err
+		}
+		extensions, err := item.ReadBool()
+		if err != nil {
+			return err
+		}
+		quantity, err := item.ReadEnumerated(int(measurementQuantities), true)
+		if err != nil {
+			return err
+		}
+		(*v)[i] = MeasurementQuantity(quantity)
+		if err := skipIEExtensions(item, extensions); err != nil {
+			return err
+		}
+		if err := skip(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CauseGroup is the group of a cause.
+type CauseGroup int
+
+const (
+	CauseGroupRadioNetwork CauseGroup = iota
+	CauseGroupProtocol
+	CauseGroupMisc
+)
+
+// Causes of the radio network group.
+const (
+	CauseRadioNetworkUnspecified = iota
+	CauseRequestedItemNotSupported
+	CauseRequestedItemTemporarilyNotAvailable
+)
+
+// Causes of the protocol group.
+const (
+	CauseTransferSyntaxError = iota
+	CauseAbstractSyntaxErrorReject
+	CauseAbstractSyntaxErrorIgnoreAndNotify
+	CauseMessageNotCompatibleWithReceiverState
+	CauseSemanticError
+	CauseProtocolUnspecified
+	CauseAbstractSyntaxErrorFalselyConstructedMessage
+)
+
+// CauseMiscUnspecified is the only cause of the misc group.
+const CauseMiscUnspecified = 0
+
+// causeCounts are the numbers of root causes of the groups.
+var causeCounts = [...]int{3, 7, 1}
+
+// Cause is the cause of a failure.
+type Cause struct {
+	Group CauseGroup
+	Value int
+}
+
+func (v *Cause) encode(e *per.Encoder) error {
+	if v.Group < 0 || int(v.Group) >= len(causeCounts) {
+		return fmt.Errorf("cause group %d", v.Group)
+	}
+	return e.WriteChoice(int(v.Group), len(causeCounts)+1, false, func(e *per.Encoder) error {
+		return e.WriteEnumerated(v.Value, causeCounts[v.Group], true)
+	})
+}
+
+func (v *Cause) decode(d *per.Decoder) error {
+	group, _, err := d.ReadChoice(len(causeCounts)+1, false)
+	if err != nil {
+		return err
+	}
+	if group == len(causeCounts) {
+		return fmt.Errorf("%w: cause extension", ErrUnsupported)
+	}
+	v.Group = CauseGroup(group)
+	v.Value, err = d.ReadEnumerated(causeCounts[group], true)
+	return err
+}
+
+// CGI is the global identity of an NR or E-UTRA cell.
+type CGI struct {
+	// MCC is the mobile country code of 3 digits, and MNC the mobile network code of 2 or 3 digits.
+	MCC string
+	MNC string
+	// CellID is the NR cell identity of 36 bits, or the E-UTRA cell identity of 28 bits when EUTRA is true.
+	CellID uint64
+	EUTRA  bool
+}
+
+// encodeNGRAN writes an NG-RAN-CGI.
+func (c *CGI) encodeNGRAN(e *per.Encoder) error {
+	e.WriteBool(false)
+	e.WriteBool(false)
+	if err := writePLMNIdentity(e, c.MCC, c.MNC); err != nil {
+		return err
+	}
+	index, length := 1, 36
+	if c.EUTRA {
+		index, length = 0, 28
+	}
+	if c.CellID>>uint(length) != 0 {
+		return fmt.Errorf("cell identity %d out of %d bits", c.CellID, length)
+	}
+	return e.WriteChoice(index, 3, false, func(e *per.Encoder) error {
+		return e.WriteBitString(bitString(c.CellID, length), length, length, false)
+	})
+}
+
+func (c *CGI) decodeNGRAN(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	extensions, err := d.ReadBool()
+	if err != nil {
+		return err
+	}
+	if c.MCC, c.MNC, err = readPLMNIdentity(d); err != nil {
+		return err
+	}
+	index, _, err := d.ReadChoice(3, false)
+	if err != nil {
+		return err
+	}
+	if index == 2 {
+		return fmt.Errorf("%w: NG-RAN cell extension", ErrUnsupported)
+	}
+	length := 36
+	if c.EUTRA = index == 0; c.EUTRA {
+		length = 28
+	}
+	b, err := d.ReadBitString(length, length, false)
+	if err != nil {
+		return err
+	}
+	c.CellID = bitStringValue(b)
+	if err := skipIEExtensions(d, extensions); err != nil {
+		return err
+	}
+	return skip()
+}
+
+// encodeEUTRA writes a CGI-EUTRA.
+func (c *CGI) encodeEUTRA(e *per.Encoder) error {
+	if !c.EUTRA || c.CellID>>28 != 0 {
+		return fmt.Errorf("E-UTRA cell identity %d out of 28 bits", c.CellID)
+	}
+	e.WriteBool(false)
+	e.WriteBool(false)
+	if err := writePLMNIdentity(e, c.MCC, c.MNC); err != nil {
+		return err
+	}
+	return e.WriteBitString(bitString(c.CellID, 28), 28, 28, false)
+}
+
+func (c *CGI) decodeEUTRA(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	extensions, err := d.ReadBool()
+	if err != nil {
+		return err
+	}
+	if c.MCC, c.MNC, err = readPLMNIdentity(d); err != nil {
+		return err
+	}
+	b, err := d.ReadBitString(28, 28, false)
+	if err != nil {
+		return err
+	}
+	c.CellID, c.EUTRA = bitStringValue(b), true
+	if err := skipIEExtensions(d, extensions); err != nil {
+		return err
+	}
+	return skip()
+}
+
+// encodeNR writes a CGI-NR.
+func (c *CGI) encodeNR(e *per.Encoder) error {
+	if c.EUTRA || c.CellID>>36 != 0 {
+		return fmt.Errorf("NR cell identity %d out of 36 bits", c.CellID)
+	}
+	writeSequence(e)
+	if err := writePLMNIdentity(e, c.MCC, c.MNC); err != nil {
+		return err
+	}
+	return e.WriteBitString(bitString(c.CellID, 36), 36, 36, false)
+}
+
+func (c *CGI) decodeNR(d *per.Decoder) error {
+	return readSequence(d, 0, func([]bool) error {
+		var err error
+		if c.MCC, c.MNC, err = readPLMNIdentity(d); err != nil {
+			return err
+		}
+		b, err := d.ReadBitString(36, 36, false)
+		c.CellID, c.EUTRA = bitStringValue(b), false
+		return err
+	})
+}
+
+// bitString returns the BIT STRING of a value of length bits, such as a cell identity.
+func bitString(v uint64, length int) per.BitString {
+	var set []int
+	for i := 0; i < length; i++ {
+		if v&(1<<uint(length-1-i)) != 0 {
+			set = append(set, i)
+		}
+	}
+	return per.NewBitString(length, set...)
+}
+
+func bitStringValue(b per.BitString) uint64 {
+	var v uint64
+	for i := 0; i < b.Length; i++ {
+		v <<= 1
+		if b.At(i) {
+			v |= 1
+		}
+	}
+	return v
+}
+
+// writePLMNIdentity writes a PLMN-Identity, the MCC and the MNC digits in TBCD over 3 octets (TS 38.413 clause
+// 9.3.3.5), a filler replacing the third digit of a 2 digits MNC.
+func writePLMNIdentity(e *per.Encoder, mcc, mnc string) error {
+	if len(mcc) != 3 || (len(mnc) != 2 && len(mnc) != 3) {
+		return fmt.Errorf("invalid PLMN %s-%s", mcc, mnc)
+	}
+	for _, c := range mcc + mnc {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("invalid PLMN %s-%s", mcc, mnc)
+		}
+	}
+	mnc3 := byte(0xf)
+	if len(mnc) == 3 {
+		mnc3 = mnc[2] - '0'
+	}
+	b := []byte{(mcc[1]-'0')<<4 | (mcc[0] - '0'), mnc3<<4 | (mcc[2] - '0'), (mnc[1]-'0')<<4 | (mnc[0] - '0')}
+	return e.WriteOctetString(b, 3, 3, false)
+}
+
+func readPLMNIdentity(d *per.Decoder) (string, string, error) {
+	b, err := d.ReadOctetString(3, 3, false)
+	if err != nil {
+		return "", "", err
+	}
+	digit := func(n byte) (byte, error) {
+		if n > 9 {
+			return 0, fmt.Errorf("invalid PLMN identity %x", b)
+		}
+		return '0' + n, nil
+	}
+	var digits []byte
+	for _, n := range []byte{b[0] & 0xf, b[0] >> 4, b[1] & 0xf, b[2] & 0xf, b[2] >> 4, b[1] >> 4} {
+		if n == 0xf && len(digits) == 5 {
+			break
+		}
+		c, err := digit(n)
+		if err != nil {
+			return "", "", err
+		}
+		digits = append(digits, c)
+	}
+	return string(digits[:3]), string(digits[3:]), nil
+}
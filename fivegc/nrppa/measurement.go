@@ -0,0 +1,584 @@
+package nrppa
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// Limits of TS 38.455 clause 9.4.
+const (
+	maxNoOfMeasTRPs = 64
+	maxNoPosMeas    = 16384
+)
+
+// SystemFrameNumber is a system frame number, in [0, 1023].
+type SystemFrameNumber int32
+
+func (v *SystemFrameNumber) encode(e *per.Encoder) error {
+	return e.WriteConstrainedInt(int64(*v), 0, 1023)
+}
+
+func (v *SystemFrameNumber) decode(d *per.Decoder) error {
+	n, err := d.ReadConstrainedInt(0, 1023)
+	*v = SystemFrameNumber(n)
+	return err
+}
+
+// SlotNumber is a slot number, in [0, 79].
+type SlotNumber int32
+
+func (v *SlotNumber) encode(e *per.Encoder) error {
+	return e.WriteConstrainedInt(int64(*v), 0, 79)
+}
+
+func (v *SlotNumber) decode(d *per.Decoder) error {
+	n, err := d.ReadConstrainedInt(0, 79)
+	*v = SlotNumber(n)
+	return err
+}
+
+// enumeratedTrue is the value of the IEs of type ENUMERATED {true, ...}, present or absent.
+type enumeratedTrue struct{}
+
+func (v *enumeratedTrue) encode(e *per.Encoder) error {
+	return e.WriteEnumerated(0, 1, true)
+}
+
+func (v *enumeratedTrue) decode(d *per.Decoder) error {
+	_, err := d.ReadEnumerated(1, true)
+	return err
+}
+
+// TRPMeasurementRequest is a TRP requested to measure the SRS of a UE.
+type TRPMeasurementRequest struct {
+	TRPID        TRPID
+	SearchWindow *SearchWindow
+}
+
+// SearchWindow is the search window of the UL RTOA measurements.
+type SearchWindow struct {
+	// ExpectedPropagationDelay is in [-3841, 3841], and DelayUncertainty in [1, 246].
+	ExpectedPropagationDelay int32
+	DelayUncertainty         int32
+}
+
+// TRPMeasurementRequestList are the TRPs requested to measure, between 1 and 64.
+type TRPMeasurementRequestList []TRPMeasurementRequest
+
+func (v *TRPMeasurementRequestList) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoOfMeasTRPs); err != nil {
+		return err
+	}
+	for _, request := range *v {
+		writeSequence(e, request.SearchWindow != nil)
+		if err := writeTRPID(e, request.TRPID); err != nil {
+			return err
+		}
+		if request.SearchWindow == nil {
+			continue
+		}
+		writeSequence(e)
+		if err := e.WriteExtensibleInt(int64(request.SearchWindow.ExpectedPropagationDelay), -3841, 3841); err != nil {
+			return err
+		}
+		if err := e.WriteExtensibleInt(int64(request.SearchWindow.DelayUncertainty), 1, 246); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *TRPMeasurementRequestList) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoOfMeasTRPs)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPMeasurementRequestList, n)
+	for i := range *v {
+		request := &(*v)[i]
+		if err := readSequence(d, 1, func(present []bool) error {
+			var err error
+			if request.TRPID, err = readTRPID(d); err != nil || !present[0] {
+				return err
+			}
+			request.SearchWindow = &SearchWindow{}
+			return readSequence(d, 0, func([]bool) error {
+				if err := readExtensibleInt32(d, &request.SearchWindow.ExpectedPropagationDelay, -3841, 3841); err != nil {
+					return err
+				}
+				return readExtensibleInt32(d, &request.SearchWindow.DelayUncertainty, 1, 246)
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TRPMeasurementType is a type of TRP measurement.
+type TRPMeasurementType int
+
+const (
+	TRPMeasurementGNBRxTxTimeDiff TRPMeasurementType = iota
+	TRPMeasurementULSRSRSRP
+	TRPMeasurementULAoA
+	TRPMeasurementULRTOA
+	trpMeasurementTypes
+)
+
+// TRPMeasurementQuantity is a TRP measurement requested.
+type TRPMeasurementQuantity struct {
+	Type TRPMeasurementType
+	// TimingReportingGranularityFactor is the resolution index of the timing measurements, in [0, 5].
+	TimingReportingGranularityFactor *int32
+}
+
+// TRPMeasurementQuantities are the TRP measurements requested, between 1 and 16384.
+type TRPMeasurementQuantities []TRPMeasurementQuantity
+
+func (v *TRPMeasurementQuantities) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoPosMeas); err != nil {
+		return err
+	}
+	for _, quantity := range *v {
+		writeSequence(e, quantity.TimingReportingGranularityFactor != nil)
+		if err := e.WriteEnumerated(int(quantity.Type), int(trpMeasurementTypes), true); err != nil {
+			return err
+		}
+		if quantity.TimingReportingGranularityFactor != nil {
+			if err := e.WriteConstrainedInt(int64(*quantity.TimingReportingGranularityFactor), 0, 5); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *TRPMeasurementQuantities) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoPosMeas)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPMeasurementQuantities, n)
+	for i := range *v {
+		quantity := &(*v)[i]
+		if err := readSequence(d, 1, func(present []bool) error {
+			t, err := d.ReadEnumerated(int(trpMeasurementTypes), true)
+			if err != nil {
+				return err
+			}
+			quantity.Type = TRPMeasurementType(t)
+			if present[0] {
+				quantity.TimingReportingGranularityFactor = new(int32)
+				return readInt32(d, quantity.TimingReportingGranularityFactor, 0, 5)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TRPMeasurementResponse is the measurement result of a TRP.
+type TRPMeasurementResponse struct {
+	TRPID TRPID
+	// Results has between 1 and 16384 results.
+	Results []TRPMeasurementResult
+	// CGI is the NR cell of the TRP.
+	CGI *CGI
+}
+
+// TRPMeasurementResult is a TRP measurement result. The measurement beam information is not supported.
+type TRPMeasurementResult struct {
+	Value     TRPMeasuredResultsValue
+	TimeStamp TimeStamp
+	Quality   *TRPMeasurementQuality
+}
+
+// TRPMeasuredResultsValue is the value of a TRP measurement, only one of its fields is set.
+type TRPMeasuredResultsValue struct {
+	ULAoA *ULAoA
+	// ULSRSRSRP is the UL SRS-RSRP, in [0, 126].
+	ULSRSRSRP       *int32
+	ULRTOA          *TimingMeasurement
+	GNBRxTxTimeDiff *TimingMeasurement
+	// Extension is a result of the choice extension, in its single container.
+	Extension *IE
+}
+
+// ULAoA is an uplink angle of arrival, in steps of 0.1 degree. The LCS to GCS translation is not supported.
+type ULAoA struct {
+	// Azimuth is in [0, 3599], and Zenith in [0, 1799].
+	Azimuth int32
+	Zenith  *int32
+}
+
+// TimingMeasurement is a UL RTOA or a gNB Rx-Tx time difference measurement, without additional paths.
+type TimingMeasurement struct {
+	// K is the index of the reporting resolution, in [0, 5]. The value is in [0, 1970049] for K = 0, the range being
+	// halved at each index up to [0, 61565] for K = 5.
+	K     int32
+	Value int32
+}
+
+// timingMeasurements are the upper bounds of the values of the timing measurements, by resolution index.
+var timingMeasurements = []int64{1970049, 985025, 492513, 246257, 123129, 61565}
+
+// TimeStamp is the time of a TRP measurement.
+type TimeStamp struct {
+	SystemFrameNumber SystemFrameNumber
+	SubcarrierSpacing SubcarrierSpacing
+	// SlotIndex is the slot of the measurement at the subcarrier spacing, in [0, 9] at 15 kHz, the range being
+	// doubled at each spacing up to [0, 79] at 120 kHz.
+	SlotIndex       int32
+	MeasurementTime *RelativeTime1900
+}
+
+// TRPMeasurementQuality is the quality of a TRP measurement, only one of its fields is set.
+type TRPMeasurementQuality struct {
+	Timing *TimingMeasurementQuality
+	Angle  *AngleMeasurementQuality
+}
+
+// TimingMeasurementQuality is the quality of a timing measurement, in [0, 31], in units of its resolution.
+type TimingMeasurementQuality struct {
+	Quality    int32
+	Resolution TimingResolution
+}
+
+// TimingResolution is the resolution of the quality of a timing measurement.
+type TimingResolution int
+
+const (
+	Resolution0dot1m TimingResolution = iota
+	Resolution1m
+	Resolution10m
+	Resolution30m
+	timingResolutions
+)
+
+// AngleMeasurementQuality is the quality of an angle measurement, in [0, 255], in steps of 0.1 degree.
+type AngleMeasurementQuality struct {
+	Azimuth int32
+	Zenith  *int32
+}
+
+// Indexes of the alternatives of TrpMeasuredResultsValue.
+const (
+	trpResultULAoA = iota
+	trpResultULSRSRSRP
+	trpResultULRTOA
+	trpResultGNBRxTxTimeDiff
+	trpResultExtension
+	trpMeasuredResultsValues
+)
+
+// TRPMeasurementResponseList are the measurement results of the TRPs, between 1 and 64.
+type TRPMeasurementResponseList []TRPMeasurementResponse
+
+func (v *TRPMeasurementResponseList) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoOfMeasTRPs); err != nil {
+		return err
+	}
+	for _, response := range *v {
+		writeSequence(e, response.CGI != nil)
+		if err := writeTRPID(e, response.TRPID); err != nil {
+			return err
+		}
+		if err := e.WriteLength(len(response.Results), 1, maxNoPosMeas); err != nil {
+			return err
+		}
+		for i := range response.Results {
+			if err := response.Results[i].encode(e); err != nil {
+				return err
+			}
+		}
+		if response.CGI != nil {
+			if err := response.CGI.encodeNR(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *TRPMeasurementResponseList) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoOfMeasTRPs)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPMeasurementResponseList, n)
+	for i := range *v {
+		response := &(*v)[i]
+		if err := readSequence(d, 1, func(present []bool) error {
+			var err error
+			if response.TRPID, err = readTRPID(d); err != nil {
+				return err
+			}
+			n, err := d.ReadLength(1, maxNoPosMeas)
+			if err != nil {
+				return err
+			}
+			response.Results = make([]TRPMeasurementResult, n)
+			for i := range response.Results {
+				if err := response.Results[i].decode(d); err != nil {
+					return err
+				}
+			}
+			if present[0] {
+				response.CGI = &CGI{}
+				return response.CGI.decodeNR(d)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TRPMeasurementResult) encode(e *per.Encoder) error {
+	writeSequence(e, r.Quality != nil, false)
+	if err := r.Value.encode(e); err != nil {
+		return err
+	}
+	if err := r.TimeStamp.encode(e); err != nil {
+		return err
+	}
+	if r.Quality != nil {
+		return r.Quality.encode(e)
+	}
+	return nil
+}
+
+func (r *TRPMeasurementResult) decode(d *per.Decoder) error {
+	return readSequence(d, 2, func(present []bool) error {
+		if err := r.Value.decode(d); err != nil {
+			return err
+		}
+		if err := r.TimeStamp.decode(d); err != nil {
+			return err
+		}
+		if present[0] {
+			r.Quality = &TRPMeasurementQuality{}
+			if err := r.Quality.decode(d); err != nil {
+				return err
+			}
+		}
+		if present[1] {
+			return fmt.Errorf("%w: measurement beam information", ErrUnsupported)
+		}
+		return nil
+	})
+}
+
+func (v *TRPMeasuredResultsValue) encode(e *per.Encoder) error {
+	var index int
+	var value func(e *per.Encoder) error
+	switch {
+	case v.ULAoA != nil:
+		index, value = trpResultULAoA, v.ULAoA.encode
+	case v.ULSRSRSRP != nil:
+		index, value = trpResultULSRSRSRP, func(e *per.Encoder) error { return e.WriteConstrainedInt(int64(*v.ULSRSRSRP), 0, 126) }
+	case v.ULRTOA != nil:
+		index, value = trpResultULRTOA, v.ULRTOA.encode
+	case v.GNBRxTxTimeDiff != nil:
+		index, value = trpResultGNBRxTxTimeDiff, v.GNBRxTxTimeDiff.encode
+	case v.Extension != nil:
+		index, value = trpResultExtension, func(e *per.Encoder) error { return writeField(e, *v.Extension) }
+	default:
+		return fmt.Errorf("empty TRP measured result")
+	}
+	return e.WriteChoice(index, trpMeasuredResultsValues, false, value)
+}
+
+func (v *TRPMeasuredResultsValue) decode(d *per.Decoder) error {
+	index, _, err := d.ReadChoice(trpMeasuredResultsValues, false)
+	if err != nil {
+		return err
+	}
+	switch index {
+	case trpResultULAoA:
+		v.ULAoA = &ULAoA{}
+		return v.ULAoA.decode(d)
+	case trpResultULSRSRSRP:
+		v.ULSRSRSRP = new(int32)
+		return readInt32(d, v.ULSRSRSRP, 0, 126)
+	case trpResultULRTOA:
+		v.ULRTOA = &TimingMeasurement{}
+		return v.ULRTOA.decode(d)
+	case trpResultGNBRxTxTimeDiff:
+		v.GNBRxTxTimeDiff = &TimingMeasurement{}
+		return v.GNBRxTxTimeDiff.decode(d)
+	}
+	extension, err := readField(d)
+	v.Extension = &extension
+	return err
+}
+
+func (a *ULAoA) encode(e *per.Encoder) error {
+	writeSequence(e, a.Zenith != nil, false)
+	if err := e.WriteConstrainedInt(int64(a.Azimuth), 0, 3599); err != nil {
+		return err
+	}
+	if a.Zenith != nil {
+		return e.WriteConstrainedInt(int64(*a.Zenith), 0, 1799)
+	}
+	return nil
+}
+
+func (a *ULAoA) decode(d *per.Decoder) error {
+	return readSequence(d, 2, func(present []bool) error {
+		if err := readInt32(d, &a.Azimuth, 0, 3599); err != nil {
+			return err
+		}
+		if present[0] {
+			a.Zenith = new(int32)
+			if err := readInt32(d, a.Zenith, 0, 1799); err != nil {
+				return err
+			}
+		}
+		if present[1] {
+			return fmt.Errorf("%w: LCS to GCS translation", ErrUnsupported)
+		}
+		return nil
+	})
+}
+
+// encode writes a UL-RTOAMeasurement or a GNB-RxTxTimeDiff, which have the same structure.
+func (m *TimingMeasurement) encode(e *per.Encoder) error {
+	if m.K < 0 || int(m.K) >= len(timingMeasurements) {
+		return fmt.Errorf("timing measurement resolution %d", m.K)
+	}
+	writeSequence(e, false)
+	return e.WriteChoice(int(m.K), len(timingMeasurements)+1, false, func(e *per.Encoder) error {
+		return e.WriteConstrainedInt(int64(m.Value), 0, timingMeasurements[m.K])
+	})
+}
+
+func (m *TimingMeasurement) decode(d *per.Decoder) error {
+	return readSequence(d, 1, func(present []bool) error {
+		k, _, err := d.ReadChoice(len(timingMeasurements)+1, false)
+		if err != nil {
+			return err
+		}
+		if k == len(timingMeasurements) {
+			return fmt.Errorf("%w: timing measurement extension", ErrUnsupported)
+		}
+		m.K = int32(k)
+		if err := readInt32(d, &m.Value, 0, timingMeasurements[k]); err != nil {
+			return err
+		}
+		if present[0] {
+			return fmt.Errorf("%w: additional paths", ErrUnsupported)
+		}
+		return nil
+	})
+}
+
+func (t *TimeStamp) encode(e *per.Encoder) error {
+	if t.SubcarrierSpacing < 0 || t.SubcarrierSpacing >= subcarrierSpacings {
+		return fmt.Errorf("time stamp subcarrier spacing %d", t.SubcarrierSpacing)
+	}
+	writeSequence(e, t.MeasurementTime != nil)
+	if err := t.SystemFrameNumber.encode(e); err != nil {
+		return err
+	}
+	if err := e.WriteChoice(int(t.SubcarrierSpacing), int(subcarrierSpacings)+1, false, func(e *per.Encoder) error {
+		return e.WriteConstrainedInt(int64(t.SlotIndex), 0, 10<<uint(t.SubcarrierSpacing)-1)
+	}); err != nil {
+		return err
+	}
+	if t.MeasurementTime != nil {
+		return t.MeasurementTime.encode(e)
+	}
+	return nil
+}
+
+func (t *TimeStamp) decode(d *per.Decoder) error {
+	return readSequence(d, 1, func(present []bool) error {
+		if err := t.SystemFrameNumber.decode(d); err != nil {
+			return err
+		}
+		scs, _, err := d.ReadChoice(int(subcarrierSpacings)+1, false)
+		if err != nil {
+			return err
+		}
+		if scs == int(subcarrierSpacings) {
+			return fmt.Errorf("%w: time stamp slot index extension", ErrUnsupported)
+		}
+		t.SubcarrierSpacing = SubcarrierSpacing(scs)
+		if err := readInt32(d, &t.SlotIndex, 0, 10<<uint(scs)-1); err != nil {
+			return err
+		}
+		if present[0] {
+			t.MeasurementTime = new(RelativeTime1900)
+			return t.MeasurementTime.decode(d)
+		}
+		return nil
+	})
+}
+
+func (q *TRPMeasurementQuality) encode(e *per.Encoder) error {
+	switch {
+	case q.Timing != nil:
+		return e.WriteChoice(0, 3, false, func(e *per.Encoder) error {
+			writeSequence(e)
+			if err := e.WriteConstrainedInt(int64(q.Timing.Quality), 0, 31); err != nil {
+				return err
+			}
+			return e.WriteEnumerated(int(q.Timing.Resolution), int(timingResolutions), true)
+		})
+	case q.Angle != nil:
+		return e.WriteChoice(1, 3, false, func(e *per.Encoder) error {
+			writeSequence(e, q.Angle.Zenith != nil)
+			if err := e.WriteConstrainedInt(int64(q.Angle.Azimuth), 0, 255); err != nil {
+				return err
+			}
+			if q.Angle.Zenith != nil {
+				if err := e.WriteConstrainedInt(int64(*q.Angle.Zenith), 0, 255); err != nil {
+					return err
+				}
+			}
+			// The resolution is 0.1 degree, the single value of its ENUMERATED.
+			return e.WriteEnumerated(0, 1, true)
+		})
+	}
+	return fmt.Errorf("empty TRP measurement quality")
+}
+
+func (q *TRPMeasurementQuality) decode(d *per.Decoder) error {
+	index, _, err := d.ReadChoice(3, false)
+	if err != nil {
+		return err
+	}
+	switch index {
+	case 0:
+		q.Timing = &TimingMeasurementQuality{}
+		return readSequence(d, 0, func([]bool) error {
+			if err := readInt32(d, &q.Timing.Quality, 0, 31); err != nil {
+				return err
+			}
+			resolution, err := d.ReadEnumerated(int(timingResolutions), true)
+			q.Timing.Resolution = TimingResolution(resolution)
+			return err
+		})
+	case 1:
+		q.Angle = &AngleMeasurementQuality{}
+		return readSequence(d, 1, func(present []bool) error {
+			if err := readInt32(d, &q.Angle.Azimuth, 0, 255); err != nil {
+				return err
+			}
+			if present[0] {
+				q.Angle.Zenith = new(int32)
+				if err := readInt32(d, q.Angle.Zenith, 0, 255); err != nil {
+					return err
+				}
+			}
+			_, err := d.ReadEnumerated(1, true)
+			return err
+		})
+	}
+	return fmt.Errorf("%w: TRP measurement quality extension", ErrUnsupported)
+}
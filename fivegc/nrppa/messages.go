@@ -0,0 +1,709 @@
+package nrppa
+
+import (
+	"fmt"
+)
+
+// Message is a typed NRPPa message.
+type Message interface {
+	// procedure returns the type of PDU, the procedure code and the criticality of the procedure of the message.
+	procedure() (MessageType, ProcedureCode, Criticality)
+	ies() ([]IE, error)
+	parse([]IE) error
+}
+
+// NewPDU returns the PDU of a typed message.
+func NewPDU(transactionID uint16, m Message) (PDU, error) {
+	ies, err := m.ies()
+	if err != nil {
+		return PDU{}, err
+	}
+	messageType, code, criticality := m.procedure()
+	return PDU{
+		Type:          messageType,
+		ProcedureCode: code,
+		Criticality:   criticality,
+		TransactionID: transactionID,
+		IEs:           ies,
+	}, nil
+}
+
+type messageKey struct {
+	messageType MessageType
+	code        ProcedureCode
+}
+
+// messages are the typed messages, by type of PDU and procedure code.
+var messages = map[messageKey]func() Message{
+	{InitiatingMessage, ProcedureECIDMeasurementInitiation}:        func() Message { return &ECIDMeasurementInitiationRequest{} },
+	{SuccessfulOutcome, ProcedureECIDMeasurementInitiation}:        func() Message { return &ECIDMeasurementInitiationResponse{} },
+	{UnsuccessfulOutcome, ProcedureECIDMeasurementInitiation}:      func() Message { return &ECIDMeasurementInitiationFailure{} },
+	{InitiatingMessage, ProcedureECIDMeasurementFailureIndication}: func() Message { return &ECIDMeasurementFailureIndication{} },
+	{InitiatingMessage, ProcedureECIDMeasurementReport}:            func() Message { return &ECIDMeasurementReport{} },
+	{InitiatingMessage, ProcedureECIDMeasurementTermination}:       func() Message { return &ECIDMeasurementTerminationCommand{} },
+	{InitiatingMessage, ProcedureTRPInformationExchange}:           func() Message { return &TRPInformationRequest{} },
+	{SuccessfulOutcome, ProcedureTRPInformationExchange}:           func() Message { return &TRPInformationResponse{} },
+	{UnsuccessfulOutcome, ProcedureTRPInformationExchange}:         func() Message { return &TRPInformationFailure{} },
+	{InitiatingMessage, ProcedurePositioningInformationExchange}:   func() Message { return &PositioningInformationRequest{} },
+	{SuccessfulOutcome, ProcedurePositioningInformationExchange}:   func() Message { return &PositioningInformationResponse{} },
+	{UnsuccessfulOutcome, ProcedurePositioningInformationExchange}: func() Message { return &PositioningInformationFailure{} },
+	{InitiatingMessage, ProcedurePositioningInformationUpdate}:     func() Message { return &PositioningInformationUpdate{} },
+	{InitiatingMessage, ProcedureMeasurement}:                      func() Message { return &MeasurementRequest{} },
+	{SuccessfulOutcome, ProcedureMeasurement}:                      func() Message { return &MeasurementResponse{} },
+	{UnsuccessfulOutcome, ProcedureMeasurement}:                    func() Message { return &MeasurementFailure{} },
+	{InitiatingMessage, ProcedureMeasurementReport}:                func() Message { return &MeasurementReport{} },
+	{InitiatingMessage, ProcedureMeasurementUpdate}:                func() Message { return &MeasurementUpdate{} },
+	{InitiatingMessage, ProcedureMeasurementAbort}:                 func() Message { return &MeasurementAbort{} },
+	{InitiatingMessage, ProcedureMeasurementFailureIndication}:     func() Message { return &MeasurementFailureIndication{} },
+}
+
+// Message returns the typed message of the PDU. The IEs unknown to the message are ignored.
+func (p PDU) Message() (Message, error) {
+	newMessage, ok := messages[messageKey{p.Type, p.ProcedureCode}]
+	if !ok {
+		return nil, fmt.Errorf("%w: message %d of procedure %d", ErrUnsupported, p.Type, p.ProcedureCode)
+	}
+	m := newMessage()
+	if err := m.parse(p.IEs); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// builder encodes the IEs of a message, stopping at the first error.
+type builder struct {
+	ies []IE
+	err error
+}
+
+func (b *builder) add(id ProtocolIEID, criticality Criticality, v Value) {
+	if b.err != nil {
+		return
+	}
+	var ie IE
+	if ie, b.err = NewIE(id, criticality, v); b.err == nil {
+		b.ies = append(b.ies, ie)
+	}
+}
+
+// parser decodes the IEs of a message, stopping at the first error.
+type parser struct {
+	ies []IE
+	err error
+}
+
+func (p *parser) mandatory(id ProtocolIEID, v Value) {
+	if p.err != nil {
+		return
+	}
+	if !p.optional(id, v) && p.err == nil {
+		p.err = fmt.Errorf("%w: %d", ErrMissingIE, id)
+	}
+}
+
+// optional decodes the IE into v when it is present, and reports whether it was decoded.
+func (p *parser) optional(id ProtocolIEID, v Value) bool {
+	if p.err != nil {
+		return false
+	}
+	ie, ok := PDU{IEs: p.ies}.IE(id)
+	if !ok {
+		return false
+	}
+	p.err = ie.DecodeValue(v)
+	return p.err == nil
+}
+
+// ECIDMeasurementInitiationRequest requests E-CID measurements of a UE to the NG-RAN node.
+type ECIDMeasurementInitiationRequest struct {
+	LMFUEMeasurementID    UEMeasurementID
+	ReportCharacteristics ReportCharacteristics
+	// MeasurementPeriodicity is required for periodic reports.
+	MeasurementPeriodicity *MeasurementPeriodicity
+	MeasurementQuantities  MeasurementQuantities
+}
+
+func (m *ECIDMeasurementInitiationRequest) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureECIDMeasurementInitiation, Reject
+}
+
+func (m *ECIDMeasurementInitiationRequest) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDReportCharacteristics, Reject, &m.ReportCharacteristics)
+	if m.MeasurementPeriodicity != nil {
+		b.add(IDMeasurementPeriodicity, Reject, m.MeasurementPeriodicity)
+	}
+	b.add(IDMeasurementQuantities, Reject, &m.MeasurementQuantities)
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementInitiationRequest) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDReportCharacteristics, &m.ReportCharacteristics)
+	var periodicity MeasurementPeriodicity
+	if p.optional(IDMeasurementPeriodicity, &periodicity) {
+		m.MeasurementPeriodicity = &periodicity
+	}
+	p.mandatory(IDMeasurementQuantities, &m.MeasurementQuantities)
+	return p.err
+}
+
+// ECIDMeasurementInitiationResponse accepts an E-CID measurement initiation, with the result of a report on demand.
+type ECIDMeasurementInitiationResponse struct {
+	LMFUEMeasurementID    UEMeasurementID
+	RANUEMeasurementID    UEMeasurementID
+	ECIDMeasurementResult *ECIDMeasurementResult
+}
+
+func (m *ECIDMeasurementInitiationResponse) procedure() (MessageType, ProcedureCode, Criticality) {
+	return SuccessfulOutcome, ProcedureECIDMeasurementInitiation, Reject
+}
+
+func (m *ECIDMeasurementInitiationResponse) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDRANUEMeasurementID, Reject, &m.RANUEMeasurementID)
+	if m.ECIDMeasurementResult != nil {
+		b.add(IDECIDMeasurementResult, Ignore, m.ECIDMeasurementResult)
+	}
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementInitiationResponse) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDRANUEMeasurementID, &m.RANUEMeasurementID)
+	var result ECIDMeasurementResult
+	if p.optional(IDECIDMeasurementResult, &result) {
+		m.ECIDMeasurementResult = &result
+	}
+	return p.err
+}
+
+// ECIDMeasurementInitiationFailure rejects an E-CID measurement initiation.
+type ECIDMeasurementInitiationFailure struct {
+	LMFUEMeasurementID UEMeasurementID
+	Cause              Cause
+}
+
+func (m *ECIDMeasurementInitiationFailure) procedure() (MessageType, ProcedureCode, Criticality) {
+	return UnsuccessfulOutcome, ProcedureECIDMeasurementInitiation, Reject
+}
+
+func (m *ECIDMeasurementInitiationFailure) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementInitiationFailure) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
+
+// ECIDMeasurementFailureIndication indicates that the E-CID measurements of a UE can no longer be reported.
+type ECIDMeasurementFailureIndication struct {
+	LMFUEMeasurementID UEMeasurementID
+	RANUEMeasurementID UEMeasurementID
+	Cause              Cause
+}
+
+func (m *ECIDMeasurementFailureIndication) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureECIDMeasurementFailureIndication, Ignore
+}
+
+func (m *ECIDMeasurementFailureIndication) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDRANUEMeasurementID, Reject, &m.RANUEMeasurementID)
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementFailureIndication) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDRANUEMeasurementID, &m.RANUEMeasurementID)
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
+
+// ECIDMeasurementReport is a periodic E-CID measurement report.
+type ECIDMeasurementReport struct {
+	LMFUEMeasurementID    UEMeasurementID
+	RANUEMeasurementID    UEMeasurementID
+	ECIDMeasurementResult ECIDMeasurementResult
+}
+
+func (m *ECIDMeasurementReport) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureECIDMeasurementReport, Ignore
+}
+
+func (m *ECIDMeasurementReport) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDRANUEMeasurementID, Reject, &m.RANUEMeasurementID)
+	b.add(IDECIDMeasurementResult, Ignore, &m.ECIDMeasurementResult)
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementReport) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDRANUEMeasurementID, &m.RANUEMeasurementID)
+	p.mandatory(IDECIDMeasurementResult, &m.ECIDMeasurementResult)
+	return p.err
+}
+
+// ECIDMeasurementTerminationCommand terminates the E-CID measurements of a UE.
+type ECIDMeasurementTerminationCommand struct {
+	LMFUEMeasurementID UEMeasurementID
+	RANUEMeasurementID UEMeasurementID
+}
+
+func (m *ECIDMeasurementTerminationCommand) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureECIDMeasurementTermination, Reject
+}
+
+func (m *ECIDMeasurementTerminationCommand) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFUEMeasurementID, Reject, &m.LMFUEMeasurementID)
+	b.add(IDRANUEMeasurementID, Reject, &m.RANUEMeasurementID)
+	return b.ies, b.err
+}
+
+func (m *ECIDMeasurementTerminationCommand) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFUEMeasurementID, &m.LMFUEMeasurementID)
+	p.mandatory(IDRANUEMeasurementID, &m.RANUEMeasurementID)
+	return p.err
+}
+
+// TRPInformationRequest requests information about the TRPs of the NG-RAN node.
+type TRPInformationRequest struct {
+	// TRPList are the TRPs whose information is requested, all of them when empty.
+	TRPList             TRPList
+	TRPInformationTypes TRPInformationTypes
+}
+
+func (m *TRPInformationRequest) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureTRPInformationExchange, Reject
+}
+
+func (m *TRPInformationRequest) ies() ([]IE, error) {
+	b := builder{}
+	if len(m.TRPList) > 0 {
+		b.add(IDTRPList, Ignore, &m.TRPList)
+	}
+	b.add(IDTRPInformationTypeListTRPReq, Reject, &m.TRPInformationTypes)
+	return b.ies, b.err
+}
+
+func (m *TRPInformationRequest) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.optional(IDTRPList, &m.TRPList)
+	p.mandatory(IDTRPInformationTypeListTRPReq, &m.TRPInformationTypes)
+	return p.err
+}
+
+// TRPInformationResponse is the information about the TRPs of the NG-RAN node.
+type TRPInformationResponse struct {
+	TRPInformationList TRPInformationList
+}
+
+func (m *TRPInformationResponse) procedure() (MessageType, ProcedureCode, Criticality) {
+	return SuccessfulOutcome, ProcedureTRPInformationExchange, Reject
+}
+
+func (m *TRPInformationResponse) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDTRPInformationListTRPResp, Ignore, &m.TRPInformationList)
+	return b.ies, b.err
+}
+
+func (m *TRPInformationResponse) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDTRPInformationListTRPResp, &m.TRPInformationList)
+	return p.err
+}
+
+// TRPInformationFailure rejects a TRP information request.
+type TRPInformationFailure struct {
+	Cause Cause
+}
+
+func (m *TRPInformationFailure) procedure() (MessageType, ProcedureCode, Criticality) {
+	return UnsuccessfulOutcome, ProcedureTRPInformationExchange, Reject
+}
+
+func (m *TRPInformationFailure) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *TRPInformationFailure) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
+
+// PositioningInformationRequest requests the SRS configuration of a UE to the serving NG-RAN node.
+type PositioningInformationRequest struct {
+	RequestedSRSTransmissionCharacteristics *RequestedSRSTransmissionCharacteristics
+}
+
+func (m *PositioningInformationRequest) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedurePositioningInformationExchange, Reject
+}
+
+func (m *PositioningInformationRequest) ies() ([]IE, error) {
+	b := builder{}
+	if m.RequestedSRSTransmissionCharacteristics != nil {
+		b.add(IDRequestedSRSTransmissionCharacteristics, Ignore, m.RequestedSRSTransmissionCharacteristics)
+	}
+	return b.ies, b.err
+}
+
+func (m *PositioningInformationRequest) parse(ies []IE) error {
+	p := parser{ies: ies}
+	var characteristics RequestedSRSTransmissionCharacteristics
+	if p.optional(IDRequestedSRSTransmissionCharacteristics, &characteristics) {
+		m.RequestedSRSTransmissionCharacteristics = &characteristics
+	}
+	return p.err
+}
+
+// PositioningInformationResponse is the SRS configuration of a UE.
+type PositioningInformationResponse struct {
+	SRSConfiguration      *SRSConfiguration
+	SFNInitialisationTime *RelativeTime1900
+}
+
+func (m *PositioningInformationResponse) procedure() (MessageType, ProcedureCode, Criticality) {
+	return SuccessfulOutcome, ProcedurePositioningInformationExchange, Reject
+}
+
+func (m *PositioningInformationResponse) ies() ([]IE, error) {
+	return srsConfigurationIEs(m.SRSConfiguration, m.SFNInitialisationTime)
+}
+
+func (m *PositioningInformationResponse) parse(ies []IE) error {
+	var err error
+	m.SRSConfiguration, m.SFNInitialisationTime, err = parseSRSConfiguration(ies)
+	return err
+}
+
+// PositioningInformationFailure rejects a positioning information request.
+type PositioningInformationFailure struct {
+	Cause Cause
+}
+
+func (m *PositioningInformationFailure) procedure() (MessageType, ProcedureCode, Criticality) {
+	return UnsuccessfulOutcome, ProcedurePositioningInformationExchange, Reject
+}
+
+func (m *PositioningInformationFailure) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *PositioningInformationFailure) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
+
+// PositioningInformationUpdate indicates a change of the SRS configuration of a UE.
+type PositioningInformationUpdate struct {
+	SRSConfiguration      *SRSConfiguration
+	SFNInitialisationTime *RelativeTime1900
+}
+
+func (m *PositioningInformationUpdate) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedurePositioningInformationUpdate, Ignore
+}
+
+func (m *PositioningInformationUpdate) ies() ([]IE, error) {
+	return srsConfigurationIEs(m.SRSConfiguration, m.SFNInitialisationTime)
+}
+
+func (m *PositioningInformationUpdate) parse(ies []IE) error {
+	var err error
+	m.SRSConfiguration, m.SFNInitialisationTime, err = parseSRSConfiguration(ies)
+	return err
+}
+
+// srsConfigurationIEs returns the IEs of the positioning information response and update.
+func srsConfigurationIEs(configuration *SRSConfiguration, sfnInitialisationTime *RelativeTime1900) ([]IE, error) {
+	b := builder{}
+	if configuration != nil {
+		b.add(IDSRSConfiguration, Ignore, configuration)
+	}
+	if sfnInitialisationTime != nil {
+		b.add(IDSFNInitialisationTime, Ignore, sfnInitialisationTime)
+	}
+	return b.ies, b.err
+}
+
+func parseSRSConfiguration(ies []IE) (*SRSConfiguration, *RelativeTime1900, error) {
+	p := parser{ies: ies}
+	var configuration *SRSConfiguration
+	var c SRSConfiguration
+	if p.optional(IDSRSConfiguration, &c) {
+		configuration = &c
+	}
+	var sfnInitialisationTime *RelativeTime1900
+	var t RelativeTime1900
+	if p.optional(IDSFNInitialisationTime, &t) {
+		sfnInitialisationTime = &t
+	}
+	return configuration, sfnInitialisationTime, p.err
+}
+
+// MeasurementRequest requests measurements of the SRS of a UE to TRPs of the NG-RAN node.
+type MeasurementRequest struct {
+	LMFMeasurementID          MeasurementID
+	TRPMeasurementRequestList TRPMeasurementRequestList
+	ReportCharacteristics     ReportCharacteristics
+	// MeasurementPeriodicity is required for periodic reports.
+	MeasurementPeriodicity   *MeasurementPeriodicity
+	TRPMeasurementQuantities TRPMeasurementQuantities
+	SFNInitialisationTime    *RelativeTime1900
+	SRSConfiguration         *SRSConfiguration
+	// MeasurementBeamInfoRequest requests the beam information of the measurements.
+	MeasurementBeamInfoRequest bool
+	SystemFrameNumber          *SystemFrameNumber
+	SlotNumber                 *SlotNumber
+}
+
+func (m *MeasurementRequest) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureMeasurement, Reject
+}
+
+func (m *MeasurementRequest) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDTRPMeasurementRequestList, Reject, &m.TRPMeasurementRequestList)
+	b.add(IDReportCharacteristics, Reject, &m.ReportCharacteristics)
+	if m.MeasurementPeriodicity != nil {
+		b.add(IDMeasurementPeriodicity, Reject, m.MeasurementPeriodicity)
+	}
+	b.add(IDTRPMeasurementQuantities, Reject, &m.TRPMeasurementQuantities)
+	if m.SFNInitialisationTime != nil {
+		b.add(IDSFNInitialisationTime, Ignore, m.SFNInitialisationTime)
+	}
+	if m.SRSConfiguration != nil {
+		b.add(IDSRSConfiguration, Ignore, m.SRSConfiguration)
+	}
+	if m.MeasurementBeamInfoRequest {
+		b.add(IDMeasurementBeamInfoRequest, Ignore, &enumeratedTrue{})
+	}
+	if m.SystemFrameNumber != nil {
+		b.add(IDSystemFrameNumber, Ignore, m.SystemFrameNumber)
+	}
+	if m.SlotNumber != nil {
+		b.add(IDSlotNumber, Ignore, m.SlotNumber)
+	}
+	return b.ies, b.err
+}
+
+func (m *MeasurementRequest) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDTRPMeasurementRequestList, &m.TRPMeasurementRequestList)
+	p.mandatory(IDReportCharacteristics, &m.ReportCharacteristics)
+	var periodicity MeasurementPeriodicity
+	if p.optional(IDMeasurementPeriodicity, &periodicity) {
+		m.MeasurementPeriodicity = &periodicity
+	}
+	p.mandatory(IDTRPMeasurementQuantities, &m.TRPMeasurementQuantities)
+	var sfnInitialisationTime RelativeTime1900
+	if p.optional(IDSFNInitialisationTime, &sfnInitialisationTime) {
+		m.SFNInitialisationTime = &sfnInitialisationTime
+	}
+	var configuration SRSConfiguration
+	if p.optional(IDSRSConfiguration, &configuration) {
+		m.SRSConfiguration = &configuration
+	}
+	m.MeasurementBeamInfoRequest = p.optional(IDMeasurementBeamInfoRequest, &enumeratedTrue{})
+	var sfn SystemFrameNumber
+	if p.optional(IDSystemFrameNumber, &sfn) {
+		m.SystemFrameNumber = &sfn
+	}
+	var slot SlotNumber
+	if p.optional(IDSlotNumber, &slot) {
+		m.SlotNumber = &slot
+	}
+	return p.err
+}
+
+// MeasurementResponse accepts a measurement request, with the results of a report on demand.
+type MeasurementResponse struct {
+	LMFMeasurementID MeasurementID
+	RANMeasurementID MeasurementID
+	// TRPMeasurementResponseList is absent when empty.
+	TRPMeasurementResponseList TRPMeasurementResponseList
+}
+
+func (m *MeasurementResponse) procedure() (MessageType, ProcedureCode, Criticality) {
+	return SuccessfulOutcome, ProcedureMeasurement, Reject
+}
+
+func (m *MeasurementResponse) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDRANMeasurementID, Reject, &m.RANMeasurementID)
+	if len(m.TRPMeasurementResponseList) > 0 {
+		b.add(IDTRPMeasurementResponseList, Reject, &m.TRPMeasurementResponseList)
+	}
+	return b.ies, b.err
+}
+
+func (m *MeasurementResponse) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDRANMeasurementID, &m.RANMeasurementID)
+	p.optional(IDTRPMeasurementResponseList, &m.TRPMeasurementResponseList)
+	return p.err
+}
+
+// MeasurementFailure rejects a measurement request.
+type MeasurementFailure struct {
+	LMFMeasurementID MeasurementID
+	Cause            Cause
+}
+
+func (m *MeasurementFailure) procedure() (MessageType, ProcedureCode, Criticality) {
+	return UnsuccessfulOutcome, ProcedureMeasurement, Reject
+}
+
+func (m *MeasurementFailure) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *MeasurementFailure) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
+
+// MeasurementReport is a periodic report of the measurement results of the TRPs.
+type MeasurementReport struct {
+	LMFMeasurementID         MeasurementID
+	RANMeasurementID         MeasurementID
+	TRPMeasurementReportList TRPMeasurementResponseList
+}
+
+func (m *MeasurementReport) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureMeasurementReport, Ignore
+}
+
+func (m *MeasurementReport) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDRANMeasurementID, Reject, &m.RANMeasurementID)
+	b.add(IDTRPMeasurementReportList, Reject, &m.TRPMeasurementReportList)
+	return b.ies, b.err
+}
+
+func (m *MeasurementReport) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDRANMeasurementID, &m.RANMeasurementID)
+	p.mandatory(IDTRPMeasurementReportList, &m.TRPMeasurementReportList)
+	return p.err
+}
+
+// MeasurementUpdate updates the SRS configuration of ongoing measurements.
+type MeasurementUpdate struct {
+	LMFMeasurementID MeasurementID
+	RANMeasurementID MeasurementID
+	SRSConfiguration *SRSConfiguration
+}
+
+func (m *MeasurementUpdate) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureMeasurementUpdate, Ignore
+}
+
+func (m *MeasurementUpdate) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDRANMeasurementID, Reject, &m.RANMeasurementID)
+	if m.SRSConfiguration != nil {
+		b.add(IDSRSConfiguration, Ignore, m.SRSConfiguration)
+	}
+	return b.ies, b.err
+}
+
+func (m *MeasurementUpdate) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDRANMeasurementID, &m.RANMeasurementID)
+	var configuration SRSConfiguration
+	if p.optional(IDSRSConfiguration, &configuration) {
+		m.SRSConfiguration = &configuration
+	}
+	return p.err
+}
+
+// MeasurementAbort aborts ongoing measurements.
+type MeasurementAbort struct {
+	LMFMeasurementID MeasurementID
+	RANMeasurementID MeasurementID
+}
+
+func (m *MeasurementAbort) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureMeasurementAbort, Ignore
+}
+
+func (m *MeasurementAbort) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDRANMeasurementID, Reject, &m.RANMeasurementID)
+	return b.ies, b.err
+}
+
+func (m *MeasurementAbort) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDRANMeasurementID, &m.RANMeasurementID)
+	return p.err
+}
+
+// MeasurementFailureIndication indicates that the measurements can no longer be reported.
+type MeasurementFailureIndication struct {
+	LMFMeasurementID MeasurementID
+	RANMeasurementID MeasurementID
+	Cause            Cause
+}
+
+func (m *MeasurementFailureIndication) procedure() (MessageType, ProcedureCode, Criticality) {
+	return InitiatingMessage, ProcedureMeasurementFailureIndication, Ignore
+}
+
+func (m *MeasurementFailureIndication) ies() ([]IE, error) {
+	b := builder{}
+	b.add(IDLMFMeasurementID, Reject, &m.LMFMeasurementID)
+	b.add(IDRANMeasurementID, Reject, &m.RANMeasurementID)
+	b.add(IDCause, Ignore, &m.Cause)
+	return b.ies, b.err
+}
+
+func (m *MeasurementFailureIndication) parse(ies []IE) error {
+	p := parser{ies: ies}
+	p.mandatory(IDLMFMeasurementID, &m.LMFMeasurementID)
+	p.mandatory(IDRANMeasurementID, &m.RANMeasurementID)
+	p.mandatory(IDCause, &m.Cause)
+	return p.err
+}
@@ -0,0 +1,400 @@
+// Package nrppa implements the NR Positioning Protocol A of TS 38.455, exchanged between the LMF and the NG-RAN node
+// in the N2 NRPPa containers of the Namf_Communication service (TS 23.273 clause 6.11.2).
+//
+// Messages are encoded with the ALIGNED variant of the Packed Encoding Rules, as required by TS 38.455 clause 9.4.
+// The PDU is encoded with its protocol IEs, whose values are kept in their encoding so that any procedure can be
+// carried. The values of the following IEs are typed:
+//   - the measurement identifiers, the report characteristics, the measurement periodicity and the cause;
+//   - the E-CID measurement quantities and results, with the E-UTRA results and the access point position;
+//   - the TRP list, and the TRP information types and responses limited to the PCI, the CGI and the ARFCN;
+//   - the requested SRS transmission characteristics, without the SRS resource sets and the SSB information;
+//   - the SRS configuration, without the spatial relations of the positioning SRS resources;
+//   - the TRP measurement requests, quantities and results, without the additional paths, the LCS to GCS translation
+//     of the angles of arrival and the measurement beam information.
+//
+// The E-CID measurement procedures, the TRP information exchange, the positioning information exchange and update,
+// and the measurement procedures have typed messages. Decoding a value using an unsupported component returns
+// ErrUnsupported.
+package nrppa
+
+import (
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+var (
+	// ErrUnsupported is returned when decoding a message or a value not covered by the package.
+	ErrUnsupported = errors.New("nrppa: unsupported message or value")
+	// ErrMissingIE is returned when a mandatory IE is missing from a message.
+	ErrMissingIE = errors.New("nrppa: missing mandatory IE")
+)
+
+// Criticality is the criticality of a procedure or an IE.
+type Criticality int
+
+const (
+	Reject Criticality = iota
+	Ignore
+	Notify
+)
+
+// MessageType is the type of an NRPPa PDU.
+type MessageType int
+
+const (
+	InitiatingMessage MessageType = iota
+	SuccessfulOutcome
+	UnsuccessfulOutcome
+)
+
+// ProcedureCode identifies an elementary procedure (TS 38.455 clause 9.3.7).
+type ProcedureCode int
+
+const (
+	ProcedureErrorIndication ProcedureCode = iota
+	ProcedurePrivateMessage
+	ProcedureECIDMeasurementInitiation
+	ProcedureECIDMeasurementFailureIndication
+	ProcedureECIDMeasurementReport
+	ProcedureECIDMeasurementTermination
+	ProcedureOTDOAInformationExchange
+	ProcedureAssistanceInformationControl
+	ProcedureAssistanceInformationFeedback
+	ProcedurePositioningInformationExchange
+	ProcedurePositioningInformationUpdate
+	ProcedureMeasurement
+	ProcedureMeasurementReport
+	ProcedureMeasurementUpdate
+	ProcedureMeasurementAbort
+	ProcedureMeasurementFailureIndication
+	ProcedureTRPInformationExchange
+	ProcedurePositioningActivation
+	ProcedurePositioningDeactivation
+)
+
+// ProtocolIEID identifies a protocol IE (TS 38.455 clause 9.3.7).
+type ProtocolIEID int
+
+const (
+	IDCause                                   ProtocolIEID = 0
+	IDCriticalityDiagnostics                  ProtocolIEID = 1
+	IDLMFUEMeasurementID                      ProtocolIEID = 2
+	IDReportCharacteristics                   ProtocolIEID = 3
+	IDMeasurementPeriodicity                  ProtocolIEID = 4
+	IDMeasurementQuantities                   ProtocolIEID = 5
+	IDRANUEMeasurementID                      ProtocolIEID = 6
+	IDECIDMeasurementResult                   ProtocolIEID = 7
+	IDMeasurementQuantitiesItem               ProtocolIEID = 11
+	IDRequestedSRSTransmissionCharacteristics ProtocolIEID = 12
+	IDSRSConfiguration                        ProtocolIEID = 26
+	IDTRPInformationTypeListTRPReq            ProtocolIEID = 29
+	IDTRPInformationListTRPResp               ProtocolIEID = 30
+	IDMeasurementBeamInfoRequest              ProtocolIEID = 31
+	IDLMFMeasurementID                        ProtocolIEID = 39
+	IDRANMeasurementID                        ProtocolIEID = 40
+	IDTRPMeasurementRequestList               ProtocolIEID = 41
+	IDTRPMeasurementResponseList              ProtocolIEID = 42
+	IDTRPMeasurementReportList                ProtocolIEID = 43
+	IDTRPList                                 ProtocolIEID = 47
+	IDSystemFrameNumber                       ProtocolIEID = 49
+	IDSlotNumber                              ProtocolIEID = 50
+	IDTRPMeasurementQuantities                ProtocolIEID = 52
+	IDSFNInitialisationTime                   ProtocolIEID = 54
+	IDTRPInformationTypeItem                  ProtocolIEID = 57
+)
+
+// PDU is an NRPPA-PDU.
+type PDU struct {
+	Type          MessageType
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	// TransactionID is the NRPPa transaction ID, in [0, 32767].
+	TransactionID uint16
+	IEs           []IE
+}
+
+// IE is a protocol IE, whose value is kept in its encoding.
+type IE struct {
+	ID          ProtocolIEID
+	Criticality Criticality
+	Value       []byte
+}
+
+// Value is the value of an IE typed by the package. The values are pointers to the IE value types.
+type Value interface {
+	encode(*per.Encoder) error
+	decode(*per.Decoder) error
+}
+
+// NewIE returns an IE with the encoding of a value.
+func NewIE(id ProtocolIEID, criticality Criticality, v Value) (IE, error) {
+	e := per.NewEncoder(true)
+	if err := v.encode(e); err != nil {
+		return IE{}, fmt.Errorf("nrppa: IE %d: %w", id, err)
+	}
+	return IE{ID: id, Criticality: criticality, Value: e.Bytes()}, nil
+}
+
+// DecodeValue decodes the value of the IE into v.
+func (ie IE) DecodeValue(v Value) error {
+	if err := v.decode(per.NewDecoder(ie.Value, true)); err != nil {
+		if errors.Is(err, ErrUnsupported) {
+			return err
+		}
+		return fmt.Errorf("nrppa: IE %d: %w", ie.ID, err)
+	}
+	return nil
+}
+
+// IE returns the first IE of the PDU with the id.
+func (p PDU) IE(id ProtocolIEID) (IE, bool) {
+	for _, ie := range p.IEs {
+		if ie.ID == id {
+			return ie, true
+		}
+	}
+	return IE{}, false
+}
+
+// Limits of TS 38.455 clause 9.4.
+const (
+	maxProtocolIEs   = 65535
+	maxTransactionID = 32767
+)
+
+// Encode returns the encoding of an NRPPa PDU.
+func Encode(p PDU) ([]byte, error) {
+	e := per.NewEncoder(true)
+	if err := p.encode(e); err != nil {
+		return nil, fmt.Errorf("nrppa: %w", err)
+	}
+	return e.Bytes(), nil
+}
+
+// Decode decodes an NRPPa PDU.
+func Decode(b []byte) (PDU, error) {
+	var p PDU
+	if err := p.decode(per.NewDecoder(b, true)); err != nil {
+		if errors.Is(err, ErrUnsupported) {
+			return PDU{}, err
+		}
+		return PDU{}, fmt.Errorf("nrppa: %w", err)
+	}
+	return p, nil
+}
+
+func (p PDU) encode(e *per.Encoder) error {
+	return e.WriteChoice(int(p.Type), 3, true, func(e *per.Encoder) error {
+		if err := e.WriteConstrainedInt(int64(p.ProcedureCode), 0, 255); err != nil {
+			return err
+		}
+		if err := e.WriteEnumerated(int(p.Criticality), 3, false); err != nil {
+			return err
+		}
+		if err := e.WriteConstrainedInt(int64(p.TransactionID), 0, maxTransactionID); err != nil {
+			return err
+		}
+		// The value is the message, an extensible SEQUENCE of its protocol IEs container.
+		return e.WriteOpenType(func(e *per.Encoder) error {
+			e.WriteBool(false)
+			return writeContainer(e, p.IEs, 0)
+		})
+	})
+}
+
+func (p *PDU) decode(d *per.Decoder) error {
+	messageType, _, err := d.ReadChoice(3, true)
+	if err != nil {
+		return err
+	}
+	if messageType >= 3 {
+		return fmt.Errorf("%w: PDU %d", ErrUnsupported, messageType)
+	}
+	p.Type = MessageType(messageType)
+	code, err := d.ReadConstrainedInt(0, 255)
+	if err != nil {
+		return err
+	}
+	p.ProcedureCode = ProcedureCode(code)
+	criticality, err := d.ReadEnumerated(3, false)
+	if err != nil {
+		return err
+	}
+	p.Criticality = Criticality(criticality)
+	transactionID, err := d.ReadConstrainedInt(0, maxTransactionID)
+	if err != nil {
+		return err
+	}
+	p.TransactionID = uint16(transactionID)
+	value, err := d.ReadOpenType()
+	if err != nil {
+		return err
+	}
+	message := d.Sub(value)
+	skip, err := readExtensible(message)
+	if err != nil {
+		return err
+	}
+	if p.IEs, err = readContainer(message, 0); err != nil {
+		return err
+	}
+	return skip()
+}
+
+// writeContainer writes a ProtocolIE-Container of at least lb IEs.
+func writeContainer(e *per.Encoder, ies []IE, lb int) error {
+	if err := e.WriteLength(len(ies), lb, maxProtocolIEs); err != nil {
+		return err
+	}
+	for _, ie := range ies {
+		if err := writeField(e, ie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeField writes a ProtocolIE-Field, also used for the single containers and the extension fields.
+func writeField(e *per.Encoder, ie IE) error {
+	if err := e.WriteConstrainedInt(int64(ie.ID), 0, 65535); err != nil {
+		return err
+	}
+	if err := e.WriteEnumerated(int(ie.Criticality), 3, false); err != nil {
+		return err
+	}
+	return e.WriteOctetString(ie.Value, 0, per.Unbounded, false)
+}
+
+// readContainer reads a ProtocolIE-Container of at least lb IEs.
+func readContainer(d *per.Decoder, lb int) ([]IE, error) {
+	n, err := d.ReadLength(lb, maxProtocolIEs)
+	if err != nil {
+		return nil, err
+	}
+	ies := make([]IE, n)
+	for i := range ies {
+		if ies[i], err = readField(d); err != nil {
+			return nil, err
+		}
+	}
+	return ies, nil
+}
+
+func readField(d *per.Decoder) (IE, error) {
+	id, err := d.ReadConstrainedInt(0, 65535)
+	if err != nil {
+		return IE{}, err
+	}
+	criticality, err := d.ReadEnumerated(3, false)
+	if err != nil {
+		return IE{}, err
+	}
+	value, err := d.ReadOpenType()
+	if err != nil {
+		return IE{}, err
+	}
+	return IE{ID: ProtocolIEID(id), Criticality: Criticality(criticality), Value: value}, nil
+}
+
+// readExtensible reads the extension bit of an extensible type, and returns a function skipping the extension
+// additions to call after the root components.
+func readExtensible(d *per.Decoder) (func() error, error) {
+	extended, err := d.ReadBool()
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		if !extended {
+			return nil
+		}
+		_, err := d.ReadExtensions()
+		return err
+	}, nil
+}
+
+// skipIEExtensions skips the iE-Extensions of a SEQUENCE when present, a container of at least one extension field.
+func skipIEExtensions(d *per.Decoder, present bool) error {
+	if !present {
+		return nil
+	}
+	_, err := readContainer(d, 1)
+	return err
+}
+
+// readPresence reads the presence bits of n optional components.
+func readPresence(d *per.Decoder, n int) ([]bool, error) {
+	present := make([]bool, n)
+	for i := range present {
+		var err error
+		if present[i], err = d.ReadBool(); err != nil {
+			return nil, err
+		}
+	}
+	return present, nil
+}
+
+// writeSequence writes the preamble of an extensible SEQUENCE without extension additions: the extension bit, the
+// presence bits of its optional components, and the one of its absent iE-Extensions.
+func writeSequence(e *per.Encoder, present ...bool) {
+	e.WriteBool(false)
+	for _, p := range present {
+		e.WriteBool(p)
+	}
+	e.WriteBool(false)
+}
+
+// readSequence reads an extensible SEQUENCE with n optional components besides its iE-Extensions. The components are
+// read by read with their presence, the iE-Extensions and the extension additions are skipped.
+func readSequence(d *per.Decoder, n int, read func(present []bool) error) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	present, err := readPresence(d, n+1)
+	if err != nil {
+		return err
+	}
+	if err := read(present[:n]); err != nil {
+		return err
+	}
+	if err := skipIEExtensions(d, present[n]); err != nil {
+		return err
+	}
+	return skip()
+}
+
+// writeEnumeratedValue writes an ENUMERATED whose values are numbers, such as {n1, n2, n4} for 1, 2 and 4.
+func writeEnumeratedValue(e *per.Encoder, v int32, values []int32, extensible bool) error {
+	for i, value := range values {
+		if value == v {
+			return e.WriteEnumerated(i, len(values), extensible)
+		}
+	}
+	return fmt.Errorf("value %d not in %v", v, values)
+}
+
+func readEnumeratedValue(d *per.Decoder, values []int32, extensible bool) (int32, error) {
+	index, err := d.ReadEnumerated(len(values), extensible)
+	if err != nil {
+		return 0, err
+	}
+	if index >= len(values) {
+		return 0, fmt.Errorf("%w: enumerated extension %d", ErrUnsupported, index)
+	}
+	return values[index], nil
+}
+
+// readInt32 reads an INTEGER (lb..ub) into v.
+func readInt32(d *per.Decoder, v *int32, lb, ub int64) error {
+	n, err := d.ReadConstrainedInt(lb, ub)
+	*v = int32(n)
+	return err
+}
+
+// readExtensibleInt32 reads an INTEGER (lb..ub, ...) into v.
+func readExtensibleInt32(d *per.Decoder, v *int32, lb, ub int64) error {
+	n, err := d.ReadExtensibleInt(lb, ub)
+	*v = int32(n)
+	return err
+}
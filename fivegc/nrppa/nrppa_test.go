@@ -0,0 +1,360 @@
+package nrppa
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/gad"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+	"reflect"
+	"testing"
+)
+
+func TestEncoding(t *testing.T) {
+	m := &ECIDMeasurementTerminationCommand{LMFUEMeasurementID: 1, RANUEMeasurementID: 2}
+	p, err := NewPDU(1, m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := Encode(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// initiatingMessage 0 00, procedureCode 05, criticality 00, transactionID 0001, value of 13 octets: extension 0,
+	// 2 IEs, LMF-UE-Measurement-ID 0002 00 01 00, RAN-UE-Measurement-ID 0006 00 01 08.
+	expected := "00050000010d00000200020001000006000108"
+	if encoded := hex.EncodeToString(b); encoded != expected {
+		t.Errorf("encoded as %s, expected %s", encoded, expected)
+	}
+	decoded, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, p) {
+		t.Errorf("decoded as %+v, expected %+v", decoded, p)
+	}
+	message, err := decoded.Message()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(message, m) {
+		t.Errorf("decoded as %+v, expected %+v", message, m)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	s, err := gad.Decode([]byte{0x90, 0xb0, 0x2c, 0x6a, 0x6b, 0x87, 0xe2, 0x80, 0x64, 0x20, 0x10, 0x00, 0x30, 0x50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	position := s.(gad.PointAltitudeUncertainty)
+	periodicity := Periodicity40960ms
+	nr := CGI{MCC: "208", MNC: "93", CellID: 0xfffffffff}
+	eutra := CGI{MCC: "001", MNC: "001", CellID: 0xfffffff, EUTRA: true}
+	result := ECIDMeasurementResult{
+		ServingCellID:       nr,
+		ServingCellTAC:      0xabcdef,
+		AccessPointPosition: &position,
+		MeasuredResults: []MeasuredResult{
+			{AngleOfArrival: toInt32(719)},
+			{TimingAdvanceType2: toInt32(7690)},
+			{RSRP: []ResultEUTRA{{PCI: 503, EARFCN: 262143, CGI: &eutra, Value: 97}, {PCI: 1, EARFCN: 6300, Value: 40}}},
+			{RSRQ: []ResultEUTRA{{PCI: 2, EARFCN: 100, Value: 34}}},
+			{Extension: &IE{ID: 200, Criticality: Ignore, Value: []byte{0x01, 0x02}}},
+		},
+	}
+	configuration := SRSConfiguration{Carriers: []SRSCarrier{
+		{
+			PointA:          3279165,
+			UplinkChannelBW: []SCSSpecificCarrier{{OffsetToCarrier: 2199, SubcarrierSpacing: SCS30kHz, CarrierBandwidth: 275}},
+			ActiveULBWP: ActiveULBWP{
+				LocationAndBandwidth:    37949,
+				SubcarrierSpacing:       SCS30kHz,
+				TxDirectCurrentLocation: 3301,
+				Shift7dot5kHz:           true,
+				SRSConfig: SRSConfig{
+					Resources: []SRSResource{
+						{
+							ID:               0,
+							Ports:            4,
+							TransmissionComb: TransmissionComb{Size: 4, Offset: 3, CyclicShift: 11},
+							StartPosition:    5,
+							Symbols:          4,
+							RepetitionFactor: 2,
+							FreqDomainShift:  268,
+							CSRS:             63,
+							BSRS:             3,
+							BHop:             1,
+							Hopping:          HoppingGroup,
+							ResourceType:     ResourceType{Kind: ResourcePeriodic, Periodicity: 2560, Offset: 2559},
+							SequenceID:       1023,
+						},
+						{
+							ID:                 63,
+							Ports:              1,
+							TransmissionComb:   TransmissionComb{Size: 2, Offset: 1, CyclicShift: 7},
+							Symbols:            1,
+							RepetitionFactor:   1,
+							FreqDomainPosition: 67,
+							ResourceType:       ResourceType{Kind: ResourceAperiodic},
+						},
+					},
+					PosResources: []PosSRSResource{
+						{
+							ID:               1,
+							TransmissionComb: TransmissionComb{Size: 8, Offset: 7, CyclicShift: 5},
+							StartPosition:    12,
+							Symbols:          12,
+							FreqDomainShift:  1,
+							CSRS:             2,
+							Hopping:          HoppingSequence,
+							ResourceType:     ResourceType{Kind: ResourceSemiPersistent, Periodicity: 81920, Offset: 81919},
+							SequenceID:       65535,
+						},
+						{ID: 2, TransmissionComb: TransmissionComb{Size: 2}, Symbols: 1, ResourceType: ResourceType{Kind: ResourceAperiodic, SlotOffset: 32}},
+					},
+					ResourceSets: []SRSResourceSet{
+						{ID: 0, ResourceIDs: []int32{0}, Type: ResourceSetType{Kind: ResourcePeriodic}},
+						{ID: 15, ResourceIDs: []int32{0, 63}, Type: ResourceSetType{Kind: ResourceAperiodic, Trigger: 3, SlotOffset: 32}},
+					},
+					PosResourceSets: []SRSResourceSet{
+						{ID: 1, ResourceIDs: []int32{1}, Type: ResourceSetType{Kind: ResourceSemiPersistent}},
+						{ID: 2, ResourceIDs: []int32{2}, Type: ResourceSetType{Kind: ResourceAperiodic, Trigger: 1}},
+					},
+				},
+			},
+			PCI: toInt32(1007),
+		},
+		{
+			UplinkChannelBW: []SCSSpecificCarrier{{SubcarrierSpacing: SCS15kHz, CarrierBandwidth: 1}, {SubcarrierSpacing: SCS120kHz, CarrierBandwidth: 66}},
+			ActiveULBWP:     ActiveULBWP{SubcarrierSpacing: SCS120kHz, ExtendedCyclicPrefix: true},
+		},
+	}}
+	sfnInitialisationTime := RelativeTime1900(0xe6b1a1fc80000000)
+	sfn, slot := SystemFrameNumber(1023), SlotNumber(79)
+	measurements := TRPMeasurementResponseList{
+		{
+			TRPID: 1,
+			Results: []TRPMeasurementResult{
+				{
+					Value:     TRPMeasuredResultsValue{ULAoA: &ULAoA{Azimuth: 3599, Zenith: toInt32(1799)}},
+					TimeStamp: TimeStamp{SystemFrameNumber: 1023, SubcarrierSpacing: SCS120kHz, SlotIndex: 79, MeasurementTime: &sfnInitialisationTime},
+					Quality:   &TRPMeasurementQuality{Angle: &AngleMeasurementQuality{Azimuth: 255, Zenith: toInt32(0)}},
+				},
+				{
+					Value:     TRPMeasuredResultsValue{ULSRSRSRP: toInt32(126)},
+					TimeStamp: TimeStamp{SubcarrierSpacing: SCS15kHz, SlotIndex: 9},
+				},
+				{
+					Value:     TRPMeasuredResultsValue{ULRTOA: &TimingMeasurement{K: 0, Value: 1970049}},
+					TimeStamp: TimeStamp{SystemFrameNumber: 1, SubcarrierSpacing: SCS30kHz, SlotIndex: 19},
+					Quality:   &TRPMeasurementQuality{Timing: &TimingMeasurementQuality{Quality: 31, Resolution: Resolution30m}},
+				},
+				{
+					Value:     TRPMeasuredResultsValue{GNBRxTxTimeDiff: &TimingMeasurement{K: 5, Value: 61565}},
+					TimeStamp: TimeStamp{SubcarrierSpacing: SCS60kHz, SlotIndex: 39},
+				},
+			},
+			CGI: &nr,
+		},
+		{
+			TRPID:   65535,
+			Results: []TRPMeasurementResult{{Value: TRPMeasuredResultsValue{Extension: &IE{ID: 200, Criticality: Ignore, Value: []byte{0x00}}}}},
+		},
+	}
+	messages := map[string]Message{
+		"measurement initiation request": &ECIDMeasurementInitiationRequest{
+			LMFUEMeasurementID:     256,
+			ReportCharacteristics:  ReportPeriodic,
+			MeasurementPeriodicity: &periodicity,
+			MeasurementQuantities:  MeasurementQuantities{QuantityCellID, QuantityRSRP, QuantityTimingAdvanceNR},
+		},
+		"measurement initiation response": &ECIDMeasurementInitiationResponse{
+			LMFUEMeasurementID:    1,
+			RANUEMeasurementID:    15,
+			ECIDMeasurementResult: &result,
+		},
+		"measurement initiation failure": &ECIDMeasurementInitiationFailure{
+			LMFUEMeasurementID: 16,
+			Cause:              Cause{Group: CauseGroupProtocol, Value: CauseAbstractSyntaxErrorFalselyConstructedMessage},
+		},
+		"measurement failure indication": &ECIDMeasurementFailureIndication{
+			LMFUEMeasurementID: 1,
+			RANUEMeasurementID: 1,
+			Cause:              Cause{Group: CauseGroupRadioNetwork, Value: CauseRequestedItemTemporarilyNotAvailable},
+		},
+		"measurement report": &ECIDMeasurementReport{
+			LMFUEMeasurementID:    1,
+			RANUEMeasurementID:    2,
+			ECIDMeasurementResult: ECIDMeasurementResult{ServingCellID: eutra},
+		},
+		"TRP information request": &TRPInformationRequest{
+			TRPList:             TRPList{1, 65535},
+			TRPInformationTypes: TRPInformationTypes{TRPInformationPCI, TRPInformationCGI, TRPInformationARFCN},
+		},
+		"TRP information response": &TRPInformationResponse{
+			TRPInformationList: TRPInformationList{
+				{TRPID: 1, Responses: []TRPInformationTypeResponse{{PCI: toInt32(1007)}, {CGI: &nr}, {ARFCN: toInt32(3279165)}}},
+				{TRPID: 2, Responses: []TRPInformationTypeResponse{{CGI: &eutra}}},
+			},
+		},
+		"TRP information failure": &TRPInformationFailure{Cause: Cause{Group: CauseGroupMisc, Value: CauseMiscUnspecified}},
+		"positioning information request": &PositioningInformationRequest{
+			RequestedSRSTransmissionCharacteristics: &RequestedSRSTransmissionCharacteristics{
+				NumberOfTransmissions: toInt32(500),
+				ResourceType:          ResourceSemiPersistent,
+				Bandwidth:             BandwidthSRS{FR2: true, MHz: 400},
+			},
+		},
+		"positioning information response": &PositioningInformationResponse{SRSConfiguration: &configuration, SFNInitialisationTime: &sfnInitialisationTime},
+		"positioning information failure": &PositioningInformationFailure{
+			Cause: Cause{Group: CauseGroupRadioNetwork, Value: CauseRequestedItemNotSupported},
+		},
+		"positioning information update": &PositioningInformationUpdate{SFNInitialisationTime: &sfnInitialisationTime},
+		"TRP measurement request": &MeasurementRequest{
+			LMFMeasurementID: 65536,
+			TRPMeasurementRequestList: TRPMeasurementRequestList{
+				{TRPID: 1, SearchWindow: &SearchWindow{ExpectedPropagationDelay: -3841, DelayUncertainty: 246}},
+				{TRPID: 2},
+			},
+			ReportCharacteristics:  ReportPeriodic,
+			MeasurementPeriodicity: &periodicity,
+			TRPMeasurementQuantities: TRPMeasurementQuantities{
+				{Type: TRPMeasurementGNBRxTxTimeDiff, TimingReportingGranularityFactor: toInt32(5)},
+				{Type: TRPMeasurementULSRSRSRP},
+				{Type: TRPMeasurementULAoA},
+				{Type: TRPMeasurementULRTOA, TimingReportingGranularityFactor: toInt32(0)},
+			},
+			SFNInitialisationTime:      &sfnInitialisationTime,
+			SRSConfiguration:           &configuration,
+			MeasurementBeamInfoRequest: true,
+			SystemFrameNumber:          &sfn,
+			SlotNumber:                 &slot,
+		},
+		"TRP measurement response":                 &MeasurementResponse{LMFMeasurementID: 1, RANMeasurementID: 2, TRPMeasurementResponseList: measurements},
+		"TRP measurement response without results": &MeasurementResponse{LMFMeasurementID: 1, RANMeasurementID: 2},
+		"TRP measurement failure": &MeasurementFailure{
+			LMFMeasurementID: 3,
+			Cause:            Cause{Group: CauseGroupRadioNetwork, Value: CauseRequestedItemTemporarilyNotAvailable},
+		},
+		"TRP measurement report": &MeasurementReport{LMFMeasurementID: 1, RANMeasurementID: 2, TRPMeasurementReportList: measurements},
+		"TRP measurement update": &MeasurementUpdate{LMFMeasurementID: 1, RANMeasurementID: 2, SRSConfiguration: &configuration},
+		"TRP measurement abort":  &MeasurementAbort{LMFMeasurementID: 1, RANMeasurementID: 2},
+		"TRP measurement failure indication": &MeasurementFailureIndication{
+			LMFMeasurementID: 1,
+			RANMeasurementID: 2,
+			Cause:            Cause{Group: CauseGroupMisc, Value: CauseMiscUnspecified},
+		},
+	}
+	for name, m := range messages {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewPDU(32767, m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			b, err := Encode(p)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			decoded, err := Decode(b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, p) {
+				t.Errorf("decoded as %+v, expected %+v", decoded, p)
+			}
+			message, err := decoded.Message()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(message, m) {
+				t.Errorf("decoded as %+v, expected %+v", message, m)
+			}
+		})
+	}
+}
+
+func TestMessageErrors(t *testing.T) {
+	ie, err := NewIE(IDLMFUEMeasurementID, Reject, toUEMeasurementID(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name string
+		pdu  PDU
+		err  error
+	}{
+		{
+			name: "missing IE",
+			pdu:  PDU{Type: InitiatingMessage, ProcedureCode: ProcedureECIDMeasurementTermination, IEs: []IE{ie}},
+			err:  ErrMissingIE,
+		},
+		{
+			name: "unsupported message",
+			pdu:  PDU{Type: InitiatingMessage, ProcedureCode: ProcedurePositioningActivation},
+			err:  ErrUnsupported,
+		},
+		{
+			name: "invalid value",
+			pdu: PDU{Type: InitiatingMessage, ProcedureCode: ProcedureECIDMeasurementTermination, IEs: []IE{
+				ie, {ID: IDRANUEMeasurementID, Value: nil},
+			}},
+			err: per.ErrTruncated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.pdu.Message(); !errors.Is(err, test.err) {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+	if _, err := NewIE(IDLMFUEMeasurementID, Reject, toUEMeasurementID(257)); err == nil {
+		t.Error("expected an error for a UE measurement ID out of range")
+	}
+	resource := SRSResource{Ports: 1, TransmissionComb: TransmissionComb{Size: 8}, Symbols: 1, RepetitionFactor: 1}
+	configuration := SRSConfiguration{Carriers: []SRSCarrier{{
+		UplinkChannelBW: []SCSSpecificCarrier{{CarrierBandwidth: 1}},
+		ActiveULBWP:     ActiveULBWP{SRSConfig: SRSConfig{Resources: []SRSResource{resource}}},
+	}}}
+	if _, err := NewIE(IDSRSConfiguration, Ignore, &configuration); err == nil {
+		t.Error("expected an error for a comb of 8 out of the positioning SRS resources")
+	}
+	timeStamp := TimeStamp{SubcarrierSpacing: SCS15kHz, SlotIndex: 10}
+	measurements := TRPMeasurementResponseList{{TRPID: 1, Results: []TRPMeasurementResult{{Value: TRPMeasuredResultsValue{ULSRSRSRP: toInt32(1)}, TimeStamp: timeStamp}}}}
+	if _, err := NewIE(IDTRPMeasurementReportList, Reject, &measurements); err == nil {
+		t.Error("expected an error for a slot out of range at 15 kHz")
+	}
+}
+
+func TestPLMNIdentity(t *testing.T) {
+	tests := []struct {
+		mcc, mnc string
+		encoded  string
+	}{
+		{mcc: "208", mnc: "93", encoded: "02f839"},
+		{mcc: "310", mnc: "410", encoded: "130014"},
+	}
+	for _, test := range tests {
+		e := per.NewEncoder(true)
+		if err := writePLMNIdentity(e, test.mcc, test.mnc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if encoded := hex.EncodeToString(e.Bytes()); encoded != test.encoded {
+			t.Errorf("%s-%s encoded as %s, expected %s", test.mcc, test.mnc, encoded, test.encoded)
+		}
+		mcc, mnc, err := readPLMNIdentity(per.NewDecoder(e.Bytes(), true))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if mcc != test.mcc || mnc != test.mnc {
+			t.Errorf("decoded as %s-%s, expected %s-%s", mcc, mnc, test.mcc, test.mnc)
+		}
+	}
+	if err := writePLMNIdentity(per.NewEncoder(true), "20", "93"); err == nil {
+		t.Error("expected an error for an MCC of 2 digits")
+	}
+}
+
+func toUEMeasurementID(v UEMeasurementID) *UEMeasurementID {
+	return &v
+}
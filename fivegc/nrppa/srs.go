@@ -0,0 +1,831 @@
+package nrppa
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// Limits of TS 38.455 clause 9.4.
+const (
+	maxNoSRSCarriers        = 32
+	maxNoSCSs               = 5
+	maxNoSRSResources       = 64
+	maxNoSRSPosResources    = 64
+	maxNoSRSResourceSets    = 16
+	maxNoSRSPosResourceSets = 16
+	maxNoSRSResourcePerSet  = 16
+)
+
+// SubcarrierSpacing is the subcarrier spacing of a carrier or of a bandwidth part.
+type SubcarrierSpacing int
+
+const (
+	SCS15kHz SubcarrierSpacing = iota
+	SCS30kHz
+	SCS60kHz
+	SCS120kHz
+	subcarrierSpacings
+)
+
+func writeSubcarrierSpacing(e *per.Encoder, scs SubcarrierSpacing) error {
+	return e.WriteEnumerated(int(scs), int(subcarrierSpacings), true)
+}
+
+func readSubcarrierSpacing(d *per.Decoder) (SubcarrierSpacing, error) {
+	index, err := d.ReadEnumerated(int(subcarrierSpacings), true)
+	return SubcarrierSpacing(index), err
+}
+
+// RelativeTime1900 is a time in the 64 bits format of the NTP timestamps, the seconds since 1 January 1900 followed by
+// their fraction (IETF RFC 5905), such as the SFN initialisation time of a TRP.
+type RelativeTime1900 uint64
+
+func (v *RelativeTime1900) encode(e *per.Encoder) error {
+	return e.WriteBitString(bitString(uint64(*v), 64), 64, 64, false)
+}
+
+func (v *RelativeTime1900) decode(d *per.Decoder) error {
+	b, err := d.ReadBitString(64, 64, false)
+	*v = RelativeTime1900(bitStringValue(b))
+	return err
+}
+
+// ResourceKind is the time domain behaviour of SRS resources.
+type ResourceKind int
+
+const (
+	ResourcePeriodic ResourceKind = iota
+	ResourceSemiPersistent
+	ResourceAperiodic
+	resourceKinds
+)
+
+// RequestedSRSTransmissionCharacteristics are the SRS transmission characteristics requested by the LMF for a UE.
+// The SRS resource sets and the SSB information cannot be requested.
+type RequestedSRSTransmissionCharacteristics struct {
+	// NumberOfTransmissions is the number of periodic transmissions, in [0, 500], 0 for an infinite number.
+	NumberOfTransmissions *int32
+	ResourceType          ResourceKind
+	Bandwidth             BandwidthSRS
+}
+
+// BandwidthSRS is the bandwidth of the SRS in the frequency range 1 or 2.
+type BandwidthSRS struct {
+	FR2 bool
+	// MHz is 5, 10, 20, 40, 50, 80 or 100 in FR1, and 50, 100, 200 or 400 in FR2.
+	MHz int32
+}
+
+// Bandwidths of the SRS, in MHz, by frequency range.
+var (
+	bandwidthsFR1 = []int32{5, 10, 20, 40, 50, 80, 100}
+	bandwidthsFR2 = []int32{50, 100, 200, 400}
+)
+
+func (v *RequestedSRSTransmissionCharacteristics) encode(e *per.Encoder) error {
+	writeSequence(e, v.NumberOfTransmissions != nil, false, false)
+	if v.NumberOfTransmissions != nil {
+		if err := e.WriteExtensibleInt(int64(*v.NumberOfTransmissions), 0, 500); err != nil {
+			return err
+		}
+	}
+	if err := e.WriteEnumerated(int(v.ResourceType), int(resourceKinds), true); err != nil {
+		return err
+	}
+	index, bandwidths := 0, bandwidthsFR1
+	if v.Bandwidth.FR2 {
+		index, bandwidths = 1, bandwidthsFR2
+	}
+	return e.WriteChoice(index, 3, false, func(e *per.Encoder) error {
+		return writeEnumeratedValue(e, v.Bandwidth.MHz, bandwidths, true)
+	})
+}
+
+func (v *RequestedSRSTransmissionCharacteristics) decode(d *per.Decoder) error {
+	return readSequence(d, 3, func(present []bool) error {
+		if present[0] {
+			v.NumberOfTransmissions = new(int32)
+			if err := readExtensibleInt32(d, v.NumberOfTransmissions, 0, 500); err != nil {
+				return err
+			}
+		}
+		kind, err := d.ReadEnumerated(int(resourceKinds), true)
+		if err != nil {
+			return err
+		}
+		v.ResourceType = ResourceKind(kind)
+		index, _, err := d.ReadChoice(3, false)
+		if err != nil {
+			return err
+		}
+		if index == 2 {
+			return fmt.Errorf("%w: SRS bandwidth extension", ErrUnsupported)
+		}
+		bandwidths := bandwidthsFR1
+		if v.Bandwidth.FR2 = index == 1; v.Bandwidth.FR2 {
+			bandwidths = bandwidthsFR2
+		}
+		if v.Bandwidth.MHz, err = readEnumeratedValue(d, bandwidths, true); err != nil {
+			return err
+		}
+		if present[1] || present[2] {
+			return fmt.Errorf("%w: requested SRS resource sets or SSB information", ErrUnsupported)
+		}
+		return nil
+	})
+}
+
+// SRSConfiguration is the SRS configuration of a UE.
+type SRSConfiguration struct {
+	// Carriers has between 1 and 32 uplink carriers.
+	Carriers []SRSCarrier
+}
+
+// SRSCarrier is the SRS configuration of an uplink carrier.
+type SRSCarrier struct {
+	// PointA is the NR ARFCN of the point A of the carrier, in [0, 3279165].
+	PointA int32
+	// UplinkChannelBW has the carrier of each subcarrier spacing, between 1 and 5.
+	UplinkChannelBW []SCSSpecificCarrier
+	ActiveULBWP     ActiveULBWP
+	// PCI is the NR physical cell identity of the carrier, in [0, 1007].
+	PCI *int32
+}
+
+// SCSSpecificCarrier is the carrier of a subcarrier spacing.
+type SCSSpecificCarrier struct {
+	// OffsetToCarrier is in [0, 2199] and CarrierBandwidth in [1, 275], in physical resource blocks.
+	OffsetToCarrier   int32
+	SubcarrierSpacing SubcarrierSpacing
+	CarrierBandwidth  int32
+}
+
+// ActiveULBWP is the active uplink bandwidth part of a carrier, with its SRS configuration.
+type ActiveULBWP struct {
+	// LocationAndBandwidth is the resource indicator value of the bandwidth part, in [0, 37949].
+	LocationAndBandwidth int32
+	SubcarrierSpacing    SubcarrierSpacing
+	ExtendedCyclicPrefix bool
+	// TxDirectCurrentLocation is in [0, 3301].
+	TxDirectCurrentLocation int32
+	Shift7dot5kHz           bool
+	SRSConfig               SRSConfig
+}
+
+// SRSConfig is the SRS configuration of a bandwidth part. The lists are absent when empty.
+type SRSConfig struct {
+	// Resources and PosResources have up to 64 resources, ResourceSets and PosResourceSets up to 16 sets.
+	Resources       []SRSResource
+	PosResources    []PosSRSResource
+	ResourceSets    []SRSResourceSet
+	PosResourceSets []SRSResourceSet
+}
+
+// SRSResource is an SRS resource.
+type SRSResource struct {
+	// ID is in [0, 63].
+	ID int32
+	// Ports is 1, 2 or 4.
+	Ports            int32
+	TransmissionComb TransmissionComb
+	// StartPosition is in [0, 5], and Symbols and RepetitionFactor are 1, 2 or 4.
+	StartPosition    int32
+	Symbols          int32
+	RepetitionFactor int32
+	// FreqDomainPosition is in [0, 67] and FreqDomainShift in [0, 268].
+	FreqDomainPosition int32
+	FreqDomainShift    int32
+	// CSRS is in [0, 63], BSRS and BHop in [0, 3].
+	CSRS         int32
+	BSRS         int32
+	BHop         int32
+	Hopping      Hopping
+	ResourceType ResourceType
+	// SequenceID is in [0, 1023].
+	SequenceID int32
+}
+
+// PosSRSResource is an SRS resource for positioning. Its spatial relation is not supported.
+type PosSRSResource struct {
+	// ID is in [0, 63].
+	ID               int32
+	TransmissionComb TransmissionComb
+	// StartPosition is in [0, 12], and Symbols is 1, 2, 4, 8 or 12.
+	StartPosition int32
+	Symbols       int32
+	// FreqDomainShift is in [0, 268] and CSRS in [0, 63].
+	FreqDomainShift int32
+	CSRS            int32
+	Hopping         Hopping
+	ResourceType    ResourceType
+	// SequenceID is in [0, 65535].
+	SequenceID int32
+}
+
+// Hopping is the group or sequence hopping of an SRS resource.
+type Hopping int
+
+const (
+	HoppingNeither Hopping = iota
+	HoppingGroup
+	HoppingSequence
+	hoppings
+)
+
+// Values of the ENUMERATED of the SRS resources.
+var (
+	srsPorts            = []int32{1, 2, 4}
+	srsSymbols          = []int32{1, 2, 4}
+	srsPosSymbols       = []int32{1, 2, 4, 8, 12}
+	srsRepetitionFactor = []int32{1, 2, 4}
+)
+
+// TransmissionComb is the transmission comb of an SRS resource.
+type TransmissionComb struct {
+	// Size is 2 or 4, or 8 for the positioning SRS resources.
+	Size int32
+	// Offset is in [0, Size-1], and CyclicShift in [0, 7] for a comb of 2, [0, 11] of 4 and [0, 5] of 8.
+	Offset      int32
+	CyclicShift int32
+}
+
+// transmissionCombs are the alternatives of TransmissionCombPos, TransmissionComb having the first two.
+var transmissionCombs = []struct {
+	size, maxCyclicShift int64
+}{{2, 7}, {4, 11}, {8, 5}}
+
+// ResourceType is the time domain behaviour of an SRS resource.
+type ResourceType struct {
+	Kind ResourceKind
+	// Periodicity is the periodicity of the periodic and semi-persistent resources, in slots: 1, 2, 4, 5, 8, 10, 16,
+	// 20, 32, 40, 64, 80, 160, 320, 640, 1280 or 2560, and up to 81920 for the positioning SRS resources. Offset is
+	// their offset in slots, in [0, 2559], and in [0, 81919] for the positioning SRS resources.
+	Periodicity int32
+	Offset      int32
+	// SlotOffset is the slot offset of the aperiodic positioning SRS resources, in [0, 32].
+	SlotOffset int32
+}
+
+// Periodicities of the SRS resources, in slots.
+var (
+	srsPeriodicities    = []int32{1, 2, 4, 5, 8, 10, 16, 20, 32, 40, 64, 80, 160, 320, 640, 1280, 2560}
+	srsPosPeriodicities = append(srsPeriodicities[:len(srsPeriodicities):len(srsPeriodicities)], 5120, 10240, 20480, 40960, 81920)
+)
+
+// SRSResourceSet is an SRS resource set, or a positioning SRS resource set.
+type SRSResourceSet struct {
+	// ID is in [0, 15].
+	ID int32
+	// ResourceIDs are the IDs of the resources of the set, between 1 and 16.
+	ResourceIDs []int32
+	Type        ResourceSetType
+}
+
+// ResourceSetType is the time domain behaviour of an SRS resource set.
+type ResourceSetType struct {
+	Kind ResourceKind
+	// Trigger is the SRS resource trigger of the aperiodic sets, in [1, 3].
+	Trigger int32
+	// SlotOffset is the slot offset of the aperiodic sets, in [0, 32], absent from the positioning sets.
+	SlotOffset int32
+}
+
+func (v *SRSConfiguration) encode(e *per.Encoder) error {
+	writeSequence(e)
+	if err := e.WriteLength(len(v.Carriers), 1, maxNoSRSCarriers); err != nil {
+		return err
+	}
+	for i := range v.Carriers {
+		if err := v.Carriers[i].encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *SRSConfiguration) decode(d *per.Decoder) error {
+	return readSequence(d, 0, func([]bool) error {
+		n, err := d.ReadLength(1, maxNoSRSCarriers)
+		if err != nil {
+			return err
+		}
+		v.Carriers = make([]SRSCarrier, n)
+		for i := range v.Carriers {
+			if err := v.Carriers[i].decode(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *SRSCarrier) encode(e *per.Encoder) error {
+	writeSequence(e, c.PCI != nil)
+	if err := e.WriteConstrainedInt(int64(c.PointA), 0, 3279165); err != nil {
+		return err
+	}
+	if err := e.WriteLength(len(c.UplinkChannelBW), 1, maxNoSCSs); err != nil {
+		return err
+	}
+	for _, carrier := range c.UplinkChannelBW {
+		writeSequence(e)
+		if err := e.WriteExtensibleInt(int64(carrier.OffsetToCarrier), 0, 2199); err != nil {
+			return err
+		}
+		if err := writeSubcarrierSpacing(e, carrier.SubcarrierSpacing); err != nil {
+			return err
+		}
+		if err := e.WriteExtensibleInt(int64(carrier.CarrierBandwidth), 1, 275); err != nil {
+			return err
+		}
+	}
+	if err := c.ActiveULBWP.encode(e); err != nil {
+		return err
+	}
+	if c.PCI != nil {
+		return e.WriteConstrainedInt(int64(*c.PCI), 0, 1007)
+	}
+	return nil
+}
+
+func (c *SRSCarrier) decode(d *per.Decoder) error {
+	return readSequence(d, 1, func(present []bool) error {
+		if err := readInt32(d, &c.PointA, 0, 3279165); err != nil {
+			return err
+		}
+		n, err := d.ReadLength(1, maxNoSCSs)
+		if err != nil {
+			return err
+		}
+		c.UplinkChannelBW = make([]SCSSpecificCarrier, n)
+		for i := range c.UplinkChannelBW {
+			carrier := &c.UplinkChannelBW[i]
+			if err := readSequence(d, 0, func([]bool) error {
+				if err := readExtensibleInt32(d, &carrier.OffsetToCarrier, 0, 2199); err != nil {
+					return err
+				}
+				if carrier.SubcarrierSpacing, err = readSubcarrierSpacing(d); err != nil {
+					return err
+				}
+				return readExtensibleInt32(d, &carrier.CarrierBandwidth, 1, 275)
+			}); err != nil {
+				return err
+			}
+		}
+		if err := c.ActiveULBWP.decode(d); err != nil {
+			return err
+		}
+		if present[0] {
+			c.PCI = new(int32)
+			return readInt32(d, c.PCI, 0, 1007)
+		}
+		return nil
+	})
+}
+
+func (b *ActiveULBWP) encode(e *per.Encoder) error {
+	writeSequence(e, b.Shift7dot5kHz)
+	if err := e.WriteExtensibleInt(int64(b.LocationAndBandwidth), 0, 37949); err != nil {
+		return err
+	}
+	if err := writeSubcarrierSpacing(e, b.SubcarrierSpacing); err != nil {
+		return err
+	}
+	cyclicPrefix := 0
+	if b.ExtendedCyclicPrefix {
+		cyclicPrefix = 1
+	}
+	if err := e.WriteEnumerated(cyclicPrefix, 2, false); err != nil {
+		return err
+	}
+	if err := e.WriteExtensibleInt(int64(b.TxDirectCurrentLocation), 0, 3301); err != nil {
+		return err
+	}
+	if b.Shift7dot5kHz {
+		if err := e.WriteEnumerated(0, 1, true); err != nil {
+			return err
+		}
+	}
+	return b.SRSConfig.encode(e)
+}
+
+func (b *ActiveULBWP) decode(d *per.Decoder) error {
+	return readSequence(d, 1, func(present []bool) error {
+		if err := readExtensibleInt32(d, &b.LocationAndBandwidth, 0, 37949); err != nil {
+			return err
+		}
+		var err error
+		if b.SubcarrierSpacing, err = readSubcarrierSpacing(d); err != nil {
+			return err
+		}
+		cyclicPrefix, err := d.ReadEnumerated(2, false)
+		if err != nil {
+			return err
+		}
+		b.ExtendedCyclicPrefix = cyclicPrefix == 1
+		if err := readExtensibleInt32(d, &b.TxDirectCurrentLocation, 0, 3301); err != nil {
+			return err
+		}
+		if b.Shift7dot5kHz = present[0]; b.Shift7dot5kHz {
+			if _, err := d.ReadEnumerated(1, true); err != nil {
+				return err
+			}
+		}
+		return b.SRSConfig.decode(d)
+	})
+}
+
+func (c *SRSConfig) encode(e *per.Encoder) error {
+	writeSequence(e, len(c.Resources) > 0, len(c.PosResources) > 0, len(c.ResourceSets) > 0, len(c.PosResourceSets) > 0)
+	if len(c.Resources) > 0 {
+		if err := e.WriteLength(len(c.Resources), 1, maxNoSRSResources); err != nil {
+			return err
+		}
+		for i := range c.Resources {
+			if err := c.Resources[i].encode(e); err != nil {
+				return err
+			}
+		}
+	}
+	if len(c.PosResources) > 0 {
+		if err := e.WriteLength(len(c.PosResources), 1, maxNoSRSPosResources); err != nil {
+			return err
+		}
+		for i := range c.PosResources {
+			if err := c.PosResources[i].encode(e); err != nil {
+				return err
+			}
+		}
+	}
+	for _, sets := range []struct {
+		sets []SRSResourceSet
+		max  int
+		pos  bool
+	}{{c.ResourceSets, maxNoSRSResourceSets, false}, {c.PosResourceSets, maxNoSRSPosResourceSets, true}} {
+		if len(sets.sets) == 0 {
+			continue
+		}
+		if err := e.WriteLength(len(sets.sets), 1, sets.max); err != nil {
+			return err
+		}
+		for i := range sets.sets {
+			if err := sets.sets[i].encode(e, sets.pos); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *SRSConfig) decode(d *per.Decoder) error {
+	return readSequence(d, 4, func(present []bool) error {
+		if present[0] {
+			n, err := d.ReadLength(1, maxNoSRSResources)
+			if err != nil {
+				return err
+			}
+			c.Resources = make([]SRSResource, n)
+			for i := range c.Resources {
+				if err := c.Resources[i].decode(d); err != nil {
+					return err
+				}
+			}
+		}
+		if present[1] {
+			n, err := d.ReadLength(1, maxNoSRSPosResources)
+			if err != nil {
+				return err
+			}
+			c.PosResources = make([]PosSRSResource, n)
+			for i := range c.PosResources {
+				if err := c.PosResources[i].decode(d); err != nil {
+					return err
+				}
+			}
+		}
+		for _, sets := range []struct {
+			sets    *[]SRSResourceSet
+			max     int
+			pos     bool
+			present bool
+		}{{&c.ResourceSets, maxNoSRSResourceSets, false, present[2]}, {&c.PosResourceSets, maxNoSRSPosResourceSets, true, present[3]}} {
+			if !sets.present {
+				continue
+			}
+			n, err := d.ReadLength(1, sets.max)
+			if err != nil {
+				return err
+			}
+			*sets.sets = make([]SRSResourceSet, n)
+			for i := range *sets.sets {
+				if err := (*sets.sets)[i].decode(d, sets.pos); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SRSResource) encode(e *per.Encoder) error {
+	writeSequence(e)
+	if err := e.WriteConstrainedInt(int64(r.ID), 0, 63); err != nil {
+		return err
+	}
+	if err := writeEnumeratedValue(e, r.Ports, srsPorts, false); err != nil {
+		return err
+	}
+	if err := r.TransmissionComb.encode(e, false); err != nil {
+		return err
+	}
+	if err := e.WriteConstrainedInt(int64(r.StartPosition), 0, 5); err != nil {
+		return err
+	}
+	if err := writeEnumeratedValue(e, r.Symbols, srsSymbols, false); err != nil {
+		return err
+	}
+	if err := writeEnumeratedValue(e, r.RepetitionFactor, srsRepetitionFactor, false); err != nil {
+		return err
+	}
+	for _, field := range []struct {
+		value int32
+		ub    int64
+	}{{r.FreqDomainPosition, 67}, {r.FreqDomainShift, 268}, {r.CSRS, 63}, {r.BSRS, 3}, {r.BHop, 3}} {
+		if err := e.WriteConstrainedInt(int64(field.value), 0, field.ub); err != nil {
+			return err
+		}
+	}
+	if err := e.WriteEnumerated(int(r.Hopping), int(hoppings), false); err != nil {
+		return err
+	}
+	if err := r.ResourceType.encode(e, false); err != nil {
+		return err
+	}
+	return e.WriteConstrainedInt(int64(r.SequenceID), 0, 1023)
+}
+
+func (r *SRSResource) decode(d *per.Decoder) error {
+	return readSequence(d, 0, func([]bool) error {
+		if err := readInt32(d, &r.ID, 0, 63); err != nil {
+			return err
+		}
+		var err error
+		if r.Ports, err = readEnumeratedValue(d, srsPorts, false); err != nil {
+			return err
+		}
+		if err := r.TransmissionComb.decode(d, false); err != nil {
+			return err
+		}
+		if err := readInt32(d, &r.StartPosition, 0, 5); err != nil {
+			return err
+		}
+		if r.Symbols, err = readEnumeratedValue(d, srsSymbols, false); err != nil {
+			return err
+		}
+		if r.RepetitionFactor, err = readEnumeratedValue(d, srsRepetitionFactor, false); err != nil {
+			return err
+		}
+		for _, field := range []struct {
+			value *int32
+			ub    int64
+		}{{&r.FreqDomainPosition, 67}, {&r.FreqDomainShift, 268}, {&r.CSRS, 63}, {&r.BSRS, 3}, {&r.BHop, 3}} {
+			if err := readInt32(d, field.value, 0, field.ub); err != nil {
+				return err
+			}
+		}
+		hopping, err := d.ReadEnumerated(int(hoppings), false)
+		if err != nil {
+			return err
+		}
+		r.Hopping = Hopping(hopping)
+		if err := r.ResourceType.decode(d, false); err != nil {
+			return err
+		}
+		return readInt32(d, &r.SequenceID, 0, 1023)
+	})
+}
+
+func (r *PosSRSResource) encode(e *per.Encoder) error {
+	writeSequence(e, false)
+	if err := e.WriteConstrainedInt(int64(r.ID), 0, 63); err != nil {
+		return err
+	}
+	if err := r.TransmissionComb.encode(e, true); err != nil {
+		return err
+	}
+	if err := e.WriteConstrainedInt(int64(r.StartPosition), 0, 12); err != nil {
+		return err
+	}
+	if err := writeEnumeratedValue(e, r.Symbols, srsPosSymbols, false); err != nil {
+		return err
+	}
+	if err := e.WriteConstrainedInt(int64(r.FreqDomainShift), 0, 268); err != nil {
+		return err
+	}
+	if err := e.WriteConstrainedInt(int64(r.CSRS), 0, 63); err != nil {
+		return err
+	}
+	if err := e.WriteEnumerated(int(r.Hopping), int(hoppings), false); err != nil {
+		return err
+	}
+	if err := r.ResourceType.encode(e, true); err != nil {
+		return err
+	}
+	return e.WriteConstrainedInt(int64(r.SequenceID), 0, 65535)
+}
+
+func (r *PosSRSResource) decode(d *per.Decoder) error {
+	return readSequence(d, 1, func(present []bool) error {
+		if err := readInt32(d, &r.ID, 0, 63); err != nil {
+			return err
+		}
+		if err := r.TransmissionComb.decode(d, true); err != nil {
+			return err
+		}
+		if err := readInt32(d, &r.StartPosition, 0, 12); err != nil {
+			return err
+		}
+		var err error
+		if r.Symbols, err = readEnumeratedValue(d, srsPosSymbols, false); err != nil {
+			return err
+		}
+		if err := readInt32(d, &r.FreqDomainShift, 0, 268); err != nil {
+			return err
+		}
+		if err := readInt32(d, &r.CSRS, 0, 63); err != nil {
+			return err
+		}
+		hopping, err := d.ReadEnumerated(int(hoppings), false)
+		if err != nil {
+			return err
+		}
+		r.Hopping = Hopping(hopping)
+		if err := r.ResourceType.decode(d, true); err != nil {
+			return err
+		}
+		if err := readInt32(d, &r.SequenceID, 0, 65535); err != nil {
+			return err
+		}
+		if present[0] {
+			return fmt.Errorf("%w: spatial relation of a positioning SRS resource", ErrUnsupported)
+		}
+		return nil
+	})
+}
+
+// encode writes a TransmissionComb, or a TransmissionCombPos for a positioning SRS resource.
+func (c *TransmissionComb) encode(e *per.Encoder, pos bool) error {
+	combs := transmissionCombs[:2]
+	if pos {
+		combs = transmissionCombs
+	}
+	for i, comb := range combs {
+		if int64(c.Size) != comb.size {
+			continue
+		}
+		return e.WriteChoice(i, len(combs)+1, false, func(e *per.Encoder) error {
+			if err := e.WriteConstrainedInt(int64(c.Offset), 0, comb.size-1); err != nil {
+				return err
+			}
+			return e.WriteConstrainedInt(int64(c.CyclicShift), 0, comb.maxCyclicShift)
+		})
+	}
+	return fmt.Errorf("transmission comb %d", c.Size)
+}
+
+func (c *TransmissionComb) decode(d *per.Decoder, pos bool) error {
+	combs := transmissionCombs[:2]
+	if pos {
+		combs = transmissionCombs
+	}
+	index, _, err := d.ReadChoice(len(combs)+1, false)
+	if err != nil {
+		return err
+	}
+	if index == len(combs) {
+		return fmt.Errorf("%w: transmission comb extension", ErrUnsupported)
+	}
+	c.Size = int32(combs[index].size)
+	if err := readInt32(d, &c.Offset, 0, combs[index].size-1); err != nil {
+		return err
+	}
+	return readInt32(d, &c.CyclicShift, 0, combs[index].maxCyclicShift)
+}
+
+// encode writes a ResourceType, or a ResourceTypePos for a positioning SRS resource.
+func (r *ResourceType) encode(e *per.Encoder, pos bool) error {
+	if r.Kind < 0 || r.Kind >= resourceKinds {
+		return fmt.Errorf("resource type %d", r.Kind)
+	}
+	periodicities, maxOffset := srsPeriodicities, int64(2559)
+	if pos {
+		periodicities, maxOffset = srsPosPeriodicities, 81919
+	}
+	return e.WriteChoice(int(r.Kind), int(resourceKinds)+1, false, func(e *per.Encoder) error {
+		writeSequence(e)
+		switch {
+		case r.Kind != ResourceAperiodic:
+			if err := writeEnumeratedValue(e, r.Periodicity, periodicities, true); err != nil {
+				return err
+			}
+			return e.WriteExtensibleInt(int64(r.Offset), 0, maxOffset)
+		case pos:
+			return e.WriteConstrainedInt(int64(r.SlotOffset), 0, 32)
+		}
+		return e.WriteEnumerated(0, 1, true)
+	})
+}
+
+func (r *ResourceType) decode(d *per.Decoder, pos bool) error {
+	periodicities, maxOffset := srsPeriodicities, int64(2559)
+	if pos {
+		periodicities, maxOffset = srsPosPeriodicities, 81919
+	}
+	index, _, err := d.ReadChoice(int(resourceKinds)+1, false)
+	if err != nil {
+		return err
+	}
+	if index == int(resourceKinds) {
+		return fmt.Errorf("%w: resource type extension", ErrUnsupported)
+	}
+	r.Kind = ResourceKind(index)
+	return readSequence(d, 0, func([]bool) error {
+		switch {
+		case r.Kind != ResourceAperiodic:
+			var err error
+			if r.Periodicity, err = readEnumeratedValue(d, periodicities, true); err != nil {
+				return err
+			}
+			return readExtensibleInt32(d, &r.Offset, 0, maxOffset)
+		case pos:
+			return readInt32(d, &r.SlotOffset, 0, 32)
+		}
+		_, err := d.ReadEnumerated(1, true)
+		return err
+	})
+}
+
+// encode writes an SRSResourceSet, or a PosSRSResourceSet-Item for a positioning SRS resource set.
+func (s *SRSResourceSet) encode(e *per.Encoder, pos bool) error {
+	writeSequence(e)
+	if err := e.WriteConstrainedInt(int64(s.ID), 0, 15); err != nil {
+		return err
+	}
+	if err := e.WriteLength(len(s.ResourceIDs), 1, maxNoSRSResourcePerSet); err != nil {
+		return err
+	}
+	for _, id := range s.ResourceIDs {
+		if err := e.WriteConstrainedInt(int64(id), 0, 63); err != nil {
+			return err
+		}
+	}
+	if s.Type.Kind < 0 || s.Type.Kind >= resourceKinds {
+		return fmt.Errorf("resource set type %d", s.Type.Kind)
+	}
+	return e.WriteChoice(int(s.Type.Kind), int(resourceKinds)+1, false, func(e *per.Encoder) error {
+		writeSequence(e)
+		if s.Type.Kind != ResourceAperiodic {
+			return e.WriteEnumerated(0, 1, true)
+		}
+		if err := e.WriteConstrainedInt(int64(s.Type.Trigger), 1, 3); err != nil || pos {
+			return err
+		}
+		return e.WriteConstrainedInt(int64(s.Type.SlotOffset), 0, 32)
+	})
+}
+
+func (s *SRSResourceSet) decode(d *per.Decoder, pos bool) error {
+	return readSequence(d, 0, func([]bool) error {
+		if err := readInt32(d, &s.ID, 0, 15); err != nil {
+			return err
+		}
+		n, err := d.ReadLength(1, maxNoSRSResourcePerSet)
+		if err != nil {
+			return err
+		}
+		s.ResourceIDs = make([]int32, n)
+		for i := range s.ResourceIDs {
+			if err := readInt32(d, &s.ResourceIDs[i], 0, 63); err != nil {
+				return err
+			}
+		}
+		index, _, err := d.ReadChoice(int(resourceKinds)+1, false)
+		if err != nil {
+			return err
+		}
+		if index == int(resourceKinds) {
+			return fmt.Errorf("%w: resource set type extension", ErrUnsupported)
+		}
+		s.Type.Kind = ResourceKind(index)
+		return readSequence(d, 0, func([]bool) error {
+			if s.Type.Kind != ResourceAperiodic {
+				_, err := d.ReadEnumerated(1, true)
+				return err
+			}
+			if err := readInt32(d, &s.Type.Trigger, 1, 3); err != nil || pos {
+				return err
+			}
+			return readInt32(d, &s.Type.SlotOffset, 0, 32)
+		})
+	})
+}
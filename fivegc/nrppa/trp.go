@@ -0,0 +1,269 @@
+package nrppa
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/per"
+)
+
+// TRPID identifies a transmission-reception point of the NG-RAN node, in [1, 65535].
+type TRPID int32
+
+// Limits of TS 38.455 clause 9.4.
+const (
+	maxNoTRPs         = 65535
+	maxNoTRPInfoTypes = 64
+)
+
+func writeTRPID(e *per.Encoder, id TRPID) error {
+	return e.WriteExtensibleInt(int64(id), 1, maxNoTRPs)
+}
+
+func readTRPID(d *per.Decoder) (TRPID, error) {
+	id, err := d.ReadExtensibleInt(1, maxNoTRPs)
+	return TRPID(id), err
+}
+
+// TRPList is a list of TRPs.
+type TRPList []TRPID
+
+func (v *TRPList) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoTRPs); err != nil {
+		return err
+	}
+	for _, id := range *v {
+		e.WriteBool(false)
+		e.WriteBool(false)
+		if err := writeTRPID(e, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *TRPList) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoTRPs)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPList, n)
+	for i := range *v {
+		skip, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		extensions, err := d.ReadBool()
+		if err != nil {
+			return err
+		}
+		if (*v)[i], err = readTRPID(d); err != nil {
+			return err
+		}
+		if err := skipIEExtensions(d, extensions); err != nil {
+			return err
+		}
+		if err := skip(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TRPInformationType is a type of TRP information requested.
+type TRPInformationType int
+
+const (
+	TRPInformationPCI TRPInformationType = iota
+	TRPInformationCGI
+	TRPInformationARFCN
+	TRPInformationPRSConfiguration
+	TRPInformationSSBInformation
+	TRPInformationSFNInitialisationTime
+	TRPInformationSpatialDirection
+	TRPInformationGeographicalCoordinates
+	trpInformationTypes
+)
+
+// TRPInformationTypes are the types of TRP information requested, between 1 and 64.
+type TRPInformationTypes []TRPInformationType
+
+func (v *TRPInformationTypes) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoTRPInfoTypes); err != nil {
+		return err
+	}
+	for _, t := range *v {
+		// Each item is a single container of TRPInformationTypeItem.
+		item := per.NewEncoder(true)
+		if err := item.WriteEnumerated(int(t), int(trpInformationTypes), true); err != nil {
+			return err
+		}
+		if err := writeField(e, IE{ID: IDTRPInformationTypeItem, Criticality: Reject, Value: item.Bytes()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *TRPInformationTypes) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoTRPInfoTypes)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPInformationTypes, n)
+	for i := range *v {
+		field, err := readField(d)
+		if err != nil {
+			return err
+		}
+		t, err := d.Sub(field.Value).ReadEnumerated(int(trpInformationTypes), true)
+		if err != nil {
+			return err
+		}
+		(*v)[i] = TRPInformationType(t)
+	}
+	return nil
+}
+
+// TRPInformation is the information about a TRP.
+type TRPInformation struct {
+	TRPID TRPID
+	// Responses has between 1 and 64 responses.
+	Responses []TRPInformationTypeResponse
+}
+
+// TRPInformationTypeResponse is the response for a type of TRP information, only one of its fields is set.
+type TRPInformationTypeResponse struct {
+	// PCI is the NR physical cell identity, in [0, 1007].
+	PCI *int32
+	CGI *CGI
+	// ARFCN is the NR ARFCN, in [0, 3279165].
+	ARFCN *int32
+}
+
+// Indexes of the alternatives of TRPInformationTypeResponseItem, the alternatives after responseARFCN not being
+// supported.
+const (
+	responsePCI = iota
+	responseCGI
+	responseARFCN
+	trpInformationResponses = 9
+)
+
+// TRPInformationList is the information about the TRPs of the NG-RAN node, between 1 and 65535 TRPs.
+type TRPInformationList []TRPInformation
+
+func (v *TRPInformationList) encode(e *per.Encoder) error {
+	if err := e.WriteLength(len(*v), 1, maxNoTRPs); err != nil {
+		return err
+	}
+	for _, information := range *v {
+		e.WriteBool(false)
+		e.WriteBool(false)
+		e.WriteBool(false)
+		e.WriteBool(false)
+		if err := writeTRPID(e, information.TRPID); err != nil {
+			return err
+		}
+		if err := e.WriteLength(len(information.Responses), 1, maxNoTRPInfoTypes); err != nil {
+			return err
+		}
+		for i := range information.Responses {
+			if err := information.Responses[i].encode(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *TRPInformationList) decode(d *per.Decoder) error {
+	n, err := d.ReadLength(1, maxNoTRPs)
+	if err != nil {
+		return err
+	}
+	*v = make(TRPInformationList, n)
+	for i := range *v {
+		skipItem, err := readExtensible(d)
+		if err != nil {
+			return err
+		}
+		itemExtensions, err := d.ReadBool()
+		if err != nil {
+			return err
+		}
+		if err := (*v)[i].decode(d); err != nil {
+			return err
+		}
+		if err := skipIEExtensions(d, itemExtensions); err != nil {
+			return err
+		}
+		if err := skipItem(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TRPInformation) decode(d *per.Decoder) error {
+	skip, err := readExtensible(d)
+	if err != nil {
+		return err
+	}
+	extensions, err := d.ReadBool()
+	if err != nil {
+		return err
+	}
+	if t.TRPID, err = readTRPID(d); err != nil {
+		return err
+	}
+	n, err := d.ReadLength(1, maxNoTRPInfoTypes)
+	if err != nil {
+		return err
+	}
+	t.Responses = make([]TRPInformationTypeResponse, n)
+	for i := range t.Responses {
+		if err := t.Responses[i].decode(d); err != nil {
+			return err
+		}
+	}
+	if err := skipIEExtensions(d, extensions); err != nil {
+		return err
+	}
+	return skip()
+}
+
+func (r *TRPInformationTypeResponse) encode(e *per.Encoder) error {
+	switch {
+	case r.PCI != nil:
+		return e.WriteChoice(responsePCI, trpInformationResponses, false, func(e *per.Encoder) error {
+			return e.WriteConstrainedInt(int64(*r.PCI), 0, 1007)
+		})
+	case r.CGI != nil:
+		return e.WriteChoice(responseCGI, trpInformationResponses, false, r.CGI.encodeNGRAN)
+	case r.ARFCN != nil:
+		return e.WriteChoice(responseARFCN, trpInformationResponses, false, func(e *per.Encoder) error {
+			return e.WriteConstrainedInt(int64(*r.ARFCN), 0, 3279165)
+		})
+	}
+	return fmt.Errorf("empty TRP information response")
+}
+
+func (r *TRPInformationTypeResponse) decode(d *per.Decoder) error {
+	index, _, err := d.ReadChoice(trpInformationResponses, false)
+	if err != nil {
+		return err
+	}
+	switch index {
+	case responsePCI:
+		pci, err := d.ReadConstrainedInt(0, 1007)
+		r.PCI = toInt32(int32(pci))
+		return err
+	case responseCGI:
+		r.CGI = &CGI{}
+		return r.CGI.decodeNGRAN(d)
+	case responseARFCN:
+		arfcn, err := d.ReadConstrainedInt(0, 3279165)
+		r.ARFCN = toInt32(int32(arfcn))
+		return err
+	}
+	return fmt.Errorf("%w: TRP information response %d", ErrUnsupported, index)
+}
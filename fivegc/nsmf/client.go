@@ -0,0 +1,18 @@
+package nsmf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+type Client struct {
+	*EventExposureClient
+	*NIDDClient
+	*PDUSessionClient
+}
+
+// NewClient returns a new client for an NSMF service.
+func NewClient(config fivegc.ClientConfiguration) *Client {
+	return &Client{
+		EventExposureClient: NewEventExposureClient(config),
+		NIDDClient:          NewNIDDClient(config),
+		PDUSessionClient:    NewPDUSessionClient(config),
+	}
+}
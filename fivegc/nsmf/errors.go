@@ -0,0 +1,72 @@
+package nsmf
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+// Application error causes of the NSMF PDUSession service, defined in TS 29.502 clause 6.1.7.3.
+const (
+	CauseN1SMError                 = "N1_SM_ERROR"
+	CauseN2SMError                 = "N2_SM_ERROR"
+	CauseSNSSAIDenied              = "SNSSAI_DENIED"
+	CauseDNNDenied                 = "DNN_DENIED"
+	CausePDUTypeDenied             = "PDUTYPE_DENIED"
+	CauseSSCDenied                 = "SSC_DENIED"
+	CauseSubscriptionDenied        = "SUBSCRIPTION_DENIED"
+	CauseDNNNotSupported           = "DNN_NOT_SUPPORTED"
+	CausePDUTypeNotSupported       = "PDUTYPE_NOT_SUPPORTED"
+	CauseSSCNotSupported           = "SSC_NOT_SUPPORTED"
+	CauseHomeRoutedRoamingRequired = "HOME_ROUTED_ROAMING_REQUIRED"
+	CauseOutOfLADNServiceArea      = "OUT_OF_LADN_SERVICE_AREA"
+	CauseContextNotFound           = "CONTEXT_NOT_FOUND"
+	CauseHOTAUInProgress           = "HO_TAU_IN_PROGRESS"
+	CauseDNNCongestion             = "DNN_CONGESTION"
+	CauseSNSSAICongestion          = "S_NSSAI_CONGESTION"
+	CauseUENotResponding           = "UE_NOT_RESPONDING"
+	CauseUPFNotResponding          = "UPF_NOT_RESPONDING"
+	CauseNetworkFailure            = "NETWORK_FAILURE"
+	CausePeerNotResponding         = "PEER_NOT_RESPONDING"
+)
+
+// Application errors of the NSMF PDUSession service, defined in TS 29.502 clause 6.1.7.3.
+// The errors common to all the APIs, such as fivegc.ErrNfCongestion or fivegc.ErrInsufficientResources, can be used as well.
+var (
+	// ErrN1SMError is returned when the N1 SM message of the request is erroneous.
+	ErrN1SMError = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseN1SMError)
+	// ErrN2SMError is returned when the N2 SM information of the request is erroneous.
+	ErrN2SMError = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseN2SMError)
+	// ErrSNSSAIDenied is returned when the subscription of the UE does not allow the S-NSSAI of the PDU session.
+	ErrSNSSAIDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseSNSSAIDenied)
+	// ErrDNNDenied is returned when the subscription of the UE does not allow the DNN of the PDU session.
+	ErrDNNDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseDNNDenied)
+	// ErrPDUTypeDenied is returned when the subscription of the UE does not allow the PDU session type.
+	ErrPDUTypeDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CausePDUTypeDenied)
+	// ErrSSCDenied is returned when the subscription of the UE does not allow the SSC mode.
+	ErrSSCDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseSSCDenied)
+	// ErrSubscriptionDenied is returned when the subscription of the UE does not allow the PDU session.
+	ErrSubscriptionDenied = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseSubscriptionDenied)
+	// ErrDNNNotSupported is returned when the DNN of the PDU session is not supported by the SMF.
+	ErrDNNNotSupported = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseDNNNotSupported)
+	// ErrPDUTypeNotSupported is returned when the PDU session type is not supported by the SMF.
+	ErrPDUTypeNotSupported = fivegc.NewApplicationError(fivegc.StatusForbidden, CausePDUTypeNotSupported)
+	// ErrSSCNotSupported is returned when the SSC mode is not supported by the SMF.
+	ErrSSCNotSupported = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseSSCNotSupported)
+	// ErrHomeRoutedRoamingRequired is returned by the V-SMF when the PDU session must be established in home-routed roaming.
+	ErrHomeRoutedRoamingRequired = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseHomeRoutedRoamingRequired)
+	// ErrOutOfLADNServiceArea is returned when the UE is outside of the service area of the LADN of the PDU session.
+	ErrOutOfLADNServiceArea = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseOutOfLADNServiceArea)
+	// ErrContextNotFound is returned when the SM context or the PDU session of the request does not exist in the SMF.
+	ErrContextNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseContextNotFound)
+	// ErrHOTAUInProgress is returned when the request collides with a handover or a tracking area update in progress.
+	ErrHOTAUInProgress = fivegc.NewApplicationError(fivegc.StatusConflict, CauseHOTAUInProgress)
+	// ErrDNNCongestion is returned when the DNN of the PDU session is congested.
+	ErrDNNCongestion = fivegc.NewApplicationError(fivegc.StatusServiceUnavailable, CauseDNNCongestion)
+	// ErrSNSSAICongestion is returned when the S-NSSAI of the PDU session is congested.
+	ErrSNSSAICongestion = fivegc.NewApplicationError(fivegc.StatusServiceUnavailable, CauseSNSSAICongestion)
+	// ErrUENotResponding is returned when the UE did not answer the request of the SMF.
+	ErrUENotResponding = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CauseUENotResponding)
+	// ErrUPFNotResponding is returned when the UPF of the PDU session did not answer the request of the SMF.
+	ErrUPFNotResponding = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CauseUPFNotResponding)
+	// ErrNetworkFailure is returned when the request failed due to a network failure.
+	ErrNetworkFailure = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CauseNetworkFailure)
+	// ErrPeerNotResponding is returned when the peer SMF, such as the H-SMF, did not answer the request of the SMF.
+	ErrPeerNotResponding = fivegc.NewApplicationError(fivegc.StatusGatewayTimeout, CausePeerNotResponding)
+)
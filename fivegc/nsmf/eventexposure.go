@@ -0,0 +1,487 @@
+package nsmf
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfeventexposure "github.com/5GCoreNet/openapi/openapi_Nsmf_EventExposure"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+	"net/http"
+)
+
+const (
+	eventExposureRouterGroup = "/nsmf-event-exposure/v1"
+	subIDParam               = "subId"
+	subscriptionsEndpoint    = "/subscriptions"
+	subscriptionEndpoint     = "/subscriptions/{subId}"
+)
+
+// SMF events, defined in TS 29.508 clause 5.6.3.3.
+const (
+	EventAccessTypeChange                = "AC_TY_CH"
+	EventUPPathChange                    = "UP_PATH_CH"
+	EventPDUSessionRelease               = "PDU_SES_REL"
+	EventPLMNChange                      = "PLMN_CH"
+	EventUEIPAddressChange               = "UE_IP_CH"
+	EventRATTypeChange                   = "RAT_TY_CH"
+	EventDownlinkDataDeliveryStatus      = "DDDS"
+	EventCommunicationFailure            = "COMM_FAIL"
+	EventPDUSessionEstablishment         = "PDU_SES_EST"
+	EventQFIAllocation                   = "QFI_ALLOC"
+	EventQoSMonitoring                   = "QOS_MON"
+	EventSMCongestionControlExpiry       = "SMCC_EXP"
+	EventDispersion                      = "DISPERSION"
+	EventRedundantTransmissionExperience = "RED_TRANS_EXP"
+	EventWLANInfo                        = "WLAN_INFO"
+	EventUPFInfo                         = "UPF_INFO"
+	EventUPStatusInfo                    = "UP_STATUS_INFO"
+)
+
+// Types of the DNAI changes of the UP path change events, defined in TS 29.571 clause 5.4.3.23.
+const (
+	DnaiChangeTypeEarly     = "EARLY"
+	DnaiChangeTypeEarlyLate = "EARLY_LATE"
+	DnaiChangeTypeLate      = "LATE"
+)
+
+// EventExposure is the interface that wraps the NSMF EventExposure service (TS 29.508 clause 5.2).
+// It is implemented by the SMF to expose the events of the PDU sessions, such as the UP path changes, the PDU session
+// releases or the QFI allocations, to the NEF, the NWDAF or the AF.
+type EventExposure interface {
+	fivegc.CommonInterface
+	// CreateSubscription subscribes to the events of a UE, a group of UEs or any UE.
+	// The subscription ID of the created subscription is sent in the Location of the response.
+	CreateSubscription(context.Context, nsmfeventexposure.NsmfEventExposure) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSubscriptionStatusCode)
+	// GetSubscription reads the subscription identified by its ID.
+	GetSubscription(context.Context, string) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSubscriptionStatusCode)
+	// ReplaceSubscription replaces the subscription identified by its ID.
+	ReplaceSubscription(context.Context, string, nsmfeventexposure.NsmfEventExposure) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReplaceSubscriptionStatusCode)
+	// DeleteSubscription removes the subscription identified by its ID.
+	DeleteSubscription(context.Context, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteSubscriptionStatusCode)
+}
+
+// EventExposureService is an alternative to the EventExposure interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one, or with 204 No
+// Content for a ReplaceSubscription. Use NewEventExposure to attach it to the NSMF Server.
+type EventExposureService interface {
+	fivegc.CommonInterface
+	// CreateSubscription subscribes to the events of a UE, a group of UEs or any UE.
+	CreateSubscription(context.Context, nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error)
+	// GetSubscription reads the subscription identified by its ID.
+	GetSubscription(context.Context, string) (*nsmfeventexposure.NsmfEventExposure, error)
+	// ReplaceSubscription replaces the subscription identified by its ID.
+	ReplaceSubscription(context.Context, string, nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error)
+	// DeleteSubscription removes the subscription identified by its ID.
+	DeleteSubscription(context.Context, string) error
+}
+
+// EventExposureNotification is the interface that wraps the Notify callback of the NSMF EventExposure service.
+// It is implemented by the consumers of the SMF events, such as the NEF or the NWDAF, to receive the event
+// notifications sent by the SMF to the notifUri of their subscriptions.
+type EventExposureNotification interface {
+	fivegc.CommonInterface
+	// EventExposureNotify receives the event notifications of a subscription.
+	EventExposureNotify(context.Context, nsmfeventexposure.NsmfEventExposureNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventExposureNotifyStatusCode)
+}
+
+// CreateSubscriptionStatusCode is the status code of a CreateSubscription response.
+type CreateSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// CreateSubscriptionStatusCreated is the status code for the response when the subscription is created.
+	CreateSubscriptionStatusCreated           CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusCreated)
+	CreateSubscriptionStatusTemporaryRedirect CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	CreateSubscriptionStatusPermanentRedirect CreateSubscriptionStatusCode = CreateSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var createSubscriptionErrors = response.BodyErrors
+
+// GetSubscriptionStatusCode is the status code of a GetSubscription response.
+type GetSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// GetSubscriptionStatusOK is the status code for a successful response.
+	GetSubscriptionStatusOK                GetSubscriptionStatusCode = GetSubscriptionStatusCode(fivegc.StatusOK)
+	GetSubscriptionStatusTemporaryRedirect GetSubscriptionStatusCode = GetSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSubscriptionStatusPermanentRedirect GetSubscriptionStatusCode = GetSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSubscriptionErrors = response.QueryErrors
+
+// ReplaceSubscriptionStatusCode is the status code of a ReplaceSubscription response.
+type ReplaceSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// ReplaceSubscriptionStatusOK is the status code for the response when the subscription is replaced and returned.
+	ReplaceSubscriptionStatusOK ReplaceSubscriptionStatusCode = ReplaceSubscriptionStatusCode(fivegc.StatusOK)
+	// ReplaceSubscriptionStatusNoContent is the status code for the response when the subscription is replaced.
+	ReplaceSubscriptionStatusNoContent         ReplaceSubscriptionStatusCode = ReplaceSubscriptionStatusCode(fivegc.StatusNoContent)
+	ReplaceSubscriptionStatusTemporaryRedirect ReplaceSubscriptionStatusCode = ReplaceSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	ReplaceSubscriptionStatusPermanentRedirect ReplaceSubscriptionStatusCode = ReplaceSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var replaceSubscriptionErrors = response.BodyErrors
+
+// DeleteSubscriptionStatusCode is the status code of a DeleteSubscription response.
+type DeleteSubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// DeleteSubscriptionStatusNoContent is the status code for the response when the subscription is removed.
+	DeleteSubscriptionStatusNoContent         DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusNoContent)
+	DeleteSubscriptionStatusTemporaryRedirect DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	DeleteSubscriptionStatusPermanentRedirect DeleteSubscriptionStatusCode = DeleteSubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deleteSubscriptionErrors = response.DeletionErrors
+
+// EventExposureNotifyStatusCode is the status code of an EventExposureNotify response.
+type EventExposureNotifyStatusCode fivegc.StatusCode
+
+const (
+	// EventExposureNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	EventExposureNotifyStatusNoContent         EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusNoContent)
+	EventExposureNotifyStatusTemporaryRedirect EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	EventExposureNotifyStatusPermanentRedirect EventExposureNotifyStatusCode = EventExposureNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var eventExposureNotifyErrors = response.BodyErrors
+
+func attachEventExposureHandler(r *gin.RouterGroup, e EventExposure, logger *log.Logger) {
+	group := r.Group(eventExposureRouterGroup)
+	{
+		group.POST(router.Path(subscriptionsEndpoint), func(c *gin.Context) {
+			var req nsmfeventexposure.NsmfEventExposure
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, e, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, createSubscriptionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := e.CreateSubscription(c, req)
+			switch status {
+			case CreateSubscriptionStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.GetSubId()))
+				c.JSON(int(status), res)
+			case CreateSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CreateSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, createSubscriptionErrors)
+			}
+			return
+		})
+		group.GET(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := e.GetSubscription(c, c.Param(subIDParam))
+			switch status {
+			case GetSubscriptionStatusOK:
+				c.JSON(int(status), res)
+			case GetSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSubscriptionErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			var req nsmfeventexposure.NsmfEventExposure
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, e, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, replaceSubscriptionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := e.ReplaceSubscription(c, c.Param(subIDParam), req)
+			switch status {
+			case ReplaceSubscriptionStatusOK:
+				c.JSON(int(status), res)
+			case ReplaceSubscriptionStatusNoContent:
+				c.JSON(int(status), nil)
+			case ReplaceSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ReplaceSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, replaceSubscriptionErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(subscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := e.DeleteSubscription(c, c.Param(subIDParam))
+			switch status {
+			case DeleteSubscriptionStatusNoContent:
+				c.JSON(int(status), nil)
+			case DeleteSubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeleteSubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deleteSubscriptionErrors)
+			}
+			return
+		})
+	}
+}
+
+func attachEventExposureNotificationHandler(router *gin.RouterGroup, path string, n EventExposureNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nsmfeventexposure.NsmfEventExposureNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, eventExposureNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.EventExposureNotify(c, req)
+		switch status {
+		case EventExposureNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case EventExposureNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case EventExposureNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, eventExposureNotifyErrors)
+		}
+		return
+	})
+}
+
+// NewEventExposure adapts an EventExposureService to the EventExposure interface.
+func NewEventExposure(s EventExposureService) EventExposure {
+	return eventExposureAdapter{service: s}
+}
+
+type eventExposureAdapter struct {
+	service EventExposureService
+}
+
+func (a eventExposureAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a eventExposureAdapter) CreateSubscription(ctx context.Context, req nsmfeventexposure.NsmfEventExposure) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSubscriptionStatusCode) {
+	res, err := a.service.CreateSubscription(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfeventexposure.NsmfEventExposure{}, problemDetails, redirectResponse, CreateSubscriptionStatusCode(status)
+	}
+	if res == nil {
+		res = &nsmfeventexposure.NsmfEventExposure{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CreateSubscriptionStatusCreated
+}
+
+func (a eventExposureAdapter) GetSubscription(ctx context.Context, subID string) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSubscriptionStatusCode) {
+	res, err := a.service.GetSubscription(ctx, subID)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfeventexposure.NsmfEventExposure{}, problemDetails, redirectResponse, GetSubscriptionStatusCode(status)
+	}
+	if res == nil {
+		res = &nsmfeventexposure.NsmfEventExposure{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSubscriptionStatusOK
+}
+
+func (a eventExposureAdapter) ReplaceSubscription(ctx context.Context, subID string, req nsmfeventexposure.NsmfEventExposure) (nsmfeventexposure.NsmfEventExposure, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReplaceSubscriptionStatusCode) {
+	res, err := a.service.ReplaceSubscription(ctx, subID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfeventexposure.NsmfEventExposure{}, problemDetails, redirectResponse, ReplaceSubscriptionStatusCode(status)
+	}
+	if res == nil {
+		return nsmfeventexposure.NsmfEventExposure{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReplaceSubscriptionStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReplaceSubscriptionStatusOK
+}
+
+func (a eventExposureAdapter) DeleteSubscription(ctx context.Context, subID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteSubscriptionStatusCode) {
+	if err := a.service.DeleteSubscription(ctx, subID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, DeleteSubscriptionStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeleteSubscriptionStatusNoContent
+}
+
+// NewEventExposureService adapts an EventExposure to the EventExposureService interface.
+func NewEventExposureService(e EventExposure) EventExposureService {
+	return eventExposureServiceAdapter{eventExposure: e}
+}
+
+type eventExposureServiceAdapter struct {
+	eventExposure EventExposure
+}
+
+func (a eventExposureServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.eventExposure.Error(ctx, err)
+}
+
+func (a eventExposureServiceAdapter) CreateSubscription(ctx context.Context, req nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	res, problemDetails, redirectResponse, status := a.eventExposure.CreateSubscription(ctx, req)
+	if status == CreateSubscriptionStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a eventExposureServiceAdapter) GetSubscription(ctx context.Context, subID string) (*nsmfeventexposure.NsmfEventExposure, error) {
+	res, problemDetails, redirectResponse, status := a.eventExposure.GetSubscription(ctx, subID)
+	if status == GetSubscriptionStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a eventExposureServiceAdapter) ReplaceSubscription(ctx context.Context, subID string, req nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	res, problemDetails, redirectResponse, status := a.eventExposure.ReplaceSubscription(ctx, subID, req)
+	switch status {
+	case ReplaceSubscriptionStatusOK:
+		return &res, nil
+	case ReplaceSubscriptionStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a eventExposureServiceAdapter) DeleteSubscription(ctx context.Context, subID string) error {
+	problemDetails, redirectResponse, status := a.eventExposure.DeleteSubscription(ctx, subID)
+	if status == DeleteSubscriptionStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+// EventExposureClient is a client for the NSMF EventExposure service. It also sends the Notify callbacks.
+type EventExposureClient struct {
+	client  *nsmfeventexposure.APIClient
+	request *request.Client
+}
+
+// NewEventExposureClient creates a new client for the NSMF EventExposure service.
+func NewEventExposureClient(cfg fivegc.ClientConfiguration) *EventExposureClient {
+	openapiCfg := &nsmfeventexposure.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nsmfeventexposure.ServerConfiguration{},
+		OperationServers: make(map[string]nsmfeventexposure.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nsmfeventexposure.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nsmfeventexposure.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nsmfeventexposure.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nsmfeventexposure.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nsmfeventexposure.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nsmfeventexposure.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nsmfeventexposure.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &EventExposureClient{
+		client:  nsmfeventexposure.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, eventExposureRouterGroup),
+	}
+}
+
+// CreateSubscription returns a request subscribing to the SMF events.
+func (e *EventExposureClient) CreateSubscription(ctx context.Context) nsmfeventexposure.ApiCreateIndividualSubcriptionRequest {
+	return e.client.SubscriptionsCollectionApi.CreateIndividualSubcription(ctx)
+}
+
+// CreateSubscriptionExecute executes a CreateSubscription request.
+func (e *EventExposureClient) CreateSubscriptionExecute(r nsmfeventexposure.ApiCreateIndividualSubcriptionRequest) (*nsmfeventexposure.NsmfEventExposure, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSubscription returns a request reading a subscription to the SMF events.
+func (e *EventExposureClient) GetSubscription(ctx context.Context, subID string) nsmfeventexposure.ApiGetIndividualSubcriptionRequest {
+	return e.client.IndividualSubscriptionApi.GetIndividualSubcription(ctx, subID)
+}
+
+// GetSubscriptionExecute executes a GetSubscription request.
+func (e *EventExposureClient) GetSubscriptionExecute(r nsmfeventexposure.ApiGetIndividualSubcriptionRequest) (*nsmfeventexposure.NsmfEventExposure, *http.Response, error) {
+	return r.Execute()
+}
+
+// ReplaceSubscription returns a request replacing a subscription to the SMF events.
+func (e *EventExposureClient) ReplaceSubscription(ctx context.Context, subID string) nsmfeventexposure.ApiReplaceIndividualSubcriptionRequest {
+	return e.client.IndividualSubscriptionApi.ReplaceIndividualSubcription(ctx, subID)
+}
+
+// ReplaceSubscriptionExecute executes a ReplaceSubscription request.
+func (e *EventExposureClient) ReplaceSubscriptionExecute(r nsmfeventexposure.ApiReplaceIndividualSubcriptionRequest) (*nsmfeventexposure.NsmfEventExposure, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeleteSubscription returns a request removing a subscription to the SMF events.
+func (e *EventExposureClient) DeleteSubscription(ctx context.Context, subID string) nsmfeventexposure.ApiDeleteIndividualSubcriptionRequest {
+	return e.client.IndividualSubscriptionApi.DeleteIndividualSubcription(ctx, subID)
+}
+
+// DeleteSubscriptionExecute executes a DeleteSubscription request.
+func (e *EventExposureClient) DeleteSubscriptionExecute(r nsmfeventexposure.ApiDeleteIndividualSubcriptionRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// EventExposureNotify sends event notifications to the notifUri of a subscription.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (e *EventExposureClient) EventExposureNotify(ctx context.Context, notifURI string, data nsmfeventexposure.NsmfEventExposureNotification) error {
+	if notifURI == "" {
+		return fmt.Errorf("event exposure notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("notifUri is missing"))
+	}
+	if err := e.request.PostJSON(ctx, notifURI, data); err != nil {
+		return fmt.Errorf("event exposure notify: %w", err)
+	}
+	return nil
+}
+
+// NotifySubscription sends event notifications to the notifUri of a subscription, along with its notifId.
+func (e *EventExposureClient) NotifySubscription(ctx context.Context, subscription nsmfeventexposure.NsmfEventExposure, notifications ...nsmfeventexposure.EventNotification) error {
+	var data nsmfeventexposure.NsmfEventExposureNotification
+	data.SetNotifId(subscription.NotifId)
+	data.SetEventNotifs(notifications)
+	return e.EventExposureNotify(ctx, subscription.NotifUri, data)
+}
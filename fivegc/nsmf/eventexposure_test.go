@@ -0,0 +1,137 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfeventexposure "github.com/5GCoreNet/openapi/openapi_Nsmf_EventExposure"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smfEvents stores the subscriptions to the SMF events.
+type smfEvents struct {
+	subscriptions map[string]nsmfeventexposure.NsmfEventExposure
+}
+
+func (s *smfEvents) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (s *smfEvents) CreateSubscription(_ context.Context, req nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	if len(req.EventSubs) == 0 {
+		return nil, fivegc.ErrMandatoryIeMissing.WithDetail("eventSubs is missing")
+	}
+	req.SetSubId("1")
+	s.subscriptions["1"] = req
+	return &req, nil
+}
+
+func (s *smfEvents) GetSubscription(_ context.Context, subID string) (*nsmfeventexposure.NsmfEventExposure, error) {
+	subscription, ok := s.subscriptions[subID]
+	if !ok {
+		return nil, fivegc.ErrSubscriptionNotFound
+	}
+	return &subscription, nil
+}
+
+func (s *smfEvents) ReplaceSubscription(_ context.Context, subID string, req nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	if _, ok := s.subscriptions[subID]; !ok {
+		return nil, fivegc.ErrSubscriptionNotFound
+	}
+	s.subscriptions[subID] = req
+	return nil, nil
+}
+
+func (s *smfEvents) DeleteSubscription(_ context.Context, subID string) error {
+	if _, ok := s.subscriptions[subID]; !ok {
+		return fivegc.ErrSubscriptionNotFound
+	}
+	delete(s.subscriptions, subID)
+	return nil
+}
+
+// eventConsumer receives the SMF event notifications, such as a NEF.
+type eventConsumer struct {
+	notifications []nsmfeventexposure.NsmfEventExposureNotification
+}
+
+func (e *eventConsumer) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (e *eventConsumer) EventExposureNotify(_ context.Context, req nsmfeventexposure.NsmfEventExposureNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, EventExposureNotifyStatusCode) {
+	e.notifications = append(e.notifications, req)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, EventExposureNotifyStatusNoContent
+}
+
+func TestEventExposure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	s := &smfEvents{subscriptions: map[string]nsmfeventexposure.NsmfEventExposure{}}
+	attachEventExposureHandler(router.Group(""), NewEventExposure(s), log.Default())
+
+	send := func(method, path string, body interface{}) *http.Response {
+		return apitest.Send(t, router, method, "http://smf.example.com"+eventExposureRouterGroup+path, nil, body).Result()
+	}
+	var subscription nsmfeventexposure.NsmfEventExposure
+	subscription.NotifId = "notif-1"
+	subscription.NotifUri = "http://nef.example.com/notify"
+	subscription.SetSupi("imsi-208930000000001")
+	if resp := send(http.MethodPost, "/subscriptions", subscription); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	subscription.EventSubs = []nsmfeventexposure.EventSubscription{{Event: nsmfeventexposure.SmfEvent{String: fivegc.ToString(EventUPPathChange)}}}
+	resp := send(http.MethodPost, "/subscriptions", subscription)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/subscriptions/1") {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if resp := send(http.MethodGet, "/subscriptions/1", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodPut, "/subscriptions/1", subscription); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodDelete, "/subscriptions/1", nil); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodGet, "/subscriptions/1", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+}
+
+func TestEventExposureNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	e := &eventConsumer{}
+	attachEventExposureNotificationHandler(router.Group(""), "/notify", e, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewEventExposureClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var subscription nsmfeventexposure.NsmfEventExposure
+	subscription.NotifId = "notif-1"
+	subscription.NotifUri = server.URL + "/notify"
+	var notification nsmfeventexposure.EventNotification
+	notification.Event = nsmfeventexposure.SmfEvent{String: fivegc.ToString(EventQFIAllocation)}
+	notification.TimeStamp = time.Now()
+	notification.SetQfi(5)
+	if err := client.NotifySubscription(ctx, subscription, notification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(e.notifications) != 1 || e.notifications[0].NotifId != "notif-1" || e.notifications[0].EventNotifs[0].GetQfi() != 5 {
+		t.Errorf("unexpected notifications %+v", e.notifications)
+	}
+	subscription.NotifUri = ""
+	if err := client.NotifySubscription(ctx, subscription, notification); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nsmf"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfeventexposure "github.com/5GCoreNet/openapi/openapi_Nsmf_EventExposure"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"log"
+)
+
+// MyPDUSession implements the nsmf.PDUSessionService interface, whose handlers return a result and an error.
+type MyPDUSession struct {
+}
+
+func (m MyPDUSession) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyPDUSession) CreateSMContext(ctx context.Context, req nsmf.CreateSMContextRequest) (*nsmf.CreateSMContextResponse, error) {
+	// Your code here ...
+	if _, err := req.N1SmMsg(); err != nil {
+		// Application errors carry their cause and status code, see fivegc.ErrMandatoryIeMissing, nsmf.ErrDNNDenied...
+		return nil, nsmf.ErrN1SMError.WithDetail("%s", err)
+	}
+	res := &nsmf.CreateSMContextResponse{SMContextRef: "1"}
+	res.JSONData.SetPduSessionId(req.JSONData.GetPduSessionId())
+	return res, nil
+}
+
+func (m MyPDUSession) RetrieveSMContext(ctx context.Context, smContextRef string, data nsmfpdusession.SmContextRetrieveData) (*nsmfpdusession.SmContextRetrievedData, error) {
+	// Your code here ...
+	return nil, nsmf.ErrContextNotFound
+}
+
+func (m MyPDUSession) UpdateSMContext(ctx context.Context, smContextRef string, req nsmf.UpdateSMContextRequest) (*nsmf.UpdateSMContextResponse, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyPDUSession) ReleaseSMContext(ctx context.Context, smContextRef string, req nsmf.ReleaseSMContextRequest) (*nsmfpdusession.SmContextReleasedData, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyPDUSession) CreatePDUSession(ctx context.Context, req nsmf.CreatePDUSessionRequest) (*nsmf.CreatePDUSessionResponse, error) {
+	// Your code here ...
+	return nil, nsmf.ErrDNNNotSupported
+}
+
+func (m MyPDUSession) UpdatePDUSession(ctx context.Context, pduSessionRef string, req nsmf.UpdatePDUSessionRequest) (*nsmf.UpdatePDUSessionResponse, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyPDUSession) ReleasePDUSession(ctx context.Context, pduSessionRef string, data nsmfpdusession.ReleaseData) (*nsmfpdusession.ReleasedData, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyPDUSession) RetrievePDUSession(ctx context.Context, pduSessionRef string, data nsmfpdusession.RetrieveData) (*nsmfpdusession.RetrievedData, error) {
+	// Your code here ...
+	return nil, nsmf.ErrContextNotFound
+}
+
+// MyEventExposure implements the nsmf.EventExposureService interface.
+type MyEventExposure struct {
+}
+
+func (m MyEventExposure) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (m MyEventExposure) CreateSubscription(ctx context.Context, subscription nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	// Your code here ...
+	subscription.SetSubId("1")
+	return &subscription, nil
+}
+
+func (m MyEventExposure) GetSubscription(ctx context.Context, subID string) (*nsmfeventexposure.NsmfEventExposure, error) {
+	// Your code here ...
+	return nil, fivegc.ErrSubscriptionNotFound
+}
+
+func (m MyEventExposure) ReplaceSubscription(ctx context.Context, subID string, subscription nsmfeventexposure.NsmfEventExposure) (*nsmfeventexposure.NsmfEventExposure, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyEventExposure) DeleteSubscription(ctx context.Context, subID string) error {
+	// Your code here ...
+	return nil
+}
+
+// MyNIDD implements the nsmf.NIDDService interface.
+type MyNIDD struct {
+}
+
+func (m MyNIDD) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (m MyNIDD) Deliver(ctx context.Context, pduSessionRef string, req nsmf.DeliverRequest) error {
+	// Your code here ...
+	if _, err := req.MtData(); err != nil {
+		return fivegc.ErrMandatoryIeMissing.WithDetail("%s", err)
+	}
+	return nil
+}
+
+func main() {
+	nsmfServer := nsmf.NewServer(":8080", "/v1/", log.Default())
+	nsmfServer.AttachPDUSession(nsmf.NewPDUSession(MyPDUSession{}))
+	nsmfServer.AttachEventExposure(nsmf.NewEventExposure(MyEventExposure{}))
+	nsmfServer.AttachNIDD(nsmf.NewNIDD(MyNIDD{}))
+	nsmfServer.Start()
+	// Your code here ...
+	nsmfServer.Stop()
+}
@@ -0,0 +1,134 @@
+package nsmf
+
+import (
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	nsmfnidd "github.com/5GCoreNet/openapi/openapi_Nsmf_NIDD"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+)
+
+// Media types of the binary parts, defined in TS 29.502 clause 6.1.2.4.
+const (
+	ContentTypeNAS  = "application/vnd.3gpp.5gnas"
+	ContentTypeNGAP = "application/vnd.3gpp.ngap"
+)
+
+// ErrBinaryPart is returned when a binary part referenced by the JSON data is missing.
+var ErrBinaryPart = errors.New("nsmf: missing binary part")
+
+// BinaryPart is a binary part of a multipart/related body, referenced from the JSON data by its content ID.
+type BinaryPart = multipart.Part
+
+// CreateSMContextRequest is the body of a CreateSMContext request, whose binary part is the N1 SM message of the UE.
+type CreateSMContextRequest struct {
+	JSONData    nsmfpdusession.SmContextCreateData
+	BinaryParts []BinaryPart
+}
+
+// CreateSMContextResponse is the body of a CreateSMContext response.
+type CreateSMContextResponse struct {
+	// SMContextRef identifies the SM context resource created, in the Location of the response.
+	SMContextRef string
+	JSONData     nsmfpdusession.SmContextCreatedData
+	// ErrorData is the error data, such as the N1 SM message rejecting the PDU session, answered along with the
+	// ProblemDetails and the binary parts.
+	ErrorData   *nsmfpdusession.SmContextCreateError
+	BinaryParts []BinaryPart
+}
+
+// UpdateSMContextRequest is the body of an UpdateSMContext request.
+type UpdateSMContextRequest struct {
+	JSONData    nsmfpdusession.SmContextUpdateData
+	BinaryParts []BinaryPart
+}
+
+// UpdateSMContextResponse is the body of an UpdateSMContext response.
+type UpdateSMContextResponse struct {
+	JSONData nsmfpdusession.SmContextUpdatedData
+	// ErrorData is the error data answered along with the ProblemDetails and the binary parts.
+	ErrorData   *nsmfpdusession.SmContextUpdateError
+	BinaryParts []BinaryPart
+}
+
+// ReleaseSMContextRequest is the body of a ReleaseSMContext request, whose binary part is the N2 SM information.
+type ReleaseSMContextRequest struct {
+	JSONData    nsmfpdusession.SmContextReleaseData
+	BinaryParts []BinaryPart
+}
+
+// CreatePDUSessionRequest is the body of a CreatePDUSession request, whose binary part is the N1 SM message of the UE.
+type CreatePDUSessionRequest struct {
+	JSONData    nsmfpdusession.PduSessionCreateData
+	BinaryParts []BinaryPart
+}
+
+// CreatePDUSessionResponse is the body of a CreatePDUSession response.
+type CreatePDUSessionResponse struct {
+	// PDUSessionRef identifies the PDU session resource created, in the Location of the response.
+	PDUSessionRef string
+	JSONData      nsmfpdusession.PduSessionCreatedData
+	// ErrorData is the error data, such as the N1 SM information rejecting the PDU session, answered along with the
+	// ProblemDetails and the binary parts.
+	ErrorData   *nsmfpdusession.PduSessionCreateError
+	BinaryParts []BinaryPart
+}
+
+// UpdatePDUSessionRequest is the body of an UpdatePDUSession request.
+type UpdatePDUSessionRequest struct {
+	JSONData    nsmfpdusession.HsmfUpdateData
+	BinaryParts []BinaryPart
+}
+
+// UpdatePDUSessionResponse is the body of an UpdatePDUSession response.
+type UpdatePDUSessionResponse struct {
+	JSONData nsmfpdusession.HsmfUpdatedData
+	// ErrorData is the error data answered along with the ProblemDetails and the binary parts.
+	ErrorData   *nsmfpdusession.HsmfUpdateError
+	BinaryParts []BinaryPart
+}
+
+// DeliverRequest is the body of a Deliver request, whose binary part is the mobile terminated data of the UE.
+type DeliverRequest struct {
+	JSONData    nsmfnidd.DeliverReqData
+	BinaryParts []BinaryPart
+}
+
+// N1SmMsg returns the N1 SM message of the request.
+func (r CreateSMContextRequest) N1SmMsg() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.GetN1SmMsg().ContentId)
+}
+
+// N1SmMsg returns the N1 SM message of the request.
+func (r UpdateSMContextRequest) N1SmMsg() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.GetN1SmMsg().ContentId)
+}
+
+// N2SmInfo returns the N2 SM information of the request.
+func (r UpdateSMContextRequest) N2SmInfo() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.GetN2SmInfo().ContentId)
+}
+
+// N1SmInfoFromUe returns the N1 SM information received from the UE.
+func (r CreatePDUSessionRequest) N1SmInfoFromUe() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.GetN1SmInfoFromUe().ContentId)
+}
+
+// N1SmInfoFromUe returns the N1 SM information received from the UE.
+func (r UpdatePDUSessionRequest) N1SmInfoFromUe() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.GetN1SmInfoFromUe().ContentId)
+}
+
+// MtData returns the mobile terminated data of the request.
+func (r DeliverRequest) MtData() ([]byte, error) {
+	return findBinaryPart(r.BinaryParts, r.JSONData.MtData.ContentId)
+}
+
+func findBinaryPart(parts []BinaryPart, contentID string) ([]byte, error) {
+	for _, part := range parts {
+		if part.ContentID == contentID {
+			return part.Body, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: content ID %q", ErrBinaryPart, contentID)
+}
@@ -0,0 +1,6 @@
+package mock
+
+//go:generate mockgen -source=../eventexposure.go -destination=eventexposure.go -package=mock
+//go:generate mockgen -source=../nidd.go -destination=nidd.go -package=mock
+//go:generate mockgen -source=../notification.go -destination=notification.go -package=mock
+//go:generate mockgen -source=../pdusession.go -destination=pdusession.go -package=mock
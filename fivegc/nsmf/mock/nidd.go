@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../nidd.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nsmf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nsmf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nsmf_NIDD "github.com/5GCoreNet/openapi/openapi_Nsmf_NIDD"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNIDD is a mock of NIDD interface.
+type MockNIDD struct {
+	ctrl     *gomock.Controller
+	recorder *MockNIDDMockRecorder
+}
+
+// MockNIDDMockRecorder is the mock recorder for MockNIDD.
+type MockNIDDMockRecorder struct {
+	mock *MockNIDD
+}
+
+// NewMockNIDD creates a new mock instance.
+func NewMockNIDD(ctrl *gomock.Controller) *MockNIDD {
+	mock := &MockNIDD{ctrl: ctrl}
+	mock.recorder = &MockNIDDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNIDD) EXPECT() *MockNIDDMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockNIDD) Deliver(arg0 context.Context, arg1 string, arg2 nsmf.DeliverRequest) (*openapi_Nsmf_NIDD.DeliverError, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.DeliverStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nsmf_NIDD.DeliverError)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.DeliverStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockNIDDMockRecorder) Deliver(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockNIDD)(nil).Deliver), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockNIDD) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockNIDDMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockNIDD)(nil).Error), ctx, err)
+}
+
+// MockNIDDService is a mock of NIDDService interface.
+type MockNIDDService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNIDDServiceMockRecorder
+}
+
+// MockNIDDServiceMockRecorder is the mock recorder for MockNIDDService.
+type MockNIDDServiceMockRecorder struct {
+	mock *MockNIDDService
+}
+
+// NewMockNIDDService creates a new mock instance.
+func NewMockNIDDService(ctrl *gomock.Controller) *MockNIDDService {
+	mock := &MockNIDDService{ctrl: ctrl}
+	mock.recorder = &MockNIDDServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNIDDService) EXPECT() *MockNIDDServiceMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockNIDDService) Deliver(arg0 context.Context, arg1 string, arg2 nsmf.DeliverRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockNIDDServiceMockRecorder) Deliver(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockNIDDService)(nil).Deliver), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockNIDDService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockNIDDServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockNIDDService)(nil).Error), ctx, err)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../notification.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nsmf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nsmf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nsmf_PDUSession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSMContextStatusNotification is a mock of SMContextStatusNotification interface.
+type MockSMContextStatusNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMContextStatusNotificationMockRecorder
+}
+
+// MockSMContextStatusNotificationMockRecorder is the mock recorder for MockSMContextStatusNotification.
+type MockSMContextStatusNotificationMockRecorder struct {
+	mock *MockSMContextStatusNotification
+}
+
+// NewMockSMContextStatusNotification creates a new mock instance.
+func NewMockSMContextStatusNotification(ctrl *gomock.Controller) *MockSMContextStatusNotification {
+	mock := &MockSMContextStatusNotification{ctrl: ctrl}
+	mock.recorder = &MockSMContextStatusNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMContextStatusNotification) EXPECT() *MockSMContextStatusNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockSMContextStatusNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockSMContextStatusNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSMContextStatusNotification)(nil).Error), ctx, err)
+}
+
+// SMContextStatusNotify mocks base method.
+func (m *MockSMContextStatusNotification) SMContextStatusNotify(arg0 context.Context, arg1 openapi_Nsmf_PDUSession.SmContextStatusNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.SMContextStatusNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SMContextStatusNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nsmf.SMContextStatusNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// SMContextStatusNotify indicates an expected call of SMContextStatusNotify.
+func (mr *MockSMContextStatusNotificationMockRecorder) SMContextStatusNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMContextStatusNotify", reflect.TypeOf((*MockSMContextStatusNotification)(nil).SMContextStatusNotify), arg0, arg1)
+}
+
+// MockPDUSessionStatusNotification is a mock of PDUSessionStatusNotification interface.
+type MockPDUSessionStatusNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockPDUSessionStatusNotificationMockRecorder
+}
+
+// MockPDUSessionStatusNotificationMockRecorder is the mock recorder for MockPDUSessionStatusNotification.
+type MockPDUSessionStatusNotificationMockRecorder struct {
+	mock *MockPDUSessionStatusNotification
+}
+
+// NewMockPDUSessionStatusNotification creates a new mock instance.
+func NewMockPDUSessionStatusNotification(ctrl *gomock.Controller) *MockPDUSessionStatusNotification {
+	mock := &MockPDUSessionStatusNotification{ctrl: ctrl}
+	mock.recorder = &MockPDUSessionStatusNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPDUSessionStatusNotification) EXPECT() *MockPDUSessionStatusNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockPDUSessionStatusNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockPDUSessionStatusNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockPDUSessionStatusNotification)(nil).Error), ctx, err)
+}
+
+// PDUSessionStatusNotify mocks base method.
+func (m *MockPDUSessionStatusNotification) PDUSessionStatusNotify(arg0 context.Context, arg1 openapi_Nsmf_PDUSession.StatusNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.PDUSessionStatusNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PDUSessionStatusNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nsmf.PDUSessionStatusNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// PDUSessionStatusNotify indicates an expected call of PDUSessionStatusNotify.
+func (mr *MockPDUSessionStatusNotificationMockRecorder) PDUSessionStatusNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PDUSessionStatusNotify", reflect.TypeOf((*MockPDUSessionStatusNotification)(nil).PDUSessionStatusNotify), arg0, arg1)
+}
@@ -0,0 +1,346 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../pdusession.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nsmf "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nsmf"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nsmf_PDUSession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPDUSession is a mock of PDUSession interface.
+type MockPDUSession struct {
+	ctrl     *gomock.Controller
+	recorder *MockPDUSessionMockRecorder
+}
+
+// MockPDUSessionMockRecorder is the mock recorder for MockPDUSession.
+type MockPDUSessionMockRecorder struct {
+	mock *MockPDUSession
+}
+
+// NewMockPDUSession creates a new mock instance.
+func NewMockPDUSession(ctrl *gomock.Controller) *MockPDUSession {
+	mock := &MockPDUSession{ctrl: ctrl}
+	mock.recorder = &MockPDUSessionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPDUSession) EXPECT() *MockPDUSessionMockRecorder {
+	return m.recorder
+}
+
+// CreatePDUSession mocks base method.
+func (m *MockPDUSession) CreatePDUSession(arg0 context.Context, arg1 nsmf.CreatePDUSessionRequest) (nsmf.CreatePDUSessionResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.CreatePDUSessionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePDUSession", arg0, arg1)
+	ret0, _ := ret[0].(nsmf.CreatePDUSessionResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.CreatePDUSessionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreatePDUSession indicates an expected call of CreatePDUSession.
+func (mr *MockPDUSessionMockRecorder) CreatePDUSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePDUSession", reflect.TypeOf((*MockPDUSession)(nil).CreatePDUSession), arg0, arg1)
+}
+
+// CreateSMContext mocks base method.
+func (m *MockPDUSession) CreateSMContext(arg0 context.Context, arg1 nsmf.CreateSMContextRequest) (nsmf.CreateSMContextResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.CreateSMContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSMContext", arg0, arg1)
+	ret0, _ := ret[0].(nsmf.CreateSMContextResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.CreateSMContextStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreateSMContext indicates an expected call of CreateSMContext.
+func (mr *MockPDUSessionMockRecorder) CreateSMContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSMContext", reflect.TypeOf((*MockPDUSession)(nil).CreateSMContext), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockPDUSession) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockPDUSessionMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockPDUSession)(nil).Error), ctx, err)
+}
+
+// ReleasePDUSession mocks base method.
+func (m *MockPDUSession) ReleasePDUSession(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.ReleaseData) (openapi_Nsmf_PDUSession.ReleasedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.ReleasePDUSessionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nsmf_PDUSession.ReleasedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.ReleasePDUSessionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ReleasePDUSession indicates an expected call of ReleasePDUSession.
+func (mr *MockPDUSessionMockRecorder) ReleasePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePDUSession", reflect.TypeOf((*MockPDUSession)(nil).ReleasePDUSession), arg0, arg1, arg2)
+}
+
+// ReleaseSMContext mocks base method.
+func (m *MockPDUSession) ReleaseSMContext(arg0 context.Context, arg1 string, arg2 nsmf.ReleaseSMContextRequest) (openapi_Nsmf_PDUSession.SmContextReleasedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.ReleaseSMContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nsmf_PDUSession.SmContextReleasedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.ReleaseSMContextStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ReleaseSMContext indicates an expected call of ReleaseSMContext.
+func (mr *MockPDUSessionMockRecorder) ReleaseSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSMContext", reflect.TypeOf((*MockPDUSession)(nil).ReleaseSMContext), arg0, arg1, arg2)
+}
+
+// RetrievePDUSession mocks base method.
+func (m *MockPDUSession) RetrievePDUSession(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.RetrieveData) (openapi_Nsmf_PDUSession.RetrievedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.RetrievePDUSessionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nsmf_PDUSession.RetrievedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.RetrievePDUSessionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RetrievePDUSession indicates an expected call of RetrievePDUSession.
+func (mr *MockPDUSessionMockRecorder) RetrievePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePDUSession", reflect.TypeOf((*MockPDUSession)(nil).RetrievePDUSession), arg0, arg1, arg2)
+}
+
+// RetrieveSMContext mocks base method.
+func (m *MockPDUSession) RetrieveSMContext(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.SmContextRetrieveData) (openapi_Nsmf_PDUSession.SmContextRetrievedData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.RetrieveSMContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nsmf_PDUSession.SmContextRetrievedData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.RetrieveSMContextStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RetrieveSMContext indicates an expected call of RetrieveSMContext.
+func (mr *MockPDUSessionMockRecorder) RetrieveSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSMContext", reflect.TypeOf((*MockPDUSession)(nil).RetrieveSMContext), arg0, arg1, arg2)
+}
+
+// UpdatePDUSession mocks base method.
+func (m *MockPDUSession) UpdatePDUSession(arg0 context.Context, arg1 string, arg2 nsmf.UpdatePDUSessionRequest) (nsmf.UpdatePDUSessionResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.UpdatePDUSessionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nsmf.UpdatePDUSessionResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.UpdatePDUSessionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// UpdatePDUSession indicates an expected call of UpdatePDUSession.
+func (mr *MockPDUSessionMockRecorder) UpdatePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePDUSession", reflect.TypeOf((*MockPDUSession)(nil).UpdatePDUSession), arg0, arg1, arg2)
+}
+
+// UpdateSMContext mocks base method.
+func (m *MockPDUSession) UpdateSMContext(arg0 context.Context, arg1 string, arg2 nsmf.UpdateSMContextRequest) (nsmf.UpdateSMContextResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nsmf.UpdateSMContextStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nsmf.UpdateSMContextResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nsmf.UpdateSMContextStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// UpdateSMContext indicates an expected call of UpdateSMContext.
+func (mr *MockPDUSessionMockRecorder) UpdateSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSMContext", reflect.TypeOf((*MockPDUSession)(nil).UpdateSMContext), arg0, arg1, arg2)
+}
+
+// MockPDUSessionService is a mock of PDUSessionService interface.
+type MockPDUSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPDUSessionServiceMockRecorder
+}
+
+// MockPDUSessionServiceMockRecorder is the mock recorder for MockPDUSessionService.
+type MockPDUSessionServiceMockRecorder struct {
+	mock *MockPDUSessionService
+}
+
+// NewMockPDUSessionService creates a new mock instance.
+func NewMockPDUSessionService(ctrl *gomock.Controller) *MockPDUSessionService {
+	mock := &MockPDUSessionService{ctrl: ctrl}
+	mock.recorder = &MockPDUSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPDUSessionService) EXPECT() *MockPDUSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePDUSession mocks base method.
+func (m *MockPDUSessionService) CreatePDUSession(arg0 context.Context, arg1 nsmf.CreatePDUSessionRequest) (*nsmf.CreatePDUSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePDUSession", arg0, arg1)
+	ret0, _ := ret[0].(*nsmf.CreatePDUSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePDUSession indicates an expected call of CreatePDUSession.
+func (mr *MockPDUSessionServiceMockRecorder) CreatePDUSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePDUSession", reflect.TypeOf((*MockPDUSessionService)(nil).CreatePDUSession), arg0, arg1)
+}
+
+// CreateSMContext mocks base method.
+func (m *MockPDUSessionService) CreateSMContext(arg0 context.Context, arg1 nsmf.CreateSMContextRequest) (*nsmf.CreateSMContextResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSMContext", arg0, arg1)
+	ret0, _ := ret[0].(*nsmf.CreateSMContextResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSMContext indicates an expected call of CreateSMContext.
+func (mr *MockPDUSessionServiceMockRecorder) CreateSMContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSMContext", reflect.TypeOf((*MockPDUSessionService)(nil).CreateSMContext), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockPDUSessionService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockPDUSessionServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockPDUSessionService)(nil).Error), ctx, err)
+}
+
+// ReleasePDUSession mocks base method.
+func (m *MockPDUSessionService) ReleasePDUSession(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.ReleaseData) (*openapi_Nsmf_PDUSession.ReleasedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nsmf_PDUSession.ReleasedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleasePDUSession indicates an expected call of ReleasePDUSession.
+func (mr *MockPDUSessionServiceMockRecorder) ReleasePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePDUSession", reflect.TypeOf((*MockPDUSessionService)(nil).ReleasePDUSession), arg0, arg1, arg2)
+}
+
+// ReleaseSMContext mocks base method.
+func (m *MockPDUSessionService) ReleaseSMContext(arg0 context.Context, arg1 string, arg2 nsmf.ReleaseSMContextRequest) (*openapi_Nsmf_PDUSession.SmContextReleasedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nsmf_PDUSession.SmContextReleasedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseSMContext indicates an expected call of ReleaseSMContext.
+func (mr *MockPDUSessionServiceMockRecorder) ReleaseSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSMContext", reflect.TypeOf((*MockPDUSessionService)(nil).ReleaseSMContext), arg0, arg1, arg2)
+}
+
+// RetrievePDUSession mocks base method.
+func (m *MockPDUSessionService) RetrievePDUSession(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.RetrieveData) (*openapi_Nsmf_PDUSession.RetrievedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nsmf_PDUSession.RetrievedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePDUSession indicates an expected call of RetrievePDUSession.
+func (mr *MockPDUSessionServiceMockRecorder) RetrievePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePDUSession", reflect.TypeOf((*MockPDUSessionService)(nil).RetrievePDUSession), arg0, arg1, arg2)
+}
+
+// RetrieveSMContext mocks base method.
+func (m *MockPDUSessionService) RetrieveSMContext(arg0 context.Context, arg1 string, arg2 openapi_Nsmf_PDUSession.SmContextRetrieveData) (*openapi_Nsmf_PDUSession.SmContextRetrievedData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nsmf_PDUSession.SmContextRetrievedData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSMContext indicates an expected call of RetrieveSMContext.
+func (mr *MockPDUSessionServiceMockRecorder) RetrieveSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSMContext", reflect.TypeOf((*MockPDUSessionService)(nil).RetrieveSMContext), arg0, arg1, arg2)
+}
+
+// UpdatePDUSession mocks base method.
+func (m *MockPDUSessionService) UpdatePDUSession(arg0 context.Context, arg1 string, arg2 nsmf.UpdatePDUSessionRequest) (*nsmf.UpdatePDUSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePDUSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nsmf.UpdatePDUSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePDUSession indicates an expected call of UpdatePDUSession.
+func (mr *MockPDUSessionServiceMockRecorder) UpdatePDUSession(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePDUSession", reflect.TypeOf((*MockPDUSessionService)(nil).UpdatePDUSession), arg0, arg1, arg2)
+}
+
+// UpdateSMContext mocks base method.
+func (m *MockPDUSessionService) UpdateSMContext(arg0 context.Context, arg1 string, arg2 nsmf.UpdateSMContextRequest) (*nsmf.UpdateSMContextResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSMContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nsmf.UpdateSMContextResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSMContext indicates an expected call of UpdateSMContext.
+func (mr *MockPDUSessionServiceMockRecorder) UpdateSMContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSMContext", reflect.TypeOf((*MockPDUSessionService)(nil).UpdateSMContext), arg0, arg1, arg2)
+}
@@ -0,0 +1,183 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfnidd "github.com/5GCoreNet/openapi/openapi_Nsmf_NIDD"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+)
+
+const (
+	niddRouterGroup = "/nsmf-nidd/v1"
+	deliverResource = "deliver"
+	deliverEndpoint = "/pdu-sessions/{pduSessionRef}/deliver"
+)
+
+// DeliverError is the error of a Deliver response carrying error data besides its ProblemDetails, such as the time
+// to wait before delivering the mobile terminated data again (TS 29.542 clause 6.1.6.2.3).
+// The error attribute of the error data is set from the ProblemDetails.
+type DeliverError struct {
+	fivegc.ProblemDetailsError
+	ErrorData nsmfnidd.DeliverError
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *DeliverError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// NIDD is the interface that wraps the NSMF NIDD service (TS 29.542 clause 5.2).
+// It is implemented by the SMF to deliver the mobile terminated data, sent by the NEF, to the UE over the PDU session
+// of a non-IP data delivery.
+type NIDD interface {
+	fivegc.CommonInterface
+	// Deliver delivers the mobile terminated data to the UE over the PDU session, identified by its reference.
+	// The ErrorData of the response is answered along with the ProblemDetails when it is not nil.
+	Deliver(context.Context, string, DeliverRequest) (*nsmfnidd.DeliverError, openapicommon.ProblemDetails, fivegc.RedirectResponse, DeliverStatusCode)
+}
+
+// NIDDService is an alternative to the NIDD interface whose handlers return an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). Use NewNIDD to attach it to the NSMF Server.
+type NIDDService interface {
+	fivegc.CommonInterface
+	// Deliver delivers the mobile terminated data to the UE over the PDU session, identified by its reference.
+	// A *DeliverError is answered along with its error data.
+	Deliver(context.Context, string, DeliverRequest) error
+}
+
+// DeliverStatusCode is the status code of a Deliver response.
+type DeliverStatusCode fivegc.StatusCode
+
+const (
+	// DeliverStatusNoContent is the status code for the response when the mobile terminated data is delivered.
+	DeliverStatusNoContent         DeliverStatusCode = DeliverStatusCode(fivegc.StatusNoContent)
+	DeliverStatusTemporaryRedirect DeliverStatusCode = DeliverStatusCode(fivegc.StatusTemporaryRedirect)
+	DeliverStatusPermanentRedirect DeliverStatusCode = DeliverStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deliverErrors = response.BodyErrors
+
+func attachNIDDHandler(r *gin.RouterGroup, n NIDD, logger *log.Logger) {
+	group := r.Group(niddRouterGroup)
+	{
+		group.POST(router.Path(deliverEndpoint), func(c *gin.Context) {
+			var req DeliverRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, n, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, deliverErrors)
+				return
+			}
+			errorData, problemDetails, redirectResponse, status := n.Deliver(c, c.Param(pduSessionRefParam), req)
+			switch status {
+			case DeliverStatusNoContent:
+				c.JSON(int(status), nil)
+			case DeliverStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeliverStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if errorData != nil && deliverErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, errorData, &errorData.Error, nil)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deliverErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewNIDD adapts a NIDDService to the NIDD interface.
+func NewNIDD(s NIDDService) NIDD {
+	return niddAdapter{service: s}
+}
+
+type niddAdapter struct {
+	service NIDDService
+}
+
+func (a niddAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a niddAdapter) Deliver(ctx context.Context, pduSessionRef string, req DeliverRequest) (*nsmfnidd.DeliverError, openapicommon.ProblemDetails, fivegc.RedirectResponse, DeliverStatusCode) {
+	if err := a.service.Deliver(ctx, pduSessionRef, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var deliverErr *DeliverError
+		if errors.As(err, &deliverErr) {
+			return &deliverErr.ErrorData, problemDetails, redirectResponse, DeliverStatusCode(status)
+		}
+		return nil, problemDetails, redirectResponse, DeliverStatusCode(status)
+	}
+	return nil, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeliverStatusNoContent
+}
+
+// NewNIDDService adapts a NIDD to the NIDDService interface.
+func NewNIDDService(n NIDD) NIDDService {
+	return niddServiceAdapter{nidd: n}
+}
+
+type niddServiceAdapter struct {
+	nidd NIDD
+}
+
+func (a niddServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.nidd.Error(ctx, err)
+}
+
+func (a niddServiceAdapter) Deliver(ctx context.Context, pduSessionRef string, req DeliverRequest) error {
+	errorData, problemDetails, redirectResponse, status := a.nidd.Deliver(ctx, pduSessionRef, req)
+	if status == DeliverStatusNoContent {
+		return nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if errorData != nil && errors.As(err, &problemDetailsErr) {
+		return &DeliverError{ProblemDetailsError: problemDetailsErr, ErrorData: *errorData}
+	}
+	return err
+}
+
+// NIDDClient is a client for the NSMF NIDD service.
+type NIDDClient struct {
+	request *request.Client
+}
+
+// NewNIDDClient creates a new client for the NSMF NIDD service.
+func NewNIDDClient(cfg fivegc.ClientConfiguration) *NIDDClient {
+	return &NIDDClient{
+		request: request.NewClient(cfg, niddRouterGroup),
+	}
+}
+
+// Deliver sends the mobile terminated data to the SMF, to be delivered to the UE over the PDU session identified by
+// its reference. Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *DeliverError when they
+// carry error data, or as a *fivegc.RedirectError for the redirections.
+func (c *NIDDClient) Deliver(ctx context.Context, pduSessionRef string, req DeliverRequest) (*http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(pduSessionsResource, pduSessionRef, deliverResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return resp, fmt.Errorf("deliver: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data nsmfnidd.DeliverError
+		if problemDetailsErr, _, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return resp, fmt.Errorf("deliver: %w", &DeliverError{ProblemDetailsError: problemDetailsErr, ErrorData: data})
+		}
+		return resp, fmt.Errorf("deliver: %w", request.Error(resp, raw))
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfnidd "github.com/5GCoreNet/openapi/openapi_Nsmf_NIDD"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// niddSMF delivers the mobile terminated data over the PDU session 1, whose UE is reachable again in 60 seconds. The
+// PDU session 3 answers with a status code the operation does not allow.
+type niddSMF struct {
+	delivered [][]byte
+	reachable bool
+}
+
+func (s *niddSMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (s *niddSMF) Deliver(_ context.Context, pduSessionRef string, req DeliverRequest) error {
+	if pduSessionRef == "3" {
+		var data nsmfnidd.DeliverError
+		data.SetMaxWaitingTime(60)
+		return &DeliverError{ProblemDetailsError: fivegc.ProblemDetailsError(fivegc.NewApplicationError(fivegc.StatusConflict, "").ProblemDetails()), ErrorData: data}
+	}
+	if pduSessionRef != "1" {
+		return ErrContextNotFound
+	}
+	mtData, err := req.MtData()
+	if err != nil {
+		return fivegc.ErrMandatoryIeMissing.WithDetail("%s", err)
+	}
+	if !s.reachable {
+		var data nsmfnidd.DeliverError
+		data.SetMaxWaitingTime(60)
+		return &DeliverError{ProblemDetailsError: fivegc.ProblemDetailsError(ErrUENotResponding.ProblemDetails()), ErrorData: data}
+	}
+	s.delivered = append(s.delivered, mtData)
+	return nil
+}
+
+func TestNIDD(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	s := &niddSMF{}
+	attachNIDDHandler(router.Group(""), NewNIDD(s), log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewNIDDClient(fivegc.ClientConfiguration{Servers: fivegc.ServerConfigurations{{URL: server.URL + niddRouterGroup}}, HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var req DeliverRequest
+	req.JSONData.MtData.ContentId = "mtData"
+	req.BinaryParts = []BinaryPart{{ContentID: "mtData", ContentType: "application/octet-stream", Body: []byte("hello")}}
+	_, err := client.Deliver(ctx, "1", req)
+	var deliverErr *DeliverError
+	if !errors.As(err, &deliverErr) || deliverErr.ErrorData.GetMaxWaitingTime() != 60 || *deliverErr.Cause != CauseUENotResponding {
+		t.Fatalf("unexpected error %v", err)
+	}
+	s.reachable = true
+	if resp, err := client.Deliver(ctx, "1", req); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(s.delivered) != 1 || string(s.delivered[0]) != "hello" {
+		t.Errorf("unexpected delivered data %q", s.delivered)
+	}
+	_, err = client.Deliver(ctx, "2", req)
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || errors.As(err, &deliverErr) || *problemDetailsErr.Status != int32(fivegc.StatusNotFound) {
+		t.Errorf("unexpected error %v", err)
+	}
+	_, err = client.Deliver(ctx, "3", req)
+	if !errors.As(err, &problemDetailsErr) || errors.As(err, &deliverErr) || *problemDetailsErr.Status != int32(fivegc.StatusInternalServerError) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
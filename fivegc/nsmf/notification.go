@@ -0,0 +1,106 @@
+package nsmf
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// SMContextStatusNotification is the interface that wraps the SM context status notification of the NSMF PDUSession
+// service (TS 29.502 clause 5.2.2.5). It is implemented by the AMF to receive the status of the SM contexts, such as
+// their release, sent by the SMF to the smContextStatusUri given when creating them.
+type SMContextStatusNotification interface {
+	fivegc.CommonInterface
+	// SMContextStatusNotify receives the status of an SM context.
+	SMContextStatusNotify(context.Context, nsmfpdusession.SmContextStatusNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, SMContextStatusNotifyStatusCode)
+}
+
+// PDUSessionStatusNotification is the interface that wraps the PDU session status notification of the NSMF
+// PDUSession service (TS 29.502 clause 5.2.2.10). It is implemented by the V-SMF or the I-SMF to receive the status
+// of the PDU sessions, sent by the H-SMF or the SMF to the vsmfPduSessionUri or the ismfPduSessionUri given when
+// creating them.
+type PDUSessionStatusNotification interface {
+	fivegc.CommonInterface
+	// PDUSessionStatusNotify receives the status of a PDU session.
+	PDUSessionStatusNotify(context.Context, nsmfpdusession.StatusNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, PDUSessionStatusNotifyStatusCode)
+}
+
+// SMContextStatusNotifyStatusCode is the status code of an SMContextStatusNotify response.
+type SMContextStatusNotifyStatusCode fivegc.StatusCode
+
+const (
+	// SMContextStatusNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	SMContextStatusNotifyStatusNoContent         SMContextStatusNotifyStatusCode = SMContextStatusNotifyStatusCode(fivegc.StatusNoContent)
+	SMContextStatusNotifyStatusTemporaryRedirect SMContextStatusNotifyStatusCode = SMContextStatusNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	SMContextStatusNotifyStatusPermanentRedirect SMContextStatusNotifyStatusCode = SMContextStatusNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var smContextStatusNotifyErrors = response.BodyErrors
+
+// PDUSessionStatusNotifyStatusCode is the status code of a PDUSessionStatusNotify response.
+type PDUSessionStatusNotifyStatusCode fivegc.StatusCode
+
+const (
+	// PDUSessionStatusNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	PDUSessionStatusNotifyStatusNoContent         PDUSessionStatusNotifyStatusCode = PDUSessionStatusNotifyStatusCode(fivegc.StatusNoContent)
+	PDUSessionStatusNotifyStatusTemporaryRedirect PDUSessionStatusNotifyStatusCode = PDUSessionStatusNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	PDUSessionStatusNotifyStatusPermanentRedirect PDUSessionStatusNotifyStatusCode = PDUSessionStatusNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var pduSessionStatusNotifyErrors = response.BodyErrors
+
+func attachSMContextStatusNotificationHandler(router *gin.RouterGroup, path string, n SMContextStatusNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nsmfpdusession.SmContextStatusNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, smContextStatusNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.SMContextStatusNotify(c, req)
+		switch status {
+		case SMContextStatusNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case SMContextStatusNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case SMContextStatusNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, smContextStatusNotifyErrors)
+		}
+		return
+	})
+}
+
+func attachPDUSessionStatusNotificationHandler(router *gin.RouterGroup, path string, n PDUSessionStatusNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nsmfpdusession.StatusNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, pduSessionStatusNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.PDUSessionStatusNotify(c, req)
+		switch status {
+		case PDUSessionStatusNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case PDUSessionStatusNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case PDUSessionStatusNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, pduSessionStatusNotifyErrors)
+		}
+		return
+	})
+}
@@ -0,0 +1,85 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http/httptest"
+	"testing"
+)
+
+// servingAMF receives the status of the SM contexts created by the SMF.
+type servingAMF struct {
+	released []string
+}
+
+func (a *servingAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *servingAMF) SMContextStatusNotify(_ context.Context, req nsmfpdusession.SmContextStatusNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, SMContextStatusNotifyStatusCode) {
+	if status := req.StatusInfo.ResourceStatus; status.String == nil || *status.String != ResourceStatusReleased {
+		return fivegc.ErrMandatoryIeIncorrect.ProblemDetails(), fivegc.RedirectResponse{}, SMContextStatusNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	a.released = append(a.released, req.GetOldSmContextRef())
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, SMContextStatusNotifyStatusNoContent
+}
+
+// visitedSMF receives the status of the PDU sessions created in the H-SMF.
+type visitedSMF struct {
+	statuses []string
+}
+
+func (v *visitedSMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (v *visitedSMF) PDUSessionStatusNotify(_ context.Context, req nsmfpdusession.StatusNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, PDUSessionStatusNotifyStatusCode) {
+	v.statuses = append(v.statuses, *req.StatusInfo.ResourceStatus.String)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, PDUSessionStatusNotifyStatusNoContent
+}
+
+func TestStatusNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &servingAMF{}
+	v := &visitedSMF{}
+	attachSMContextStatusNotificationHandler(router.Group(""), "/sm-context-status/1", a, log.Default())
+	attachPDUSessionStatusNotificationHandler(router.Group(""), "/vsmf/pdu-sessions/1", v, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewPDUSessionClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var smContextStatus nsmfpdusession.SmContextStatusNotification
+	smContextStatus.StatusInfo.ResourceStatus = nsmfpdusession.ResourceStatus{String: fivegc.ToString(ResourceStatusReleased)}
+	smContextStatus.SetOldSmContextRef("1")
+	if err := client.SMContextStatusNotify(ctx, server.URL+"/sm-context-status/1", smContextStatus); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.released) != 1 || a.released[0] != "1" {
+		t.Errorf("unexpected released sm contexts %v", a.released)
+	}
+	smContextStatus.StatusInfo.ResourceStatus = nsmfpdusession.ResourceStatus{String: fivegc.ToString(ResourceStatusUpdated)}
+	err := client.SMContextStatusNotify(ctx, server.URL+"/sm-context-status/1", smContextStatus)
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusBadRequest) {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := client.SMContextStatusNotify(ctx, "", smContextStatus); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	var status nsmfpdusession.StatusNotification
+	status.StatusInfo.ResourceStatus = nsmfpdusession.ResourceStatus{String: fivegc.ToString(ResourceStatusTransferred)}
+	if err := client.PDUSessionStatusNotify(ctx, server.URL+"/vsmf/pdu-sessions/1", status); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(v.statuses) != 1 || v.statuses[0] != ResourceStatusTransferred {
+		t.Errorf("unexpected statuses %v", v.statuses)
+	}
+}
@@ -0,0 +1,734 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+const (
+	pduSessionRouterGroup      = "/nsmf-pdusession/v1"
+	smContextsResource         = "sm-contexts"
+	pduSessionsResource        = "pdu-sessions"
+	modifyResource             = "modify"
+	releaseResource            = "release"
+	smContextRefParam          = "smContextRef"
+	pduSessionRefParam         = "pduSessionRef"
+	smContextsEndpoint         = "/sm-contexts"
+	retrieveSMContextEndpoint  = "/sm-contexts/{smContextRef}/retrieve"
+	updateSMContextEndpoint    = "/sm-contexts/{smContextRef}/modify"
+	releaseSMContextEndpoint   = "/sm-contexts/{smContextRef}/release"
+	pduSessionsEndpoint        = "/pdu-sessions"
+	updatePDUSessionEndpoint   = "/pdu-sessions/{pduSessionRef}/modify"
+	releasePDUSessionEndpoint  = "/pdu-sessions/{pduSessionRef}/release"
+	retrievePDUSessionEndpoint = "/pdu-sessions/{pduSessionRef}/retrieve"
+)
+
+// Resource statuses of the status notifications, defined in TS 29.502 clause 6.1.6.3.8.
+const (
+	ResourceStatusReleased     = "RELEASED"
+	ResourceStatusUnchanged    = "UNCHANGED"
+	ResourceStatusTransferred  = "TRANSFERRED"
+	ResourceStatusUpdated      = "UPDATED"
+	ResourceStatusAltAnchorSMF = "ALT_ANCHOR_SMF"
+)
+
+// User plane connection states of an SM context, defined in TS 29.502 clause 6.1.6.3.2.
+const (
+	UpCnxStateActivated   = "ACTIVATED"
+	UpCnxStateDeactivated = "DEACTIVATED"
+	UpCnxStateActivating  = "ACTIVATING"
+	UpCnxStateSuspended   = "SUSPENDED"
+)
+
+// Handover states of an SM context, defined in TS 29.502 clause 6.1.6.3.4.
+const (
+	HoStateNone      = "NONE"
+	HoStatePreparing = "PREPARING"
+	HoStatePrepared  = "PREPARED"
+	HoStateCompleted = "COMPLETED"
+	HoStateCancelled = "CANCELLED"
+)
+
+// Request indications of an UpdatePDUSession request, defined in TS 29.502 clause 6.1.6.3.6.
+const (
+	RequestIndicationUEReqPDUSesMod      = "UE_REQ_PDU_SES_MOD"
+	RequestIndicationUEReqPDUSesRel      = "UE_REQ_PDU_SES_REL"
+	RequestIndicationPDUSesMob           = "PDU_SES_MOB"
+	RequestIndicationNWReqPDUSesAuth     = "NW_REQ_PDU_SES_AUTH"
+	RequestIndicationNWReqPDUSesMod      = "NW_REQ_PDU_SES_MOD"
+	RequestIndicationEBIAssignmentReq    = "EBI_ASSIGNMENT_REQ"
+	RequestIndicationRelDueTo5GANRequest = "REL_DUE_TO_5G_AN_REQUEST"
+)
+
+// CreateSMContextError is the error of a CreateSMContext response carrying error data besides its ProblemDetails,
+// such as the N1 SM message rejecting the PDU session establishment (TS 29.502 clause 6.1.6.2).
+// The error attribute of the error data is set from the ProblemDetails.
+type CreateSMContextError struct {
+	fivegc.ProblemDetailsError
+	ErrorData   nsmfpdusession.SmContextCreateError
+	BinaryParts []BinaryPart
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *CreateSMContextError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// UpdateSMContextError is the error of an UpdateSMContext response carrying error data besides its ProblemDetails,
+// such as the N1 SM message or the N2 SM information rejecting the update (TS 29.502 clause 6.1.6.2).
+// The error attribute of the error data is set from the ProblemDetails.
+type UpdateSMContextError struct {
+	fivegc.ProblemDetailsError
+	ErrorData   nsmfpdusession.SmContextUpdateError
+	BinaryParts []BinaryPart
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *UpdateSMContextError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// CreatePDUSessionError is the error of a CreatePDUSession response carrying error data besides its ProblemDetails,
+// such as the 5GSM cause and the N1 SM information rejecting the PDU session establishment (TS 29.502 clause 6.1.6.2).
+// The error attribute of the error data is set from the ProblemDetails.
+type CreatePDUSessionError struct {
+	fivegc.ProblemDetailsError
+	ErrorData   nsmfpdusession.PduSessionCreateError
+	BinaryParts []BinaryPart
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *CreatePDUSessionError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// UpdatePDUSessionError is the error of an UpdatePDUSession response carrying error data besides its ProblemDetails,
+// such as the 5GSM cause and the N1 SM information rejecting the PDU session modification (TS 29.502 clause 6.1.6.2).
+// The error attribute of the error data is set from the ProblemDetails.
+type UpdatePDUSessionError struct {
+	fivegc.ProblemDetailsError
+	ErrorData   nsmfpdusession.HsmfUpdateError
+	BinaryParts []BinaryPart
+}
+
+// Unwrap returns the fivegc.ProblemDetailsError of the response.
+func (e *UpdatePDUSessionError) Unwrap() error {
+	return e.ProblemDetailsError
+}
+
+// PDUSession is the interface that wraps the NSMF PDUSession service (TS 29.502 clause 5.2).
+// The SM context operations are served by the SMF, the V-SMF or the I-SMF to the AMF, and the PDU session operations
+// by the H-SMF or the SMF to the V-SMF or the I-SMF of a home-routed or an I-SMF PDU session.
+// Error responses may carry error data along with their ProblemDetails, see CreateSMContextResponse.ErrorData.
+type PDUSession interface {
+	fivegc.CommonInterface
+	// CreateSMContext creates the SM context of a PDU session establishment or of a handover.
+	CreateSMContext(context.Context, CreateSMContextRequest) (CreateSMContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSMContextStatusCode)
+	// RetrieveSMContext retrieves the SM context, identified by its reference, such as for a mobility to EPS.
+	RetrieveSMContext(context.Context, string, nsmfpdusession.SmContextRetrieveData) (nsmfpdusession.SmContextRetrievedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, RetrieveSMContextStatusCode)
+	// UpdateSMContext updates the SM context, identified by its reference.
+	UpdateSMContext(context.Context, string, UpdateSMContextRequest) (UpdateSMContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateSMContextStatusCode)
+	// ReleaseSMContext releases the SM context, identified by its reference.
+	ReleaseSMContext(context.Context, string, ReleaseSMContextRequest) (nsmfpdusession.SmContextReleasedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleaseSMContextStatusCode)
+	// CreatePDUSession creates the PDU session in the H-SMF, or in the SMF, on behalf of the V-SMF or the I-SMF.
+	CreatePDUSession(context.Context, CreatePDUSessionRequest) (CreatePDUSessionResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreatePDUSessionStatusCode)
+	// UpdatePDUSession updates the PDU session, identified by its reference.
+	UpdatePDUSession(context.Context, string, UpdatePDUSessionRequest) (UpdatePDUSessionResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdatePDUSessionStatusCode)
+	// ReleasePDUSession releases the PDU session, identified by its reference.
+	ReleasePDUSession(context.Context, string, nsmfpdusession.ReleaseData) (nsmfpdusession.ReleasedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleasePDUSessionStatusCode)
+	// RetrievePDUSession retrieves the PDU session, identified by its reference.
+	RetrievePDUSession(context.Context, string, nsmfpdusession.RetrieveData) (nsmfpdusession.RetrievedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, RetrievePDUSessionStatusCode)
+}
+
+// PDUSessionService is an alternative to the PDUSession interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). A nil result is answered as an empty one, or with 204 No Content
+// for the operations allowing it. Use NewPDUSession to attach it to the NSMF Server.
+type PDUSessionService interface {
+	fivegc.CommonInterface
+	// CreateSMContext creates the SM context of a PDU session establishment or of a handover.
+	// A *CreateSMContextError is answered along with its error data.
+	CreateSMContext(context.Context, CreateSMContextRequest) (*CreateSMContextResponse, error)
+	// RetrieveSMContext retrieves the SM context, identified by its reference, such as for a mobility to EPS.
+	RetrieveSMContext(context.Context, string, nsmfpdusession.SmContextRetrieveData) (*nsmfpdusession.SmContextRetrievedData, error)
+	// UpdateSMContext updates the SM context, identified by its reference.
+	// A *UpdateSMContextError is answered along with its error data.
+	UpdateSMContext(context.Context, string, UpdateSMContextRequest) (*UpdateSMContextResponse, error)
+	// ReleaseSMContext releases the SM context, identified by its reference.
+	ReleaseSMContext(context.Context, string, ReleaseSMContextRequest) (*nsmfpdusession.SmContextReleasedData, error)
+	// CreatePDUSession creates the PDU session in the H-SMF, or in the SMF, on behalf of the V-SMF or the I-SMF.
+	// A *CreatePDUSessionError is answered along with its error data.
+	CreatePDUSession(context.Context, CreatePDUSessionRequest) (*CreatePDUSessionResponse, error)
+	// UpdatePDUSession updates the PDU session, identified by its reference.
+	// A *UpdatePDUSessionError is answered along with its error data.
+	UpdatePDUSession(context.Context, string, UpdatePDUSessionRequest) (*UpdatePDUSessionResponse, error)
+	// ReleasePDUSession releases the PDU session, identified by its reference.
+	ReleasePDUSession(context.Context, string, nsmfpdusession.ReleaseData) (*nsmfpdusession.ReleasedData, error)
+	// RetrievePDUSession retrieves the PDU session, identified by its reference.
+	RetrievePDUSession(context.Context, string, nsmfpdusession.RetrieveData) (*nsmfpdusession.RetrievedData, error)
+}
+
+// CreateSMContextStatusCode is the status code of a CreateSMContext response.
+type CreateSMContextStatusCode fivegc.StatusCode
+
+const (
+	// CreateSMContextStatusCreated is the status code for the response when the SM context is created.
+	CreateSMContextStatusCreated           CreateSMContextStatusCode = CreateSMContextStatusCode(fivegc.StatusCreated)
+	CreateSMContextStatusTemporaryRedirect CreateSMContextStatusCode = CreateSMContextStatusCode(fivegc.StatusTemporaryRedirect)
+	CreateSMContextStatusPermanentRedirect CreateSMContextStatusCode = CreateSMContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var createSMContextErrors = response.BodyErrors
+
+// RetrieveSMContextStatusCode is the status code of a RetrieveSMContext response.
+type RetrieveSMContextStatusCode fivegc.StatusCode
+
+const (
+	// RetrieveSMContextStatusOK is the status code for a successful response.
+	RetrieveSMContextStatusOK                RetrieveSMContextStatusCode = RetrieveSMContextStatusCode(fivegc.StatusOK)
+	RetrieveSMContextStatusTemporaryRedirect RetrieveSMContextStatusCode = RetrieveSMContextStatusCode(fivegc.StatusTemporaryRedirect)
+	RetrieveSMContextStatusPermanentRedirect RetrieveSMContextStatusCode = RetrieveSMContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var retrieveSMContextErrors = response.BodyErrors
+
+// UpdateSMContextStatusCode is the status code of an UpdateSMContext response.
+// The operation may also fail with 409 Conflict.
+type UpdateSMContextStatusCode fivegc.StatusCode
+
+const (
+	// UpdateSMContextStatusOK is the status code for the response when the SM context is updated with data to return.
+	UpdateSMContextStatusOK UpdateSMContextStatusCode = UpdateSMContextStatusCode(fivegc.StatusOK)
+	// UpdateSMContextStatusNoContent is the status code for the response when the SM context is updated without data to return.
+	UpdateSMContextStatusNoContent         UpdateSMContextStatusCode = UpdateSMContextStatusCode(fivegc.StatusNoContent)
+	UpdateSMContextStatusTemporaryRedirect UpdateSMContextStatusCode = UpdateSMContextStatusCode(fivegc.StatusTemporaryRedirect)
+	UpdateSMContextStatusPermanentRedirect UpdateSMContextStatusCode = UpdateSMContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var updateSMContextErrors = response.BodyErrors.With(fivegc.StatusConflict)
+
+// ReleaseSMContextStatusCode is the status code of a ReleaseSMContext response.
+// The operation may also fail with 409 Conflict.
+type ReleaseSMContextStatusCode fivegc.StatusCode
+
+const (
+	// ReleaseSMContextStatusOK is the status code for the response when the SM context is released with data to return.
+	ReleaseSMContextStatusOK ReleaseSMContextStatusCode = ReleaseSMContextStatusCode(fivegc.StatusOK)
+	// ReleaseSMContextStatusNoContent is the status code for the response when the SM context is released without data to return.
+	ReleaseSMContextStatusNoContent         ReleaseSMContextStatusCode = ReleaseSMContextStatusCode(fivegc.StatusNoContent)
+	ReleaseSMContextStatusTemporaryRedirect ReleaseSMContextStatusCode = ReleaseSMContextStatusCode(fivegc.StatusTemporaryRedirect)
+	ReleaseSMContextStatusPermanentRedirect ReleaseSMContextStatusCode = ReleaseSMContextStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var releaseSMContextErrors = response.BodyErrors.With(fivegc.StatusConflict)
+
+// CreatePDUSessionStatusCode is the status code of a CreatePDUSession response.
+type CreatePDUSessionStatusCode fivegc.StatusCode
+
+const (
+	// CreatePDUSessionStatusCreated is the status code for the response when the PDU session is created.
+	CreatePDUSessionStatusCreated           CreatePDUSessionStatusCode = CreatePDUSessionStatusCode(fivegc.StatusCreated)
+	CreatePDUSessionStatusTemporaryRedirect CreatePDUSessionStatusCode = CreatePDUSessionStatusCode(fivegc.StatusTemporaryRedirect)
+	CreatePDUSessionStatusPermanentRedirect CreatePDUSessionStatusCode = CreatePDUSessionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var createPDUSessionErrors = response.BodyErrors
+
+// UpdatePDUSessionStatusCode is the status code of an UpdatePDUSession response.
+// The operation may also fail with 409 Conflict.
+type UpdatePDUSessionStatusCode fivegc.StatusCode
+
+const (
+	// UpdatePDUSessionStatusOK is the status code for the response when the PDU session is updated with data to return.
+	UpdatePDUSessionStatusOK UpdatePDUSessionStatusCode = UpdatePDUSessionStatusCode(fivegc.StatusOK)
+	// UpdatePDUSessionStatusNoContent is the status code for the response when the PDU session is updated without data to return.
+	UpdatePDUSessionStatusNoContent         UpdatePDUSessionStatusCode = UpdatePDUSessionStatusCode(fivegc.StatusNoContent)
+	UpdatePDUSessionStatusTemporaryRedirect UpdatePDUSessionStatusCode = UpdatePDUSessionStatusCode(fivegc.StatusTemporaryRedirect)
+	UpdatePDUSessionStatusPermanentRedirect UpdatePDUSessionStatusCode = UpdatePDUSessionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var updatePDUSessionErrors = response.BodyErrors.With(fivegc.StatusConflict)
+
+// ReleasePDUSessionStatusCode is the status code of a ReleasePDUSession response.
+// The operation may also fail with 409 Conflict.
+type ReleasePDUSessionStatusCode fivegc.StatusCode
+
+const (
+	// ReleasePDUSessionStatusOK is the status code for the response when the PDU session is released with data to return.
+	ReleasePDUSessionStatusOK ReleasePDUSessionStatusCode = ReleasePDUSessionStatusCode(fivegc.StatusOK)
+	// ReleasePDUSessionStatusNoContent is the status code for the response when the PDU session is released without data to return.
+	ReleasePDUSessionStatusNoContent         ReleasePDUSessionStatusCode = ReleasePDUSessionStatusCode(fivegc.StatusNoContent)
+	ReleasePDUSessionStatusTemporaryRedirect ReleasePDUSessionStatusCode = ReleasePDUSessionStatusCode(fivegc.StatusTemporaryRedirect)
+	ReleasePDUSessionStatusPermanentRedirect ReleasePDUSessionStatusCode = ReleasePDUSessionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var releasePDUSessionErrors = response.BodyErrors.With(fivegc.StatusConflict)
+
+// RetrievePDUSessionStatusCode is the status code of a RetrievePDUSession response.
+type RetrievePDUSessionStatusCode fivegc.StatusCode
+
+const (
+	// RetrievePDUSessionStatusOK is the status code for a successful response.
+	RetrievePDUSessionStatusOK                RetrievePDUSessionStatusCode = RetrievePDUSessionStatusCode(fivegc.StatusOK)
+	RetrievePDUSessionStatusTemporaryRedirect RetrievePDUSessionStatusCode = RetrievePDUSessionStatusCode(fivegc.StatusTemporaryRedirect)
+	RetrievePDUSessionStatusPermanentRedirect RetrievePDUSessionStatusCode = RetrievePDUSessionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var retrievePDUSessionErrors = response.BodyErrors
+
+func attachPDUSessionHandler(r *gin.RouterGroup, p PDUSession, logger *log.Logger) {
+	group := r.Group(pduSessionRouterGroup)
+	{
+		group.POST(router.Path(smContextsEndpoint), func(c *gin.Context) {
+			var req CreateSMContextRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, createSMContextErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.CreateSMContext(c, req)
+			switch status {
+			case CreateSMContextStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.SMContextRef))
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case CreateSMContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CreateSMContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrorData != nil && createSMContextErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, res.ErrorData, &res.ErrorData.Error, res.BinaryParts)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, createSMContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(retrieveSMContextEndpoint), func(c *gin.Context) {
+			var req nsmfpdusession.SmContextRetrieveData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, retrieveSMContextErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.RetrieveSMContext(c, c.Param(smContextRefParam), req)
+			switch status {
+			case RetrieveSMContextStatusOK:
+				c.JSON(int(status), res)
+			case RetrieveSMContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RetrieveSMContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, retrieveSMContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(updateSMContextEndpoint), func(c *gin.Context) {
+			var req UpdateSMContextRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, updateSMContextErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.UpdateSMContext(c, c.Param(smContextRefParam), req)
+			switch status {
+			case UpdateSMContextStatusOK:
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case UpdateSMContextStatusNoContent:
+				c.JSON(int(status), nil)
+			case UpdateSMContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UpdateSMContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrorData != nil && updateSMContextErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, res.ErrorData, &res.ErrorData.Error, res.BinaryParts)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, updateSMContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(releaseSMContextEndpoint), func(c *gin.Context) {
+			var req ReleaseSMContextRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, releaseSMContextErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.ReleaseSMContext(c, c.Param(smContextRefParam), req)
+			switch status {
+			case ReleaseSMContextStatusOK:
+				c.JSON(int(status), res)
+			case ReleaseSMContextStatusNoContent:
+				c.JSON(int(status), nil)
+			case ReleaseSMContextStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ReleaseSMContextStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, releaseSMContextErrors)
+			}
+			return
+		})
+		group.POST(router.Path(pduSessionsEndpoint), func(c *gin.Context) {
+			var req CreatePDUSessionRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, createPDUSessionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.CreatePDUSession(c, req)
+			switch status {
+			case CreatePDUSessionStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.PDUSessionRef))
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case CreatePDUSessionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case CreatePDUSessionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrorData != nil && createPDUSessionErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, res.ErrorData, &res.ErrorData.Error, res.BinaryParts)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, createPDUSessionErrors)
+			}
+			return
+		})
+		group.POST(router.Path(updatePDUSessionEndpoint), func(c *gin.Context) {
+			var req UpdatePDUSessionRequest
+			var err error
+			if req.BinaryParts, err = multipart.Bind(c, &req.JSONData); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, updatePDUSessionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.UpdatePDUSession(c, c.Param(pduSessionRefParam), req)
+			switch status {
+			case UpdatePDUSessionStatusOK:
+				multipart.Render(c, logger, int(status), res.JSONData, res.BinaryParts)
+			case UpdatePDUSessionStatusNoContent:
+				c.JSON(int(status), nil)
+			case UpdatePDUSessionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UpdatePDUSessionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				if res.ErrorData != nil && updatePDUSessionErrors.Allows(fivegc.StatusCode(status)) {
+					multipart.RenderError(c, logger, int(status), problemDetails, res.ErrorData, &res.ErrorData.Error, res.BinaryParts)
+					return
+				}
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, updatePDUSessionErrors)
+			}
+			return
+		})
+		group.POST(router.Path(releasePDUSessionEndpoint), func(c *gin.Context) {
+			var req nsmfpdusession.ReleaseData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, releasePDUSessionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.ReleasePDUSession(c, c.Param(pduSessionRefParam), req)
+			switch status {
+			case ReleasePDUSessionStatusOK:
+				c.JSON(int(status), res)
+			case ReleasePDUSessionStatusNoContent:
+				c.JSON(int(status), nil)
+			case ReleasePDUSessionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ReleasePDUSessionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, releasePDUSessionErrors)
+			}
+			return
+		})
+		group.POST(router.Path(retrievePDUSessionEndpoint), func(c *gin.Context) {
+			var req nsmfpdusession.RetrieveData
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, p, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, retrievePDUSessionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := p.RetrievePDUSession(c, c.Param(pduSessionRefParam), req)
+			switch status {
+			case RetrievePDUSessionStatusOK:
+				c.JSON(int(status), res)
+			case RetrievePDUSessionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RetrievePDUSessionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, retrievePDUSessionErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewPDUSession adapts a PDUSessionService to the PDUSession interface.
+func NewPDUSession(s PDUSessionService) PDUSession {
+	return pduSessionAdapter{service: s}
+}
+
+type pduSessionAdapter struct {
+	service PDUSessionService
+}
+
+func (a pduSessionAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a pduSessionAdapter) CreateSMContext(ctx context.Context, req CreateSMContextRequest) (CreateSMContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreateSMContextStatusCode) {
+	res, err := a.service.CreateSMContext(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var createErr *CreateSMContextError
+		if errors.As(err, &createErr) {
+			return CreateSMContextResponse{ErrorData: &createErr.ErrorData, BinaryParts: createErr.BinaryParts}, problemDetails, redirectResponse, CreateSMContextStatusCode(status)
+		}
+		return CreateSMContextResponse{}, problemDetails, redirectResponse, CreateSMContextStatusCode(status)
+	}
+	if res == nil {
+		res = &CreateSMContextResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CreateSMContextStatusCreated
+}
+
+func (a pduSessionAdapter) RetrieveSMContext(ctx context.Context, smContextRef string, req nsmfpdusession.SmContextRetrieveData) (nsmfpdusession.SmContextRetrievedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, RetrieveSMContextStatusCode) {
+	res, err := a.service.RetrieveSMContext(ctx, smContextRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfpdusession.SmContextRetrievedData{}, problemDetails, redirectResponse, RetrieveSMContextStatusCode(status)
+	}
+	if res == nil {
+		res = &nsmfpdusession.SmContextRetrievedData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RetrieveSMContextStatusOK
+}
+
+func (a pduSessionAdapter) UpdateSMContext(ctx context.Context, smContextRef string, req UpdateSMContextRequest) (UpdateSMContextResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateSMContextStatusCode) {
+	res, err := a.service.UpdateSMContext(ctx, smContextRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var updateErr *UpdateSMContextError
+		if errors.As(err, &updateErr) {
+			return UpdateSMContextResponse{ErrorData: &updateErr.ErrorData, BinaryParts: updateErr.BinaryParts}, problemDetails, redirectResponse, UpdateSMContextStatusCode(status)
+		}
+		return UpdateSMContextResponse{}, problemDetails, redirectResponse, UpdateSMContextStatusCode(status)
+	}
+	if res == nil {
+		return UpdateSMContextResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdateSMContextStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdateSMContextStatusOK
+}
+
+func (a pduSessionAdapter) ReleaseSMContext(ctx context.Context, smContextRef string, req ReleaseSMContextRequest) (nsmfpdusession.SmContextReleasedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleaseSMContextStatusCode) {
+	res, err := a.service.ReleaseSMContext(ctx, smContextRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfpdusession.SmContextReleasedData{}, problemDetails, redirectResponse, ReleaseSMContextStatusCode(status)
+	}
+	if res == nil {
+		return nsmfpdusession.SmContextReleasedData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReleaseSMContextStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReleaseSMContextStatusOK
+}
+
+func (a pduSessionAdapter) CreatePDUSession(ctx context.Context, req CreatePDUSessionRequest) (CreatePDUSessionResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, CreatePDUSessionStatusCode) {
+	res, err := a.service.CreatePDUSession(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var createErr *CreatePDUSessionError
+		if errors.As(err, &createErr) {
+			return CreatePDUSessionResponse{ErrorData: &createErr.ErrorData, BinaryParts: createErr.BinaryParts}, problemDetails, redirectResponse, CreatePDUSessionStatusCode(status)
+		}
+		return CreatePDUSessionResponse{}, problemDetails, redirectResponse, CreatePDUSessionStatusCode(status)
+	}
+	if res == nil {
+		res = &CreatePDUSessionResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, CreatePDUSessionStatusCreated
+}
+
+func (a pduSessionAdapter) UpdatePDUSession(ctx context.Context, pduSessionRef string, req UpdatePDUSessionRequest) (UpdatePDUSessionResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdatePDUSessionStatusCode) {
+	res, err := a.service.UpdatePDUSession(ctx, pduSessionRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		var updateErr *UpdatePDUSessionError
+		if errors.As(err, &updateErr) {
+			return UpdatePDUSessionResponse{ErrorData: &updateErr.ErrorData, BinaryParts: updateErr.BinaryParts}, problemDetails, redirectResponse, UpdatePDUSessionStatusCode(status)
+		}
+		return UpdatePDUSessionResponse{}, problemDetails, redirectResponse, UpdatePDUSessionStatusCode(status)
+	}
+	if res == nil {
+		return UpdatePDUSessionResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdatePDUSessionStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdatePDUSessionStatusOK
+}
+
+func (a pduSessionAdapter) ReleasePDUSession(ctx context.Context, pduSessionRef string, req nsmfpdusession.ReleaseData) (nsmfpdusession.ReleasedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, ReleasePDUSessionStatusCode) {
+	res, err := a.service.ReleasePDUSession(ctx, pduSessionRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfpdusession.ReleasedData{}, problemDetails, redirectResponse, ReleasePDUSessionStatusCode(status)
+	}
+	if res == nil {
+		return nsmfpdusession.ReleasedData{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReleasePDUSessionStatusNoContent
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ReleasePDUSessionStatusOK
+}
+
+func (a pduSessionAdapter) RetrievePDUSession(ctx context.Context, pduSessionRef string, req nsmfpdusession.RetrieveData) (nsmfpdusession.RetrievedData, openapicommon.ProblemDetails, fivegc.RedirectResponse, RetrievePDUSessionStatusCode) {
+	res, err := a.service.RetrievePDUSession(ctx, pduSessionRef, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nsmfpdusession.RetrievedData{}, problemDetails, redirectResponse, RetrievePDUSessionStatusCode(status)
+	}
+	if res == nil {
+		res = &nsmfpdusession.RetrievedData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RetrievePDUSessionStatusOK
+}
+
+// NewPDUSessionService adapts a PDUSession to the PDUSessionService interface.
+func NewPDUSessionService(p PDUSession) PDUSessionService {
+	return pduSessionServiceAdapter{pduSession: p}
+}
+
+type pduSessionServiceAdapter struct {
+	pduSession PDUSession
+}
+
+func (a pduSessionServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.pduSession.Error(ctx, err)
+}
+
+func (a pduSessionServiceAdapter) CreateSMContext(ctx context.Context, req CreateSMContextRequest) (*CreateSMContextResponse, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.CreateSMContext(ctx, req)
+	if status == CreateSMContextStatusCreated {
+		return &res, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrorData != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &CreateSMContextError{ProblemDetailsError: problemDetailsErr, ErrorData: *res.ErrorData, BinaryParts: res.BinaryParts}
+	}
+	return nil, err
+}
+
+func (a pduSessionServiceAdapter) RetrieveSMContext(ctx context.Context, smContextRef string, req nsmfpdusession.SmContextRetrieveData) (*nsmfpdusession.SmContextRetrievedData, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.RetrieveSMContext(ctx, smContextRef, req)
+	if status == RetrieveSMContextStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a pduSessionServiceAdapter) UpdateSMContext(ctx context.Context, smContextRef string, req UpdateSMContextRequest) (*UpdateSMContextResponse, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.UpdateSMContext(ctx, smContextRef, req)
+	switch status {
+	case UpdateSMContextStatusOK:
+		return &res, nil
+	case UpdateSMContextStatusNoContent:
+		return nil, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrorData != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &UpdateSMContextError{ProblemDetailsError: problemDetailsErr, ErrorData: *res.ErrorData, BinaryParts: res.BinaryParts}
+	}
+	return nil, err
+}
+
+func (a pduSessionServiceAdapter) ReleaseSMContext(ctx context.Context, smContextRef string, req ReleaseSMContextRequest) (*nsmfpdusession.SmContextReleasedData, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.ReleaseSMContext(ctx, smContextRef, req)
+	switch status {
+	case ReleaseSMContextStatusOK:
+		return &res, nil
+	case ReleaseSMContextStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a pduSessionServiceAdapter) CreatePDUSession(ctx context.Context, req CreatePDUSessionRequest) (*CreatePDUSessionResponse, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.CreatePDUSession(ctx, req)
+	if status == CreatePDUSessionStatusCreated {
+		return &res, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrorData != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &CreatePDUSessionError{ProblemDetailsError: problemDetailsErr, ErrorData: *res.ErrorData, BinaryParts: res.BinaryParts}
+	}
+	return nil, err
+}
+
+func (a pduSessionServiceAdapter) UpdatePDUSession(ctx context.Context, pduSessionRef string, req UpdatePDUSessionRequest) (*UpdatePDUSessionResponse, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.UpdatePDUSession(ctx, pduSessionRef, req)
+	switch status {
+	case UpdatePDUSessionStatusOK:
+		return &res, nil
+	case UpdatePDUSessionStatusNoContent:
+		return nil, nil
+	}
+	err := fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if res.ErrorData != nil && errors.As(err, &problemDetailsErr) {
+		return nil, &UpdatePDUSessionError{ProblemDetailsError: problemDetailsErr, ErrorData: *res.ErrorData, BinaryParts: res.BinaryParts}
+	}
+	return nil, err
+}
+
+func (a pduSessionServiceAdapter) ReleasePDUSession(ctx context.Context, pduSessionRef string, req nsmfpdusession.ReleaseData) (*nsmfpdusession.ReleasedData, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.ReleasePDUSession(ctx, pduSessionRef, req)
+	switch status {
+	case ReleasePDUSessionStatusOK:
+		return &res, nil
+	case ReleasePDUSessionStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a pduSessionServiceAdapter) RetrievePDUSession(ctx context.Context, pduSessionRef string, req nsmfpdusession.RetrieveData) (*nsmfpdusession.RetrievedData, error) {
+	res, problemDetails, redirectResponse, status := a.pduSession.RetrievePDUSession(ctx, pduSessionRef, req)
+	if status == RetrievePDUSessionStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
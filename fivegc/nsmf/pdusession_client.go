@@ -0,0 +1,273 @@
+package nsmf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/multipart"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"net/http"
+	"path"
+)
+
+// PDUSessionClient is a client for the NSMF PDUSession service.
+type PDUSessionClient struct {
+	client  *nsmfpdusession.APIClient
+	request *request.Client
+}
+
+// NewPDUSessionClient creates a new client for the NSMF PDUSession service.
+func NewPDUSessionClient(cfg fivegc.ClientConfiguration) *PDUSessionClient {
+	openapiCfg := &nsmfpdusession.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nsmfpdusession.ServerConfiguration{},
+		OperationServers: make(map[string]nsmfpdusession.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nsmfpdusession.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nsmfpdusession.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nsmfpdusession.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nsmfpdusession.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nsmfpdusession.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nsmfpdusession.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nsmfpdusession.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &PDUSessionClient{
+		client:  nsmfpdusession.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, pduSessionRouterGroup),
+	}
+}
+
+// CreateSMContext creates the SM context of a PDU session establishment or of a handover. The request and the
+// response are sent as multipart/related bodies when they have binary parts, which the generated openapi client does
+// not support. The SM context reference is the last segment of the Location of the response.
+// Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *CreateSMContextError when they carry
+// error data, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) CreateSMContext(ctx context.Context, req CreateSMContextRequest) (*CreateSMContextResponse, *http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(smContextsResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("create sm context: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data nsmfpdusession.SmContextCreateError
+		if problemDetailsErr, parts, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return nil, resp, fmt.Errorf("create sm context: %w", &CreateSMContextError{ProblemDetailsError: problemDetailsErr, ErrorData: data, BinaryParts: parts})
+		}
+		return nil, resp, fmt.Errorf("create sm context: %w", request.Error(resp, raw))
+	}
+	res := CreateSMContextResponse{SMContextRef: resourceRef(resp)}
+	if res.BinaryParts, err = decodeMultipart(resp, raw, &res.JSONData); err != nil {
+		return nil, resp, fmt.Errorf("create sm context: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// RetrieveSMContext returns a request retrieving an SM context, such as for a mobility to EPS.
+func (c *PDUSessionClient) RetrieveSMContext(ctx context.Context, smContextRef string) nsmfpdusession.ApiRetrieveSmContextRequest {
+	return c.client.IndividualSMContextApi.RetrieveSmContext(ctx, smContextRef)
+}
+
+// RetrieveSMContextExecute executes a RetrieveSMContext request.
+func (c *PDUSessionClient) RetrieveSMContextExecute(r nsmfpdusession.ApiRetrieveSmContextRequest) (*nsmfpdusession.SmContextRetrievedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// UpdateSMContext updates an SM context. The request and the response are sent as multipart/related bodies when they
+// have binary parts, which the generated openapi client does not support. The response is nil for 204 No Content.
+// Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *UpdateSMContextError when they carry
+// error data, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) UpdateSMContext(ctx context.Context, smContextRef string, req UpdateSMContextRequest) (*UpdateSMContextResponse, *http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(smContextsResource, smContextRef, modifyResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("update sm context: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data nsmfpdusession.SmContextUpdateError
+		if problemDetailsErr, parts, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return nil, resp, fmt.Errorf("update sm context: %w", &UpdateSMContextError{ProblemDetailsError: problemDetailsErr, ErrorData: data, BinaryParts: parts})
+		}
+		return nil, resp, fmt.Errorf("update sm context: %w", request.Error(resp, raw))
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+	var res UpdateSMContextResponse
+	if res.BinaryParts, err = decodeMultipart(resp, raw, &res.JSONData); err != nil {
+		return nil, resp, fmt.Errorf("update sm context: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// ReleaseSMContext releases an SM context. The request is sent as a multipart/related body when it has binary parts,
+// which the generated openapi client does not support. The response is nil for 204 No Content.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) ReleaseSMContext(ctx context.Context, smContextRef string, req ReleaseSMContextRequest) (*nsmfpdusession.SmContextReleasedData, *http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(smContextsResource, smContextRef, releaseResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("release sm context: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, resp, fmt.Errorf("release sm context: %w", request.Error(resp, raw))
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+	var data nsmfpdusession.SmContextReleasedData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, resp, fmt.Errorf("release sm context: %w", err)
+	}
+	return &data, resp, nil
+}
+
+// CreatePDUSession creates a PDU session in the H-SMF, or in the SMF, on behalf of the V-SMF or the I-SMF. The request
+// and the response are sent as multipart/related bodies when they have binary parts, which the generated openapi
+// client does not support. The PDU session reference is the last segment of the Location of the response.
+// Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *CreatePDUSessionError when they carry
+// error data, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) CreatePDUSession(ctx context.Context, req CreatePDUSessionRequest) (*CreatePDUSessionResponse, *http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(pduSessionsResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("create pdu session: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data nsmfpdusession.PduSessionCreateError
+		if problemDetailsErr, parts, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return nil, resp, fmt.Errorf("create pdu session: %w", &CreatePDUSessionError{ProblemDetailsError: problemDetailsErr, ErrorData: data, BinaryParts: parts})
+		}
+		return nil, resp, fmt.Errorf("create pdu session: %w", request.Error(resp, raw))
+	}
+	res := CreatePDUSessionResponse{PDUSessionRef: resourceRef(resp)}
+	if res.BinaryParts, err = decodeMultipart(resp, raw, &res.JSONData); err != nil {
+		return nil, resp, fmt.Errorf("create pdu session: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// UpdatePDUSession updates a PDU session. The request and the response are sent as multipart/related bodies when they
+// have binary parts, which the generated openapi client does not support. The response is nil for 204 No Content.
+// Error responses are returned as a fivegc.ProblemDetailsError, wrapped in a *UpdatePDUSessionError when they carry
+// error data, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) UpdatePDUSession(ctx context.Context, pduSessionRef string, req UpdatePDUSessionRequest) (*UpdatePDUSessionResponse, *http.Response, error) {
+	resp, raw, err := doMultipart(ctx, c.request, http.MethodPost, c.request.URL(pduSessionsResource, pduSessionRef, modifyResource), req.JSONData, req.BinaryParts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("update pdu session: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var data nsmfpdusession.HsmfUpdateError
+		if problemDetailsErr, parts, ok := multipart.DecodeError(resp, raw, &data); ok {
+			return nil, resp, fmt.Errorf("update pdu session: %w", &UpdatePDUSessionError{ProblemDetailsError: problemDetailsErr, ErrorData: data, BinaryParts: parts})
+		}
+		return nil, resp, fmt.Errorf("update pdu session: %w", request.Error(resp, raw))
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+	var res UpdatePDUSessionResponse
+	if res.BinaryParts, err = decodeMultipart(resp, raw, &res.JSONData); err != nil {
+		return nil, resp, fmt.Errorf("update pdu session: %w", err)
+	}
+	return &res, resp, nil
+}
+
+// ReleasePDUSession returns a request releasing a PDU session.
+func (c *PDUSessionClient) ReleasePDUSession(ctx context.Context, pduSessionRef string) nsmfpdusession.ApiReleasePduSessionRequest {
+	return c.client.IndividualPDUSessionHSMFOrSMFApi.ReleasePduSession(ctx, pduSessionRef)
+}
+
+// ReleasePDUSessionExecute executes a ReleasePDUSession request.
+func (c *PDUSessionClient) ReleasePDUSessionExecute(r nsmfpdusession.ApiReleasePduSessionRequest) (*nsmfpdusession.ReleasedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// RetrievePDUSession returns a request retrieving a PDU session.
+func (c *PDUSessionClient) RetrievePDUSession(ctx context.Context, pduSessionRef string) nsmfpdusession.ApiRetrievePduSessionRequest {
+	return c.client.IndividualPDUSessionHSMFOrSMFApi.RetrievePduSession(ctx, pduSessionRef)
+}
+
+// RetrievePDUSessionExecute executes a RetrievePDUSession request.
+func (c *PDUSessionClient) RetrievePDUSessionExecute(r nsmfpdusession.ApiRetrievePduSessionRequest) (*nsmfpdusession.RetrievedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// SMContextStatusNotify sends the status of an SM context to the smContextStatusUri given by the AMF.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) SMContextStatusNotify(ctx context.Context, smContextStatusURI string, data nsmfpdusession.SmContextStatusNotification) error {
+	if smContextStatusURI == "" {
+		return fmt.Errorf("sm context status notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("smContextStatusUri is missing"))
+	}
+	if err := c.request.PostJSON(ctx, smContextStatusURI, data); err != nil {
+		return fmt.Errorf("sm context status notify: %w", err)
+	}
+	return nil
+}
+
+// PDUSessionStatusNotify sends the status of a PDU session to the vsmfPduSessionUri, or the ismfPduSessionUri, given
+// by the V-SMF or the I-SMF. Error responses are returned as a fivegc.ProblemDetailsError, or as a
+// *fivegc.RedirectError for the redirections.
+func (c *PDUSessionClient) PDUSessionStatusNotify(ctx context.Context, pduSessionURI string, data nsmfpdusession.StatusNotification) error {
+	if pduSessionURI == "" {
+		return fmt.Errorf("pdu session status notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("vsmfPduSessionUri is missing"))
+	}
+	if err := c.request.PostJSON(ctx, pduSessionURI, data); err != nil {
+		return fmt.Errorf("pdu session status notify: %w", err)
+	}
+	return nil
+}
+
+// doMultipart sends root and its binary parts to uri, and returns the response with its body read.
+func doMultipart(ctx context.Context, client *request.Client, method, uri string, root interface{}, parts []BinaryPart) (*http.Response, []byte, error) {
+	body, contentType, err := multipart.Encode(root, parts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.Do(ctx, method, uri, body, contentType)
+}
+
+// decodeMultipart decodes the JSON or multipart/related body of a response into root, and returns its binary parts.
+func decodeMultipart(resp *http.Response, raw []byte, root interface{}) ([]BinaryPart, error) {
+	parts, err := multipart.Decode(resp.Header.Get("Content-Type"), raw, root)
+	if err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// resourceRef returns the last segment of the Location of a response, which is the reference of the resource created.
+func resourceRef(resp *http.Response) string {
+	location, err := resp.Location()
+	if err != nil {
+		return ""
+	}
+	return path.Base(location.Path)
+}
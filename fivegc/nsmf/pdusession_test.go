@@ -0,0 +1,201 @@
+package nsmf
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nsmfpdusession "github.com/5GCoreNet/openapi/openapi_Nsmf_PDUSession"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// anchorSMF serves the SM contexts of the internet DNN, rejecting the others with a PDU SESSION ESTABLISHMENT REJECT.
+type anchorSMF struct {
+	smContexts  map[string]nsmfpdusession.SmContextCreateData
+	pduSessions map[string]nsmfpdusession.PduSessionCreateData
+}
+
+func (s *anchorSMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (s *anchorSMF) CreateSMContext(_ context.Context, req CreateSMContextRequest) (*CreateSMContextResponse, error) {
+	if _, err := req.N1SmMsg(); err != nil {
+		return nil, ErrN1SMError.WithDetail("%s", err)
+	}
+	if req.JSONData.GetDnn() != "internet" {
+		var data nsmfpdusession.SmContextCreateError
+		data.SetN1SmMsg(nsmfpdusession.RefToBinaryData{ContentId: "n1SmMsg"})
+		return nil, &CreateSMContextError{
+			ProblemDetailsError: fivegc.ProblemDetailsError(ErrDNNDenied.ProblemDetails()),
+			ErrorData:           data,
+			BinaryParts:         []BinaryPart{{ContentID: "n1SmMsg", ContentType: ContentTypeNAS, Body: []byte{0x2e, 0x01, 0x01, 0xc3, 0x1b}}},
+		}
+	}
+	s.smContexts["1"] = req.JSONData
+	res := &CreateSMContextResponse{SMContextRef: "1"}
+	res.JSONData.SetPduSessionId(req.JSONData.GetPduSessionId())
+	res.JSONData.SetN2SmInfo(nsmfpdusession.RefToBinaryData{ContentId: "n2SmInfo"})
+	res.BinaryParts = []BinaryPart{{ContentID: "n2SmInfo", ContentType: ContentTypeNGAP, Body: []byte{0x00, 0x01}}}
+	return res, nil
+}
+
+func (s *anchorSMF) RetrieveSMContext(context.Context, string, nsmfpdusession.SmContextRetrieveData) (*nsmfpdusession.SmContextRetrievedData, error) {
+	return nil, ErrContextNotFound
+}
+
+func (s *anchorSMF) UpdateSMContext(_ context.Context, smContextRef string, req UpdateSMContextRequest) (*UpdateSMContextResponse, error) {
+	if _, ok := s.smContexts[smContextRef]; !ok {
+		return nil, ErrContextNotFound
+	}
+	if upCnxState := req.JSONData.GetUpCnxState(); upCnxState.String != nil && *upCnxState.String == UpCnxStateDeactivated {
+		return nil, nil
+	}
+	var res UpdateSMContextResponse
+	res.JSONData.SetUpCnxState(nsmfpdusession.UpCnxState{String: fivegc.ToString(UpCnxStateActivated)})
+	return &res, nil
+}
+
+func (s *anchorSMF) ReleaseSMContext(_ context.Context, smContextRef string, _ ReleaseSMContextRequest) (*nsmfpdusession.SmContextReleasedData, error) {
+	if _, ok := s.smContexts[smContextRef]; !ok {
+		return nil, ErrContextNotFound
+	}
+	delete(s.smContexts, smContextRef)
+	return nil, nil
+}
+
+func (s *anchorSMF) CreatePDUSession(_ context.Context, req CreatePDUSessionRequest) (*CreatePDUSessionResponse, error) {
+	if req.JSONData.Dnn == "v2x" {
+		var data nsmfpdusession.PduSessionCreateError
+		data.SetN1smCause("1B")
+		return nil, &CreatePDUSessionError{ProblemDetailsError: fivegc.ProblemDetailsError(fivegc.NewApplicationError(fivegc.StatusConflict, "").ProblemDetails()), ErrorData: data}
+	}
+	if req.JSONData.Dnn != "internet" {
+		var data nsmfpdusession.PduSessionCreateError
+		data.SetN1smCause("1B")
+		data.SetBackOffTimer(60)
+		return nil, &CreatePDUSessionError{ProblemDetailsError: fivegc.ProblemDetailsError(ErrDNNNotSupported.ProblemDetails()), ErrorData: data}
+	}
+	s.pduSessions["1"] = req.JSONData
+	res := &CreatePDUSessionResponse{PDUSessionRef: "1"}
+	res.JSONData.SscMode = "SSC_MODE_1"
+	return res, nil
+}
+
+func (s *anchorSMF) UpdatePDUSession(context.Context, string, UpdatePDUSessionRequest) (*UpdatePDUSessionResponse, error) {
+	return nil, nil
+}
+
+func (s *anchorSMF) ReleasePDUSession(_ context.Context, pduSessionRef string, _ nsmfpdusession.ReleaseData) (*nsmfpdusession.ReleasedData, error) {
+	if _, ok := s.pduSessions[pduSessionRef]; !ok {
+		return nil, ErrContextNotFound
+	}
+	delete(s.pduSessions, pduSessionRef)
+	return nil, nil
+}
+
+func (s *anchorSMF) RetrievePDUSession(context.Context, string, nsmfpdusession.RetrieveData) (*nsmfpdusession.RetrievedData, error) {
+	return &nsmfpdusession.RetrievedData{}, nil
+}
+
+func TestPDUSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	s := &anchorSMF{smContexts: map[string]nsmfpdusession.SmContextCreateData{}, pduSessions: map[string]nsmfpdusession.PduSessionCreateData{}}
+	attachPDUSessionHandler(router.Group(""), NewPDUSession(s), log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewPDUSessionClient(fivegc.ClientConfiguration{Servers: fivegc.ServerConfigurations{{URL: server.URL + pduSessionRouterGroup}}, HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var createReq CreateSMContextRequest
+	createReq.JSONData.SetDnn("internet")
+	createReq.JSONData.SetPduSessionId(5)
+	createReq.JSONData.SetN1SmMsg(nsmfpdusession.RefToBinaryData{ContentId: "n1SmMsg"})
+	createReq.BinaryParts = []BinaryPart{{ContentID: "n1SmMsg", ContentType: ContentTypeNAS, Body: []byte{0x2e, 0x05, 0x01, 0xc1}}}
+	created, resp, err := client.CreateSMContext(ctx, createReq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || created.SMContextRef != "1" || !strings.HasSuffix(resp.Header.Get("Location"), "/sm-contexts/1") {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if created.JSONData.GetPduSessionId() != 5 || len(created.BinaryParts) != 1 || created.BinaryParts[0].ContentType != ContentTypeNGAP {
+		t.Errorf("unexpected sm context %+v", created)
+	}
+
+	createReq.JSONData.SetDnn("ims")
+	_, _, err = client.CreateSMContext(ctx, createReq)
+	var createErr *CreateSMContextError
+	if !errors.As(err, &createErr) || *createErr.Cause != CauseDNNDenied || len(createErr.BinaryParts) != 1 {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if n1SmMsg := createErr.ErrorData.GetN1SmMsg(); n1SmMsg.ContentId != createErr.BinaryParts[0].ContentID || createErr.ErrorData.Error.GetCause() != CauseDNNDenied {
+		t.Errorf("unexpected error data %+v", createErr.ErrorData)
+	}
+	_, _, err = client.CreateSMContext(ctx, CreateSMContextRequest{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || errors.As(err, &createErr) || *problemDetailsErr.Cause != CauseN1SMError {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	var updateReq UpdateSMContextRequest
+	updated, resp, err := client.UpdateSMContext(ctx, "1", updateReq)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if upCnxState := updated.JSONData.GetUpCnxState(); upCnxState.String == nil || *upCnxState.String != UpCnxStateActivated {
+		t.Errorf("unexpected sm context %+v", updated)
+	}
+	updateReq.JSONData.SetUpCnxState(nsmfpdusession.UpCnxState{String: fivegc.ToString(UpCnxStateDeactivated)})
+	if updated, resp, err := client.UpdateSMContext(ctx, "1", updateReq); err != nil || resp.StatusCode != http.StatusNoContent || updated != nil {
+		t.Errorf("unexpected response %v %v", updated, err)
+	}
+	if _, resp, err := client.ReleaseSMContext(ctx, "1", ReleaseSMContextRequest{}); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected error %v", err)
+	}
+	_, _, err = client.UpdateSMContext(ctx, "1", UpdateSMContextRequest{})
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusNotFound) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	var createPDUSessionReq CreatePDUSessionRequest
+	createPDUSessionReq.JSONData.Dnn = "internet"
+	pduSession, resp, err := client.CreatePDUSession(ctx, createPDUSessionReq)
+	if err != nil || resp.StatusCode != http.StatusCreated || pduSession.PDUSessionRef != "1" || pduSession.JSONData.SscMode != "SSC_MODE_1" {
+		t.Fatalf("unexpected response %+v %v", pduSession, err)
+	}
+	createPDUSessionReq.JSONData.Dnn = "ims"
+	_, _, err = client.CreatePDUSession(ctx, createPDUSessionReq)
+	var createPDUSessionErr *CreatePDUSessionError
+	if !errors.As(err, &createPDUSessionErr) || createPDUSessionErr.ErrorData.GetN1smCause() != "1B" || createPDUSessionErr.ErrorData.GetBackOffTimer() != 60 {
+		t.Errorf("unexpected error %v", err)
+	}
+	createPDUSessionReq.JSONData.Dnn = "v2x"
+	_, _, err = client.CreatePDUSession(ctx, createPDUSessionReq)
+	if !errors.As(err, &problemDetailsErr) || errors.As(err, &createPDUSessionErr) || *problemDetailsErr.Status != int32(fivegc.StatusInternalServerError) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	send := func(method, path, body string) *http.Response {
+		return apitest.Send(t, router, method, server.URL+pduSessionRouterGroup+path, nil, body).Result()
+	}
+	if resp := send(http.MethodPost, "/pdu-sessions/1/release", `{}`); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodPost, "/pdu-sessions/1/release", `{}`); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodPost, "/pdu-sessions/1/retrieve", `{}`); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp := send(http.MethodPost, "/sm-contexts/1/retrieve", `{}`); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+}
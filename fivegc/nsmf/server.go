@@ -0,0 +1,109 @@
+package nsmf
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Server represents a NSMF server.
+type Server struct {
+	address                          string // IP:PORT
+	apiRoot                          string
+	eventExposure                    EventExposure
+	eventExposureNotification        EventExposureNotification
+	eventExposureNotificationPath    string
+	nidd                             NIDD
+	pduSession                       PDUSession
+	pduSessionStatusNotification     PDUSessionStatusNotification
+	pduSessionStatusNotificationPath string
+	smContextStatusNotification      SMContextStatusNotification
+	smContextStatusNotificationPath  string
+	logger                           *log.Logger
+	router                           *gin.Engine
+	stop                             chan bool
+}
+
+// NewServer creates a new Server NSMF server instance.
+// The address is the IP:PORT of the NSMF server.
+// The apiRoot, such as https://smf.example.com/prefix or /prefix, is the one of the URIs of the created resources.
+func NewServer(address string, apiRoot string, logger *log.Logger) *Server {
+	return &Server{
+		address: address,
+		apiRoot: apiRoot,
+		logger:  logger,
+		stop:    make(chan bool),
+	}
+}
+
+// AttachEventExposure attaches an EventExposure handler to the NSMF Server.
+func (n *Server) AttachEventExposure(e EventExposure) {
+	n.eventExposure = e
+}
+
+// AttachEventExposureNotification attaches an EventExposureNotification handler to the server of a consumer of the
+// SMF events, such as the NEF or the NWDAF. The path, relative to the API root, is the one of the notifUri given when
+// subscribing.
+func (n *Server) AttachEventExposureNotification(path string, notification EventExposureNotification) {
+	n.eventExposureNotificationPath = path
+	n.eventExposureNotification = notification
+}
+
+// AttachNIDD attaches a NIDD handler to the NSMF Server.
+func (n *Server) AttachNIDD(nidd NIDD) {
+	n.nidd = nidd
+}
+
+// AttachPDUSession attaches a PDUSession handler to the NSMF Server.
+func (n *Server) AttachPDUSession(p PDUSession) {
+	n.pduSession = p
+}
+
+// AttachPDUSessionStatusNotification attaches a PDUSessionStatusNotification handler to the NSMF Server of a V-SMF
+// or an I-SMF. The path, relative to the API root, is the one of the vsmfPduSessionUri or the ismfPduSessionUri given
+// when creating the PDU sessions.
+func (n *Server) AttachPDUSessionStatusNotification(path string, notification PDUSessionStatusNotification) {
+	n.pduSessionStatusNotificationPath = path
+	n.pduSessionStatusNotification = notification
+}
+
+// AttachSMContextStatusNotification attaches a SMContextStatusNotification handler to the server of an AMF.
+// The path, relative to the API root, is the one of the smContextStatusUri given when creating the SM contexts.
+func (n *Server) AttachSMContextStatusNotification(path string, notification SMContextStatusNotification) {
+	n.smContextStatusNotificationPath = path
+	n.smContextStatusNotification = notification
+}
+
+// Start starts the NSMF Server.
+func (n *Server) Start() {
+	n.router = gin.Default()
+	n.router.Use(gin.Recovery())
+	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
+	root := router.Root(n.router, n.apiRoot)
+	if n.eventExposure != nil {
+		attachEventExposureHandler(root, n.eventExposure, n.logger)
+	}
+	if n.eventExposureNotification != nil {
+		attachEventExposureNotificationHandler(root, n.eventExposureNotificationPath, n.eventExposureNotification, n.logger)
+	}
+	if n.nidd != nil {
+		attachNIDDHandler(root, n.nidd, n.logger)
+	}
+	if n.pduSession != nil {
+		attachPDUSessionHandler(root, n.pduSession, n.logger)
+	}
+	if n.pduSessionStatusNotification != nil {
+		attachPDUSessionStatusNotificationHandler(root, n.pduSessionStatusNotificationPath, n.pduSessionStatusNotification, n.logger)
+	}
+	if n.smContextStatusNotification != nil {
+		attachSMContextStatusNotificationHandler(root, n.smContextStatusNotificationPath, n.smContextStatusNotification, n.logger)
+	}
+	go n.router.Run(n.address)
+	<-n.stop
+	return
+}
+
+// Stop stops the NSMF Server.
+func (n *Server) Stop() {
+	n.stop <- true
+}
@@ -0,0 +1,18 @@
+package nudm
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+type Client struct {
+	*SDMClient
+	*UECMClient
+	*UEAUClient
+}
+
+// NewClient returns a new client for an NUDM service.
+func NewClient(config fivegc.ClientConfiguration) *Client {
+	return &Client{
+		SDMClient:  NewSDMClient(config),
+		UECMClient: NewUECMClient(config),
+		UEAUClient: NewUEAUClient(config),
+	}
+}
@@ -0,0 +1,61 @@
+package nudm
+
+import "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+
+// Application error causes of the NUDM services, defined in TS 29.503 clauses 6.1.7.3, 6.2.7.3 and 6.3.7.3.
+const (
+	CauseUserNotFound                 = "USER_NOT_FOUND"
+	CauseDataNotFound                 = "DATA_NOT_FOUND"
+	CauseUnsupportedResourceURI       = "UNSUPPORTED_RESOURCE_URI"
+	CauseUnknown5GSSubscription       = "UNKNOWN_5GS_SUBSCRIPTION"
+	CauseNoPSSubscription             = "NO_PS_SUBSCRIPTION"
+	CauseRoamingNotAllowed            = "ROAMING_NOT_ALLOWED"
+	CauseAccessNotAllowed             = "ACCESS_NOT_ALLOWED"
+	CauseRATNotAllowed                = "RAT_NOT_ALLOWED"
+	CauseReauthenticationRequired     = "REAUTHENTICATION_REQUIRED"
+	CauseInvalidGUAMI                 = "INVALID_GUAMI"
+	CauseContextNotFound              = "CONTEXT_NOT_FOUND"
+	CauseAuthenticationRejected       = "AUTHENTICATION_REJECTED"
+	CauseServingNetworkNotAuthorized  = "SERVING_NETWORK_NOT_AUTHORIZED"
+	CauseInvalidHNPublicKeyIdentifier = "INVALID_HN_PUBLIC_KEY_IDENTIFIER"
+	CauseInvalidSchemeOutput          = "INVALID_SCHEME_OUTPUT"
+	CauseUnsupportedProtectionScheme  = "UNSUPPORTED_PROTECTION_SCHEME"
+)
+
+// Application errors of the NUDM services, defined in TS 29.503 clauses 6.1.7.3, 6.2.7.3 and 6.3.7.3.
+// The errors common to all the APIs, such as fivegc.ErrSubscriptionNotFound or fivegc.ErrNfCongestion, can be used as well.
+var (
+	// ErrUserNotFound is returned when the UE of the request has no subscription in the UDM.
+	ErrUserNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseUserNotFound)
+	// ErrDataNotFound is returned when the subscription data requested is not found.
+	ErrDataNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseDataNotFound)
+	// ErrUnsupportedResourceURI is returned when the monitored resource URIs of a subscription are not supported.
+	ErrUnsupportedResourceURI = fivegc.NewApplicationError(fivegc.StatusNotImplemented, CauseUnsupportedResourceURI)
+	// ErrUnknown5GSSubscription is returned when the UE has no 5GS subscription.
+	ErrUnknown5GSSubscription = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseUnknown5GSSubscription)
+	// ErrNoPSSubscription is returned when the UE has no packet switched subscription.
+	ErrNoPSSubscription = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseNoPSSubscription)
+	// ErrRoamingNotAllowed is returned when the UE is not allowed to roam in the PLMN of the registered NF.
+	ErrRoamingNotAllowed = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseRoamingNotAllowed)
+	// ErrAccessNotAllowed is returned when the UE is not allowed to use the access type of the registration.
+	ErrAccessNotAllowed = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseAccessNotAllowed)
+	// ErrRATNotAllowed is returned when the UE is not allowed to use the RAT type of the registration.
+	ErrRATNotAllowed = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseRATNotAllowed)
+	// ErrReauthenticationRequired is returned when the UE must be authenticated again before registering the AMF.
+	ErrReauthenticationRequired = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseReauthenticationRequired)
+	// ErrInvalidGUAMI is returned when the GUAMI of a registration update is not the one of the registered AMF.
+	ErrInvalidGUAMI = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseInvalidGUAMI)
+	// ErrContextNotFound is returned when the registration of the request is not found.
+	ErrContextNotFound = fivegc.NewApplicationError(fivegc.StatusNotFound, CauseContextNotFound)
+	// ErrAuthenticationRejected is returned when the UE is not allowed to be authenticated, such as when its
+	// subscription is barred.
+	ErrAuthenticationRejected = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseAuthenticationRejected)
+	// ErrServingNetworkNotAuthorized is returned when the serving network is not authorized to authenticate the UE.
+	ErrServingNetworkNotAuthorized = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseServingNetworkNotAuthorized)
+	// ErrInvalidHNPublicKeyIdentifier is returned when the home network public key identifier of a SUCI is unknown.
+	ErrInvalidHNPublicKeyIdentifier = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseInvalidHNPublicKeyIdentifier)
+	// ErrInvalidSchemeOutput is returned when the scheme output of a SUCI can not be deconcealed.
+	ErrInvalidSchemeOutput = fivegc.NewApplicationError(fivegc.StatusForbidden, CauseInvalidSchemeOutput)
+	// ErrUnsupportedProtectionScheme is returned when the protection scheme of a SUCI is not supported.
+	ErrUnsupportedProtectionScheme = fivegc.NewApplicationError(fivegc.StatusNotImplemented, CauseUnsupportedProtectionScheme)
+)
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/nudm"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc/security"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"log"
+)
+
+// MySDM implements the nudm.SDMService interface, whose handlers return a result and an error.
+type MySDM struct {
+}
+
+func (m MySDM) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	// The SDK already built a 400 ProblemDetails from err, only add the instance.
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MySDM) GetDataSets(ctx context.Context, supi string, req nudm.GetDataSetsRequest) (*nudm.DataSetsResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrUserNotFound
+}
+
+func (m MySDM) GetNSSAI(ctx context.Context, supi string, req nudm.DataRequest) (*nudm.NSSAIResponse, error) {
+	// Your code here ...
+	res := &nudm.NSSAIResponse{CacheHeader: nudm.CacheHeader{ETag: "1"}}
+	res.JSONData.DefaultSingleNssais = []nudmsdm.Snssai{{Sst: 1}}
+	return res, nil
+}
+
+func (m MySDM) GetAMData(ctx context.Context, supi string, req nudm.DataRequest) (*nudm.AMDataResponse, error) {
+	// Your code here ...
+	if req.IfNoneMatch == "1" {
+		// The data cached by the consumer is still valid.
+		return nil, nudm.ErrNotModified
+	}
+	return &nudm.AMDataResponse{CacheHeader: nudm.CacheHeader{ETag: "1"}}, nil
+}
+
+func (m MySDM) GetSMFSelectData(ctx context.Context, supi string, req nudm.DataRequest) (*nudm.SMFSelectDataResponse, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MySDM) GetUEContextInSMFData(ctx context.Context, supi string, supportedFeatures string) (*nudmsdm.UeContextInSmfData, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MySDM) GetSMData(ctx context.Context, supi string, req nudm.GetSMDataRequest) (*nudm.SMDataResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrDataNotFound
+}
+
+func (m MySDM) GetSMSData(ctx context.Context, supi string, req nudm.DataRequest) (*nudm.SMSDataResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrDataNotFound
+}
+
+func (m MySDM) GetSMSManagementData(ctx context.Context, supi string, req nudm.DataRequest) (*nudm.SMSManagementDataResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrDataNotFound
+}
+
+func (m MySDM) GetSupiOrGpsi(ctx context.Context, ueID string, req nudm.GetSupiOrGpsiRequest) (*nudmsdm.IdTranslationResult, error) {
+	// Your code here ...
+	return nil, nudm.ErrUserNotFound
+}
+
+func (m MySDM) GetSharedData(ctx context.Context, req nudm.GetSharedDataRequest) (*nudm.SharedDataResponse, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MySDM) GetIndividualSharedData(ctx context.Context, sharedDataID string, req nudm.DataRequest) (*nudm.IndividualSharedDataResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrDataNotFound
+}
+
+func (m MySDM) Subscribe(ctx context.Context, ueID string, subscription nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	// Your code here ...
+	subscription.SetSubscriptionId("1")
+	return &subscription, nil
+}
+
+func (m MySDM) ModifySubscription(ctx context.Context, ueID string, subscriptionID string, modification nudmsdm.SdmSubsModification) (*nudmsdm.SdmSubscription, error) {
+	// Your code here ...
+	return nil, fivegc.ErrSubscriptionNotFound
+}
+
+func (m MySDM) Unsubscribe(ctx context.Context, ueID string, subscriptionID string) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MySDM) SubscribeToSharedData(ctx context.Context, subscription nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	// Your code here ...
+	subscription.SetSubscriptionId("1")
+	return &subscription, nil
+}
+
+func (m MySDM) UnsubscribeForSharedData(ctx context.Context, subscriptionID string) error {
+	// Your code here ...
+	return nil
+}
+
+// MyUECM implements the nudm.UECMService interface.
+type MyUECM struct {
+}
+
+func (m MyUECM) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyUECM) RegisterAMF3GPPAccess(ctx context.Context, ueID string, registration nudmuecm.Amf3GppAccessRegistration) (*nudm.AMF3GPPAccessRegistrationResponse, error) {
+	// Your code here ...
+	// Notify the previously registered AMF, if any, with a nudm.UECMClient.
+	return &nudm.AMF3GPPAccessRegistrationResponse{Created: true, JSONData: registration}, nil
+}
+
+func (m MyUECM) UpdateAMF3GPPAccessRegistration(ctx context.Context, ueID string, modification nudmuecm.Amf3GppAccessRegistrationModification) error {
+	// Your code here ...
+	return nudm.ErrInvalidGUAMI
+}
+
+func (m MyUECM) GetAMF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.Amf3GppAccessRegistration, error) {
+	// Your code here ...
+	return nil, nudm.ErrContextNotFound
+}
+
+func (m MyUECM) RegisterAMFNon3GPPAccess(ctx context.Context, ueID string, registration nudmuecm.AmfNon3GppAccessRegistration) (*nudm.AMFNon3GPPAccessRegistrationResponse, error) {
+	// Your code here ...
+	return nil, nudm.ErrAccessNotAllowed
+}
+
+func (m MyUECM) UpdateAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, modification nudmuecm.AmfNon3GppAccessRegistrationModification) error {
+	// Your code here ...
+	return nudm.ErrContextNotFound
+}
+
+func (m MyUECM) GetAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.AmfNon3GppAccessRegistration, error) {
+	// Your code here ...
+	return nil, nudm.ErrContextNotFound
+}
+
+func (m MyUECM) GetSMFRegistrations(ctx context.Context, ueID string, req nudm.GetSMFRegistrationsRequest) (*nudmuecm.SmfRegistrationInfo, error) {
+	// Your code here ...
+	return nil, nil
+}
+
+func (m MyUECM) RegisterSMF(ctx context.Context, ueID string, pduSessionID string, registration nudmuecm.SmfRegistration) (*nudm.SMFRegistrationResponse, error) {
+	// Your code here ...
+	return &nudm.SMFRegistrationResponse{Created: true, JSONData: registration}, nil
+}
+
+func (m MyUECM) DeregisterSMF(ctx context.Context, ueID string, pduSessionID string, smfSetID string) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyUECM) GetSMFRegistration(ctx context.Context, ueID string, pduSessionID string, supportedFeatures string) (*nudmuecm.SmfRegistration, error) {
+	// Your code here ...
+	return nil, nudm.ErrContextNotFound
+}
+
+func (m MyUECM) RegisterSMSF3GPPAccess(ctx context.Context, ueID string, registration nudmuecm.SmsfRegistration) (*nudm.SMSFRegistrationResponse, error) {
+	// Your code here ...
+	// A nil result replaces the existing registration and answers a 204 No Content.
+	return nil, nil
+}
+
+func (m MyUECM) DeregisterSMSF3GPPAccess(ctx context.Context, ueID string, smsfSetID string) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyUECM) GetSMSF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.SmsfRegistration, error) {
+	// Your code here ...
+	return nil, nudm.ErrContextNotFound
+}
+
+// MyUEAU implements the nudm.UEAUService interface.
+type MyUEAU struct {
+}
+
+func (m MyUEAU) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{
+		Instance: fivegc.ToString("fake_instance"),
+	}
+}
+
+func (m MyUEAU) GenerateAuthData(ctx context.Context, supiOrSuci string, req nudmueau.AuthenticationInfoRequest) (*nudmueau.AuthenticationInfoResult, error) {
+	// Your code here ...
+	// Deconceal the SUCI and retrieve the K, the OPc and the SQN of the subscriber.
+	k, opc, sqn := make([]byte, security.KeyLength), make([]byte, security.KeyLength), make([]byte, security.SQNLength)
+	milenage, err := security.NewMilenageOPc(k, opc)
+	if err != nil {
+		return nil, err
+	}
+	if req.ResynchronizationInfo != nil {
+		// The UE rejected the SQN of the previous vector, restart from the one of the UE.
+		if sqn, err = milenage.Resynchronize(*req.ResynchronizationInfo); err != nil {
+			return nil, nudm.ErrAuthenticationRejected
+		}
+	}
+	rand, err := security.GenerateRAND()
+	if err != nil {
+		return nil, err
+	}
+	// The separation bit of the AMF is set for 5G.
+	heav, err := milenage.Generate5GHEAV(rand, sqn, []byte{0x80, 0x00}, req.ServingNetworkName)
+	if err != nil {
+		return nil, err
+	}
+	av := heav.AuthenticationVector()
+	return &nudmueau.AuthenticationInfoResult{AuthType: nudm.AuthType5GAKA, AuthenticationVector: &av}, nil
+}
+
+func (m MyUEAU) ConfirmAuth(ctx context.Context, supi string, event nudmueau.AuthEvent) (*nudm.ConfirmAuthResponse, error) {
+	// Your code here ...
+	return &nudm.ConfirmAuthResponse{AuthEventID: "1", JSONData: event}, nil
+}
+
+func (m MyUEAU) DeleteAuth(ctx context.Context, supi string, authEventID string, event nudmueau.AuthEvent) error {
+	// Your code here ...
+	return nil
+}
+
+func (m MyUEAU) GenerateHSSAV(ctx context.Context, supi string, hssAuthType string, req nudmueau.HssAuthenticationInfoRequest) (*nudmueau.HssAuthenticationInfoResult, error) {
+	// Your code here ...
+	return nil, nudm.ErrAuthenticationRejected
+}
+
+func main() {
+	nudmServer := nudm.NewServer(":8080", "/v1/", log.Default())
+	nudmServer.AttachSDM(nudm.NewSDM(MySDM{}))
+	nudmServer.AttachUECM(nudm.NewUECM(MyUECM{}))
+	nudmServer.AttachUEAU(nudm.NewUEAU(MyUEAU{}))
+	nudmServer.Start()
+	// Your code here ...
+	nudmServer.Stop()
+}
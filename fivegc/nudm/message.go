@@ -0,0 +1,230 @@
+package nudm
+
+import (
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+)
+
+const (
+	supportedFeaturesQuery  = "supported-features"
+	plmnIDQuery             = "plmn-id"
+	disasterRoamingIndQuery = "disaster-roaming-ind"
+	datasetNamesQuery       = "dataset-names"
+	singleNssaiQuery        = "single-nssai"
+	dnnQuery                = "dnn"
+	afIDQuery               = "af-id"
+	afServiceIDQuery        = "af-service-id"
+	mtcProviderInfoQuery    = "mtc-provider-info"
+	requestedGpsiTypeQuery  = "requested-gpsi-type"
+	sharedDataIDsQuery      = "shared-data-ids"
+	smfSetIDQuery           = "smf-set-id"
+	smsfSetIDQuery          = "smsf-set-id"
+	ifNoneMatchHeader       = "If-None-Match"
+	ifModifiedSinceHeader   = "If-Modified-Since"
+	eTagHeader              = "ETag"
+	lastModifiedHeader      = "Last-Modified"
+	cacheControlHeader      = "Cache-Control"
+)
+
+// ErrNotModified is returned by the handlers of a SDMService when the data requested has not been modified since
+// the version identified by the If-None-Match or the If-Modified-Since header of the request. It is answered with a
+// 304 Not Modified.
+var ErrNotModified = errors.New("nudm: not modified")
+
+// DataRequest holds the query parameters and the conditional request headers common to the retrievals of the
+// subscription data. The PLMN ID is the serving PLMN of the UE, when it differs from the home PLMN.
+type DataRequest struct {
+	SupportedFeatures  string
+	PlmnID             *nudmsdm.PlmnId
+	DisasterRoamingInd bool
+	IfNoneMatch        string
+	IfModifiedSince    string
+}
+
+// GetDataSetsRequest holds the query parameters of a GetDataSets request.
+type GetDataSetsRequest struct {
+	DataRequest
+	DatasetNames []string
+}
+
+// GetSMDataRequest holds the query parameters of a GetSMData request, which filter the session management data
+// by S-NSSAI and by DNN.
+type GetSMDataRequest struct {
+	DataRequest
+	SingleNssai *nudmsdm.Snssai
+	Dnn         string
+}
+
+// GetSupiOrGpsiRequest holds the query parameters of a GetSupiOrGpsi request.
+type GetSupiOrGpsiRequest struct {
+	SupportedFeatures string
+	AfID              string
+	AfServiceID       string
+	MtcProviderInfo   string
+	RequestedGpsiType string
+}
+
+// GetSharedDataRequest holds the query parameters of a GetSharedData request.
+type GetSharedDataRequest struct {
+	SharedDataIDs     []string
+	SupportedFeatures string
+	IfNoneMatch       string
+	IfModifiedSince   string
+}
+
+// CacheHeader holds the headers of a response used by the consumer to cache the subscription data, and to send
+// conditional requests with the ETag in the If-None-Match header or the Last-Modified in the If-Modified-Since header
+// (TS 29.500 clause 5.2.3.3). Empty headers are not sent.
+type CacheHeader struct {
+	ETag         string
+	LastModified string
+	CacheControl string
+}
+
+// DataSetsResponse is the body of a GetDataSets response.
+type DataSetsResponse struct {
+	CacheHeader
+	JSONData nudmsdm.SubscriptionDataSets
+}
+
+// NSSAIResponse is the body of a GetNSSAI response.
+type NSSAIResponse struct {
+	CacheHeader
+	JSONData nudmsdm.Nssai
+}
+
+// AMDataResponse is the body of a GetAMData response.
+type AMDataResponse struct {
+	CacheHeader
+	JSONData nudmsdm.AccessAndMobilitySubscriptionData
+}
+
+// SMFSelectDataResponse is the body of a GetSMFSelectData response.
+type SMFSelectDataResponse struct {
+	CacheHeader
+	JSONData nudmsdm.SmfSelectionSubscriptionData
+}
+
+// SMDataResponse is the body of a GetSMData response.
+type SMDataResponse struct {
+	CacheHeader
+	JSONData []nudmsdm.SessionManagementSubscriptionData
+}
+
+// SMSDataResponse is the body of a GetSMSData response.
+type SMSDataResponse struct {
+	CacheHeader
+	JSONData nudmsdm.SmsSubscriptionData
+}
+
+// SMSManagementDataResponse is the body of a GetSMSManagementData response.
+type SMSManagementDataResponse struct {
+	CacheHeader
+	JSONData nudmsdm.SmsManagementSubscriptionData
+}
+
+// SharedDataResponse is the body of a GetSharedData response.
+type SharedDataResponse struct {
+	CacheHeader
+	JSONData []nudmsdm.SharedData
+}
+
+// IndividualSharedDataResponse is the body of a GetIndividualSharedData response.
+type IndividualSharedDataResponse struct {
+	CacheHeader
+	JSONData nudmsdm.SharedData
+}
+
+// GetSMFRegistrationsRequest holds the query parameters of a GetSMFRegistrations request, which filter the SMF
+// registrations by S-NSSAI and by DNN.
+type GetSMFRegistrationsRequest struct {
+	SingleNssai       *nudmuecm.Snssai
+	Dnn               string
+	SupportedFeatures string
+}
+
+// AMF3GPPAccessRegistrationResponse is the result of the RegisterAMF3GPPAccess handler of a UECMService.
+// Created is set when the registration is created rather than replacing the existing one.
+type AMF3GPPAccessRegistrationResponse struct {
+	Created  bool
+	JSONData nudmuecm.Amf3GppAccessRegistration
+}
+
+// AMFNon3GPPAccessRegistrationResponse is the result of the RegisterAMFNon3GPPAccess handler of a UECMService.
+// Created is set when the registration is created rather than replacing the existing one.
+type AMFNon3GPPAccessRegistrationResponse struct {
+	Created  bool
+	JSONData nudmuecm.AmfNon3GppAccessRegistration
+}
+
+// SMFRegistrationResponse is the result of the RegisterSMF handler of a UECMService.
+// Created is set when the registration is created rather than replacing the existing one.
+type SMFRegistrationResponse struct {
+	Created  bool
+	JSONData nudmuecm.SmfRegistration
+}
+
+// SMSFRegistrationResponse is the result of the RegisterSMSF3GPPAccess handler of a UECMService.
+// Created is set when the registration is created rather than replacing the existing one.
+type SMSFRegistrationResponse struct {
+	Created  bool
+	JSONData nudmuecm.SmsfRegistration
+}
+
+// ConfirmAuthResponse is the body of a ConfirmAuth response.
+type ConfirmAuthResponse struct {
+	// AuthEventID identifies the authentication event created, in the Location of the response.
+	AuthEventID string
+	JSONData    nudmueau.AuthEvent
+}
+
+// bindDataRequest reads the common query parameters and the conditional request headers of a retrieval.
+func bindDataRequest(c *gin.Context) (DataRequest, error) {
+	req := DataRequest{
+		SupportedFeatures: c.Query(supportedFeaturesQuery),
+		IfNoneMatch:       c.GetHeader(ifNoneMatchHeader),
+		IfModifiedSince:   c.GetHeader(ifModifiedSinceHeader),
+	}
+	var plmnID nudmsdm.PlmnId
+	found, err := router.JSONQuery(c, plmnIDQuery, &plmnID, false)
+	if err != nil {
+		return DataRequest{}, err
+	}
+	if found {
+		req.PlmnID = &plmnID
+	}
+	req.DisasterRoamingInd, _ = strconv.ParseBool(c.Query(disasterRoamingIndQuery))
+	return req, nil
+}
+
+// listQuery returns the values of a query parameter which is an array, sent comma separated or repeated.
+func listQuery(c *gin.Context, name string) []string {
+	var values []string
+	for _, value := range c.QueryArray(name) {
+		for _, v := range strings.Split(value, ",") {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// bindCacheHeader writes the cache headers of a response.
+func bindCacheHeader(c *gin.Context, h CacheHeader) {
+	if h.ETag != "" {
+		c.Header(eTagHeader, h.ETag)
+	}
+	if h.LastModified != "" {
+		c.Header(lastModifiedHeader, h.LastModified)
+	}
+	if h.CacheControl != "" {
+		c.Header(cacheControlHeader, h.CacheControl)
+	}
+}
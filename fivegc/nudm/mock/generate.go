@@ -0,0 +1,6 @@
+package mock
+
+//go:generate mockgen -source=../notification.go -destination=notification.go -package=mock
+//go:generate mockgen -source=../sdm.go -destination=sdm.go -package=mock
+//go:generate mockgen -source=../uecm.go -destination=uecm.go -package=mock
+//go:generate mockgen -source=../ueau.go -destination=ueau.go -package=mock
@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../notification.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nudm "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nudm"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nudm_SDM "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	openapi_Nudm_UECM "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataChangeNotification is a mock of DataChangeNotification interface.
+type MockDataChangeNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataChangeNotificationMockRecorder
+}
+
+// MockDataChangeNotificationMockRecorder is the mock recorder for MockDataChangeNotification.
+type MockDataChangeNotificationMockRecorder struct {
+	mock *MockDataChangeNotification
+}
+
+// NewMockDataChangeNotification creates a new mock instance.
+func NewMockDataChangeNotification(ctrl *gomock.Controller) *MockDataChangeNotification {
+	mock := &MockDataChangeNotification{ctrl: ctrl}
+	mock.recorder = &MockDataChangeNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataChangeNotification) EXPECT() *MockDataChangeNotificationMockRecorder {
+	return m.recorder
+}
+
+// DataChangeNotify mocks base method.
+func (m *MockDataChangeNotification) DataChangeNotify(arg0 context.Context, arg1 openapi_Nudm_SDM.ModificationNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.DataChangeNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataChangeNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.DataChangeNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DataChangeNotify indicates an expected call of DataChangeNotify.
+func (mr *MockDataChangeNotificationMockRecorder) DataChangeNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataChangeNotify", reflect.TypeOf((*MockDataChangeNotification)(nil).DataChangeNotify), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockDataChangeNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockDataChangeNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockDataChangeNotification)(nil).Error), ctx, err)
+}
+
+// MockDeregistrationNotification is a mock of DeregistrationNotification interface.
+type MockDeregistrationNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeregistrationNotificationMockRecorder
+}
+
+// MockDeregistrationNotificationMockRecorder is the mock recorder for MockDeregistrationNotification.
+type MockDeregistrationNotificationMockRecorder struct {
+	mock *MockDeregistrationNotification
+}
+
+// NewMockDeregistrationNotification creates a new mock instance.
+func NewMockDeregistrationNotification(ctrl *gomock.Controller) *MockDeregistrationNotification {
+	mock := &MockDeregistrationNotification{ctrl: ctrl}
+	mock.recorder = &MockDeregistrationNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeregistrationNotification) EXPECT() *MockDeregistrationNotificationMockRecorder {
+	return m.recorder
+}
+
+// DeregistrationNotify mocks base method.
+func (m *MockDeregistrationNotification) DeregistrationNotify(arg0 context.Context, arg1 openapi_Nudm_UECM.DeregistrationData) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.DeregistrationNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregistrationNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.DeregistrationNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DeregistrationNotify indicates an expected call of DeregistrationNotify.
+func (mr *MockDeregistrationNotificationMockRecorder) DeregistrationNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregistrationNotify", reflect.TypeOf((*MockDeregistrationNotification)(nil).DeregistrationNotify), arg0, arg1)
+}
+
+// Error mocks base method.
+func (m *MockDeregistrationNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockDeregistrationNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockDeregistrationNotification)(nil).Error), ctx, err)
+}
+
+// MockPCSCFRestorationNotification is a mock of PCSCFRestorationNotification interface.
+type MockPCSCFRestorationNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockPCSCFRestorationNotificationMockRecorder
+}
+
+// MockPCSCFRestorationNotificationMockRecorder is the mock recorder for MockPCSCFRestorationNotification.
+type MockPCSCFRestorationNotificationMockRecorder struct {
+	mock *MockPCSCFRestorationNotification
+}
+
+// NewMockPCSCFRestorationNotification creates a new mock instance.
+func NewMockPCSCFRestorationNotification(ctrl *gomock.Controller) *MockPCSCFRestorationNotification {
+	mock := &MockPCSCFRestorationNotification{ctrl: ctrl}
+	mock.recorder = &MockPCSCFRestorationNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPCSCFRestorationNotification) EXPECT() *MockPCSCFRestorationNotificationMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockPCSCFRestorationNotification) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockPCSCFRestorationNotificationMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockPCSCFRestorationNotification)(nil).Error), ctx, err)
+}
+
+// PCSCFRestorationNotify mocks base method.
+func (m *MockPCSCFRestorationNotification) PCSCFRestorationNotify(arg0 context.Context, arg1 openapi_Nudm_UECM.PcscfRestorationNotification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.PCSCFRestorationNotifyStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PCSCFRestorationNotify", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.PCSCFRestorationNotifyStatusCode)
+	return ret0, ret1, ret2
+}
+
+// PCSCFRestorationNotify indicates an expected call of PCSCFRestorationNotify.
+func (mr *MockPCSCFRestorationNotificationMockRecorder) PCSCFRestorationNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PCSCFRestorationNotify", reflect.TypeOf((*MockPCSCFRestorationNotification)(nil).PCSCFRestorationNotify), arg0, arg1)
+}
@@ -0,0 +1,598 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../sdm.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nudm "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nudm"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nudm_SDM "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSDM is a mock of SDM interface.
+type MockSDM struct {
+	ctrl     *gomock.Controller
+	recorder *MockSDMMockRecorder
+}
+
+// MockSDMMockRecorder is the mock recorder for MockSDM.
+type MockSDMMockRecorder struct {
+	mock *MockSDM
+}
+
+// NewMockSDM creates a new mock instance.
+func NewMockSDM(ctrl *gomock.Controller) *MockSDM {
+	mock := &MockSDM{ctrl: ctrl}
+	mock.recorder = &MockSDMMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSDM) EXPECT() *MockSDMMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockSDM) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockSDMMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSDM)(nil).Error), ctx, err)
+}
+
+// GetAMData mocks base method.
+func (m *MockSDM) GetAMData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.AMDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetAMDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.AMDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetAMDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetAMData indicates an expected call of GetAMData.
+func (mr *MockSDMMockRecorder) GetAMData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMData", reflect.TypeOf((*MockSDM)(nil).GetAMData), arg0, arg1, arg2)
+}
+
+// GetDataSets mocks base method.
+func (m *MockSDM) GetDataSets(arg0 context.Context, arg1 string, arg2 nudm.GetDataSetsRequest) (nudm.DataSetsResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetDataSetsStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataSets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.DataSetsResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetDataSetsStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetDataSets indicates an expected call of GetDataSets.
+func (mr *MockSDMMockRecorder) GetDataSets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataSets", reflect.TypeOf((*MockSDM)(nil).GetDataSets), arg0, arg1, arg2)
+}
+
+// GetIndividualSharedData mocks base method.
+func (m *MockSDM) GetIndividualSharedData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.IndividualSharedDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetIndividualSharedDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndividualSharedData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.IndividualSharedDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetIndividualSharedDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetIndividualSharedData indicates an expected call of GetIndividualSharedData.
+func (mr *MockSDMMockRecorder) GetIndividualSharedData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndividualSharedData", reflect.TypeOf((*MockSDM)(nil).GetIndividualSharedData), arg0, arg1, arg2)
+}
+
+// GetNSSAI mocks base method.
+func (m *MockSDM) GetNSSAI(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.NSSAIResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetNSSAIStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNSSAI", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.NSSAIResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetNSSAIStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetNSSAI indicates an expected call of GetNSSAI.
+func (mr *MockSDMMockRecorder) GetNSSAI(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNSSAI", reflect.TypeOf((*MockSDM)(nil).GetNSSAI), arg0, arg1, arg2)
+}
+
+// GetSMData mocks base method.
+func (m *MockSDM) GetSMData(arg0 context.Context, arg1 string, arg2 nudm.GetSMDataRequest) (nudm.SMDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.SMDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMData indicates an expected call of GetSMData.
+func (mr *MockSDMMockRecorder) GetSMData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMData", reflect.TypeOf((*MockSDM)(nil).GetSMData), arg0, arg1, arg2)
+}
+
+// GetSMFSelectData mocks base method.
+func (m *MockSDM) GetSMFSelectData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.SMFSelectDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMFSelectDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFSelectData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.SMFSelectDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMFSelectDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMFSelectData indicates an expected call of GetSMFSelectData.
+func (mr *MockSDMMockRecorder) GetSMFSelectData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFSelectData", reflect.TypeOf((*MockSDM)(nil).GetSMFSelectData), arg0, arg1, arg2)
+}
+
+// GetSMSData mocks base method.
+func (m *MockSDM) GetSMSData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.SMSDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMSDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.SMSDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMSDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMSData indicates an expected call of GetSMSData.
+func (mr *MockSDMMockRecorder) GetSMSData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSData", reflect.TypeOf((*MockSDM)(nil).GetSMSData), arg0, arg1, arg2)
+}
+
+// GetSMSManagementData mocks base method.
+func (m *MockSDM) GetSMSManagementData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (nudm.SMSManagementDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMSManagementDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSManagementData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.SMSManagementDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMSManagementDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMSManagementData indicates an expected call of GetSMSManagementData.
+func (mr *MockSDMMockRecorder) GetSMSManagementData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSManagementData", reflect.TypeOf((*MockSDM)(nil).GetSMSManagementData), arg0, arg1, arg2)
+}
+
+// GetSharedData mocks base method.
+func (m *MockSDM) GetSharedData(arg0 context.Context, arg1 nudm.GetSharedDataRequest) (nudm.SharedDataResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSharedDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedData", arg0, arg1)
+	ret0, _ := ret[0].(nudm.SharedDataResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSharedDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSharedData indicates an expected call of GetSharedData.
+func (mr *MockSDMMockRecorder) GetSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedData", reflect.TypeOf((*MockSDM)(nil).GetSharedData), arg0, arg1)
+}
+
+// GetSupiOrGpsi mocks base method.
+func (m *MockSDM) GetSupiOrGpsi(arg0 context.Context, arg1 string, arg2 nudm.GetSupiOrGpsiRequest) (openapi_Nudm_SDM.IdTranslationResult, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSupiOrGpsiStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupiOrGpsi", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_SDM.IdTranslationResult)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSupiOrGpsiStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSupiOrGpsi indicates an expected call of GetSupiOrGpsi.
+func (mr *MockSDMMockRecorder) GetSupiOrGpsi(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupiOrGpsi", reflect.TypeOf((*MockSDM)(nil).GetSupiOrGpsi), arg0, arg1, arg2)
+}
+
+// GetUEContextInSMFData mocks base method.
+func (m *MockSDM) GetUEContextInSMFData(arg0 context.Context, arg1, arg2 string) (openapi_Nudm_SDM.UeContextInSmfData, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetUEContextInSMFDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUEContextInSMFData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_SDM.UeContextInSmfData)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetUEContextInSMFDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetUEContextInSMFData indicates an expected call of GetUEContextInSMFData.
+func (mr *MockSDMMockRecorder) GetUEContextInSMFData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUEContextInSMFData", reflect.TypeOf((*MockSDM)(nil).GetUEContextInSMFData), arg0, arg1, arg2)
+}
+
+// ModifySubscription mocks base method.
+func (m *MockSDM) ModifySubscription(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_SDM.SdmSubsModification) (openapi_Nudm_SDM.SdmSubscription, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.ModifySubscriptionStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySubscription", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.ModifySubscriptionStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ModifySubscription indicates an expected call of ModifySubscription.
+func (mr *MockSDMMockRecorder) ModifySubscription(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySubscription", reflect.TypeOf((*MockSDM)(nil).ModifySubscription), arg0, arg1, arg2, arg3)
+}
+
+// Subscribe mocks base method.
+func (m *MockSDM) Subscribe(arg0 context.Context, arg1 string, arg2 openapi_Nudm_SDM.SdmSubscription) (openapi_Nudm_SDM.SdmSubscription, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.SubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.SubscribeStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSDMMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSDM)(nil).Subscribe), arg0, arg1, arg2)
+}
+
+// SubscribeToSharedData mocks base method.
+func (m *MockSDM) SubscribeToSharedData(arg0 context.Context, arg1 openapi_Nudm_SDM.SdmSubscription) (openapi_Nudm_SDM.SdmSubscription, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.SubscribeToSharedDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToSharedData", arg0, arg1)
+	ret0, _ := ret[0].(openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.SubscribeToSharedDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// SubscribeToSharedData indicates an expected call of SubscribeToSharedData.
+func (mr *MockSDMMockRecorder) SubscribeToSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToSharedData", reflect.TypeOf((*MockSDM)(nil).SubscribeToSharedData), arg0, arg1)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockSDM) Unsubscribe(arg0 context.Context, arg1, arg2 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.UnsubscribeStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.UnsubscribeStatusCode)
+	return ret0, ret1, ret2
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSDMMockRecorder) Unsubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSDM)(nil).Unsubscribe), arg0, arg1, arg2)
+}
+
+// UnsubscribeForSharedData mocks base method.
+func (m *MockSDM) UnsubscribeForSharedData(arg0 context.Context, arg1 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.UnsubscribeForSharedDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeForSharedData", arg0, arg1)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.UnsubscribeForSharedDataStatusCode)
+	return ret0, ret1, ret2
+}
+
+// UnsubscribeForSharedData indicates an expected call of UnsubscribeForSharedData.
+func (mr *MockSDMMockRecorder) UnsubscribeForSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeForSharedData", reflect.TypeOf((*MockSDM)(nil).UnsubscribeForSharedData), arg0, arg1)
+}
+
+// MockSDMService is a mock of SDMService interface.
+type MockSDMService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSDMServiceMockRecorder
+}
+
+// MockSDMServiceMockRecorder is the mock recorder for MockSDMService.
+type MockSDMServiceMockRecorder struct {
+	mock *MockSDMService
+}
+
+// NewMockSDMService creates a new mock instance.
+func NewMockSDMService(ctrl *gomock.Controller) *MockSDMService {
+	mock := &MockSDMService{ctrl: ctrl}
+	mock.recorder = &MockSDMServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSDMService) EXPECT() *MockSDMServiceMockRecorder {
+	return m.recorder
+}
+
+// Error mocks base method.
+func (m *MockSDMService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockSDMServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockSDMService)(nil).Error), ctx, err)
+}
+
+// GetAMData mocks base method.
+func (m *MockSDMService) GetAMData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.AMDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.AMDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAMData indicates an expected call of GetAMData.
+func (mr *MockSDMServiceMockRecorder) GetAMData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMData", reflect.TypeOf((*MockSDMService)(nil).GetAMData), arg0, arg1, arg2)
+}
+
+// GetDataSets mocks base method.
+func (m *MockSDMService) GetDataSets(arg0 context.Context, arg1 string, arg2 nudm.GetDataSetsRequest) (*nudm.DataSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataSets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.DataSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataSets indicates an expected call of GetDataSets.
+func (mr *MockSDMServiceMockRecorder) GetDataSets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataSets", reflect.TypeOf((*MockSDMService)(nil).GetDataSets), arg0, arg1, arg2)
+}
+
+// GetIndividualSharedData mocks base method.
+func (m *MockSDMService) GetIndividualSharedData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.IndividualSharedDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndividualSharedData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.IndividualSharedDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndividualSharedData indicates an expected call of GetIndividualSharedData.
+func (mr *MockSDMServiceMockRecorder) GetIndividualSharedData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndividualSharedData", reflect.TypeOf((*MockSDMService)(nil).GetIndividualSharedData), arg0, arg1, arg2)
+}
+
+// GetNSSAI mocks base method.
+func (m *MockSDMService) GetNSSAI(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.NSSAIResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNSSAI", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.NSSAIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNSSAI indicates an expected call of GetNSSAI.
+func (mr *MockSDMServiceMockRecorder) GetNSSAI(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNSSAI", reflect.TypeOf((*MockSDMService)(nil).GetNSSAI), arg0, arg1, arg2)
+}
+
+// GetSMData mocks base method.
+func (m *MockSDMService) GetSMData(arg0 context.Context, arg1 string, arg2 nudm.GetSMDataRequest) (*nudm.SMDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.SMDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMData indicates an expected call of GetSMData.
+func (mr *MockSDMServiceMockRecorder) GetSMData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMData", reflect.TypeOf((*MockSDMService)(nil).GetSMData), arg0, arg1, arg2)
+}
+
+// GetSMFSelectData mocks base method.
+func (m *MockSDMService) GetSMFSelectData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.SMFSelectDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFSelectData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.SMFSelectDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMFSelectData indicates an expected call of GetSMFSelectData.
+func (mr *MockSDMServiceMockRecorder) GetSMFSelectData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFSelectData", reflect.TypeOf((*MockSDMService)(nil).GetSMFSelectData), arg0, arg1, arg2)
+}
+
+// GetSMSData mocks base method.
+func (m *MockSDMService) GetSMSData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.SMSDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.SMSDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMSData indicates an expected call of GetSMSData.
+func (mr *MockSDMServiceMockRecorder) GetSMSData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSData", reflect.TypeOf((*MockSDMService)(nil).GetSMSData), arg0, arg1, arg2)
+}
+
+// GetSMSManagementData mocks base method.
+func (m *MockSDMService) GetSMSManagementData(arg0 context.Context, arg1 string, arg2 nudm.DataRequest) (*nudm.SMSManagementDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSManagementData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.SMSManagementDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMSManagementData indicates an expected call of GetSMSManagementData.
+func (mr *MockSDMServiceMockRecorder) GetSMSManagementData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSManagementData", reflect.TypeOf((*MockSDMService)(nil).GetSMSManagementData), arg0, arg1, arg2)
+}
+
+// GetSharedData mocks base method.
+func (m *MockSDMService) GetSharedData(arg0 context.Context, arg1 nudm.GetSharedDataRequest) (*nudm.SharedDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedData", arg0, arg1)
+	ret0, _ := ret[0].(*nudm.SharedDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedData indicates an expected call of GetSharedData.
+func (mr *MockSDMServiceMockRecorder) GetSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedData", reflect.TypeOf((*MockSDMService)(nil).GetSharedData), arg0, arg1)
+}
+
+// GetSupiOrGpsi mocks base method.
+func (m *MockSDMService) GetSupiOrGpsi(arg0 context.Context, arg1 string, arg2 nudm.GetSupiOrGpsiRequest) (*openapi_Nudm_SDM.IdTranslationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupiOrGpsi", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_SDM.IdTranslationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupiOrGpsi indicates an expected call of GetSupiOrGpsi.
+func (mr *MockSDMServiceMockRecorder) GetSupiOrGpsi(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupiOrGpsi", reflect.TypeOf((*MockSDMService)(nil).GetSupiOrGpsi), arg0, arg1, arg2)
+}
+
+// GetUEContextInSMFData mocks base method.
+func (m *MockSDMService) GetUEContextInSMFData(arg0 context.Context, arg1, arg2 string) (*openapi_Nudm_SDM.UeContextInSmfData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUEContextInSMFData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_SDM.UeContextInSmfData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUEContextInSMFData indicates an expected call of GetUEContextInSMFData.
+func (mr *MockSDMServiceMockRecorder) GetUEContextInSMFData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUEContextInSMFData", reflect.TypeOf((*MockSDMService)(nil).GetUEContextInSMFData), arg0, arg1, arg2)
+}
+
+// ModifySubscription mocks base method.
+func (m *MockSDMService) ModifySubscription(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_SDM.SdmSubsModification) (*openapi_Nudm_SDM.SdmSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySubscription", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySubscription indicates an expected call of ModifySubscription.
+func (mr *MockSDMServiceMockRecorder) ModifySubscription(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySubscription", reflect.TypeOf((*MockSDMService)(nil).ModifySubscription), arg0, arg1, arg2, arg3)
+}
+
+// Subscribe mocks base method.
+func (m *MockSDMService) Subscribe(arg0 context.Context, arg1 string, arg2 openapi_Nudm_SDM.SdmSubscription) (*openapi_Nudm_SDM.SdmSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSDMServiceMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSDMService)(nil).Subscribe), arg0, arg1, arg2)
+}
+
+// SubscribeToSharedData mocks base method.
+func (m *MockSDMService) SubscribeToSharedData(arg0 context.Context, arg1 openapi_Nudm_SDM.SdmSubscription) (*openapi_Nudm_SDM.SdmSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToSharedData", arg0, arg1)
+	ret0, _ := ret[0].(*openapi_Nudm_SDM.SdmSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToSharedData indicates an expected call of SubscribeToSharedData.
+func (mr *MockSDMServiceMockRecorder) SubscribeToSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToSharedData", reflect.TypeOf((*MockSDMService)(nil).SubscribeToSharedData), arg0, arg1)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockSDMService) Unsubscribe(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSDMServiceMockRecorder) Unsubscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSDMService)(nil).Unsubscribe), arg0, arg1, arg2)
+}
+
+// UnsubscribeForSharedData mocks base method.
+func (m *MockSDMService) UnsubscribeForSharedData(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeForSharedData", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeForSharedData indicates an expected call of UnsubscribeForSharedData.
+func (mr *MockSDMServiceMockRecorder) UnsubscribeForSharedData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeForSharedData", reflect.TypeOf((*MockSDMService)(nil).UnsubscribeForSharedData), arg0, arg1)
+}
@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ueau.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nudm "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nudm"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nudm_UEAU "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUEAU is a mock of UEAU interface.
+type MockUEAU struct {
+	ctrl     *gomock.Controller
+	recorder *MockUEAUMockRecorder
+}
+
+// MockUEAUMockRecorder is the mock recorder for MockUEAU.
+type MockUEAUMockRecorder struct {
+	mock *MockUEAU
+}
+
+// NewMockUEAU creates a new mock instance.
+func NewMockUEAU(ctrl *gomock.Controller) *MockUEAU {
+	mock := &MockUEAU{ctrl: ctrl}
+	mock.recorder = &MockUEAUMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUEAU) EXPECT() *MockUEAUMockRecorder {
+	return m.recorder
+}
+
+// ConfirmAuth mocks base method.
+func (m *MockUEAU) ConfirmAuth(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UEAU.AuthEvent) (nudm.ConfirmAuthResponse, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.ConfirmAuthStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmAuth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(nudm.ConfirmAuthResponse)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.ConfirmAuthStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// ConfirmAuth indicates an expected call of ConfirmAuth.
+func (mr *MockUEAUMockRecorder) ConfirmAuth(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmAuth", reflect.TypeOf((*MockUEAU)(nil).ConfirmAuth), arg0, arg1, arg2)
+}
+
+// DeleteAuth mocks base method.
+func (m *MockUEAU) DeleteAuth(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UEAU.AuthEvent) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.DeleteAuthStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuth", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.DeleteAuthStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DeleteAuth indicates an expected call of DeleteAuth.
+func (mr *MockUEAUMockRecorder) DeleteAuth(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuth", reflect.TypeOf((*MockUEAU)(nil).DeleteAuth), arg0, arg1, arg2, arg3)
+}
+
+// Error mocks base method.
+func (m *MockUEAU) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUEAUMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUEAU)(nil).Error), ctx, err)
+}
+
+// GenerateAuthData mocks base method.
+func (m *MockUEAU) GenerateAuthData(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UEAU.AuthenticationInfoRequest) (openapi_Nudm_UEAU.AuthenticationInfoResult, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GenerateAuthDataStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAuthData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UEAU.AuthenticationInfoResult)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GenerateAuthDataStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GenerateAuthData indicates an expected call of GenerateAuthData.
+func (mr *MockUEAUMockRecorder) GenerateAuthData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAuthData", reflect.TypeOf((*MockUEAU)(nil).GenerateAuthData), arg0, arg1, arg2)
+}
+
+// GenerateHSSAV mocks base method.
+func (m *MockUEAU) GenerateHSSAV(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UEAU.HssAuthenticationInfoRequest) (openapi_Nudm_UEAU.HssAuthenticationInfoResult, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GenerateHSSAVStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHSSAV", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_Nudm_UEAU.HssAuthenticationInfoResult)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GenerateHSSAVStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GenerateHSSAV indicates an expected call of GenerateHSSAV.
+func (mr *MockUEAUMockRecorder) GenerateHSSAV(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHSSAV", reflect.TypeOf((*MockUEAU)(nil).GenerateHSSAV), arg0, arg1, arg2, arg3)
+}
+
+// MockUEAUService is a mock of UEAUService interface.
+type MockUEAUService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUEAUServiceMockRecorder
+}
+
+// MockUEAUServiceMockRecorder is the mock recorder for MockUEAUService.
+type MockUEAUServiceMockRecorder struct {
+	mock *MockUEAUService
+}
+
+// NewMockUEAUService creates a new mock instance.
+func NewMockUEAUService(ctrl *gomock.Controller) *MockUEAUService {
+	mock := &MockUEAUService{ctrl: ctrl}
+	mock.recorder = &MockUEAUServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUEAUService) EXPECT() *MockUEAUServiceMockRecorder {
+	return m.recorder
+}
+
+// ConfirmAuth mocks base method.
+func (m *MockUEAUService) ConfirmAuth(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UEAU.AuthEvent) (*nudm.ConfirmAuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmAuth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.ConfirmAuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmAuth indicates an expected call of ConfirmAuth.
+func (mr *MockUEAUServiceMockRecorder) ConfirmAuth(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmAuth", reflect.TypeOf((*MockUEAUService)(nil).ConfirmAuth), arg0, arg1, arg2)
+}
+
+// DeleteAuth mocks base method.
+func (m *MockUEAUService) DeleteAuth(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UEAU.AuthEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuth", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuth indicates an expected call of DeleteAuth.
+func (mr *MockUEAUServiceMockRecorder) DeleteAuth(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuth", reflect.TypeOf((*MockUEAUService)(nil).DeleteAuth), arg0, arg1, arg2, arg3)
+}
+
+// Error mocks base method.
+func (m *MockUEAUService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUEAUServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUEAUService)(nil).Error), ctx, err)
+}
+
+// GenerateAuthData mocks base method.
+func (m *MockUEAUService) GenerateAuthData(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UEAU.AuthenticationInfoRequest) (*openapi_Nudm_UEAU.AuthenticationInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAuthData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_UEAU.AuthenticationInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAuthData indicates an expected call of GenerateAuthData.
+func (mr *MockUEAUServiceMockRecorder) GenerateAuthData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAuthData", reflect.TypeOf((*MockUEAUService)(nil).GenerateAuthData), arg0, arg1, arg2)
+}
+
+// GenerateHSSAV mocks base method.
+func (m *MockUEAUService) GenerateHSSAV(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UEAU.HssAuthenticationInfoRequest) (*openapi_Nudm_UEAU.HssAuthenticationInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateHSSAV", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*openapi_Nudm_UEAU.HssAuthenticationInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateHSSAV indicates an expected call of GenerateHSSAV.
+func (mr *MockUEAUServiceMockRecorder) GenerateHSSAV(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateHSSAV", reflect.TypeOf((*MockUEAUService)(nil).GenerateHSSAV), arg0, arg1, arg2, arg3)
+}
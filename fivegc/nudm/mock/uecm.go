@@ -0,0 +1,498 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../uecm.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	fivegc "github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nudm "github.com/5GCoreNet/5GCoreNetSDK/fivegc/nudm"
+	openapi_CommonData "github.com/5GCoreNet/openapi/openapi_CommonData"
+	openapi_Nudm_UECM "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUECM is a mock of UECM interface.
+type MockUECM struct {
+	ctrl     *gomock.Controller
+	recorder *MockUECMMockRecorder
+}
+
+// MockUECMMockRecorder is the mock recorder for MockUECM.
+type MockUECMMockRecorder struct {
+	mock *MockUECM
+}
+
+// NewMockUECM creates a new mock instance.
+func NewMockUECM(ctrl *gomock.Controller) *MockUECM {
+	mock := &MockUECM{ctrl: ctrl}
+	mock.recorder = &MockUECMMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUECM) EXPECT() *MockUECMMockRecorder {
+	return m.recorder
+}
+
+// DeregisterSMF mocks base method.
+func (m *MockUECM) DeregisterSMF(arg0 context.Context, arg1, arg2, arg3 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.DeregisterSMFStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterSMF", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.DeregisterSMFStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DeregisterSMF indicates an expected call of DeregisterSMF.
+func (mr *MockUECMMockRecorder) DeregisterSMF(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterSMF", reflect.TypeOf((*MockUECM)(nil).DeregisterSMF), arg0, arg1, arg2, arg3)
+}
+
+// DeregisterSMSF3GPPAccess mocks base method.
+func (m *MockUECM) DeregisterSMSF3GPPAccess(arg0 context.Context, arg1, arg2 string) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.DeregisterSMSF3GPPAccessStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterSMSF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.DeregisterSMSF3GPPAccessStatusCode)
+	return ret0, ret1, ret2
+}
+
+// DeregisterSMSF3GPPAccess indicates an expected call of DeregisterSMSF3GPPAccess.
+func (mr *MockUECMMockRecorder) DeregisterSMSF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterSMSF3GPPAccess", reflect.TypeOf((*MockUECM)(nil).DeregisterSMSF3GPPAccess), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockUECM) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUECMMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUECM)(nil).Error), ctx, err)
+}
+
+// GetAMF3GPPAccessRegistration mocks base method.
+func (m *MockUECM) GetAMF3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (openapi_Nudm_UECM.Amf3GppAccessRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetAMF3GPPAccessRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.Amf3GppAccessRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetAMF3GPPAccessRegistrationStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetAMF3GPPAccessRegistration indicates an expected call of GetAMF3GPPAccessRegistration.
+func (mr *MockUECMMockRecorder) GetAMF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMF3GPPAccessRegistration", reflect.TypeOf((*MockUECM)(nil).GetAMF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// GetAMFNon3GPPAccessRegistration mocks base method.
+func (m *MockUECM) GetAMFNon3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (openapi_Nudm_UECM.AmfNon3GppAccessRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetAMFNon3GPPAccessRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMFNon3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.AmfNon3GppAccessRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetAMFNon3GPPAccessRegistrationStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetAMFNon3GPPAccessRegistration indicates an expected call of GetAMFNon3GPPAccessRegistration.
+func (mr *MockUECMMockRecorder) GetAMFNon3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMFNon3GPPAccessRegistration", reflect.TypeOf((*MockUECM)(nil).GetAMFNon3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// GetSMFRegistration mocks base method.
+func (m *MockUECM) GetSMFRegistration(arg0 context.Context, arg1, arg2, arg3 string) (openapi_Nudm_UECM.SmfRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMFRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFRegistration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.SmfRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMFRegistrationStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMFRegistration indicates an expected call of GetSMFRegistration.
+func (mr *MockUECMMockRecorder) GetSMFRegistration(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFRegistration", reflect.TypeOf((*MockUECM)(nil).GetSMFRegistration), arg0, arg1, arg2, arg3)
+}
+
+// GetSMFRegistrations mocks base method.
+func (m *MockUECM) GetSMFRegistrations(arg0 context.Context, arg1 string, arg2 nudm.GetSMFRegistrationsRequest) (openapi_Nudm_UECM.SmfRegistrationInfo, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMFRegistrationsStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFRegistrations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.SmfRegistrationInfo)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMFRegistrationsStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMFRegistrations indicates an expected call of GetSMFRegistrations.
+func (mr *MockUECMMockRecorder) GetSMFRegistrations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFRegistrations", reflect.TypeOf((*MockUECM)(nil).GetSMFRegistrations), arg0, arg1, arg2)
+}
+
+// GetSMSF3GPPAccessRegistration mocks base method.
+func (m *MockUECM) GetSMSF3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (openapi_Nudm_UECM.SmsfRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.GetSMSF3GPPAccessRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.SmsfRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.GetSMSF3GPPAccessRegistrationStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSMSF3GPPAccessRegistration indicates an expected call of GetSMSF3GPPAccessRegistration.
+func (mr *MockUECMMockRecorder) GetSMSF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSF3GPPAccessRegistration", reflect.TypeOf((*MockUECM)(nil).GetSMSF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// RegisterAMF3GPPAccess mocks base method.
+func (m *MockUECM) RegisterAMF3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.Amf3GppAccessRegistration) (openapi_Nudm_UECM.Amf3GppAccessRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.RegisterAMF3GPPAccessStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAMF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.Amf3GppAccessRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.RegisterAMF3GPPAccessStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RegisterAMF3GPPAccess indicates an expected call of RegisterAMF3GPPAccess.
+func (mr *MockUECMMockRecorder) RegisterAMF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAMF3GPPAccess", reflect.TypeOf((*MockUECM)(nil).RegisterAMF3GPPAccess), arg0, arg1, arg2)
+}
+
+// RegisterAMFNon3GPPAccess mocks base method.
+func (m *MockUECM) RegisterAMFNon3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.AmfNon3GppAccessRegistration) (openapi_Nudm_UECM.AmfNon3GppAccessRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.RegisterAMFNon3GPPAccessStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAMFNon3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.AmfNon3GppAccessRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.RegisterAMFNon3GPPAccessStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RegisterAMFNon3GPPAccess indicates an expected call of RegisterAMFNon3GPPAccess.
+func (mr *MockUECMMockRecorder) RegisterAMFNon3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAMFNon3GPPAccess", reflect.TypeOf((*MockUECM)(nil).RegisterAMFNon3GPPAccess), arg0, arg1, arg2)
+}
+
+// RegisterSMF mocks base method.
+func (m *MockUECM) RegisterSMF(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UECM.SmfRegistration) (openapi_Nudm_UECM.SmfRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.RegisterSMFStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSMF", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.SmfRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.RegisterSMFStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RegisterSMF indicates an expected call of RegisterSMF.
+func (mr *MockUECMMockRecorder) RegisterSMF(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSMF", reflect.TypeOf((*MockUECM)(nil).RegisterSMF), arg0, arg1, arg2, arg3)
+}
+
+// RegisterSMSF3GPPAccess mocks base method.
+func (m *MockUECM) RegisterSMSF3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.SmsfRegistration) (openapi_Nudm_UECM.SmsfRegistration, openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.RegisterSMSF3GPPAccessStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSMSF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_Nudm_UECM.SmsfRegistration)
+	ret1, _ := ret[1].(openapi_CommonData.ProblemDetails)
+	ret2, _ := ret[2].(fivegc.RedirectResponse)
+	ret3, _ := ret[3].(nudm.RegisterSMSF3GPPAccessStatusCode)
+	return ret0, ret1, ret2, ret3
+}
+
+// RegisterSMSF3GPPAccess indicates an expected call of RegisterSMSF3GPPAccess.
+func (mr *MockUECMMockRecorder) RegisterSMSF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSMSF3GPPAccess", reflect.TypeOf((*MockUECM)(nil).RegisterSMSF3GPPAccess), arg0, arg1, arg2)
+}
+
+// UpdateAMF3GPPAccessRegistration mocks base method.
+func (m *MockUECM) UpdateAMF3GPPAccessRegistration(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.Amf3GppAccessRegistrationModification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.UpdateAMF3GPPAccessRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAMF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.UpdateAMF3GPPAccessRegistrationStatusCode)
+	return ret0, ret1, ret2
+}
+
+// UpdateAMF3GPPAccessRegistration indicates an expected call of UpdateAMF3GPPAccessRegistration.
+func (mr *MockUECMMockRecorder) UpdateAMF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAMF3GPPAccessRegistration", reflect.TypeOf((*MockUECM)(nil).UpdateAMF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// UpdateAMFNon3GPPAccessRegistration mocks base method.
+func (m *MockUECM) UpdateAMFNon3GPPAccessRegistration(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.AmfNon3GppAccessRegistrationModification) (openapi_CommonData.ProblemDetails, fivegc.RedirectResponse, nudm.UpdateAMFNon3GPPAccessRegistrationStatusCode) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAMFNon3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	ret1, _ := ret[1].(fivegc.RedirectResponse)
+	ret2, _ := ret[2].(nudm.UpdateAMFNon3GPPAccessRegistrationStatusCode)
+	return ret0, ret1, ret2
+}
+
+// UpdateAMFNon3GPPAccessRegistration indicates an expected call of UpdateAMFNon3GPPAccessRegistration.
+func (mr *MockUECMMockRecorder) UpdateAMFNon3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAMFNon3GPPAccessRegistration", reflect.TypeOf((*MockUECM)(nil).UpdateAMFNon3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// MockUECMService is a mock of UECMService interface.
+type MockUECMService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUECMServiceMockRecorder
+}
+
+// MockUECMServiceMockRecorder is the mock recorder for MockUECMService.
+type MockUECMServiceMockRecorder struct {
+	mock *MockUECMService
+}
+
+// NewMockUECMService creates a new mock instance.
+func NewMockUECMService(ctrl *gomock.Controller) *MockUECMService {
+	mock := &MockUECMService{ctrl: ctrl}
+	mock.recorder = &MockUECMServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUECMService) EXPECT() *MockUECMServiceMockRecorder {
+	return m.recorder
+}
+
+// DeregisterSMF mocks base method.
+func (m *MockUECMService) DeregisterSMF(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterSMF", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterSMF indicates an expected call of DeregisterSMF.
+func (mr *MockUECMServiceMockRecorder) DeregisterSMF(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterSMF", reflect.TypeOf((*MockUECMService)(nil).DeregisterSMF), arg0, arg1, arg2, arg3)
+}
+
+// DeregisterSMSF3GPPAccess mocks base method.
+func (m *MockUECMService) DeregisterSMSF3GPPAccess(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterSMSF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterSMSF3GPPAccess indicates an expected call of DeregisterSMSF3GPPAccess.
+func (mr *MockUECMServiceMockRecorder) DeregisterSMSF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterSMSF3GPPAccess", reflect.TypeOf((*MockUECMService)(nil).DeregisterSMSF3GPPAccess), arg0, arg1, arg2)
+}
+
+// Error mocks base method.
+func (m *MockUECMService) Error(ctx context.Context, err error) openapi_CommonData.ProblemDetails {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Error", ctx, err)
+	ret0, _ := ret[0].(openapi_CommonData.ProblemDetails)
+	return ret0
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockUECMServiceMockRecorder) Error(ctx, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockUECMService)(nil).Error), ctx, err)
+}
+
+// GetAMF3GPPAccessRegistration mocks base method.
+func (m *MockUECMService) GetAMF3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (*openapi_Nudm_UECM.Amf3GppAccessRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_UECM.Amf3GppAccessRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAMF3GPPAccessRegistration indicates an expected call of GetAMF3GPPAccessRegistration.
+func (mr *MockUECMServiceMockRecorder) GetAMF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMF3GPPAccessRegistration", reflect.TypeOf((*MockUECMService)(nil).GetAMF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// GetAMFNon3GPPAccessRegistration mocks base method.
+func (m *MockUECMService) GetAMFNon3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (*openapi_Nudm_UECM.AmfNon3GppAccessRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAMFNon3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_UECM.AmfNon3GppAccessRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAMFNon3GPPAccessRegistration indicates an expected call of GetAMFNon3GPPAccessRegistration.
+func (mr *MockUECMServiceMockRecorder) GetAMFNon3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAMFNon3GPPAccessRegistration", reflect.TypeOf((*MockUECMService)(nil).GetAMFNon3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// GetSMFRegistration mocks base method.
+func (m *MockUECMService) GetSMFRegistration(arg0 context.Context, arg1, arg2, arg3 string) (*openapi_Nudm_UECM.SmfRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFRegistration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*openapi_Nudm_UECM.SmfRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMFRegistration indicates an expected call of GetSMFRegistration.
+func (mr *MockUECMServiceMockRecorder) GetSMFRegistration(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFRegistration", reflect.TypeOf((*MockUECMService)(nil).GetSMFRegistration), arg0, arg1, arg2, arg3)
+}
+
+// GetSMFRegistrations mocks base method.
+func (m *MockUECMService) GetSMFRegistrations(arg0 context.Context, arg1 string, arg2 nudm.GetSMFRegistrationsRequest) (*openapi_Nudm_UECM.SmfRegistrationInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMFRegistrations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_UECM.SmfRegistrationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMFRegistrations indicates an expected call of GetSMFRegistrations.
+func (mr *MockUECMServiceMockRecorder) GetSMFRegistrations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMFRegistrations", reflect.TypeOf((*MockUECMService)(nil).GetSMFRegistrations), arg0, arg1, arg2)
+}
+
+// GetSMSF3GPPAccessRegistration mocks base method.
+func (m *MockUECMService) GetSMSF3GPPAccessRegistration(arg0 context.Context, arg1, arg2 string) (*openapi_Nudm_UECM.SmsfRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMSF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*openapi_Nudm_UECM.SmsfRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMSF3GPPAccessRegistration indicates an expected call of GetSMSF3GPPAccessRegistration.
+func (mr *MockUECMServiceMockRecorder) GetSMSF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMSF3GPPAccessRegistration", reflect.TypeOf((*MockUECMService)(nil).GetSMSF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// RegisterAMF3GPPAccess mocks base method.
+func (m *MockUECMService) RegisterAMF3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.Amf3GppAccessRegistration) (*nudm.AMF3GPPAccessRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAMF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.AMF3GPPAccessRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterAMF3GPPAccess indicates an expected call of RegisterAMF3GPPAccess.
+func (mr *MockUECMServiceMockRecorder) RegisterAMF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAMF3GPPAccess", reflect.TypeOf((*MockUECMService)(nil).RegisterAMF3GPPAccess), arg0, arg1, arg2)
+}
+
+// RegisterAMFNon3GPPAccess mocks base method.
+func (m *MockUECMService) RegisterAMFNon3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.AmfNon3GppAccessRegistration) (*nudm.AMFNon3GPPAccessRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterAMFNon3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.AMFNon3GPPAccessRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterAMFNon3GPPAccess indicates an expected call of RegisterAMFNon3GPPAccess.
+func (mr *MockUECMServiceMockRecorder) RegisterAMFNon3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAMFNon3GPPAccess", reflect.TypeOf((*MockUECMService)(nil).RegisterAMFNon3GPPAccess), arg0, arg1, arg2)
+}
+
+// RegisterSMF mocks base method.
+func (m *MockUECMService) RegisterSMF(arg0 context.Context, arg1, arg2 string, arg3 openapi_Nudm_UECM.SmfRegistration) (*nudm.SMFRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSMF", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*nudm.SMFRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSMF indicates an expected call of RegisterSMF.
+func (mr *MockUECMServiceMockRecorder) RegisterSMF(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSMF", reflect.TypeOf((*MockUECMService)(nil).RegisterSMF), arg0, arg1, arg2, arg3)
+}
+
+// RegisterSMSF3GPPAccess mocks base method.
+func (m *MockUECMService) RegisterSMSF3GPPAccess(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.SmsfRegistration) (*nudm.SMSFRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSMSF3GPPAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*nudm.SMSFRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSMSF3GPPAccess indicates an expected call of RegisterSMSF3GPPAccess.
+func (mr *MockUECMServiceMockRecorder) RegisterSMSF3GPPAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSMSF3GPPAccess", reflect.TypeOf((*MockUECMService)(nil).RegisterSMSF3GPPAccess), arg0, arg1, arg2)
+}
+
+// UpdateAMF3GPPAccessRegistration mocks base method.
+func (m *MockUECMService) UpdateAMF3GPPAccessRegistration(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.Amf3GppAccessRegistrationModification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAMF3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAMF3GPPAccessRegistration indicates an expected call of UpdateAMF3GPPAccessRegistration.
+func (mr *MockUECMServiceMockRecorder) UpdateAMF3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAMF3GPPAccessRegistration", reflect.TypeOf((*MockUECMService)(nil).UpdateAMF3GPPAccessRegistration), arg0, arg1, arg2)
+}
+
+// UpdateAMFNon3GPPAccessRegistration mocks base method.
+func (m *MockUECMService) UpdateAMFNon3GPPAccessRegistration(arg0 context.Context, arg1 string, arg2 openapi_Nudm_UECM.AmfNon3GppAccessRegistrationModification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAMFNon3GPPAccessRegistration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAMFNon3GPPAccessRegistration indicates an expected call of UpdateAMFNon3GPPAccessRegistration.
+func (mr *MockUECMServiceMockRecorder) UpdateAMFNon3GPPAccessRegistration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAMFNon3GPPAccessRegistration", reflect.TypeOf((*MockUECMService)(nil).UpdateAMFNon3GPPAccessRegistration), arg0, arg1, arg2)
+}
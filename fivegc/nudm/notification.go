@@ -0,0 +1,154 @@
+package nudm
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// DataChangeNotification is the interface that wraps the data change notification of the NUDM
+// SubscriberDataManagement service (TS 29.503 clause 5.2.2.5). It is implemented by the AMF, the SMF or the SMSF to
+// receive the changes of the subscription data, sent by the UDM to the callbackReference of their subscriptions.
+type DataChangeNotification interface {
+	fivegc.CommonInterface
+	// DataChangeNotify receives the changes of the monitored resources of a subscription.
+	DataChangeNotify(context.Context, nudmsdm.ModificationNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DataChangeNotifyStatusCode)
+}
+
+// DataChangeNotifyStatusCode is the status code of a DataChangeNotify response.
+type DataChangeNotifyStatusCode fivegc.StatusCode
+
+const (
+	// DataChangeNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	DataChangeNotifyStatusNoContent         DataChangeNotifyStatusCode = DataChangeNotifyStatusCode(fivegc.StatusNoContent)
+	DataChangeNotifyStatusTemporaryRedirect DataChangeNotifyStatusCode = DataChangeNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	DataChangeNotifyStatusPermanentRedirect DataChangeNotifyStatusCode = DataChangeNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var dataChangeNotifyErrors = response.BodyErrors
+
+func attachDataChangeNotificationHandler(router *gin.RouterGroup, path string, n DataChangeNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nudmsdm.ModificationNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, dataChangeNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.DataChangeNotify(c, req)
+		switch status {
+		case DataChangeNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case DataChangeNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case DataChangeNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, dataChangeNotifyErrors)
+		}
+		return
+	})
+}
+
+// DeregistrationNotification is the interface that wraps the deregistration notification of the NUDM
+// UEContextManagement service (TS 29.503 clause 5.3). It is implemented by the AMF or the SMF to be notified by the UDM
+// that it is no longer registered for the UE, such as when the UE registers to another AMF. The path is the one of the
+// deregCallbackUri of the registration.
+type DeregistrationNotification interface {
+	fivegc.CommonInterface
+	// DeregistrationNotify receives the reason of the deregistration, and the access type or the PDU session ID it applies to.
+	DeregistrationNotify(context.Context, nudmuecm.DeregistrationData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregistrationNotifyStatusCode)
+}
+
+// DeregistrationNotifyStatusCode is the status code of a DeregistrationNotify response.
+type DeregistrationNotifyStatusCode fivegc.StatusCode
+
+const (
+	// DeregistrationNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	DeregistrationNotifyStatusNoContent         DeregistrationNotifyStatusCode = DeregistrationNotifyStatusCode(fivegc.StatusNoContent)
+	DeregistrationNotifyStatusTemporaryRedirect DeregistrationNotifyStatusCode = DeregistrationNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	DeregistrationNotifyStatusPermanentRedirect DeregistrationNotifyStatusCode = DeregistrationNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deregistrationNotifyErrors = response.BodyErrors
+
+func attachDeregistrationNotificationHandler(router *gin.RouterGroup, path string, n DeregistrationNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nudmuecm.DeregistrationData
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, deregistrationNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.DeregistrationNotify(c, req)
+		switch status {
+		case DeregistrationNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case DeregistrationNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case DeregistrationNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deregistrationNotifyErrors)
+		}
+		return
+	})
+}
+
+// PCSCFRestorationNotification is the interface that wraps the P-CSCF restoration notification of the NUDM
+// UEContextManagement service (TS 29.503 clause 5.3). It is implemented by the AMF or the SMF to be notified by the UDM
+// of the failure of the P-CSCF serving the UE, so that a new one is selected. The path is the one of the
+// pcscfRestorationCallbackUri of the registration.
+type PCSCFRestorationNotification interface {
+	fivegc.CommonInterface
+	// PCSCFRestorationNotify receives the SUPI of the UE whose P-CSCF has failed.
+	PCSCFRestorationNotify(context.Context, nudmuecm.PcscfRestorationNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, PCSCFRestorationNotifyStatusCode)
+}
+
+// PCSCFRestorationNotifyStatusCode is the status code of a PCSCFRestorationNotify response.
+type PCSCFRestorationNotifyStatusCode fivegc.StatusCode
+
+const (
+	// PCSCFRestorationNotifyStatusNoContent is the status code for the response when the notification is successfully received.
+	PCSCFRestorationNotifyStatusNoContent         PCSCFRestorationNotifyStatusCode = PCSCFRestorationNotifyStatusCode(fivegc.StatusNoContent)
+	PCSCFRestorationNotifyStatusTemporaryRedirect PCSCFRestorationNotifyStatusCode = PCSCFRestorationNotifyStatusCode(fivegc.StatusTemporaryRedirect)
+	PCSCFRestorationNotifyStatusPermanentRedirect PCSCFRestorationNotifyStatusCode = PCSCFRestorationNotifyStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var pcscfRestorationNotifyErrors = response.BodyErrors
+
+func attachPCSCFRestorationNotificationHandler(router *gin.RouterGroup, path string, n PCSCFRestorationNotification, logger *log.Logger) {
+	router.POST(path, func(c *gin.Context) {
+		var req nudmuecm.PcscfRestorationNotification
+		if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+			problemDetails := fivegc.BindingProblemDetails(c, n, err)
+			response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, pcscfRestorationNotifyErrors)
+			return
+		}
+		problemDetails, redirectResponse, status := n.PCSCFRestorationNotify(c, req)
+		switch status {
+		case PCSCFRestorationNotifyStatusNoContent:
+			c.JSON(int(status), nil)
+		case PCSCFRestorationNotifyStatusTemporaryRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		case PCSCFRestorationNotifyStatusPermanentRedirect:
+			header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+			c.JSON(int(status), redirectResponse)
+		default:
+			response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, pcscfRestorationNotifyErrors)
+		}
+		return
+	})
+}
@@ -0,0 +1,124 @@
+package nudm
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http/httptest"
+	"testing"
+)
+
+// subscribedAMF receives the changes of the subscription data of its UEs.
+type subscribedAMF struct {
+	notifications []nudmsdm.ModificationNotification
+}
+
+func (a *subscribedAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *subscribedAMF) DataChangeNotify(_ context.Context, req nudmsdm.ModificationNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DataChangeNotifyStatusCode) {
+	if len(req.NotifyItems) == 0 {
+		return fivegc.ErrMandatoryIeMissing.ProblemDetails(), fivegc.RedirectResponse{}, DataChangeNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	a.notifications = append(a.notifications, req)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DataChangeNotifyStatusNoContent
+}
+
+func TestDataChangeNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &subscribedAMF{}
+	attachDataChangeNotificationHandler(router.Group(""), "/sdm-notify", a, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewSDMClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var subscription nudmsdm.SdmSubscription
+	subscription.CallbackReference = server.URL + "/sdm-notify"
+	subscription.SetSubscriptionId("1")
+	item := nudmsdm.NotifyItem{
+		ResourceId: "/nudm-sdm/v2/imsi-208930000000001/am-data",
+		Changes:    []nudmsdm.ChangeItem{{Op: ChangeTypeReplace, Path: "/mpsPriority"}},
+	}
+	if err := client.NotifySubscription(ctx, subscription, item); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.notifications) != 1 || a.notifications[0].GetSubscriptionId() != "1" || a.notifications[0].NotifyItems[0].Changes[0].Op != ChangeTypeReplace {
+		t.Errorf("unexpected notifications %+v", a.notifications)
+	}
+	err := client.NotifySubscription(ctx, subscription)
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusBadRequest) {
+		t.Errorf("unexpected error %v", err)
+	}
+	subscription.CallbackReference = ""
+	if err := client.NotifySubscription(ctx, subscription, item); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// registeredAMF is notified of its deregistrations and of the P-CSCF failures of its UEs.
+type registeredAMF struct {
+	deregistrations []nudmuecm.DeregistrationData
+	restorations    []nudmuecm.PcscfRestorationNotification
+}
+
+func (a *registeredAMF) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (a *registeredAMF) DeregistrationNotify(_ context.Context, req nudmuecm.DeregistrationData) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregistrationNotifyStatusCode) {
+	a.deregistrations = append(a.deregistrations, req)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeregistrationNotifyStatusNoContent
+}
+
+func (a *registeredAMF) PCSCFRestorationNotify(_ context.Context, req nudmuecm.PcscfRestorationNotification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, PCSCFRestorationNotifyStatusCode) {
+	if req.Supi == "" {
+		return fivegc.ErrMandatoryIeMissing.ProblemDetails(), fivegc.RedirectResponse{}, PCSCFRestorationNotifyStatusCode(fivegc.StatusBadRequest)
+	}
+	a.restorations = append(a.restorations, req)
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, PCSCFRestorationNotifyStatusNoContent
+}
+
+func TestDeregistrationNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	a := &registeredAMF{}
+	attachDeregistrationNotificationHandler(router.Group(""), "/dereg-notify", a, log.Default())
+	attachPCSCFRestorationNotificationHandler(router.Group(""), "/pcscf-restoration", a, log.Default())
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := NewUECMClient(fivegc.ClientConfiguration{HTTPClient: server.Client()})
+	ctx := context.Background()
+
+	var registration nudmuecm.Amf3GppAccessRegistration
+	registration.DeregCallbackUri = server.URL + "/dereg-notify"
+	if err := client.NotifyAMFDeregistration(ctx, registration, DeregistrationReasonUEInitialRegistration); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.deregistrations) != 1 || a.deregistrations[0].DeregReason != DeregistrationReasonUEInitialRegistration || a.deregistrations[0].GetAccessType() != AccessType3GPP {
+		t.Errorf("unexpected deregistrations %+v", a.deregistrations)
+	}
+	if err := client.DeregistrationNotify(ctx, "", nudmuecm.DeregistrationData{}); !errors.Is(err, fivegc.ErrMandatoryIeMissing) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err := client.PCSCFRestorationNotify(ctx, server.URL+"/pcscf-restoration", nudmuecm.PcscfRestorationNotification{Supi: "imsi-208930000000001"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(a.restorations) != 1 || a.restorations[0].Supi != "imsi-208930000000001" {
+		t.Errorf("unexpected restorations %+v", a.restorations)
+	}
+	err := client.PCSCFRestorationNotify(ctx, server.URL+"/pcscf-restoration", nudmuecm.PcscfRestorationNotification{})
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || *problemDetailsErr.Status != int32(fivegc.StatusBadRequest) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,1157 @@
+package nudm
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// The {supi} and {ueId} parameters of the resource paths of TS 29.503 share the same route parameter, gin requiring
+// the same name for the parameters at the same position of the paths.
+const (
+	sdmRouterGroup                  = "/nudm-sdm/v2"
+	ueIDParam                       = "ueId"
+	sharedDataIDParam               = "sharedDataId"
+	subscriptionIDParam             = "subscriptionId"
+	dataSetsEndpoint                = "/{ueId}"
+	nssaiEndpoint                   = "/{ueId}/nssai"
+	amDataEndpoint                  = "/{ueId}/am-data"
+	smfSelectDataEndpoint           = "/{ueId}/smf-select-data"
+	ueContextInSMFDataEndpoint      = "/{ueId}/ue-context-in-smf-data"
+	smDataEndpoint                  = "/{ueId}/sm-data"
+	smsDataEndpoint                 = "/{ueId}/sms-data"
+	smsManagementDataEndpoint       = "/{ueId}/sms-mng-data"
+	idTranslationResultEndpoint     = "/{ueId}/id-translation-result"
+	sdmSubscriptionsEndpoint        = "/{ueId}/sdm-subscriptions"
+	sdmSubscriptionEndpoint         = "/{ueId}/sdm-subscriptions/{subscriptionId}"
+	sharedDataEndpoint              = "/shared-data"
+	individualSharedDataEndpoint    = "/shared-data/{sharedDataId}"
+	sharedDataSubscriptionsEndpoint = "/shared-data-subscriptions"
+	sharedDataSubscriptionEndpoint  = "/shared-data-subscriptions/{subscriptionId}"
+)
+
+// Names of the data sets of a GetDataSets request, defined in TS 29.503 clause 6.1.6.3.3.
+const (
+	DataSetNameAM         = "AM"
+	DataSetNameSMFSel     = "SMF_SEL"
+	DataSetNameUECAMF     = "UEC_AMF"
+	DataSetNameUECSMF     = "UEC_SMF"
+	DataSetNameUECSMSF    = "UEC_SMSF"
+	DataSetNameSMSSub     = "SMS_SUB"
+	DataSetNameSM         = "SM"
+	DataSetNameTrace      = "TRACE"
+	DataSetNameSMSMng     = "SMS_MNG"
+	DataSetNameLCSPrivacy = "LCS_PRIVACY"
+	DataSetNameLCSMO      = "LCS_MO"
+	DataSetNameLCSBCA     = "LCS_BCA"
+	DataSetNameV2X        = "V2X"
+	DataSetNameProSe      = "PROSE"
+	DataSetNameMBS        = "MBS"
+	DataSetNameUC         = "UC"
+)
+
+// Types of the changes of a data change notification, defined in TS 29.571 clause 5.2.4.21.
+const (
+	ChangeTypeAdd     = "ADD"
+	ChangeTypeMove    = "MOVE"
+	ChangeTypeRemove  = "REMOVE"
+	ChangeTypeReplace = "REPLACE"
+)
+
+// SDM is the interface that wraps the NUDM SubscriberDataManagement service (TS 29.503 clause 5.2).
+// It is implemented by the UDM to provide the subscription data of the UEs to the AMF, the SMF or the SMSF, and to
+// notify them of its changes. The retrievals support the conditional requests: a 304 Not Modified is answered when the
+// data identified by the If-None-Match or the If-Modified-Since header of the request is still valid.
+type SDM interface {
+	fivegc.CommonInterface
+	// GetDataSets retrieves several data sets of the subscription data of the UE, such as the access and mobility data and the SMF selection data, in a single request.
+	GetDataSets(context.Context, string, GetDataSetsRequest) (DataSetsResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetDataSetsStatusCode)
+	// GetNSSAI retrieves the subscribed NSSAI of the UE.
+	GetNSSAI(context.Context, string, DataRequest) (NSSAIResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetNSSAIStatusCode)
+	// GetAMData retrieves the access and mobility subscription data of the UE.
+	GetAMData(context.Context, string, DataRequest) (AMDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMDataStatusCode)
+	// GetSMFSelectData retrieves the SMF selection subscription data of the UE.
+	GetSMFSelectData(context.Context, string, DataRequest) (SMFSelectDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFSelectDataStatusCode)
+	// GetUEContextInSMFData retrieves the SMFs serving the PDU sessions of the UE.
+	GetUEContextInSMFData(context.Context, string, string) (nudmsdm.UeContextInSmfData, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetUEContextInSMFDataStatusCode)
+	// GetSMData retrieves the session management subscription data of the UE, optionally for an S-NSSAI and a DNN.
+	GetSMData(context.Context, string, GetSMDataRequest) (SMDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMDataStatusCode)
+	// GetSMSData retrieves the SMS subscription data of the UE.
+	GetSMSData(context.Context, string, DataRequest) (SMSDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSDataStatusCode)
+	// GetSMSManagementData retrieves the SMS management subscription data of the UE.
+	GetSMSManagementData(context.Context, string, DataRequest) (SMSManagementDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSManagementDataStatusCode)
+	// GetSupiOrGpsi translates the GPSI of the UE to its SUPI, or its SUPI to its GPSI.
+	GetSupiOrGpsi(context.Context, string, GetSupiOrGpsiRequest) (nudmsdm.IdTranslationResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSupiOrGpsiStatusCode)
+	// GetSharedData retrieves the subscription data shared by several UEs, identified by the shared data IDs of the request.
+	GetSharedData(context.Context, GetSharedDataRequest) (SharedDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSharedDataStatusCode)
+	// GetIndividualSharedData retrieves the subscription data shared by several UEs, identified by its shared data ID.
+	GetIndividualSharedData(context.Context, string, DataRequest) (IndividualSharedDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetIndividualSharedDataStatusCode)
+	// Subscribe subscribes to the changes of the subscription data of the UE.
+	// The subscription ID of the created subscription is sent in the Location of the response.
+	Subscribe(context.Context, string, nudmsdm.SdmSubscription) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, SubscribeStatusCode)
+	// ModifySubscription modifies the expiry or the monitored resources of a subscription, identified by its ID.
+	ModifySubscription(context.Context, string, string, nudmsdm.SdmSubsModification) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, ModifySubscriptionStatusCode)
+	// Unsubscribe removes a subscription, identified by its ID.
+	Unsubscribe(context.Context, string, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UnsubscribeStatusCode)
+	// SubscribeToSharedData subscribes to the changes of the shared subscription data.
+	// The subscription ID of the created subscription is sent in the Location of the response.
+	SubscribeToSharedData(context.Context, nudmsdm.SdmSubscription) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, SubscribeToSharedDataStatusCode)
+	// UnsubscribeForSharedData removes a subscription to the shared subscription data, identified by its ID.
+	UnsubscribeForSharedData(context.Context, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UnsubscribeForSharedDataStatusCode)
+}
+
+// SDMService is an alternative to the SDM interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), ErrNotModified is answered with a 304 Not Modified, and a nil
+// result is answered as an empty one. Use NewSDM to attach it to the NUDM Server.
+type SDMService interface {
+	fivegc.CommonInterface
+	// GetDataSets retrieves several data sets of the subscription data of the UE.
+	GetDataSets(context.Context, string, GetDataSetsRequest) (*DataSetsResponse, error)
+	// GetNSSAI retrieves the subscribed NSSAI of the UE.
+	GetNSSAI(context.Context, string, DataRequest) (*NSSAIResponse, error)
+	// GetAMData retrieves the access and mobility subscription data of the UE.
+	GetAMData(context.Context, string, DataRequest) (*AMDataResponse, error)
+	// GetSMFSelectData retrieves the SMF selection subscription data of the UE.
+	GetSMFSelectData(context.Context, string, DataRequest) (*SMFSelectDataResponse, error)
+	// GetUEContextInSMFData retrieves the SMFs serving the PDU sessions of the UE.
+	GetUEContextInSMFData(context.Context, string, string) (*nudmsdm.UeContextInSmfData, error)
+	// GetSMData retrieves the session management subscription data of the UE.
+	GetSMData(context.Context, string, GetSMDataRequest) (*SMDataResponse, error)
+	// GetSMSData retrieves the SMS subscription data of the UE.
+	GetSMSData(context.Context, string, DataRequest) (*SMSDataResponse, error)
+	// GetSMSManagementData retrieves the SMS management subscription data of the UE.
+	GetSMSManagementData(context.Context, string, DataRequest) (*SMSManagementDataResponse, error)
+	// GetSupiOrGpsi translates the GPSI of the UE to its SUPI, or its SUPI to its GPSI.
+	GetSupiOrGpsi(context.Context, string, GetSupiOrGpsiRequest) (*nudmsdm.IdTranslationResult, error)
+	// GetSharedData retrieves the subscription data shared by several UEs.
+	GetSharedData(context.Context, GetSharedDataRequest) (*SharedDataResponse, error)
+	// GetIndividualSharedData retrieves the subscription data shared by several UEs, identified by its shared data ID.
+	GetIndividualSharedData(context.Context, string, DataRequest) (*IndividualSharedDataResponse, error)
+	// Subscribe subscribes to the changes of the subscription data of the UE.
+	Subscribe(context.Context, string, nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error)
+	// ModifySubscription modifies the expiry or the monitored resources of a subscription, identified by its ID.
+	ModifySubscription(context.Context, string, string, nudmsdm.SdmSubsModification) (*nudmsdm.SdmSubscription, error)
+	// Unsubscribe removes a subscription, identified by its ID.
+	Unsubscribe(context.Context, string, string) error
+	// SubscribeToSharedData subscribes to the changes of the shared subscription data.
+	SubscribeToSharedData(context.Context, nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error)
+	// UnsubscribeForSharedData removes a subscription to the shared subscription data, identified by its ID.
+	UnsubscribeForSharedData(context.Context, string) error
+}
+
+// GetDataSetsStatusCode is the status code of a GetDataSets response.
+type GetDataSetsStatusCode fivegc.StatusCode
+
+const (
+	// GetDataSetsStatusOK is the status code for a successful response.
+	GetDataSetsStatusOK GetDataSetsStatusCode = GetDataSetsStatusCode(fivegc.StatusOK)
+	// GetDataSetsStatusNotModified is the status code for the response when the data has not been modified.
+	GetDataSetsStatusNotModified       GetDataSetsStatusCode = GetDataSetsStatusCode(fivegc.StatusNotModified)
+	GetDataSetsStatusTemporaryRedirect GetDataSetsStatusCode = GetDataSetsStatusCode(fivegc.StatusTemporaryRedirect)
+	GetDataSetsStatusPermanentRedirect GetDataSetsStatusCode = GetDataSetsStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getDataSetsErrors = response.QueryErrors
+
+// GetNSSAIStatusCode is the status code of a GetNSSAI response.
+type GetNSSAIStatusCode fivegc.StatusCode
+
+const (
+	// GetNSSAIStatusOK is the status code for a successful response.
+	GetNSSAIStatusOK GetNSSAIStatusCode = GetNSSAIStatusCode(fivegc.StatusOK)
+	// GetNSSAIStatusNotModified is the status code for the response when the data has not been modified.
+	GetNSSAIStatusNotModified       GetNSSAIStatusCode = GetNSSAIStatusCode(fivegc.StatusNotModified)
+	GetNSSAIStatusTemporaryRedirect GetNSSAIStatusCode = GetNSSAIStatusCode(fivegc.StatusTemporaryRedirect)
+	GetNSSAIStatusPermanentRedirect GetNSSAIStatusCode = GetNSSAIStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getNSSAIErrors = response.QueryErrors
+
+// GetAMDataStatusCode is the status code of a GetAMData response.
+type GetAMDataStatusCode fivegc.StatusCode
+
+const (
+	// GetAMDataStatusOK is the status code for a successful response.
+	GetAMDataStatusOK GetAMDataStatusCode = GetAMDataStatusCode(fivegc.StatusOK)
+	// GetAMDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetAMDataStatusNotModified       GetAMDataStatusCode = GetAMDataStatusCode(fivegc.StatusNotModified)
+	GetAMDataStatusTemporaryRedirect GetAMDataStatusCode = GetAMDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetAMDataStatusPermanentRedirect GetAMDataStatusCode = GetAMDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getAMDataErrors = response.QueryErrors
+
+// GetSMFSelectDataStatusCode is the status code of a GetSMFSelectData response.
+type GetSMFSelectDataStatusCode fivegc.StatusCode
+
+const (
+	// GetSMFSelectDataStatusOK is the status code for a successful response.
+	GetSMFSelectDataStatusOK GetSMFSelectDataStatusCode = GetSMFSelectDataStatusCode(fivegc.StatusOK)
+	// GetSMFSelectDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetSMFSelectDataStatusNotModified       GetSMFSelectDataStatusCode = GetSMFSelectDataStatusCode(fivegc.StatusNotModified)
+	GetSMFSelectDataStatusTemporaryRedirect GetSMFSelectDataStatusCode = GetSMFSelectDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMFSelectDataStatusPermanentRedirect GetSMFSelectDataStatusCode = GetSMFSelectDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMFSelectDataErrors = response.QueryErrors
+
+// GetUEContextInSMFDataStatusCode is the status code of a GetUEContextInSMFData response.
+type GetUEContextInSMFDataStatusCode fivegc.StatusCode
+
+const (
+	// GetUEContextInSMFDataStatusOK is the status code for a successful response.
+	GetUEContextInSMFDataStatusOK                GetUEContextInSMFDataStatusCode = GetUEContextInSMFDataStatusCode(fivegc.StatusOK)
+	GetUEContextInSMFDataStatusTemporaryRedirect GetUEContextInSMFDataStatusCode = GetUEContextInSMFDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetUEContextInSMFDataStatusPermanentRedirect GetUEContextInSMFDataStatusCode = GetUEContextInSMFDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getUEContextInSMFDataErrors = response.QueryErrors
+
+// GetSMDataStatusCode is the status code of a GetSMData response.
+type GetSMDataStatusCode fivegc.StatusCode
+
+const (
+	// GetSMDataStatusOK is the status code for a successful response.
+	GetSMDataStatusOK GetSMDataStatusCode = GetSMDataStatusCode(fivegc.StatusOK)
+	// GetSMDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetSMDataStatusNotModified       GetSMDataStatusCode = GetSMDataStatusCode(fivegc.StatusNotModified)
+	GetSMDataStatusTemporaryRedirect GetSMDataStatusCode = GetSMDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMDataStatusPermanentRedirect GetSMDataStatusCode = GetSMDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMDataErrors = response.QueryErrors
+
+// GetSMSDataStatusCode is the status code of a GetSMSData response.
+type GetSMSDataStatusCode fivegc.StatusCode
+
+const (
+	// GetSMSDataStatusOK is the status code for a successful response.
+	GetSMSDataStatusOK GetSMSDataStatusCode = GetSMSDataStatusCode(fivegc.StatusOK)
+	// GetSMSDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetSMSDataStatusNotModified       GetSMSDataStatusCode = GetSMSDataStatusCode(fivegc.StatusNotModified)
+	GetSMSDataStatusTemporaryRedirect GetSMSDataStatusCode = GetSMSDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMSDataStatusPermanentRedirect GetSMSDataStatusCode = GetSMSDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMSDataErrors = response.QueryErrors
+
+// GetSMSManagementDataStatusCode is the status code of a GetSMSManagementData response.
+type GetSMSManagementDataStatusCode fivegc.StatusCode
+
+const (
+	// GetSMSManagementDataStatusOK is the status code for a successful response.
+	GetSMSManagementDataStatusOK GetSMSManagementDataStatusCode = GetSMSManagementDataStatusCode(fivegc.StatusOK)
+	// GetSMSManagementDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetSMSManagementDataStatusNotModified       GetSMSManagementDataStatusCode = GetSMSManagementDataStatusCode(fivegc.StatusNotModified)
+	GetSMSManagementDataStatusTemporaryRedirect GetSMSManagementDataStatusCode = GetSMSManagementDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMSManagementDataStatusPermanentRedirect GetSMSManagementDataStatusCode = GetSMSManagementDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMSManagementDataErrors = response.QueryErrors
+
+// GetSupiOrGpsiStatusCode is the status code of a GetSupiOrGpsi response.
+type GetSupiOrGpsiStatusCode fivegc.StatusCode
+
+const (
+	// GetSupiOrGpsiStatusOK is the status code for a successful response.
+	GetSupiOrGpsiStatusOK                GetSupiOrGpsiStatusCode = GetSupiOrGpsiStatusCode(fivegc.StatusOK)
+	GetSupiOrGpsiStatusTemporaryRedirect GetSupiOrGpsiStatusCode = GetSupiOrGpsiStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSupiOrGpsiStatusPermanentRedirect GetSupiOrGpsiStatusCode = GetSupiOrGpsiStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSupiOrGpsiErrors = response.QueryErrors
+
+// GetSharedDataStatusCode is the status code of a GetSharedData response.
+type GetSharedDataStatusCode fivegc.StatusCode
+
+const (
+	// GetSharedDataStatusOK is the status code for a successful response.
+	GetSharedDataStatusOK GetSharedDataStatusCode = GetSharedDataStatusCode(fivegc.StatusOK)
+	// GetSharedDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetSharedDataStatusNotModified       GetSharedDataStatusCode = GetSharedDataStatusCode(fivegc.StatusNotModified)
+	GetSharedDataStatusTemporaryRedirect GetSharedDataStatusCode = GetSharedDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSharedDataStatusPermanentRedirect GetSharedDataStatusCode = GetSharedDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSharedDataErrors = response.QueryErrors
+
+// GetIndividualSharedDataStatusCode is the status code of a GetIndividualSharedData response.
+type GetIndividualSharedDataStatusCode fivegc.StatusCode
+
+const (
+	// GetIndividualSharedDataStatusOK is the status code for a successful response.
+	GetIndividualSharedDataStatusOK GetIndividualSharedDataStatusCode = GetIndividualSharedDataStatusCode(fivegc.StatusOK)
+	// GetIndividualSharedDataStatusNotModified is the status code for the response when the data has not been modified.
+	GetIndividualSharedDataStatusNotModified       GetIndividualSharedDataStatusCode = GetIndividualSharedDataStatusCode(fivegc.StatusNotModified)
+	GetIndividualSharedDataStatusTemporaryRedirect GetIndividualSharedDataStatusCode = GetIndividualSharedDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GetIndividualSharedDataStatusPermanentRedirect GetIndividualSharedDataStatusCode = GetIndividualSharedDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getIndividualSharedDataErrors = response.QueryErrors
+
+// SubscribeStatusCode is the status code of a Subscribe response.
+// The operation may also fail with 501 Not Implemented.
+type SubscribeStatusCode fivegc.StatusCode
+
+const (
+	// SubscribeStatusCreated is the status code for the response when the subscription is created.
+	SubscribeStatusCreated           SubscribeStatusCode = SubscribeStatusCode(fivegc.StatusCreated)
+	SubscribeStatusTemporaryRedirect SubscribeStatusCode = SubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	SubscribeStatusPermanentRedirect SubscribeStatusCode = SubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var subscribeErrors = response.BodyErrors.With(fivegc.StatusNotImplemented)
+
+// ModifySubscriptionStatusCode is the status code of a ModifySubscription response.
+type ModifySubscriptionStatusCode fivegc.StatusCode
+
+const (
+	// ModifySubscriptionStatusOK is the status code for a successful response.
+	ModifySubscriptionStatusOK                ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusOK)
+	ModifySubscriptionStatusTemporaryRedirect ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusTemporaryRedirect)
+	ModifySubscriptionStatusPermanentRedirect ModifySubscriptionStatusCode = ModifySubscriptionStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var modifySubscriptionErrors = response.BodyErrors
+
+// UnsubscribeStatusCode is the status code of an Unsubscribe response.
+type UnsubscribeStatusCode fivegc.StatusCode
+
+const (
+	// UnsubscribeStatusNoContent is the status code for the response when the subscription is removed.
+	UnsubscribeStatusNoContent         UnsubscribeStatusCode = UnsubscribeStatusCode(fivegc.StatusNoContent)
+	UnsubscribeStatusTemporaryRedirect UnsubscribeStatusCode = UnsubscribeStatusCode(fivegc.StatusTemporaryRedirect)
+	UnsubscribeStatusPermanentRedirect UnsubscribeStatusCode = UnsubscribeStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var unsubscribeErrors = response.DeletionErrors
+
+// SubscribeToSharedDataStatusCode is the status code of a SubscribeToSharedData response.
+// The operation may also fail with 501 Not Implemented.
+type SubscribeToSharedDataStatusCode fivegc.StatusCode
+
+const (
+	// SubscribeToSharedDataStatusCreated is the status code for the response when the subscription is created.
+	SubscribeToSharedDataStatusCreated           SubscribeToSharedDataStatusCode = SubscribeToSharedDataStatusCode(fivegc.StatusCreated)
+	SubscribeToSharedDataStatusTemporaryRedirect SubscribeToSharedDataStatusCode = SubscribeToSharedDataStatusCode(fivegc.StatusTemporaryRedirect)
+	SubscribeToSharedDataStatusPermanentRedirect SubscribeToSharedDataStatusCode = SubscribeToSharedDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var subscribeToSharedDataErrors = response.BodyErrors.With(fivegc.StatusNotImplemented)
+
+// UnsubscribeForSharedDataStatusCode is the status code of an UnsubscribeForSharedData response.
+type UnsubscribeForSharedDataStatusCode fivegc.StatusCode
+
+const (
+	// UnsubscribeForSharedDataStatusNoContent is the status code for the response when the subscription is removed.
+	UnsubscribeForSharedDataStatusNoContent         UnsubscribeForSharedDataStatusCode = UnsubscribeForSharedDataStatusCode(fivegc.StatusNoContent)
+	UnsubscribeForSharedDataStatusTemporaryRedirect UnsubscribeForSharedDataStatusCode = UnsubscribeForSharedDataStatusCode(fivegc.StatusTemporaryRedirect)
+	UnsubscribeForSharedDataStatusPermanentRedirect UnsubscribeForSharedDataStatusCode = UnsubscribeForSharedDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var unsubscribeForSharedDataErrors = response.DeletionErrors
+
+func attachSDMHandler(r *gin.RouterGroup, s SDM, logger *log.Logger) {
+	group := r.Group(sdmRouterGroup)
+	{
+		group.GET(router.Path(dataSetsEndpoint), func(c *gin.Context) {
+			req := GetDataSetsRequest{DatasetNames: listQuery(c, datasetNamesQuery)}
+			var err error
+			if req.DataRequest, err = bindDataRequest(c); err == nil && len(req.DatasetNames) == 0 {
+				err = fivegc.ErrMandatoryQueryParamMissing.WithDetail("%s is missing", datasetNamesQuery).WithInvalidParams(openapicommon.InvalidParam{
+					Param: datasetNamesQuery,
+				})
+			}
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getDataSetsErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetDataSets(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetDataSetsStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetDataSetsStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetDataSetsStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetDataSetsStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getDataSetsErrors)
+			}
+			return
+		})
+		group.GET(router.Path(nssaiEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getNSSAIErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetNSSAI(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetNSSAIStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetNSSAIStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetNSSAIStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetNSSAIStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getNSSAIErrors)
+			}
+			return
+		})
+		group.GET(router.Path(amDataEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getAMDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetAMData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetAMDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetAMDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetAMDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetAMDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getAMDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smfSelectDataEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMFSelectDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetSMFSelectData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSMFSelectDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetSMFSelectDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetSMFSelectDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMFSelectDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMFSelectDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(ueContextInSMFDataEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := s.GetUEContextInSMFData(c, c.Param(ueIDParam), c.Query(supportedFeaturesQuery))
+			switch status {
+			case GetUEContextInSMFDataStatusOK:
+				c.JSON(int(status), res)
+			case GetUEContextInSMFDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetUEContextInSMFDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getUEContextInSMFDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smDataEndpoint), func(c *gin.Context) {
+			dataRequest, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMDataErrors)
+				return
+			}
+			req := GetSMDataRequest{DataRequest: dataRequest, Dnn: c.Query(dnnQuery)}
+			var singleNssai nudmsdm.Snssai
+			found, err := router.JSONQuery(c, singleNssaiQuery, &singleNssai, false)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMDataErrors)
+				return
+			}
+			if found {
+				req.SingleNssai = &singleNssai
+			}
+			res, problemDetails, redirectResponse, status := s.GetSMData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSMDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetSMDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetSMDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smsDataEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMSDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetSMSData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSMSDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetSMSDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetSMSDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMSDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMSDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smsManagementDataEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMSManagementDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetSMSManagementData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSMSManagementDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetSMSManagementDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetSMSManagementDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMSManagementDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMSManagementDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(idTranslationResultEndpoint), func(c *gin.Context) {
+			req := GetSupiOrGpsiRequest{
+				SupportedFeatures: c.Query(supportedFeaturesQuery),
+				AfID:              c.Query(afIDQuery),
+				AfServiceID:       c.Query(afServiceIDQuery),
+				MtcProviderInfo:   c.Query(mtcProviderInfoQuery),
+				RequestedGpsiType: c.Query(requestedGpsiTypeQuery),
+			}
+			res, problemDetails, redirectResponse, status := s.GetSupiOrGpsi(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSupiOrGpsiStatusOK:
+				c.JSON(int(status), res)
+			case GetSupiOrGpsiStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSupiOrGpsiStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSupiOrGpsiErrors)
+			}
+			return
+		})
+		group.GET(router.Path(sharedDataEndpoint), func(c *gin.Context) {
+			req := GetSharedDataRequest{
+				SharedDataIDs:     listQuery(c, sharedDataIDsQuery),
+				SupportedFeatures: c.Query(supportedFeaturesQuery),
+				IfNoneMatch:       c.GetHeader(ifNoneMatchHeader),
+				IfModifiedSince:   c.GetHeader(ifModifiedSinceHeader),
+			}
+			if len(req.SharedDataIDs) == 0 {
+				err := fivegc.ErrMandatoryQueryParamMissing.WithDetail("%s is missing", sharedDataIDsQuery).WithInvalidParams(openapicommon.InvalidParam{
+					Param: sharedDataIDsQuery,
+				})
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSharedDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetSharedData(c, req)
+			switch status {
+			case GetSharedDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetSharedDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetSharedDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSharedDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSharedDataErrors)
+			}
+			return
+		})
+		group.GET(router.Path(individualSharedDataEndpoint), func(c *gin.Context) {
+			req, err := bindDataRequest(c)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getIndividualSharedDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.GetIndividualSharedData(c, c.Param(sharedDataIDParam), req)
+			switch status {
+			case GetIndividualSharedDataStatusOK:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), res.JSONData)
+			case GetIndividualSharedDataStatusNotModified:
+				bindCacheHeader(c, res.CacheHeader)
+				c.JSON(int(status), nil)
+			case GetIndividualSharedDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetIndividualSharedDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getIndividualSharedDataErrors)
+			}
+			return
+		})
+		group.POST(router.Path(sdmSubscriptionsEndpoint), func(c *gin.Context) {
+			var req nudmsdm.SdmSubscription
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, subscribeErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.Subscribe(c, c.Param(ueIDParam), req)
+			switch status {
+			case SubscribeStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.GetSubscriptionId()))
+				c.JSON(int(status), res)
+			case SubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case SubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, subscribeErrors)
+			}
+			return
+		})
+		group.PATCH(router.Path(sdmSubscriptionEndpoint), func(c *gin.Context) {
+			var req nudmsdm.SdmSubsModification
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, modifySubscriptionErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.ModifySubscription(c, c.Param(ueIDParam), c.Param(subscriptionIDParam), req)
+			switch status {
+			case ModifySubscriptionStatusOK:
+				c.JSON(int(status), res)
+			case ModifySubscriptionStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ModifySubscriptionStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, modifySubscriptionErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(sdmSubscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := s.Unsubscribe(c, c.Param(ueIDParam), c.Param(subscriptionIDParam))
+			switch status {
+			case UnsubscribeStatusNoContent:
+				c.JSON(int(status), nil)
+			case UnsubscribeStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UnsubscribeStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, unsubscribeErrors)
+			}
+			return
+		})
+		group.POST(router.Path(sharedDataSubscriptionsEndpoint), func(c *gin.Context) {
+			var req nudmsdm.SdmSubscription
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, s, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, subscribeToSharedDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := s.SubscribeToSharedData(c, req)
+			switch status {
+			case SubscribeToSharedDataStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.GetSubscriptionId()))
+				c.JSON(int(status), res)
+			case SubscribeToSharedDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case SubscribeToSharedDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, subscribeToSharedDataErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(sharedDataSubscriptionEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := s.UnsubscribeForSharedData(c, c.Param(subscriptionIDParam))
+			switch status {
+			case UnsubscribeForSharedDataStatusNoContent:
+				c.JSON(int(status), nil)
+			case UnsubscribeForSharedDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UnsubscribeForSharedDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, unsubscribeForSharedDataErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewSDM adapts a SDMService to the SDM interface.
+func NewSDM(s SDMService) SDM {
+	return sdmAdapter{service: s}
+}
+
+type sdmAdapter struct {
+	service SDMService
+}
+
+func (a sdmAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a sdmAdapter) GetDataSets(ctx context.Context, supi string, req GetDataSetsRequest) (DataSetsResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetDataSetsStatusCode) {
+	res, err := a.service.GetDataSets(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return DataSetsResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetDataSetsStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return DataSetsResponse{}, problemDetails, redirectResponse, GetDataSetsStatusCode(status)
+	}
+	if res == nil {
+		res = &DataSetsResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetDataSetsStatusOK
+}
+
+func (a sdmAdapter) GetNSSAI(ctx context.Context, supi string, req DataRequest) (NSSAIResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetNSSAIStatusCode) {
+	res, err := a.service.GetNSSAI(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return NSSAIResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetNSSAIStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return NSSAIResponse{}, problemDetails, redirectResponse, GetNSSAIStatusCode(status)
+	}
+	if res == nil {
+		res = &NSSAIResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetNSSAIStatusOK
+}
+
+func (a sdmAdapter) GetAMData(ctx context.Context, supi string, req DataRequest) (AMDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMDataStatusCode) {
+	res, err := a.service.GetAMData(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return AMDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetAMDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return AMDataResponse{}, problemDetails, redirectResponse, GetAMDataStatusCode(status)
+	}
+	if res == nil {
+		res = &AMDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetAMDataStatusOK
+}
+
+func (a sdmAdapter) GetSMFSelectData(ctx context.Context, supi string, req DataRequest) (SMFSelectDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFSelectDataStatusCode) {
+	res, err := a.service.GetSMFSelectData(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return SMFSelectDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMFSelectDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return SMFSelectDataResponse{}, problemDetails, redirectResponse, GetSMFSelectDataStatusCode(status)
+	}
+	if res == nil {
+		res = &SMFSelectDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMFSelectDataStatusOK
+}
+
+func (a sdmAdapter) GetUEContextInSMFData(ctx context.Context, supi string, supportedFeatures string) (nudmsdm.UeContextInSmfData, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetUEContextInSMFDataStatusCode) {
+	res, err := a.service.GetUEContextInSMFData(ctx, supi, supportedFeatures)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmsdm.UeContextInSmfData{}, problemDetails, redirectResponse, GetUEContextInSMFDataStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmsdm.UeContextInSmfData{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetUEContextInSMFDataStatusOK
+}
+
+func (a sdmAdapter) GetSMData(ctx context.Context, supi string, req GetSMDataRequest) (SMDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMDataStatusCode) {
+	res, err := a.service.GetSMData(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return SMDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return SMDataResponse{}, problemDetails, redirectResponse, GetSMDataStatusCode(status)
+	}
+	if res == nil {
+		res = &SMDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMDataStatusOK
+}
+
+func (a sdmAdapter) GetSMSData(ctx context.Context, supi string, req DataRequest) (SMSDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSDataStatusCode) {
+	res, err := a.service.GetSMSData(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return SMSDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMSDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return SMSDataResponse{}, problemDetails, redirectResponse, GetSMSDataStatusCode(status)
+	}
+	if res == nil {
+		res = &SMSDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMSDataStatusOK
+}
+
+func (a sdmAdapter) GetSMSManagementData(ctx context.Context, supi string, req DataRequest) (SMSManagementDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSManagementDataStatusCode) {
+	res, err := a.service.GetSMSManagementData(ctx, supi, req)
+	if errors.Is(err, ErrNotModified) {
+		return SMSManagementDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMSManagementDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return SMSManagementDataResponse{}, problemDetails, redirectResponse, GetSMSManagementDataStatusCode(status)
+	}
+	if res == nil {
+		res = &SMSManagementDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMSManagementDataStatusOK
+}
+
+func (a sdmAdapter) GetSupiOrGpsi(ctx context.Context, ueID string, req GetSupiOrGpsiRequest) (nudmsdm.IdTranslationResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSupiOrGpsiStatusCode) {
+	res, err := a.service.GetSupiOrGpsi(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmsdm.IdTranslationResult{}, problemDetails, redirectResponse, GetSupiOrGpsiStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmsdm.IdTranslationResult{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSupiOrGpsiStatusOK
+}
+
+func (a sdmAdapter) GetSharedData(ctx context.Context, req GetSharedDataRequest) (SharedDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSharedDataStatusCode) {
+	res, err := a.service.GetSharedData(ctx, req)
+	if errors.Is(err, ErrNotModified) {
+		return SharedDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSharedDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return SharedDataResponse{}, problemDetails, redirectResponse, GetSharedDataStatusCode(status)
+	}
+	if res == nil {
+		res = &SharedDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSharedDataStatusOK
+}
+
+func (a sdmAdapter) GetIndividualSharedData(ctx context.Context, sharedDataID string, req DataRequest) (IndividualSharedDataResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetIndividualSharedDataStatusCode) {
+	res, err := a.service.GetIndividualSharedData(ctx, sharedDataID, req)
+	if errors.Is(err, ErrNotModified) {
+		return IndividualSharedDataResponse{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetIndividualSharedDataStatusNotModified
+	}
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return IndividualSharedDataResponse{}, problemDetails, redirectResponse, GetIndividualSharedDataStatusCode(status)
+	}
+	if res == nil {
+		res = &IndividualSharedDataResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetIndividualSharedDataStatusOK
+}
+
+func (a sdmAdapter) Subscribe(ctx context.Context, ueID string, req nudmsdm.SdmSubscription) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, SubscribeStatusCode) {
+	res, err := a.service.Subscribe(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmsdm.SdmSubscription{}, problemDetails, redirectResponse, SubscribeStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmsdm.SdmSubscription{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, SubscribeStatusCreated
+}
+
+func (a sdmAdapter) ModifySubscription(ctx context.Context, ueID string, subscriptionID string, req nudmsdm.SdmSubsModification) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, ModifySubscriptionStatusCode) {
+	res, err := a.service.ModifySubscription(ctx, ueID, subscriptionID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmsdm.SdmSubscription{}, problemDetails, redirectResponse, ModifySubscriptionStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmsdm.SdmSubscription{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ModifySubscriptionStatusOK
+}
+
+func (a sdmAdapter) Unsubscribe(ctx context.Context, ueID string, subscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UnsubscribeStatusCode) {
+	if err := a.service.Unsubscribe(ctx, ueID, subscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, UnsubscribeStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UnsubscribeStatusNoContent
+}
+
+func (a sdmAdapter) SubscribeToSharedData(ctx context.Context, req nudmsdm.SdmSubscription) (nudmsdm.SdmSubscription, openapicommon.ProblemDetails, fivegc.RedirectResponse, SubscribeToSharedDataStatusCode) {
+	res, err := a.service.SubscribeToSharedData(ctx, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmsdm.SdmSubscription{}, problemDetails, redirectResponse, SubscribeToSharedDataStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmsdm.SdmSubscription{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, SubscribeToSharedDataStatusCreated
+}
+
+func (a sdmAdapter) UnsubscribeForSharedData(ctx context.Context, subscriptionID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UnsubscribeForSharedDataStatusCode) {
+	if err := a.service.UnsubscribeForSharedData(ctx, subscriptionID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, UnsubscribeForSharedDataStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UnsubscribeForSharedDataStatusNoContent
+}
+
+// NewSDMService adapts a SDM to the SDMService interface.
+func NewSDMService(s SDM) SDMService {
+	return sdmServiceAdapter{sdm: s}
+}
+
+type sdmServiceAdapter struct {
+	sdm SDM
+}
+
+func (a sdmServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.sdm.Error(ctx, err)
+}
+
+func (a sdmServiceAdapter) GetDataSets(ctx context.Context, supi string, req GetDataSetsRequest) (*DataSetsResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetDataSets(ctx, supi, req)
+	switch status {
+	case GetDataSetsStatusOK:
+		return &res, nil
+	case GetDataSetsStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetNSSAI(ctx context.Context, supi string, req DataRequest) (*NSSAIResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetNSSAI(ctx, supi, req)
+	switch status {
+	case GetNSSAIStatusOK:
+		return &res, nil
+	case GetNSSAIStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetAMData(ctx context.Context, supi string, req DataRequest) (*AMDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetAMData(ctx, supi, req)
+	switch status {
+	case GetAMDataStatusOK:
+		return &res, nil
+	case GetAMDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSMFSelectData(ctx context.Context, supi string, req DataRequest) (*SMFSelectDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSMFSelectData(ctx, supi, req)
+	switch status {
+	case GetSMFSelectDataStatusOK:
+		return &res, nil
+	case GetSMFSelectDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetUEContextInSMFData(ctx context.Context, supi string, supportedFeatures string) (*nudmsdm.UeContextInSmfData, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetUEContextInSMFData(ctx, supi, supportedFeatures)
+	if status == GetUEContextInSMFDataStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSMData(ctx context.Context, supi string, req GetSMDataRequest) (*SMDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSMData(ctx, supi, req)
+	switch status {
+	case GetSMDataStatusOK:
+		return &res, nil
+	case GetSMDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSMSData(ctx context.Context, supi string, req DataRequest) (*SMSDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSMSData(ctx, supi, req)
+	switch status {
+	case GetSMSDataStatusOK:
+		return &res, nil
+	case GetSMSDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSMSManagementData(ctx context.Context, supi string, req DataRequest) (*SMSManagementDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSMSManagementData(ctx, supi, req)
+	switch status {
+	case GetSMSManagementDataStatusOK:
+		return &res, nil
+	case GetSMSManagementDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSupiOrGpsi(ctx context.Context, ueID string, req GetSupiOrGpsiRequest) (*nudmsdm.IdTranslationResult, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSupiOrGpsi(ctx, ueID, req)
+	if status == GetSupiOrGpsiStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetSharedData(ctx context.Context, req GetSharedDataRequest) (*SharedDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetSharedData(ctx, req)
+	switch status {
+	case GetSharedDataStatusOK:
+		return &res, nil
+	case GetSharedDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) GetIndividualSharedData(ctx context.Context, sharedDataID string, req DataRequest) (*IndividualSharedDataResponse, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.GetIndividualSharedData(ctx, sharedDataID, req)
+	switch status {
+	case GetIndividualSharedDataStatusOK:
+		return &res, nil
+	case GetIndividualSharedDataStatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) Subscribe(ctx context.Context, ueID string, req nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.Subscribe(ctx, ueID, req)
+	if status == SubscribeStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) ModifySubscription(ctx context.Context, ueID string, subscriptionID string, req nudmsdm.SdmSubsModification) (*nudmsdm.SdmSubscription, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.ModifySubscription(ctx, ueID, subscriptionID, req)
+	if status == ModifySubscriptionStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) Unsubscribe(ctx context.Context, ueID string, subscriptionID string) error {
+	problemDetails, redirectResponse, status := a.sdm.Unsubscribe(ctx, ueID, subscriptionID)
+	if status == UnsubscribeStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) SubscribeToSharedData(ctx context.Context, req nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	res, problemDetails, redirectResponse, status := a.sdm.SubscribeToSharedData(ctx, req)
+	if status == SubscribeToSharedDataStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a sdmServiceAdapter) UnsubscribeForSharedData(ctx context.Context, subscriptionID string) error {
+	problemDetails, redirectResponse, status := a.sdm.UnsubscribeForSharedData(ctx, subscriptionID)
+	if status == UnsubscribeForSharedDataStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
@@ -0,0 +1,251 @@
+package nudm
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	"net/http"
+)
+
+// SDMClient is a client for the NUDM SubscriberDataManagement service. It also sends the data change notifications.
+// The query parameters and the If-None-Match header of the retrievals are set on the returned requests.
+type SDMClient struct {
+	client  *nudmsdm.APIClient
+	request *request.Client
+}
+
+// NewSDMClient creates a new client for the NUDM SubscriberDataManagement service.
+func NewSDMClient(cfg fivegc.ClientConfiguration) *SDMClient {
+	openapiCfg := &nudmsdm.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nudmsdm.ServerConfiguration{},
+		OperationServers: make(map[string]nudmsdm.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nudmsdm.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nudmsdm.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nudmsdm.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nudmsdm.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nudmsdm.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nudmsdm.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nudmsdm.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &SDMClient{
+		client:  nudmsdm.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, sdmRouterGroup),
+	}
+}
+
+// GetDataSets returns a request retrieving several data sets of the subscription data of a UE.
+func (s *SDMClient) GetDataSets(ctx context.Context, supi string) nudmsdm.ApiGetDataSetsRequest {
+	return s.client.RetrievalOfMultipleDataSetsApi.GetDataSets(ctx, supi)
+}
+
+// GetDataSetsExecute executes a GetDataSets request.
+func (s *SDMClient) GetDataSetsExecute(r nudmsdm.ApiGetDataSetsRequest) (*nudmsdm.SubscriptionDataSets, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetNSSAI returns a request retrieving the subscribed NSSAI of a UE.
+func (s *SDMClient) GetNSSAI(ctx context.Context, supi string) nudmsdm.ApiGetNSSAIRequest {
+	return s.client.SliceSelectionSubscriptionDataRetrievalApi.GetNSSAI(ctx, supi)
+}
+
+// GetNSSAIExecute executes a GetNSSAI request.
+func (s *SDMClient) GetNSSAIExecute(r nudmsdm.ApiGetNSSAIRequest) (*nudmsdm.Nssai, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetAMData returns a request retrieving the access and mobility subscription data of a UE.
+func (s *SDMClient) GetAMData(ctx context.Context, supi string) nudmsdm.ApiGetAmDataRequest {
+	return s.client.AccessAndMobilitySubscriptionDataRetrievalApi.GetAmData(ctx, supi)
+}
+
+// GetAMDataExecute executes a GetAMData request.
+func (s *SDMClient) GetAMDataExecute(r nudmsdm.ApiGetAmDataRequest) (*nudmsdm.AccessAndMobilitySubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMFSelectData returns a request retrieving the SMF selection subscription data of a UE.
+func (s *SDMClient) GetSMFSelectData(ctx context.Context, supi string) nudmsdm.ApiGetSmfSelDataRequest {
+	return s.client.SMFSelectionSubscriptionDataRetrievalApi.GetSmfSelData(ctx, supi)
+}
+
+// GetSMFSelectDataExecute executes a GetSMFSelectData request.
+func (s *SDMClient) GetSMFSelectDataExecute(r nudmsdm.ApiGetSmfSelDataRequest) (*nudmsdm.SmfSelectionSubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetUEContextInSMFData returns a request retrieving the SMFs serving the PDU sessions of a UE.
+func (s *SDMClient) GetUEContextInSMFData(ctx context.Context, supi string) nudmsdm.ApiGetUeCtxInSmfDataRequest {
+	return s.client.UEContextInSMFDataRetrievalApi.GetUeCtxInSmfData(ctx, supi)
+}
+
+// GetUEContextInSMFDataExecute executes a GetUEContextInSMFData request.
+func (s *SDMClient) GetUEContextInSMFDataExecute(r nudmsdm.ApiGetUeCtxInSmfDataRequest) (*nudmsdm.UeContextInSmfData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMData returns a request retrieving the session management subscription data of a UE.
+func (s *SDMClient) GetSMData(ctx context.Context, supi string) nudmsdm.ApiGetSmDataRequest {
+	return s.client.SessionManagementSubscriptionDataRetrievalApi.GetSmData(ctx, supi)
+}
+
+// GetSMDataExecute executes a GetSMData request.
+func (s *SDMClient) GetSMDataExecute(r nudmsdm.ApiGetSmDataRequest) ([]nudmsdm.SessionManagementSubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMSData returns a request retrieving the SMS subscription data of a UE.
+func (s *SDMClient) GetSMSData(ctx context.Context, supi string) nudmsdm.ApiGetSmsDataRequest {
+	return s.client.SMSSubscriptionDataRetrievalApi.GetSmsData(ctx, supi)
+}
+
+// GetSMSDataExecute executes a GetSMSData request.
+func (s *SDMClient) GetSMSDataExecute(r nudmsdm.ApiGetSmsDataRequest) (*nudmsdm.SmsSubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMSManagementData returns a request retrieving the SMS management subscription data of a UE.
+func (s *SDMClient) GetSMSManagementData(ctx context.Context, supi string) nudmsdm.ApiGetSmsMngtDataRequest {
+	return s.client.SMSManagementSubscriptionDataRetrievalApi.GetSmsMngtData(ctx, supi)
+}
+
+// GetSMSManagementDataExecute executes a GetSMSManagementData request.
+func (s *SDMClient) GetSMSManagementDataExecute(r nudmsdm.ApiGetSmsMngtDataRequest) (*nudmsdm.SmsManagementSubscriptionData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSupiOrGpsi returns a request translating the GPSI of a UE to its SUPI, or its SUPI to its GPSI.
+func (s *SDMClient) GetSupiOrGpsi(ctx context.Context, ueID string) nudmsdm.ApiGetSupiOrGpsiRequest {
+	return s.client.GPSIToSUPITranslationOrSUPIToGPSITranslationApi.GetSupiOrGpsi(ctx, ueID)
+}
+
+// GetSupiOrGpsiExecute executes a GetSupiOrGpsi request.
+func (s *SDMClient) GetSupiOrGpsiExecute(r nudmsdm.ApiGetSupiOrGpsiRequest) (*nudmsdm.IdTranslationResult, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSharedData returns a request retrieving the shared subscription data.
+func (s *SDMClient) GetSharedData(ctx context.Context) nudmsdm.ApiGetSharedDataRequest {
+	return s.client.RetrievalOfSharedDataApi.GetSharedData(ctx)
+}
+
+// GetSharedDataExecute executes a GetSharedData request.
+func (s *SDMClient) GetSharedDataExecute(r nudmsdm.ApiGetSharedDataRequest) ([]nudmsdm.SharedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetIndividualSharedData returns a request retrieving a shared subscription data.
+func (s *SDMClient) GetIndividualSharedData(ctx context.Context, sharedDataID string) nudmsdm.ApiGetIndividualSharedDataRequest {
+	return s.client.RetrievalOfTheIndividualSharedDataApi.GetIndividualSharedData(ctx, sharedDataID)
+}
+
+// GetIndividualSharedDataExecute executes a GetIndividualSharedData request.
+func (s *SDMClient) GetIndividualSharedDataExecute(r nudmsdm.ApiGetIndividualSharedDataRequest) (*nudmsdm.SharedData, *http.Response, error) {
+	return r.Execute()
+}
+
+// Subscribe returns a request subscribing to the changes of the subscription data of a UE.
+func (s *SDMClient) Subscribe(ctx context.Context, ueID string) nudmsdm.ApiSubscribeRequest {
+	return s.client.SubscriptionCreationApi.Subscribe(ctx, ueID)
+}
+
+// SubscribeExecute executes a Subscribe request.
+func (s *SDMClient) SubscribeExecute(r nudmsdm.ApiSubscribeRequest) (*nudmsdm.SdmSubscription, *http.Response, error) {
+	return r.Execute()
+}
+
+// ModifySubscription returns a request modifying a subscription to the changes of the subscription data.
+func (s *SDMClient) ModifySubscription(ctx context.Context, ueID string, subscriptionID string) nudmsdm.ApiModifyRequest {
+	return s.client.SubscriptionModificationApi.Modify(ctx, ueID, subscriptionID)
+}
+
+// ModifySubscriptionExecute executes a ModifySubscription request.
+func (s *SDMClient) ModifySubscriptionExecute(r nudmsdm.ApiModifyRequest) (*nudmsdm.SdmSubscription, *http.Response, error) {
+	return r.Execute()
+}
+
+// Unsubscribe returns a request removing a subscription to the changes of the subscription data of a UE.
+func (s *SDMClient) Unsubscribe(ctx context.Context, ueID string, subscriptionID string) nudmsdm.ApiUnsubscribeRequest {
+	return s.client.SubscriptionDeletionApi.Unsubscribe(ctx, ueID, subscriptionID)
+}
+
+// UnsubscribeExecute executes an Unsubscribe request.
+func (s *SDMClient) UnsubscribeExecute(r nudmsdm.ApiUnsubscribeRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// SubscribeToSharedData returns a request subscribing to the changes of the shared subscription data.
+func (s *SDMClient) SubscribeToSharedData(ctx context.Context) nudmsdm.ApiSubscribeToSharedDataRequest {
+	return s.client.SubscriptionCreationForSharedDataApi.SubscribeToSharedData(ctx)
+}
+
+// SubscribeToSharedDataExecute executes a SubscribeToSharedData request.
+func (s *SDMClient) SubscribeToSharedDataExecute(r nudmsdm.ApiSubscribeToSharedDataRequest) (*nudmsdm.SdmSubscription, *http.Response, error) {
+	return r.Execute()
+}
+
+// UnsubscribeForSharedData returns a request removing a subscription to the changes of the shared subscription data.
+func (s *SDMClient) UnsubscribeForSharedData(ctx context.Context, subscriptionID string) nudmsdm.ApiUnsubscribeForSharedDataRequest {
+	return s.client.SubscriptionDeletionForSharedDataApi.UnsubscribeForSharedData(ctx, subscriptionID)
+}
+
+// UnsubscribeForSharedDataExecute executes an UnsubscribeForSharedData request.
+func (s *SDMClient) UnsubscribeForSharedDataExecute(r nudmsdm.ApiUnsubscribeForSharedDataRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// DataChangeNotify sends the changes of the subscription data to the callbackReference of a subscription.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (s *SDMClient) DataChangeNotify(ctx context.Context, callbackReference string, data nudmsdm.ModificationNotification) error {
+	if callbackReference == "" {
+		return fmt.Errorf("data change notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("callbackReference is missing"))
+	}
+	if err := s.request.PostJSON(ctx, callbackReference, data); err != nil {
+		return fmt.Errorf("data change notify: %w", err)
+	}
+	return nil
+}
+
+// NotifySubscription sends the changes of the subscription data to the callbackReference of a subscription, along
+// with its subscription ID.
+func (s *SDMClient) NotifySubscription(ctx context.Context, subscription nudmsdm.SdmSubscription, items ...nudmsdm.NotifyItem) error {
+	var data nudmsdm.ModificationNotification
+	data.SetNotifyItems(items)
+	if subscription.HasSubscriptionId() {
+		data.SetSubscriptionId(subscription.GetSubscriptionId())
+	}
+	return s.DataChangeNotify(ctx, subscription.CallbackReference, data)
+}
@@ -0,0 +1,212 @@
+package nudm
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmsdm "github.com/5GCoreNet/openapi/openapi_Nudm_SDM"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// subscriberUDM serves the subscription data of a single UE, whose access and mobility data is at version "1".
+type subscriberUDM struct {
+	supi          string
+	smRequest     GetSMDataRequest
+	subscriptions map[string]nudmsdm.SdmSubscription
+}
+
+func (u *subscriberUDM) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (u *subscriberUDM) GetDataSets(_ context.Context, supi string, req GetDataSetsRequest) (*DataSetsResponse, error) {
+	if supi != u.supi {
+		return nil, ErrUserNotFound
+	}
+	var res DataSetsResponse
+	for _, name := range req.DatasetNames {
+		if name == DataSetNameAM {
+			res.JSONData.SetAmData(nudmsdm.AccessAndMobilitySubscriptionData{})
+		}
+	}
+	return &res, nil
+}
+
+func (u *subscriberUDM) GetNSSAI(context.Context, string, DataRequest) (*NSSAIResponse, error) {
+	return nil, nil
+}
+
+func (u *subscriberUDM) GetAMData(_ context.Context, supi string, req DataRequest) (*AMDataResponse, error) {
+	if supi != u.supi {
+		return nil, ErrUserNotFound
+	}
+	if req.IfNoneMatch == "1" {
+		return nil, ErrNotModified
+	}
+	res := &AMDataResponse{CacheHeader: CacheHeader{ETag: "1", CacheControl: "max-age=3600"}}
+	res.JSONData.SetGpsis([]string{"msisdn-33600000001"})
+	if req.PlmnID != nil && req.PlmnID.Mnc != "93" {
+		res.JSONData.SetRatRestrictions([]nudmsdm.RatType{"NR"})
+	}
+	return res, nil
+}
+
+func (u *subscriberUDM) GetSMFSelectData(context.Context, string, DataRequest) (*SMFSelectDataResponse, error) {
+	return nil, nil
+}
+
+func (u *subscriberUDM) GetUEContextInSMFData(context.Context, string, string) (*nudmsdm.UeContextInSmfData, error) {
+	return nil, nil
+}
+
+func (u *subscriberUDM) GetSMData(_ context.Context, _ string, req GetSMDataRequest) (*SMDataResponse, error) {
+	u.smRequest = req
+	return &SMDataResponse{JSONData: []nudmsdm.SessionManagementSubscriptionData{{SingleNssai: nudmsdm.Snssai{Sst: 1}}}}, nil
+}
+
+func (u *subscriberUDM) GetSMSData(context.Context, string, DataRequest) (*SMSDataResponse, error) {
+	return nil, ErrDataNotFound
+}
+
+func (u *subscriberUDM) GetSMSManagementData(context.Context, string, DataRequest) (*SMSManagementDataResponse, error) {
+	return nil, ErrDataNotFound
+}
+
+func (u *subscriberUDM) GetSupiOrGpsi(context.Context, string, GetSupiOrGpsiRequest) (*nudmsdm.IdTranslationResult, error) {
+	return &nudmsdm.IdTranslationResult{Supi: u.supi}, nil
+}
+
+func (u *subscriberUDM) GetSharedData(_ context.Context, req GetSharedDataRequest) (*SharedDataResponse, error) {
+	var res SharedDataResponse
+	for _, id := range req.SharedDataIDs {
+		res.JSONData = append(res.JSONData, nudmsdm.SharedData{SharedDataId: id})
+	}
+	return &res, nil
+}
+
+func (u *subscriberUDM) GetIndividualSharedData(context.Context, string, DataRequest) (*IndividualSharedDataResponse, error) {
+	return nil, ErrDataNotFound
+}
+
+func (u *subscriberUDM) Subscribe(_ context.Context, ueID string, req nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	if ueID != u.supi {
+		return nil, ErrUserNotFound
+	}
+	req.SetSubscriptionId("1")
+	u.subscriptions["1"] = req
+	return &req, nil
+}
+
+func (u *subscriberUDM) ModifySubscription(_ context.Context, _ string, subscriptionID string, req nudmsdm.SdmSubsModification) (*nudmsdm.SdmSubscription, error) {
+	subscription, ok := u.subscriptions[subscriptionID]
+	if !ok {
+		return nil, fivegc.ErrSubscriptionNotFound
+	}
+	subscription.MonitoredResourceUris = req.MonitoredResourceUris
+	u.subscriptions[subscriptionID] = subscription
+	return &subscription, nil
+}
+
+func (u *subscriberUDM) Unsubscribe(_ context.Context, _ string, subscriptionID string) error {
+	if _, ok := u.subscriptions[subscriptionID]; !ok {
+		return fivegc.ErrSubscriptionNotFound
+	}
+	delete(u.subscriptions, subscriptionID)
+	return nil
+}
+
+func (u *subscriberUDM) SubscribeToSharedData(context.Context, nudmsdm.SdmSubscription) (*nudmsdm.SdmSubscription, error) {
+	return nil, ErrUnsupportedResourceURI
+}
+
+func (u *subscriberUDM) UnsubscribeForSharedData(context.Context, string) error {
+	return fivegc.ErrSubscriptionNotFound
+}
+
+func TestSDM(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	u := &subscriberUDM{supi: "imsi-208930000000001", subscriptions: map[string]nudmsdm.SdmSubscription{}}
+	attachSDMHandler(router.Group(""), NewSDM(u), log.Default())
+
+	send := func(method, path string, header http.Header, body interface{}) (*http.Response, []byte) {
+		w := apitest.Send(t, router, method, "http://udm.example.com"+sdmRouterGroup+path, header, body)
+		return w.Result(), w.Body.Bytes()
+	}
+
+	plmnID := url.QueryEscape(`{"mcc":"208","mnc":"01"}`)
+	resp, raw := send(http.MethodGet, "/imsi-208930000000001/am-data?plmn-id="+plmnID, nil, nil)
+	var amData nudmsdm.AccessAndMobilitySubscriptionData
+	if err := json.Unmarshal(raw, &amData); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if resp.Header.Get("ETag") != "1" || resp.Header.Get("Cache-Control") != "max-age=3600" || len(amData.RatRestrictions) != 1 {
+		t.Errorf("unexpected am data %+v %v", amData, resp.Header)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/am-data", http.Header{"If-None-Match": {"1"}}, nil); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/am-data?plmn-id=208", nil, nil); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000002/am-data", nil, nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001", nil, nil); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	resp, raw = send(http.MethodGet, "/imsi-208930000000001?dataset-names=AM,SMF_SEL", nil, nil)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(raw), "amData") {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+
+	singleNssai := url.QueryEscape(`{"sst":1,"sd":"000001"}`)
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/sm-data?dnn=internet&single-nssai="+singleNssai, nil, nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if u.smRequest.Dnn != "internet" || u.smRequest.SingleNssai == nil || u.smRequest.SingleNssai.GetSd() != "000001" {
+		t.Errorf("unexpected sm data request %+v", u.smRequest)
+	}
+
+	resp, raw = send(http.MethodGet, "/shared-data?shared-data-ids=a,b&shared-data-ids=c", nil, nil)
+	var sharedData []nudmsdm.SharedData
+	if err := json.Unmarshal(raw, &sharedData); err != nil || resp.StatusCode != http.StatusOK || len(sharedData) != 3 {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if resp, _ := send(http.MethodGet, "/shared-data", nil, nil); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/shared-data/a", nil, nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	var subscription nudmsdm.SdmSubscription
+	subscription.NfInstanceId = "amf-1"
+	subscription.CallbackReference = "http://amf.example.com/sdm-notify"
+	subscription.MonitoredResourceUris = []string{"/nudm-sdm/v2/imsi-208930000000001/am-data"}
+	resp, _ = send(http.MethodPost, "/imsi-208930000000001/sdm-subscriptions", nil, subscription)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/sdm-subscriptions/1") {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	modification := nudmsdm.SdmSubsModification{MonitoredResourceUris: []string{"/nudm-sdm/v2/imsi-208930000000001/sm-data"}}
+	if resp, _ := send(http.MethodPatch, "/imsi-208930000000001/sdm-subscriptions/1", nil, modification); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodDelete, "/imsi-208930000000001/sdm-subscriptions/1", nil, nil); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodDelete, "/imsi-208930000000001/sdm-subscriptions/1", nil, nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodPost, "/shared-data-subscriptions", nil, subscription); resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,107 @@
+package nudm
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Server represents a NUDM server.
+type Server struct {
+	address                          string // IP:PORT
+	apiRoot                          string
+	dataChangeNotification           DataChangeNotification
+	dataChangeNotificationPath       string
+	deregistrationNotification       DeregistrationNotification
+	deregistrationNotificationPath   string
+	pcscfRestorationNotification     PCSCFRestorationNotification
+	pcscfRestorationNotificationPath string
+	sdm                              SDM
+	uecm                             UECM
+	ueau                             UEAU
+	logger                           *log.Logger
+	router                           *gin.Engine
+	stop                             chan bool
+}
+
+// NewServer creates a new Server NUDM server instance.
+// The address is the IP:PORT of the NUDM server.
+// The apiRoot, such as https://udm.example.com/prefix or /prefix, is the one of the URIs of the created resources.
+func NewServer(address string, apiRoot string, logger *log.Logger) *Server {
+	return &Server{
+		address: address,
+		apiRoot: apiRoot,
+		logger:  logger,
+		stop:    make(chan bool),
+	}
+}
+
+// AttachDataChangeNotification attaches a DataChangeNotification handler to the server of an AMF, a SMF or a SMSF.
+// The path, relative to the API root, is the one of the callbackReference given when subscribing.
+func (n *Server) AttachDataChangeNotification(path string, notification DataChangeNotification) {
+	n.dataChangeNotificationPath = path
+	n.dataChangeNotification = notification
+}
+
+// AttachDeregistrationNotification attaches a DeregistrationNotification handler to the server of an AMF or a SMF.
+// The path, relative to the API root, is the one of the deregCallbackUri given when registering.
+func (n *Server) AttachDeregistrationNotification(path string, notification DeregistrationNotification) {
+	n.deregistrationNotificationPath = path
+	n.deregistrationNotification = notification
+}
+
+// AttachPCSCFRestorationNotification attaches a PCSCFRestorationNotification handler to the server of an AMF or a SMF.
+// The path, relative to the API root, is the one of the pcscfRestorationCallbackUri given when registering.
+func (n *Server) AttachPCSCFRestorationNotification(path string, notification PCSCFRestorationNotification) {
+	n.pcscfRestorationNotificationPath = path
+	n.pcscfRestorationNotification = notification
+}
+
+// AttachSDM attaches a SDM handler to the NUDM Server.
+func (n *Server) AttachSDM(s SDM) {
+	n.sdm = s
+}
+
+// AttachUECM attaches an UECM handler to the NUDM Server.
+func (n *Server) AttachUECM(u UECM) {
+	n.uecm = u
+}
+
+// AttachUEAU attaches an UEAU handler to the NUDM Server.
+func (n *Server) AttachUEAU(u UEAU) {
+	n.ueau = u
+}
+
+// Start starts the NUDM Server.
+func (n *Server) Start() {
+	n.router = gin.Default()
+	n.router.Use(gin.Recovery())
+	n.router.Use(gin.LoggerWithWriter(n.logger.Writer()))
+	root := router.Root(n.router, n.apiRoot)
+	if n.dataChangeNotification != nil {
+		attachDataChangeNotificationHandler(root, n.dataChangeNotificationPath, n.dataChangeNotification, n.logger)
+	}
+	if n.deregistrationNotification != nil {
+		attachDeregistrationNotificationHandler(root, n.deregistrationNotificationPath, n.deregistrationNotification, n.logger)
+	}
+	if n.pcscfRestorationNotification != nil {
+		attachPCSCFRestorationNotificationHandler(root, n.pcscfRestorationNotificationPath, n.pcscfRestorationNotification, n.logger)
+	}
+	if n.sdm != nil {
+		attachSDMHandler(root, n.sdm, n.logger)
+	}
+	if n.uecm != nil {
+		attachUECMHandler(root, n.uecm, n.logger)
+	}
+	if n.ueau != nil {
+		attachUEAUHandler(root, n.ueau, n.logger)
+	}
+	go n.router.Run(n.address)
+	<-n.stop
+	return
+}
+
+// Stop stops the NUDM Server.
+func (n *Server) Stop() {
+	n.stop <- true
+}
@@ -0,0 +1,329 @@
+package nudm
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+// The {supiOrSuci} and {supi} parameters share the {ueId} route parameter, as for the SubscriberDataManagement service.
+const (
+	ueauRouterGroup          = "/nudm-ueau/v1"
+	authEventIDParam         = "authEventId"
+	hssAuthTypeParam         = "hssAuthType"
+	generateAuthDataEndpoint = "/{ueId}/security-information/generate-auth-data"
+	authEventsEndpoint       = "/{ueId}/auth-events"
+	authEventEndpoint        = "/{ueId}/auth-events/{authEventId}"
+	generateHSSAVEndpoint    = "/{ueId}/hss-security-information/{hssAuthType}/generate-av"
+)
+
+// Authentication types, defined in TS 29.503 clause 6.3.6.3.3.
+const (
+	AuthType5GAKA       = "5G_AKA"
+	AuthTypeEAPAKAPrime = "EAP_AKA_PRIME"
+	AuthTypeEAPTLS      = "EAP_TLS"
+	AuthTypeEAPTTLS     = "EAP_TTLS"
+)
+
+// Types of the authentication vectors, defined in TS 29.503 clause 6.3.6.3.4.
+const (
+	AvType5GHEAKA     = "5G_HE_AKA"
+	AvTypeEAPAKAPrime = "EAP_AKA_PRIME"
+)
+
+// Types of the authentication vectors requested by the HSS, defined in TS 29.503 clause 6.3.6.3.6.
+const (
+	HSSAuthTypeEPSAKA      = "EPS_AKA"
+	HSSAuthTypeEAPAKA      = "EAP_AKA"
+	HSSAuthTypeEAPAKAPrime = "EAP_AKA_PRIME"
+	HSSAuthTypeIMSAKA      = "IMS_AKA"
+	HSSAuthTypeGBAAKA      = "GBA_AKA"
+	HSSAuthTypeUMTSAKA     = "UMTS_AKA"
+)
+
+// UEAU is the interface that wraps the NUDM UEAuthentication service (TS 29.503 clause 5.4).
+// It is implemented by the UDM to generate the authentication vectors of the UEs requested by the AUSF or the HSS,
+// and to store the results of their authentications.
+type UEAU interface {
+	fivegc.CommonInterface
+	// GenerateAuthData generates an authentication vector for the UE, identified by its SUPI or its SUCI. The SQN is
+	// resynchronized first when the request holds the AUTS sent by the UE.
+	GenerateAuthData(context.Context, string, nudmueau.AuthenticationInfoRequest) (nudmueau.AuthenticationInfoResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GenerateAuthDataStatusCode)
+	// ConfirmAuth stores the result of the authentication of the UE.
+	// The ID of the authentication event created is sent in the Location of the response.
+	ConfirmAuth(context.Context, string, nudmueau.AuthEvent) (ConfirmAuthResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, ConfirmAuthStatusCode)
+	// DeleteAuth removes the result of an authentication of the UE, identified by its authentication event ID.
+	DeleteAuth(context.Context, string, string, nudmueau.AuthEvent) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteAuthStatusCode)
+	// GenerateHSSAV generates the authentication vectors of the UE requested by the HSS, for the given HSS
+	// authentication type.
+	GenerateHSSAV(context.Context, string, string, nudmueau.HssAuthenticationInfoRequest) (nudmueau.HssAuthenticationInfoResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GenerateHSSAVStatusCode)
+}
+
+// UEAUService is an alternative to the UEAU interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError), and a nil result is answered as an empty one.
+// Use NewUEAU to attach it to the NUDM Server.
+type UEAUService interface {
+	fivegc.CommonInterface
+	// GenerateAuthData generates an authentication vector for the UE, identified by its SUPI or its SUCI.
+	GenerateAuthData(context.Context, string, nudmueau.AuthenticationInfoRequest) (*nudmueau.AuthenticationInfoResult, error)
+	// ConfirmAuth stores the result of the authentication of the UE.
+	ConfirmAuth(context.Context, string, nudmueau.AuthEvent) (*ConfirmAuthResponse, error)
+	// DeleteAuth removes the result of an authentication of the UE, identified by its authentication event ID.
+	DeleteAuth(context.Context, string, string, nudmueau.AuthEvent) error
+	// GenerateHSSAV generates the authentication vectors of the UE requested by the HSS.
+	GenerateHSSAV(context.Context, string, string, nudmueau.HssAuthenticationInfoRequest) (*nudmueau.HssAuthenticationInfoResult, error)
+}
+
+// GenerateAuthDataStatusCode is the status code of a GenerateAuthData response.
+// The operation may also fail with 501 Not Implemented.
+type GenerateAuthDataStatusCode fivegc.StatusCode
+
+const (
+	// GenerateAuthDataStatusOK is the status code for a successful response.
+	GenerateAuthDataStatusOK                GenerateAuthDataStatusCode = GenerateAuthDataStatusCode(fivegc.StatusOK)
+	GenerateAuthDataStatusTemporaryRedirect GenerateAuthDataStatusCode = GenerateAuthDataStatusCode(fivegc.StatusTemporaryRedirect)
+	GenerateAuthDataStatusPermanentRedirect GenerateAuthDataStatusCode = GenerateAuthDataStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var generateAuthDataErrors = response.BodyErrors.With(fivegc.StatusNotImplemented)
+
+// ConfirmAuthStatusCode is the status code of a ConfirmAuth response.
+type ConfirmAuthStatusCode fivegc.StatusCode
+
+const (
+	// ConfirmAuthStatusCreated is the status code for the response when the authentication event is created.
+	ConfirmAuthStatusCreated           ConfirmAuthStatusCode = ConfirmAuthStatusCode(fivegc.StatusCreated)
+	ConfirmAuthStatusTemporaryRedirect ConfirmAuthStatusCode = ConfirmAuthStatusCode(fivegc.StatusTemporaryRedirect)
+	ConfirmAuthStatusPermanentRedirect ConfirmAuthStatusCode = ConfirmAuthStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var confirmAuthErrors = response.BodyErrors
+
+// DeleteAuthStatusCode is the status code of a DeleteAuth response.
+type DeleteAuthStatusCode fivegc.StatusCode
+
+const (
+	// DeleteAuthStatusNoContent is the status code for the response when the authentication event is removed.
+	DeleteAuthStatusNoContent         DeleteAuthStatusCode = DeleteAuthStatusCode(fivegc.StatusNoContent)
+	DeleteAuthStatusTemporaryRedirect DeleteAuthStatusCode = DeleteAuthStatusCode(fivegc.StatusTemporaryRedirect)
+	DeleteAuthStatusPermanentRedirect DeleteAuthStatusCode = DeleteAuthStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deleteAuthErrors = response.BodyErrors
+
+// GenerateHSSAVStatusCode is the status code of a GenerateHSSAV response.
+type GenerateHSSAVStatusCode fivegc.StatusCode
+
+const (
+	// GenerateHSSAVStatusOK is the status code for a successful response.
+	GenerateHSSAVStatusOK                GenerateHSSAVStatusCode = GenerateHSSAVStatusCode(fivegc.StatusOK)
+	GenerateHSSAVStatusTemporaryRedirect GenerateHSSAVStatusCode = GenerateHSSAVStatusCode(fivegc.StatusTemporaryRedirect)
+	GenerateHSSAVStatusPermanentRedirect GenerateHSSAVStatusCode = GenerateHSSAVStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var generateHSSAVErrors = response.BodyErrors
+
+func attachUEAUHandler(r *gin.RouterGroup, u UEAU, logger *log.Logger) {
+	group := r.Group(ueauRouterGroup)
+	{
+		group.POST(router.Path(generateAuthDataEndpoint), func(c *gin.Context) {
+			var req nudmueau.AuthenticationInfoRequest
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, generateAuthDataErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.GenerateAuthData(c, c.Param(ueIDParam), req)
+			switch status {
+			case GenerateAuthDataStatusOK:
+				c.JSON(int(status), res)
+			case GenerateAuthDataStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GenerateAuthDataStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, generateAuthDataErrors)
+			}
+			return
+		})
+		group.POST(router.Path(authEventsEndpoint), func(c *gin.Context) {
+			var req nudmueau.AuthEvent
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, confirmAuthErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.ConfirmAuth(c, c.Param(ueIDParam), req)
+			switch status {
+			case ConfirmAuthStatusCreated:
+				c.Header("Location", router.ResourceURI(c, res.AuthEventID))
+				c.JSON(int(status), res.JSONData)
+			case ConfirmAuthStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case ConfirmAuthStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, confirmAuthErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(authEventEndpoint), func(c *gin.Context) {
+			var req nudmueau.AuthEvent
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, deleteAuthErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := u.DeleteAuth(c, c.Param(ueIDParam), c.Param(authEventIDParam), req)
+			switch status {
+			case DeleteAuthStatusNoContent:
+				c.JSON(int(status), nil)
+			case DeleteAuthStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeleteAuthStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deleteAuthErrors)
+			}
+			return
+		})
+		group.POST(router.Path(generateHSSAVEndpoint), func(c *gin.Context) {
+			var req nudmueau.HssAuthenticationInfoRequest
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, generateHSSAVErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.GenerateHSSAV(c, c.Param(ueIDParam), c.Param(hssAuthTypeParam), req)
+			switch status {
+			case GenerateHSSAVStatusOK:
+				c.JSON(int(status), res)
+			case GenerateHSSAVStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GenerateHSSAVStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, generateHSSAVErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewUEAU adapts an UEAUService to the UEAU interface.
+func NewUEAU(s UEAUService) UEAU {
+	return ueauAdapter{service: s}
+}
+
+type ueauAdapter struct {
+	service UEAUService
+}
+
+func (a ueauAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a ueauAdapter) GenerateAuthData(ctx context.Context, supiOrSuci string, req nudmueau.AuthenticationInfoRequest) (nudmueau.AuthenticationInfoResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GenerateAuthDataStatusCode) {
+	res, err := a.service.GenerateAuthData(ctx, supiOrSuci, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmueau.AuthenticationInfoResult{}, problemDetails, redirectResponse, GenerateAuthDataStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmueau.AuthenticationInfoResult{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GenerateAuthDataStatusOK
+}
+
+func (a ueauAdapter) ConfirmAuth(ctx context.Context, supi string, req nudmueau.AuthEvent) (ConfirmAuthResponse, openapicommon.ProblemDetails, fivegc.RedirectResponse, ConfirmAuthStatusCode) {
+	res, err := a.service.ConfirmAuth(ctx, supi, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return ConfirmAuthResponse{}, problemDetails, redirectResponse, ConfirmAuthStatusCode(status)
+	}
+	if res == nil {
+		res = &ConfirmAuthResponse{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, ConfirmAuthStatusCreated
+}
+
+func (a ueauAdapter) DeleteAuth(ctx context.Context, supi string, authEventID string, req nudmueau.AuthEvent) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeleteAuthStatusCode) {
+	if err := a.service.DeleteAuth(ctx, supi, authEventID, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, DeleteAuthStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeleteAuthStatusNoContent
+}
+
+func (a ueauAdapter) GenerateHSSAV(ctx context.Context, supi string, hssAuthType string, req nudmueau.HssAuthenticationInfoRequest) (nudmueau.HssAuthenticationInfoResult, openapicommon.ProblemDetails, fivegc.RedirectResponse, GenerateHSSAVStatusCode) {
+	res, err := a.service.GenerateHSSAV(ctx, supi, hssAuthType, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmueau.HssAuthenticationInfoResult{}, problemDetails, redirectResponse, GenerateHSSAVStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmueau.HssAuthenticationInfoResult{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GenerateHSSAVStatusOK
+}
+
+// NewUEAUService adapts an UEAU to the UEAUService interface.
+func NewUEAUService(u UEAU) UEAUService {
+	return ueauServiceAdapter{ueau: u}
+}
+
+type ueauServiceAdapter struct {
+	ueau UEAU
+}
+
+func (a ueauServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.ueau.Error(ctx, err)
+}
+
+func (a ueauServiceAdapter) GenerateAuthData(ctx context.Context, supiOrSuci string, req nudmueau.AuthenticationInfoRequest) (*nudmueau.AuthenticationInfoResult, error) {
+	res, problemDetails, redirectResponse, status := a.ueau.GenerateAuthData(ctx, supiOrSuci, req)
+	if status == GenerateAuthDataStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a ueauServiceAdapter) ConfirmAuth(ctx context.Context, supi string, req nudmueau.AuthEvent) (*ConfirmAuthResponse, error) {
+	res, problemDetails, redirectResponse, status := a.ueau.ConfirmAuth(ctx, supi, req)
+	if status == ConfirmAuthStatusCreated {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a ueauServiceAdapter) DeleteAuth(ctx context.Context, supi string, authEventID string, req nudmueau.AuthEvent) error {
+	problemDetails, redirectResponse, status := a.ueau.DeleteAuth(ctx, supi, authEventID, req)
+	if status == DeleteAuthStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a ueauServiceAdapter) GenerateHSSAV(ctx context.Context, supi string, hssAuthType string, req nudmueau.HssAuthenticationInfoRequest) (*nudmueau.HssAuthenticationInfoResult, error) {
+	res, problemDetails, redirectResponse, status := a.ueau.GenerateHSSAV(ctx, supi, hssAuthType, req)
+	if status == GenerateHSSAVStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
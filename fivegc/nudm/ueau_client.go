@@ -0,0 +1,103 @@
+package nudm
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	"net/http"
+)
+
+// UEAUClient is a client for the NUDM UEAuthentication service.
+type UEAUClient struct {
+	client *nudmueau.APIClient
+}
+
+// NewUEAUClient creates a new client for the NUDM UEAuthentication service.
+func NewUEAUClient(cfg fivegc.ClientConfiguration) *UEAUClient {
+	openapiCfg := &nudmueau.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nudmueau.ServerConfiguration{},
+		OperationServers: make(map[string]nudmueau.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nudmueau.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nudmueau.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nudmueau.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nudmueau.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nudmueau.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nudmueau.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nudmueau.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &UEAUClient{
+		client: nudmueau.NewAPIClient(openapiCfg),
+	}
+}
+
+// GenerateAuthData returns a request generating an authentication vector for a UE.
+func (u *UEAUClient) GenerateAuthData(ctx context.Context, supiOrSuci string) nudmueau.ApiGenerateAuthDataRequest {
+	return u.client.GenerateAuthDataApi.GenerateAuthData(ctx, supiOrSuci)
+}
+
+// GenerateAuthDataExecute executes a GenerateAuthData request.
+func (u *UEAUClient) GenerateAuthDataExecute(r nudmueau.ApiGenerateAuthDataRequest) (*nudmueau.AuthenticationInfoResult, *http.Response, error) {
+	return r.Execute()
+}
+
+// ConfirmAuth returns a request storing the result of the authentication of a UE.
+func (u *UEAUClient) ConfirmAuth(ctx context.Context, supi string) nudmueau.ApiConfirmAuthRequest {
+	return u.client.ConfirmAuthApi.ConfirmAuth(ctx, supi)
+}
+
+// ConfirmAuthExecute executes a ConfirmAuth request.
+func (u *UEAUClient) ConfirmAuthExecute(r nudmueau.ApiConfirmAuthRequest) (*nudmueau.AuthEvent, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeleteAuth returns a request removing the result of an authentication of a UE.
+func (u *UEAUClient) DeleteAuth(ctx context.Context, supi, authEventID string) nudmueau.ApiDeleteAuthRequest {
+	return u.client.DeleteAuthApi.DeleteAuth(ctx, supi, authEventID)
+}
+
+// DeleteAuthExecute executes a DeleteAuth request.
+func (u *UEAUClient) DeleteAuthExecute(r nudmueau.ApiDeleteAuthRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// GenerateHSSAV returns a request generating the authentication vectors of a UE requested by the HSS.
+func (u *UEAUClient) GenerateHSSAV(ctx context.Context, supi, hssAuthType string) nudmueau.ApiGenerateAvRequest {
+	return u.client.GetHssAvApi.GenerateAv(ctx, supi, hssAuthType)
+}
+
+// GenerateHSSAVExecute executes a GenerateHSSAV request.
+func (u *UEAUClient) GenerateHSSAVExecute(r nudmueau.ApiGenerateAvRequest) (*nudmueau.HssAuthenticationInfoResult, *http.Response, error) {
+	return r.Execute()
+}
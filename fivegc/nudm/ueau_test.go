@@ -0,0 +1,135 @@
+package nudm
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// authenticatingUDM generates 5G HE AKA vectors for a single UE and stores the results of its authentications.
+type authenticatingUDM struct {
+	supi       string
+	authEvents map[string]nudmueau.AuthEvent
+	resync     *nudmueau.ResynchronizationInfo
+}
+
+func (u *authenticatingUDM) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (u *authenticatingUDM) GenerateAuthData(_ context.Context, supiOrSuci string, req nudmueau.AuthenticationInfoRequest) (*nudmueau.AuthenticationInfoResult, error) {
+	if strings.HasPrefix(supiOrSuci, "suci-0-208-93-1-") {
+		return nil, ErrUnsupportedProtectionScheme
+	}
+	if supiOrSuci != u.supi {
+		return nil, ErrUserNotFound
+	}
+	if !strings.HasPrefix(req.ServingNetworkName, "5G:") {
+		return nil, ErrServingNetworkNotAuthorized
+	}
+	u.resync = req.ResynchronizationInfo
+	vector := &nudmueau.AuthenticationVector{AvType: AvType5GHEAKA, Rand: "00", Autn: "00"}
+	vector.SetXresStar("00")
+	vector.SetKausf("00")
+	res := &nudmueau.AuthenticationInfoResult{AuthType: AuthType5GAKA, AuthenticationVector: vector}
+	res.SetSupi(u.supi)
+	return res, nil
+}
+
+func (u *authenticatingUDM) ConfirmAuth(_ context.Context, supi string, req nudmueau.AuthEvent) (*ConfirmAuthResponse, error) {
+	if supi != u.supi {
+		return nil, ErrUserNotFound
+	}
+	id := "event-1"
+	u.authEvents[id] = req
+	return &ConfirmAuthResponse{AuthEventID: id, JSONData: req}, nil
+}
+
+func (u *authenticatingUDM) DeleteAuth(_ context.Context, _ string, authEventID string, req nudmueau.AuthEvent) error {
+	if _, ok := u.authEvents[authEventID]; !ok || !req.GetAuthRemovalInd() {
+		return ErrDataNotFound
+	}
+	delete(u.authEvents, authEventID)
+	return nil
+}
+
+func (u *authenticatingUDM) GenerateHSSAV(_ context.Context, _ string, hssAuthType string, req nudmueau.HssAuthenticationInfoRequest) (*nudmueau.HssAuthenticationInfoResult, error) {
+	if hssAuthType != HSSAuthTypeEPSAKA {
+		return nil, ErrAuthenticationRejected
+	}
+	res := &nudmueau.HssAuthenticationInfoResult{}
+	for i := int32(0); i < req.NumOfRequestedVectors; i++ {
+		res.HssAuthenticationVectors = append(res.HssAuthenticationVectors, map[string]interface{}{"avType": HSSAuthTypeEPSAKA})
+	}
+	return res, nil
+}
+
+func TestUEAU(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	u := &authenticatingUDM{supi: "imsi-208930000000001", authEvents: map[string]nudmueau.AuthEvent{}}
+	attachUEAUHandler(router.Group(""), NewUEAU(u), log.Default())
+
+	send := func(method, path string, body interface{}) (*http.Response, []byte) {
+		w := apitest.Send(t, router, method, "http://udm.example.com"+ueauRouterGroup+path, nil, body)
+		return w.Result(), w.Body.Bytes()
+	}
+
+	info := nudmueau.AuthenticationInfoRequest{ServingNetworkName: "5G:mnc093.mcc208.3gppnetwork.org", AusfInstanceId: "ausf-1"}
+	info.SetResynchronizationInfo(nudmueau.ResynchronizationInfo{Rand: "00", Auts: "00"})
+	resp, raw := send(http.MethodPost, "/imsi-208930000000001/security-information/generate-auth-data", info)
+	var result nudmueau.AuthenticationInfoResult
+	if err := json.Unmarshal(raw, &result); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if result.AuthType != AuthType5GAKA || result.AuthenticationVector == nil || result.AuthenticationVector.GetXresStar() == "" || u.resync == nil {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if resp, _ := send(http.MethodPost, "/suci-0-208-93-1-1-0-00000001/security-information/generate-auth-data", info); resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	info.ServingNetworkName = "mnc093.mcc208"
+	if resp, _ := send(http.MethodPost, "/imsi-208930000000001/security-information/generate-auth-data", info); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodPost, "/imsi-208930000000001/security-information/generate-auth-data", "ausf-1"); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	event := nudmueau.AuthEvent{
+		NfInstanceId:       "ausf-1",
+		Success:            true,
+		TimeStamp:          time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		AuthType:           AuthType5GAKA,
+		ServingNetworkName: "5G:mnc093.mcc208.3gppnetwork.org",
+	}
+	resp, _ = send(http.MethodPost, "/imsi-208930000000001/auth-events", event)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/imsi-208930000000001/auth-events/event-1") {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if resp, _ := send(http.MethodPut, "/imsi-208930000000001/auth-events/event-1", event); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	event.SetAuthRemovalInd(true)
+	if resp, _ := send(http.MethodPut, "/imsi-208930000000001/auth-events/event-1", event); resp.StatusCode != http.StatusNoContent || len(u.authEvents) != 0 {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	hssInfo := nudmueau.HssAuthenticationInfoRequest{HssAuthType: HSSAuthTypeEPSAKA, NumOfRequestedVectors: 2}
+	resp, raw = send(http.MethodPost, "/imsi-208930000000001/hss-security-information/EPS_AKA/generate-av", hssInfo)
+	var hssResult nudmueau.HssAuthenticationInfoResult
+	if err := json.Unmarshal(raw, &hssResult); err != nil || resp.StatusCode != http.StatusOK || len(hssResult.HssAuthenticationVectors) != 2 {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if resp, _ := send(http.MethodPost, "/imsi-208930000000001/hss-security-information/IMS_AKA/generate-av", hssInfo); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,874 @@
+package nudm
+
+import (
+	"context"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/header"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/router"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"log"
+)
+
+const (
+	uecmRouterGroup                      = "/nudm-uecm/v1"
+	pduSessionIDParam                    = "pduSessionId"
+	amf3GPPAccessRegistrationEndpoint    = "/{ueId}/registrations/amf-3gpp-access"
+	amfNon3GPPAccessRegistrationEndpoint = "/{ueId}/registrations/amf-non-3gpp-access"
+	smfRegistrationsEndpoint             = "/{ueId}/registrations/smf-registrations"
+	smfRegistrationEndpoint              = "/{ueId}/registrations/smf-registrations/{pduSessionId}"
+	smsf3GPPAccessRegistrationEndpoint   = "/{ueId}/registrations/smsf-3gpp-access"
+)
+
+// Reasons of the deregistration notifications, defined in TS 29.503 clause 6.2.6.3.3.
+const (
+	DeregistrationReasonUEInitialRegistration                 = "UE_INITIAL_REGISTRATION"
+	DeregistrationReasonUERegistrationAreaChange              = "UE_REGISTRATION_AREA_CHANGE"
+	DeregistrationReasonSubscriptionWithdrawn                 = "SUBSCRIPTION_WITHDRAWN"
+	DeregistrationReason5GSToEPSMobility                      = "5GS_TO_EPS_MOBILITY"
+	DeregistrationReason5GSToEPSMobilityUEInitialRegistration = "5GS_TO_EPS_MOBILITY_UE_INITIAL_REGISTRATION"
+	DeregistrationReasonReregistrationRequired                = "REREGISTRATION_REQUIRED"
+	DeregistrationReasonSMFContextTransferred                 = "SMF_CONTEXT_TRANSFERRED"
+	DeregistrationReasonDuplicatePDUSession                   = "DUPLICATE_PDU_SESSION"
+	DeregistrationReasonPDUSessionReactivationRequired        = "PDU_SESSION_REACTIVATION_REQUIRED"
+	DeregistrationReasonDisasterConditionTerminated           = "DISASTER_CONDITION_TERMINATED"
+)
+
+// Access types of the deregistration notifications, defined in TS 29.571 clause 5.4.3.2.
+const (
+	AccessType3GPP    = "3GPP_ACCESS"
+	AccessTypeNon3GPP = "NON_3GPP_ACCESS"
+)
+
+// UECM is the interface that wraps the NUDM UEContextManagement service (TS 29.503 clause 5.3).
+// It is implemented by the UDM to store the NF instances serving the UEs: the AMFs registered for the 3GPP and the
+// non-3GPP accesses, the SMFs registered for each PDU session and the SMSF registered for the 3GPP access.
+// The registrations answer a 201 Created with the Location of the registration when it is created, and a 200 OK or a
+// 204 No Content when it replaces the existing one. When an AMF registration replaces the one of another AMF, the UDM
+// sends a deregistration notification to the deregCallbackUri of the previous AMF (see UECMClient.DeregistrationNotify).
+type UECM interface {
+	fivegc.CommonInterface
+	// RegisterAMF3GPPAccess registers the AMF serving the UE over the 3GPP access, replacing the previous one.
+	RegisterAMF3GPPAccess(context.Context, string, nudmuecm.Amf3GppAccessRegistration) (nudmuecm.Amf3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterAMF3GPPAccessStatusCode)
+	// UpdateAMF3GPPAccessRegistration updates some parameters of the registration of the AMF serving the UE over the 3GPP access, such as the purge flag.
+	UpdateAMF3GPPAccessRegistration(context.Context, string, nudmuecm.Amf3GppAccessRegistrationModification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateAMF3GPPAccessRegistrationStatusCode)
+	// GetAMF3GPPAccessRegistration retrieves the registration of the AMF serving the UE over the 3GPP access.
+	GetAMF3GPPAccessRegistration(context.Context, string, string) (nudmuecm.Amf3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMF3GPPAccessRegistrationStatusCode)
+	// RegisterAMFNon3GPPAccess registers the AMF serving the UE over the non-3GPP access, replacing the previous one.
+	RegisterAMFNon3GPPAccess(context.Context, string, nudmuecm.AmfNon3GppAccessRegistration) (nudmuecm.AmfNon3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterAMFNon3GPPAccessStatusCode)
+	// UpdateAMFNon3GPPAccessRegistration updates some parameters of the registration of the AMF serving the UE over the non-3GPP access.
+	UpdateAMFNon3GPPAccessRegistration(context.Context, string, nudmuecm.AmfNon3GppAccessRegistrationModification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateAMFNon3GPPAccessRegistrationStatusCode)
+	// GetAMFNon3GPPAccessRegistration retrieves the registration of the AMF serving the UE over the non-3GPP access.
+	GetAMFNon3GPPAccessRegistration(context.Context, string, string) (nudmuecm.AmfNon3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMFNon3GPPAccessRegistrationStatusCode)
+	// GetSMFRegistrations retrieves the registrations of the SMFs serving the PDU sessions of the UE, optionally for an S-NSSAI and a DNN.
+	GetSMFRegistrations(context.Context, string, GetSMFRegistrationsRequest) (nudmuecm.SmfRegistrationInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFRegistrationsStatusCode)
+	// RegisterSMF registers the SMF serving a PDU session of the UE, identified by its PDU session ID.
+	RegisterSMF(context.Context, string, string, nudmuecm.SmfRegistration) (nudmuecm.SmfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterSMFStatusCode)
+	// DeregisterSMF removes the registration of the SMF serving a PDU session of the UE. The SMF set ID, if any, is the one of the SMF deregistering.
+	DeregisterSMF(context.Context, string, string, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregisterSMFStatusCode)
+	// GetSMFRegistration retrieves the registration of the SMF serving a PDU session of the UE.
+	GetSMFRegistration(context.Context, string, string, string) (nudmuecm.SmfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFRegistrationStatusCode)
+	// RegisterSMSF3GPPAccess registers the SMSF serving the UE over the 3GPP access.
+	RegisterSMSF3GPPAccess(context.Context, string, nudmuecm.SmsfRegistration) (nudmuecm.SmsfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterSMSF3GPPAccessStatusCode)
+	// DeregisterSMSF3GPPAccess removes the registration of the SMSF serving the UE over the 3GPP access.
+	DeregisterSMSF3GPPAccess(context.Context, string, string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregisterSMSF3GPPAccessStatusCode)
+	// GetSMSF3GPPAccessRegistration retrieves the registration of the SMSF serving the UE over the 3GPP access.
+	GetSMSF3GPPAccessRegistration(context.Context, string, string) (nudmuecm.SmsfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSF3GPPAccessRegistrationStatusCode)
+}
+
+// UECMService is an alternative to the UECM interface whose handlers return a result and an error.
+// Errors are answered with their ProblemDetails (see fivegc.ApplicationError and fivegc.ProblemDetailsError)
+// or with a redirection (see fivegc.RedirectError). A registration result whose Created field is set is answered with
+// a 201 Created, a nil one with a 204 No Content, and a nil result of a retrieval is answered as an empty one.
+// Use NewUECM to attach it to the NUDM Server.
+type UECMService interface {
+	fivegc.CommonInterface
+	// RegisterAMF3GPPAccess registers the AMF serving the UE over the 3GPP access, replacing the previous one.
+	RegisterAMF3GPPAccess(context.Context, string, nudmuecm.Amf3GppAccessRegistration) (*AMF3GPPAccessRegistrationResponse, error)
+	// UpdateAMF3GPPAccessRegistration updates some parameters of the registration of the AMF serving the UE over the 3GPP access.
+	UpdateAMF3GPPAccessRegistration(context.Context, string, nudmuecm.Amf3GppAccessRegistrationModification) error
+	// GetAMF3GPPAccessRegistration retrieves the registration of the AMF serving the UE over the 3GPP access.
+	GetAMF3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.Amf3GppAccessRegistration, error)
+	// RegisterAMFNon3GPPAccess registers the AMF serving the UE over the non-3GPP access, replacing the previous one.
+	RegisterAMFNon3GPPAccess(context.Context, string, nudmuecm.AmfNon3GppAccessRegistration) (*AMFNon3GPPAccessRegistrationResponse, error)
+	// UpdateAMFNon3GPPAccessRegistration updates some parameters of the registration of the AMF serving the UE over the non-3GPP access.
+	UpdateAMFNon3GPPAccessRegistration(context.Context, string, nudmuecm.AmfNon3GppAccessRegistrationModification) error
+	// GetAMFNon3GPPAccessRegistration retrieves the registration of the AMF serving the UE over the non-3GPP access.
+	GetAMFNon3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.AmfNon3GppAccessRegistration, error)
+	// GetSMFRegistrations retrieves the registrations of the SMFs serving the PDU sessions of the UE.
+	GetSMFRegistrations(context.Context, string, GetSMFRegistrationsRequest) (*nudmuecm.SmfRegistrationInfo, error)
+	// RegisterSMF registers the SMF serving a PDU session of the UE, identified by its PDU session ID.
+	RegisterSMF(context.Context, string, string, nudmuecm.SmfRegistration) (*SMFRegistrationResponse, error)
+	// DeregisterSMF removes the registration of the SMF serving a PDU session of the UE.
+	DeregisterSMF(context.Context, string, string, string) error
+	// GetSMFRegistration retrieves the registration of the SMF serving a PDU session of the UE.
+	GetSMFRegistration(context.Context, string, string, string) (*nudmuecm.SmfRegistration, error)
+	// RegisterSMSF3GPPAccess registers the SMSF serving the UE over the 3GPP access.
+	RegisterSMSF3GPPAccess(context.Context, string, nudmuecm.SmsfRegistration) (*SMSFRegistrationResponse, error)
+	// DeregisterSMSF3GPPAccess removes the registration of the SMSF serving the UE over the 3GPP access.
+	DeregisterSMSF3GPPAccess(context.Context, string, string) error
+	// GetSMSF3GPPAccessRegistration retrieves the registration of the SMSF serving the UE over the 3GPP access.
+	GetSMSF3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.SmsfRegistration, error)
+}
+
+// RegisterAMF3GPPAccessStatusCode is the status code of a RegisterAMF3GPPAccess response.
+type RegisterAMF3GPPAccessStatusCode fivegc.StatusCode
+
+const (
+	// RegisterAMF3GPPAccessStatusCreated is the status code for the response when the registration is created.
+	RegisterAMF3GPPAccessStatusCreated RegisterAMF3GPPAccessStatusCode = RegisterAMF3GPPAccessStatusCode(fivegc.StatusCreated)
+	// RegisterAMF3GPPAccessStatusOK is the status code for the response when the registration replaces the existing one.
+	RegisterAMF3GPPAccessStatusOK RegisterAMF3GPPAccessStatusCode = RegisterAMF3GPPAccessStatusCode(fivegc.StatusOK)
+	// RegisterAMF3GPPAccessStatusNoContent is the status code for the response when the registration replaces the existing one, without
+	// returning it.
+	RegisterAMF3GPPAccessStatusNoContent         RegisterAMF3GPPAccessStatusCode = RegisterAMF3GPPAccessStatusCode(fivegc.StatusNoContent)
+	RegisterAMF3GPPAccessStatusTemporaryRedirect RegisterAMF3GPPAccessStatusCode = RegisterAMF3GPPAccessStatusCode(fivegc.StatusTemporaryRedirect)
+	RegisterAMF3GPPAccessStatusPermanentRedirect RegisterAMF3GPPAccessStatusCode = RegisterAMF3GPPAccessStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var registerAMF3GPPAccessErrors = response.BodyErrors
+
+// UpdateAMF3GPPAccessRegistrationStatusCode is the status code of an UpdateAMF3GPPAccessRegistration response.
+type UpdateAMF3GPPAccessRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// UpdateAMF3GPPAccessRegistrationStatusNoContent is the status code for the response when the registration is updated.
+	UpdateAMF3GPPAccessRegistrationStatusNoContent         UpdateAMF3GPPAccessRegistrationStatusCode = UpdateAMF3GPPAccessRegistrationStatusCode(fivegc.StatusNoContent)
+	UpdateAMF3GPPAccessRegistrationStatusTemporaryRedirect UpdateAMF3GPPAccessRegistrationStatusCode = UpdateAMF3GPPAccessRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	UpdateAMF3GPPAccessRegistrationStatusPermanentRedirect UpdateAMF3GPPAccessRegistrationStatusCode = UpdateAMF3GPPAccessRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var updateAMF3GPPAccessRegistrationErrors = response.BodyErrors
+
+// GetAMF3GPPAccessRegistrationStatusCode is the status code of a GetAMF3GPPAccessRegistration response.
+type GetAMF3GPPAccessRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// GetAMF3GPPAccessRegistrationStatusOK is the status code for a successful response.
+	GetAMF3GPPAccessRegistrationStatusOK                GetAMF3GPPAccessRegistrationStatusCode = GetAMF3GPPAccessRegistrationStatusCode(fivegc.StatusOK)
+	GetAMF3GPPAccessRegistrationStatusTemporaryRedirect GetAMF3GPPAccessRegistrationStatusCode = GetAMF3GPPAccessRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	GetAMF3GPPAccessRegistrationStatusPermanentRedirect GetAMF3GPPAccessRegistrationStatusCode = GetAMF3GPPAccessRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getAMF3GPPAccessRegistrationErrors = response.QueryErrors
+
+// RegisterAMFNon3GPPAccessStatusCode is the status code of a RegisterAMFNon3GPPAccess response.
+type RegisterAMFNon3GPPAccessStatusCode fivegc.StatusCode
+
+const (
+	// RegisterAMFNon3GPPAccessStatusCreated is the status code for the response when the registration is created.
+	RegisterAMFNon3GPPAccessStatusCreated RegisterAMFNon3GPPAccessStatusCode = RegisterAMFNon3GPPAccessStatusCode(fivegc.StatusCreated)
+	// RegisterAMFNon3GPPAccessStatusOK is the status code for the response when the registration replaces the existing one.
+	RegisterAMFNon3GPPAccessStatusOK RegisterAMFNon3GPPAccessStatusCode = RegisterAMFNon3GPPAccessStatusCode(fivegc.StatusOK)
+	// RegisterAMFNon3GPPAccessStatusNoContent is the status code for the response when the registration replaces the existing one, without
+	// returning it.
+	RegisterAMFNon3GPPAccessStatusNoContent         RegisterAMFNon3GPPAccessStatusCode = RegisterAMFNon3GPPAccessStatusCode(fivegc.StatusNoContent)
+	RegisterAMFNon3GPPAccessStatusTemporaryRedirect RegisterAMFNon3GPPAccessStatusCode = RegisterAMFNon3GPPAccessStatusCode(fivegc.StatusTemporaryRedirect)
+	RegisterAMFNon3GPPAccessStatusPermanentRedirect RegisterAMFNon3GPPAccessStatusCode = RegisterAMFNon3GPPAccessStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var registerAMFNon3GPPAccessErrors = response.BodyErrors
+
+// UpdateAMFNon3GPPAccessRegistrationStatusCode is the status code of an UpdateAMFNon3GPPAccessRegistration response.
+type UpdateAMFNon3GPPAccessRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// UpdateAMFNon3GPPAccessRegistrationStatusNoContent is the status code for the response when the registration is updated.
+	UpdateAMFNon3GPPAccessRegistrationStatusNoContent         UpdateAMFNon3GPPAccessRegistrationStatusCode = UpdateAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusNoContent)
+	UpdateAMFNon3GPPAccessRegistrationStatusTemporaryRedirect UpdateAMFNon3GPPAccessRegistrationStatusCode = UpdateAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	UpdateAMFNon3GPPAccessRegistrationStatusPermanentRedirect UpdateAMFNon3GPPAccessRegistrationStatusCode = UpdateAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var updateAMFNon3GPPAccessRegistrationErrors = response.BodyErrors
+
+// GetAMFNon3GPPAccessRegistrationStatusCode is the status code of a GetAMFNon3GPPAccessRegistration response.
+type GetAMFNon3GPPAccessRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// GetAMFNon3GPPAccessRegistrationStatusOK is the status code for a successful response.
+	GetAMFNon3GPPAccessRegistrationStatusOK                GetAMFNon3GPPAccessRegistrationStatusCode = GetAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusOK)
+	GetAMFNon3GPPAccessRegistrationStatusTemporaryRedirect GetAMFNon3GPPAccessRegistrationStatusCode = GetAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	GetAMFNon3GPPAccessRegistrationStatusPermanentRedirect GetAMFNon3GPPAccessRegistrationStatusCode = GetAMFNon3GPPAccessRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getAMFNon3GPPAccessRegistrationErrors = response.QueryErrors
+
+// GetSMFRegistrationsStatusCode is the status code of a GetSMFRegistrations response.
+type GetSMFRegistrationsStatusCode fivegc.StatusCode
+
+const (
+	// GetSMFRegistrationsStatusOK is the status code for a successful response.
+	GetSMFRegistrationsStatusOK                GetSMFRegistrationsStatusCode = GetSMFRegistrationsStatusCode(fivegc.StatusOK)
+	GetSMFRegistrationsStatusTemporaryRedirect GetSMFRegistrationsStatusCode = GetSMFRegistrationsStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMFRegistrationsStatusPermanentRedirect GetSMFRegistrationsStatusCode = GetSMFRegistrationsStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMFRegistrationsErrors = response.QueryErrors
+
+// RegisterSMFStatusCode is the status code of a RegisterSMF response.
+type RegisterSMFStatusCode fivegc.StatusCode
+
+const (
+	// RegisterSMFStatusCreated is the status code for the response when the registration is created.
+	RegisterSMFStatusCreated RegisterSMFStatusCode = RegisterSMFStatusCode(fivegc.StatusCreated)
+	// RegisterSMFStatusOK is the status code for the response when the registration replaces the existing one.
+	RegisterSMFStatusOK RegisterSMFStatusCode = RegisterSMFStatusCode(fivegc.StatusOK)
+	// RegisterSMFStatusNoContent is the status code for the response when the registration replaces the existing one, without
+	// returning it.
+	RegisterSMFStatusNoContent         RegisterSMFStatusCode = RegisterSMFStatusCode(fivegc.StatusNoContent)
+	RegisterSMFStatusTemporaryRedirect RegisterSMFStatusCode = RegisterSMFStatusCode(fivegc.StatusTemporaryRedirect)
+	RegisterSMFStatusPermanentRedirect RegisterSMFStatusCode = RegisterSMFStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var registerSMFErrors = response.BodyErrors
+
+// DeregisterSMFStatusCode is the status code of a DeregisterSMF response.
+type DeregisterSMFStatusCode fivegc.StatusCode
+
+const (
+	// DeregisterSMFStatusNoContent is the status code for the response when the registration is removed.
+	DeregisterSMFStatusNoContent         DeregisterSMFStatusCode = DeregisterSMFStatusCode(fivegc.StatusNoContent)
+	DeregisterSMFStatusTemporaryRedirect DeregisterSMFStatusCode = DeregisterSMFStatusCode(fivegc.StatusTemporaryRedirect)
+	DeregisterSMFStatusPermanentRedirect DeregisterSMFStatusCode = DeregisterSMFStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deregisterSMFErrors = response.DeletionErrors
+
+// GetSMFRegistrationStatusCode is the status code of a GetSMFRegistration response.
+type GetSMFRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// GetSMFRegistrationStatusOK is the status code for a successful response.
+	GetSMFRegistrationStatusOK                GetSMFRegistrationStatusCode = GetSMFRegistrationStatusCode(fivegc.StatusOK)
+	GetSMFRegistrationStatusTemporaryRedirect GetSMFRegistrationStatusCode = GetSMFRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMFRegistrationStatusPermanentRedirect GetSMFRegistrationStatusCode = GetSMFRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMFRegistrationErrors = response.QueryErrors
+
+// RegisterSMSF3GPPAccessStatusCode is the status code of a RegisterSMSF3GPPAccess response.
+type RegisterSMSF3GPPAccessStatusCode fivegc.StatusCode
+
+const (
+	// RegisterSMSF3GPPAccessStatusCreated is the status code for the response when the registration is created.
+	RegisterSMSF3GPPAccessStatusCreated RegisterSMSF3GPPAccessStatusCode = RegisterSMSF3GPPAccessStatusCode(fivegc.StatusCreated)
+	// RegisterSMSF3GPPAccessStatusOK is the status code for the response when the registration replaces the existing one.
+	RegisterSMSF3GPPAccessStatusOK RegisterSMSF3GPPAccessStatusCode = RegisterSMSF3GPPAccessStatusCode(fivegc.StatusOK)
+	// RegisterSMSF3GPPAccessStatusNoContent is the status code for the response when the registration replaces the existing one, without
+	// returning it.
+	RegisterSMSF3GPPAccessStatusNoContent         RegisterSMSF3GPPAccessStatusCode = RegisterSMSF3GPPAccessStatusCode(fivegc.StatusNoContent)
+	RegisterSMSF3GPPAccessStatusTemporaryRedirect RegisterSMSF3GPPAccessStatusCode = RegisterSMSF3GPPAccessStatusCode(fivegc.StatusTemporaryRedirect)
+	RegisterSMSF3GPPAccessStatusPermanentRedirect RegisterSMSF3GPPAccessStatusCode = RegisterSMSF3GPPAccessStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var registerSMSF3GPPAccessErrors = response.BodyErrors
+
+// DeregisterSMSF3GPPAccessStatusCode is the status code of a DeregisterSMSF3GPPAccess response.
+type DeregisterSMSF3GPPAccessStatusCode fivegc.StatusCode
+
+const (
+	// DeregisterSMSF3GPPAccessStatusNoContent is the status code for the response when the registration is removed.
+	DeregisterSMSF3GPPAccessStatusNoContent         DeregisterSMSF3GPPAccessStatusCode = DeregisterSMSF3GPPAccessStatusCode(fivegc.StatusNoContent)
+	DeregisterSMSF3GPPAccessStatusTemporaryRedirect DeregisterSMSF3GPPAccessStatusCode = DeregisterSMSF3GPPAccessStatusCode(fivegc.StatusTemporaryRedirect)
+	DeregisterSMSF3GPPAccessStatusPermanentRedirect DeregisterSMSF3GPPAccessStatusCode = DeregisterSMSF3GPPAccessStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var deregisterSMSF3GPPAccessErrors = response.DeletionErrors
+
+// GetSMSF3GPPAccessRegistrationStatusCode is the status code of a GetSMSF3GPPAccessRegistration response.
+type GetSMSF3GPPAccessRegistrationStatusCode fivegc.StatusCode
+
+const (
+	// GetSMSF3GPPAccessRegistrationStatusOK is the status code for a successful response.
+	GetSMSF3GPPAccessRegistrationStatusOK                GetSMSF3GPPAccessRegistrationStatusCode = GetSMSF3GPPAccessRegistrationStatusCode(fivegc.StatusOK)
+	GetSMSF3GPPAccessRegistrationStatusTemporaryRedirect GetSMSF3GPPAccessRegistrationStatusCode = GetSMSF3GPPAccessRegistrationStatusCode(fivegc.StatusTemporaryRedirect)
+	GetSMSF3GPPAccessRegistrationStatusPermanentRedirect GetSMSF3GPPAccessRegistrationStatusCode = GetSMSF3GPPAccessRegistrationStatusCode(fivegc.StatusPermanentRedirect)
+)
+
+var getSMSF3GPPAccessRegistrationErrors = response.QueryErrors
+
+func attachUECMHandler(r *gin.RouterGroup, u UECM, logger *log.Logger) {
+	group := r.Group(uecmRouterGroup)
+	{
+		group.PUT(router.Path(amf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.Amf3GppAccessRegistration
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, registerAMF3GPPAccessErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.RegisterAMF3GPPAccess(c, c.Param(ueIDParam), req)
+			switch status {
+			case RegisterAMF3GPPAccessStatusCreated:
+				c.Header("Location", router.ResourceURI(c))
+				c.JSON(int(status), res)
+			case RegisterAMF3GPPAccessStatusOK:
+				c.JSON(int(status), res)
+			case RegisterAMF3GPPAccessStatusNoContent:
+				c.JSON(int(status), nil)
+			case RegisterAMF3GPPAccessStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RegisterAMF3GPPAccessStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, registerAMF3GPPAccessErrors)
+			}
+			return
+		})
+		group.PATCH(router.Path(amf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.Amf3GppAccessRegistrationModification
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, updateAMF3GPPAccessRegistrationErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := u.UpdateAMF3GPPAccessRegistration(c, c.Param(ueIDParam), req)
+			switch status {
+			case UpdateAMF3GPPAccessRegistrationStatusNoContent:
+				c.JSON(int(status), nil)
+			case UpdateAMF3GPPAccessRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UpdateAMF3GPPAccessRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, updateAMF3GPPAccessRegistrationErrors)
+			}
+			return
+		})
+		group.GET(router.Path(amf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := u.GetAMF3GPPAccessRegistration(c, c.Param(ueIDParam), c.Query(supportedFeaturesQuery))
+			switch status {
+			case GetAMF3GPPAccessRegistrationStatusOK:
+				c.JSON(int(status), res)
+			case GetAMF3GPPAccessRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetAMF3GPPAccessRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getAMF3GPPAccessRegistrationErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(amfNon3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.AmfNon3GppAccessRegistration
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, registerAMFNon3GPPAccessErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.RegisterAMFNon3GPPAccess(c, c.Param(ueIDParam), req)
+			switch status {
+			case RegisterAMFNon3GPPAccessStatusCreated:
+				c.Header("Location", router.ResourceURI(c))
+				c.JSON(int(status), res)
+			case RegisterAMFNon3GPPAccessStatusOK:
+				c.JSON(int(status), res)
+			case RegisterAMFNon3GPPAccessStatusNoContent:
+				c.JSON(int(status), nil)
+			case RegisterAMFNon3GPPAccessStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RegisterAMFNon3GPPAccessStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, registerAMFNon3GPPAccessErrors)
+			}
+			return
+		})
+		group.PATCH(router.Path(amfNon3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.AmfNon3GppAccessRegistrationModification
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, updateAMFNon3GPPAccessRegistrationErrors)
+				return
+			}
+			problemDetails, redirectResponse, status := u.UpdateAMFNon3GPPAccessRegistration(c, c.Param(ueIDParam), req)
+			switch status {
+			case UpdateAMFNon3GPPAccessRegistrationStatusNoContent:
+				c.JSON(int(status), nil)
+			case UpdateAMFNon3GPPAccessRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case UpdateAMFNon3GPPAccessRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, updateAMFNon3GPPAccessRegistrationErrors)
+			}
+			return
+		})
+		group.GET(router.Path(amfNon3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := u.GetAMFNon3GPPAccessRegistration(c, c.Param(ueIDParam), c.Query(supportedFeaturesQuery))
+			switch status {
+			case GetAMFNon3GPPAccessRegistrationStatusOK:
+				c.JSON(int(status), res)
+			case GetAMFNon3GPPAccessRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetAMFNon3GPPAccessRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getAMFNon3GPPAccessRegistrationErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smfRegistrationsEndpoint), func(c *gin.Context) {
+			req := GetSMFRegistrationsRequest{Dnn: c.Query(dnnQuery), SupportedFeatures: c.Query(supportedFeaturesQuery)}
+			var singleNssai nudmuecm.Snssai
+			found, err := router.JSONQuery(c, singleNssaiQuery, &singleNssai, false)
+			if err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, getSMFRegistrationsErrors)
+				return
+			}
+			if found {
+				req.SingleNssai = &singleNssai
+			}
+			res, problemDetails, redirectResponse, status := u.GetSMFRegistrations(c, c.Param(ueIDParam), req)
+			switch status {
+			case GetSMFRegistrationsStatusOK:
+				c.JSON(int(status), res)
+			case GetSMFRegistrationsStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMFRegistrationsStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMFRegistrationsErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(smfRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.SmfRegistration
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, registerSMFErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.RegisterSMF(c, c.Param(ueIDParam), c.Param(pduSessionIDParam), req)
+			switch status {
+			case RegisterSMFStatusCreated:
+				c.Header("Location", router.ResourceURI(c))
+				c.JSON(int(status), res)
+			case RegisterSMFStatusOK:
+				c.JSON(int(status), res)
+			case RegisterSMFStatusNoContent:
+				c.JSON(int(status), nil)
+			case RegisterSMFStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RegisterSMFStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, registerSMFErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(smfRegistrationEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := u.DeregisterSMF(c, c.Param(ueIDParam), c.Param(pduSessionIDParam), c.Query(smfSetIDQuery))
+			switch status {
+			case DeregisterSMFStatusNoContent:
+				c.JSON(int(status), nil)
+			case DeregisterSMFStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeregisterSMFStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deregisterSMFErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smfRegistrationEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := u.GetSMFRegistration(c, c.Param(ueIDParam), c.Param(pduSessionIDParam), c.Query(supportedFeaturesQuery))
+			switch status {
+			case GetSMFRegistrationStatusOK:
+				c.JSON(int(status), res)
+			case GetSMFRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMFRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMFRegistrationErrors)
+			}
+			return
+		})
+		group.PUT(router.Path(smsf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			var req nudmuecm.SmsfRegistration
+			if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+				problemDetails := fivegc.BindingProblemDetails(c, u, err)
+				response.ProblemDetails(c, logger, fivegc.StatusCode(*problemDetails.Status), problemDetails, registerSMSF3GPPAccessErrors)
+				return
+			}
+			res, problemDetails, redirectResponse, status := u.RegisterSMSF3GPPAccess(c, c.Param(ueIDParam), req)
+			switch status {
+			case RegisterSMSF3GPPAccessStatusCreated:
+				c.Header("Location", router.ResourceURI(c))
+				c.JSON(int(status), res)
+			case RegisterSMSF3GPPAccessStatusOK:
+				c.JSON(int(status), res)
+			case RegisterSMSF3GPPAccessStatusNoContent:
+				c.JSON(int(status), nil)
+			case RegisterSMSF3GPPAccessStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case RegisterSMSF3GPPAccessStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, registerSMSF3GPPAccessErrors)
+			}
+			return
+		})
+		group.DELETE(router.Path(smsf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			problemDetails, redirectResponse, status := u.DeregisterSMSF3GPPAccess(c, c.Param(ueIDParam), c.Query(smsfSetIDQuery))
+			switch status {
+			case DeregisterSMSF3GPPAccessStatusNoContent:
+				c.JSON(int(status), nil)
+			case DeregisterSMSF3GPPAccessStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case DeregisterSMSF3GPPAccessStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, deregisterSMSF3GPPAccessErrors)
+			}
+			return
+		})
+		group.GET(router.Path(smsf3GPPAccessRegistrationEndpoint), func(c *gin.Context) {
+			res, problemDetails, redirectResponse, status := u.GetSMSF3GPPAccessRegistration(c, c.Param(ueIDParam), c.Query(supportedFeaturesQuery))
+			switch status {
+			case GetSMSF3GPPAccessRegistrationStatusOK:
+				c.JSON(int(status), res)
+			case GetSMSF3GPPAccessRegistrationStatusTemporaryRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			case GetSMSF3GPPAccessRegistrationStatusPermanentRedirect:
+				header.BindRedirectHeader(c, redirectResponse.RedirectHeader)
+				c.JSON(int(status), redirectResponse)
+			default:
+				response.ProblemDetails(c, logger, fivegc.StatusCode(status), problemDetails, getSMSF3GPPAccessRegistrationErrors)
+			}
+			return
+		})
+	}
+}
+
+// NewUECM adapts an UECMService to the UECM interface.
+func NewUECM(s UECMService) UECM {
+	return uecmAdapter{service: s}
+}
+
+type uecmAdapter struct {
+	service UECMService
+}
+
+func (a uecmAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.service.Error(ctx, err)
+}
+
+func (a uecmAdapter) RegisterAMF3GPPAccess(ctx context.Context, ueID string, req nudmuecm.Amf3GppAccessRegistration) (nudmuecm.Amf3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterAMF3GPPAccessStatusCode) {
+	res, err := a.service.RegisterAMF3GPPAccess(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.Amf3GppAccessRegistration{}, problemDetails, redirectResponse, RegisterAMF3GPPAccessStatusCode(status)
+	}
+	if res == nil {
+		return nudmuecm.Amf3GppAccessRegistration{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMF3GPPAccessStatusNoContent
+	}
+	if res.Created {
+		return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMF3GPPAccessStatusCreated
+	}
+	return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMF3GPPAccessStatusOK
+}
+
+func (a uecmAdapter) UpdateAMF3GPPAccessRegistration(ctx context.Context, ueID string, req nudmuecm.Amf3GppAccessRegistrationModification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateAMF3GPPAccessRegistrationStatusCode) {
+	if err := a.service.UpdateAMF3GPPAccessRegistration(ctx, ueID, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, UpdateAMF3GPPAccessRegistrationStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdateAMF3GPPAccessRegistrationStatusNoContent
+}
+
+func (a uecmAdapter) GetAMF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (nudmuecm.Amf3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMF3GPPAccessRegistrationStatusCode) {
+	res, err := a.service.GetAMF3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.Amf3GppAccessRegistration{}, problemDetails, redirectResponse, GetAMF3GPPAccessRegistrationStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmuecm.Amf3GppAccessRegistration{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetAMF3GPPAccessRegistrationStatusOK
+}
+
+func (a uecmAdapter) RegisterAMFNon3GPPAccess(ctx context.Context, ueID string, req nudmuecm.AmfNon3GppAccessRegistration) (nudmuecm.AmfNon3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterAMFNon3GPPAccessStatusCode) {
+	res, err := a.service.RegisterAMFNon3GPPAccess(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.AmfNon3GppAccessRegistration{}, problemDetails, redirectResponse, RegisterAMFNon3GPPAccessStatusCode(status)
+	}
+	if res == nil {
+		return nudmuecm.AmfNon3GppAccessRegistration{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMFNon3GPPAccessStatusNoContent
+	}
+	if res.Created {
+		return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMFNon3GPPAccessStatusCreated
+	}
+	return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterAMFNon3GPPAccessStatusOK
+}
+
+func (a uecmAdapter) UpdateAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, req nudmuecm.AmfNon3GppAccessRegistrationModification) (openapicommon.ProblemDetails, fivegc.RedirectResponse, UpdateAMFNon3GPPAccessRegistrationStatusCode) {
+	if err := a.service.UpdateAMFNon3GPPAccessRegistration(ctx, ueID, req); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, UpdateAMFNon3GPPAccessRegistrationStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, UpdateAMFNon3GPPAccessRegistrationStatusNoContent
+}
+
+func (a uecmAdapter) GetAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (nudmuecm.AmfNon3GppAccessRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetAMFNon3GPPAccessRegistrationStatusCode) {
+	res, err := a.service.GetAMFNon3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.AmfNon3GppAccessRegistration{}, problemDetails, redirectResponse, GetAMFNon3GPPAccessRegistrationStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmuecm.AmfNon3GppAccessRegistration{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetAMFNon3GPPAccessRegistrationStatusOK
+}
+
+func (a uecmAdapter) GetSMFRegistrations(ctx context.Context, ueID string, req GetSMFRegistrationsRequest) (nudmuecm.SmfRegistrationInfo, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFRegistrationsStatusCode) {
+	res, err := a.service.GetSMFRegistrations(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.SmfRegistrationInfo{}, problemDetails, redirectResponse, GetSMFRegistrationsStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmuecm.SmfRegistrationInfo{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMFRegistrationsStatusOK
+}
+
+func (a uecmAdapter) RegisterSMF(ctx context.Context, ueID string, pduSessionID string, req nudmuecm.SmfRegistration) (nudmuecm.SmfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterSMFStatusCode) {
+	res, err := a.service.RegisterSMF(ctx, ueID, pduSessionID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.SmfRegistration{}, problemDetails, redirectResponse, RegisterSMFStatusCode(status)
+	}
+	if res == nil {
+		return nudmuecm.SmfRegistration{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMFStatusNoContent
+	}
+	if res.Created {
+		return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMFStatusCreated
+	}
+	return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMFStatusOK
+}
+
+func (a uecmAdapter) DeregisterSMF(ctx context.Context, ueID string, pduSessionID string, smfSetID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregisterSMFStatusCode) {
+	if err := a.service.DeregisterSMF(ctx, ueID, pduSessionID, smfSetID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, DeregisterSMFStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeregisterSMFStatusNoContent
+}
+
+func (a uecmAdapter) GetSMFRegistration(ctx context.Context, ueID string, pduSessionID string, supportedFeatures string) (nudmuecm.SmfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMFRegistrationStatusCode) {
+	res, err := a.service.GetSMFRegistration(ctx, ueID, pduSessionID, supportedFeatures)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.SmfRegistration{}, problemDetails, redirectResponse, GetSMFRegistrationStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmuecm.SmfRegistration{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMFRegistrationStatusOK
+}
+
+func (a uecmAdapter) RegisterSMSF3GPPAccess(ctx context.Context, ueID string, req nudmuecm.SmsfRegistration) (nudmuecm.SmsfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, RegisterSMSF3GPPAccessStatusCode) {
+	res, err := a.service.RegisterSMSF3GPPAccess(ctx, ueID, req)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.SmsfRegistration{}, problemDetails, redirectResponse, RegisterSMSF3GPPAccessStatusCode(status)
+	}
+	if res == nil {
+		return nudmuecm.SmsfRegistration{}, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMSF3GPPAccessStatusNoContent
+	}
+	if res.Created {
+		return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMSF3GPPAccessStatusCreated
+	}
+	return res.JSONData, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, RegisterSMSF3GPPAccessStatusOK
+}
+
+func (a uecmAdapter) DeregisterSMSF3GPPAccess(ctx context.Context, ueID string, smsfSetID string) (openapicommon.ProblemDetails, fivegc.RedirectResponse, DeregisterSMSF3GPPAccessStatusCode) {
+	if err := a.service.DeregisterSMSF3GPPAccess(ctx, ueID, smsfSetID); err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return problemDetails, redirectResponse, DeregisterSMSF3GPPAccessStatusCode(status)
+	}
+	return openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, DeregisterSMSF3GPPAccessStatusNoContent
+}
+
+func (a uecmAdapter) GetSMSF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (nudmuecm.SmsfRegistration, openapicommon.ProblemDetails, fivegc.RedirectResponse, GetSMSF3GPPAccessRegistrationStatusCode) {
+	res, err := a.service.GetSMSF3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if err != nil {
+		problemDetails, redirectResponse, status := fivegc.ErrorResponse(err)
+		return nudmuecm.SmsfRegistration{}, problemDetails, redirectResponse, GetSMSF3GPPAccessRegistrationStatusCode(status)
+	}
+	if res == nil {
+		res = &nudmuecm.SmsfRegistration{}
+	}
+	return *res, openapicommon.ProblemDetails{}, fivegc.RedirectResponse{}, GetSMSF3GPPAccessRegistrationStatusOK
+}
+
+// NewUECMService adapts an UECM to the UECMService interface.
+func NewUECMService(u UECM) UECMService {
+	return uecmServiceAdapter{uecm: u}
+}
+
+type uecmServiceAdapter struct {
+	uecm UECM
+}
+
+func (a uecmServiceAdapter) Error(ctx context.Context, err error) openapicommon.ProblemDetails {
+	return a.uecm.Error(ctx, err)
+}
+
+func (a uecmServiceAdapter) RegisterAMF3GPPAccess(ctx context.Context, ueID string, req nudmuecm.Amf3GppAccessRegistration) (*AMF3GPPAccessRegistrationResponse, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.RegisterAMF3GPPAccess(ctx, ueID, req)
+	switch status {
+	case RegisterAMF3GPPAccessStatusCreated:
+		return &AMF3GPPAccessRegistrationResponse{Created: true, JSONData: res}, nil
+	case RegisterAMF3GPPAccessStatusOK:
+		return &AMF3GPPAccessRegistrationResponse{JSONData: res}, nil
+	case RegisterAMF3GPPAccessStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) UpdateAMF3GPPAccessRegistration(ctx context.Context, ueID string, req nudmuecm.Amf3GppAccessRegistrationModification) error {
+	problemDetails, redirectResponse, status := a.uecm.UpdateAMF3GPPAccessRegistration(ctx, ueID, req)
+	if status == UpdateAMF3GPPAccessRegistrationStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) GetAMF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.Amf3GppAccessRegistration, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.GetAMF3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if status == GetAMF3GPPAccessRegistrationStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) RegisterAMFNon3GPPAccess(ctx context.Context, ueID string, req nudmuecm.AmfNon3GppAccessRegistration) (*AMFNon3GPPAccessRegistrationResponse, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.RegisterAMFNon3GPPAccess(ctx, ueID, req)
+	switch status {
+	case RegisterAMFNon3GPPAccessStatusCreated:
+		return &AMFNon3GPPAccessRegistrationResponse{Created: true, JSONData: res}, nil
+	case RegisterAMFNon3GPPAccessStatusOK:
+		return &AMFNon3GPPAccessRegistrationResponse{JSONData: res}, nil
+	case RegisterAMFNon3GPPAccessStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) UpdateAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, req nudmuecm.AmfNon3GppAccessRegistrationModification) error {
+	problemDetails, redirectResponse, status := a.uecm.UpdateAMFNon3GPPAccessRegistration(ctx, ueID, req)
+	if status == UpdateAMFNon3GPPAccessRegistrationStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) GetAMFNon3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.AmfNon3GppAccessRegistration, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.GetAMFNon3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if status == GetAMFNon3GPPAccessRegistrationStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) GetSMFRegistrations(ctx context.Context, ueID string, req GetSMFRegistrationsRequest) (*nudmuecm.SmfRegistrationInfo, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.GetSMFRegistrations(ctx, ueID, req)
+	if status == GetSMFRegistrationsStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) RegisterSMF(ctx context.Context, ueID string, pduSessionID string, req nudmuecm.SmfRegistration) (*SMFRegistrationResponse, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.RegisterSMF(ctx, ueID, pduSessionID, req)
+	switch status {
+	case RegisterSMFStatusCreated:
+		return &SMFRegistrationResponse{Created: true, JSONData: res}, nil
+	case RegisterSMFStatusOK:
+		return &SMFRegistrationResponse{JSONData: res}, nil
+	case RegisterSMFStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) DeregisterSMF(ctx context.Context, ueID string, pduSessionID string, smfSetID string) error {
+	problemDetails, redirectResponse, status := a.uecm.DeregisterSMF(ctx, ueID, pduSessionID, smfSetID)
+	if status == DeregisterSMFStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) GetSMFRegistration(ctx context.Context, ueID string, pduSessionID string, supportedFeatures string) (*nudmuecm.SmfRegistration, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.GetSMFRegistration(ctx, ueID, pduSessionID, supportedFeatures)
+	if status == GetSMFRegistrationStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) RegisterSMSF3GPPAccess(ctx context.Context, ueID string, req nudmuecm.SmsfRegistration) (*SMSFRegistrationResponse, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.RegisterSMSF3GPPAccess(ctx, ueID, req)
+	switch status {
+	case RegisterSMSF3GPPAccessStatusCreated:
+		return &SMSFRegistrationResponse{Created: true, JSONData: res}, nil
+	case RegisterSMSF3GPPAccessStatusOK:
+		return &SMSFRegistrationResponse{JSONData: res}, nil
+	case RegisterSMSF3GPPAccessStatusNoContent:
+		return nil, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) DeregisterSMSF3GPPAccess(ctx context.Context, ueID string, smsfSetID string) error {
+	problemDetails, redirectResponse, status := a.uecm.DeregisterSMSF3GPPAccess(ctx, ueID, smsfSetID)
+	if status == DeregisterSMSF3GPPAccessStatusNoContent {
+		return nil
+	}
+	return fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
+
+func (a uecmServiceAdapter) GetSMSF3GPPAccessRegistration(ctx context.Context, ueID string, supportedFeatures string) (*nudmuecm.SmsfRegistration, error) {
+	res, problemDetails, redirectResponse, status := a.uecm.GetSMSF3GPPAccessRegistration(ctx, ueID, supportedFeatures)
+	if status == GetSMSF3GPPAccessRegistrationStatusOK {
+		return &res, nil
+	}
+	return nil, fivegc.ErrorFromResponse(problemDetails, redirectResponse, fivegc.StatusCode(status))
+}
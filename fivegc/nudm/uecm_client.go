@@ -0,0 +1,233 @@
+package nudm
+
+import (
+	"context"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/request"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"net/http"
+)
+
+// UECMClient is a client for the NUDM UEContextManagement service. It also sends the deregistration and the P-CSCF
+// restoration notifications to the AMFs and the SMFs.
+type UECMClient struct {
+	client  *nudmuecm.APIClient
+	request *request.Client
+}
+
+// NewUECMClient creates a new client for the NUDM UEContextManagement service.
+func NewUECMClient(cfg fivegc.ClientConfiguration) *UECMClient {
+	openapiCfg := &nudmuecm.Configuration{
+		Host:             cfg.Host,
+		Scheme:           cfg.Scheme,
+		DefaultHeader:    cfg.DefaultHeader,
+		UserAgent:        cfg.UserAgent,
+		Debug:            cfg.Debug,
+		Servers:          []nudmuecm.ServerConfiguration{},
+		OperationServers: make(map[string]nudmuecm.ServerConfigurations),
+		HTTPClient:       cfg.HTTPClient,
+	}
+	for _, server := range cfg.Servers {
+		openapiServer := nudmuecm.ServerConfiguration{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   make(map[string]nudmuecm.ServerVariable),
+		}
+		for name, variable := range server.Variables {
+			openapiServer.Variables[name] = nudmuecm.ServerVariable{
+				Description:  variable.Description,
+				DefaultValue: variable.DefaultValue,
+				EnumValues:   variable.EnumValues,
+			}
+		}
+		openapiCfg.Servers = append(openapiCfg.Servers, openapiServer)
+	}
+	for name, servers := range cfg.OperationServers {
+		openapiServers := make(nudmuecm.ServerConfigurations, len(servers))
+		for i, server := range servers {
+			openapiServers[i] = nudmuecm.ServerConfiguration{
+				URL:         server.URL,
+				Description: server.Description,
+				Variables:   make(map[string]nudmuecm.ServerVariable),
+			}
+			for name, variable := range server.Variables {
+				openapiServers[i].Variables[name] = nudmuecm.ServerVariable{
+					Description:  variable.Description,
+					DefaultValue: variable.DefaultValue,
+					EnumValues:   variable.EnumValues,
+				}
+			}
+		}
+		openapiCfg.OperationServers[name] = openapiServers
+	}
+	return &UECMClient{
+		client:  nudmuecm.NewAPIClient(openapiCfg),
+		request: request.NewClient(cfg, uecmRouterGroup),
+	}
+}
+
+// RegisterAMF3GPPAccess returns a request registering the AMF serving a UE over the 3GPP access.
+func (u *UECMClient) RegisterAMF3GPPAccess(ctx context.Context, ueID string) nudmuecm.ApiCall3GppRegistrationRequest {
+	return u.client.AMFRegistrationFor3GPPAccessApi.Call3GppRegistration(ctx, ueID)
+}
+
+// RegisterAMF3GPPAccessExecute executes a RegisterAMF3GPPAccess request.
+func (u *UECMClient) RegisterAMF3GPPAccessExecute(r nudmuecm.ApiCall3GppRegistrationRequest) (*nudmuecm.Amf3GppAccessRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// UpdateAMF3GPPAccessRegistration returns a request updating the registration of the AMF serving a UE over the 3GPP access.
+func (u *UECMClient) UpdateAMF3GPPAccessRegistration(ctx context.Context, ueID string) nudmuecm.ApiUpdate3GppRegistrationRequest {
+	return u.client.ParameterUpdateInTheAMFRegistrationFor3GPPAccessApi.Update3GppRegistration(ctx, ueID)
+}
+
+// UpdateAMF3GPPAccessRegistrationExecute executes an UpdateAMF3GPPAccessRegistration request.
+func (u *UECMClient) UpdateAMF3GPPAccessRegistrationExecute(r nudmuecm.ApiUpdate3GppRegistrationRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// GetAMF3GPPAccessRegistration returns a request retrieving the registration of the AMF serving a UE over the 3GPP access.
+func (u *UECMClient) GetAMF3GPPAccessRegistration(ctx context.Context, ueID string) nudmuecm.ApiGet3GppRegistrationRequest {
+	return u.client.AMF3GppAccessRegistrationInfoRetrievalApi.Get3GppRegistration(ctx, ueID)
+}
+
+// GetAMF3GPPAccessRegistrationExecute executes a GetAMF3GPPAccessRegistration request.
+func (u *UECMClient) GetAMF3GPPAccessRegistrationExecute(r nudmuecm.ApiGet3GppRegistrationRequest) (*nudmuecm.Amf3GppAccessRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// RegisterAMFNon3GPPAccess returns a request registering the AMF serving a UE over the non-3GPP access.
+func (u *UECMClient) RegisterAMFNon3GPPAccess(ctx context.Context, ueID string) nudmuecm.ApiNon3gppRegistrationRequest {
+	return u.client.AMFRegistrationForNon3GPPAccessApi.Non3gppRegistration(ctx, ueID)
+}
+
+// RegisterAMFNon3GPPAccessExecute executes a RegisterAMFNon3GPPAccess request.
+func (u *UECMClient) RegisterAMFNon3GPPAccessExecute(r nudmuecm.ApiNon3gppRegistrationRequest) (*nudmuecm.AmfNon3GppAccessRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// UpdateAMFNon3GPPAccessRegistration returns a request updating the registration of the AMF serving a UE over the non-3GPP access.
+func (u *UECMClient) UpdateAMFNon3GPPAccessRegistration(ctx context.Context, ueID string) nudmuecm.ApiUpdateNon3gppRegistrationRequest {
+	return u.client.ParameterUpdateInTheAMFRegistrationForNon3GPPAccessApi.UpdateNon3gppRegistration(ctx, ueID)
+}
+
+// UpdateAMFNon3GPPAccessRegistrationExecute executes an UpdateAMFNon3GPPAccessRegistration request.
+func (u *UECMClient) UpdateAMFNon3GPPAccessRegistrationExecute(r nudmuecm.ApiUpdateNon3gppRegistrationRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// GetAMFNon3GPPAccessRegistration returns a request retrieving the registration of the AMF serving a UE over the non-3GPP access.
+func (u *UECMClient) GetAMFNon3GPPAccessRegistration(ctx context.Context, ueID string) nudmuecm.ApiGetNon3gppRegistrationRequest {
+	return u.client.AMFNon3GPPAccessRegistrationInfoRetrievalApi.GetNon3gppRegistration(ctx, ueID)
+}
+
+// GetAMFNon3GPPAccessRegistrationExecute executes a GetAMFNon3GPPAccessRegistration request.
+func (u *UECMClient) GetAMFNon3GPPAccessRegistrationExecute(r nudmuecm.ApiGetNon3gppRegistrationRequest) (*nudmuecm.AmfNon3GppAccessRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMFRegistrations returns a request retrieving the registrations of the SMFs serving the PDU sessions of a UE.
+func (u *UECMClient) GetSMFRegistrations(ctx context.Context, ueID string) nudmuecm.ApiGetSmfRegistrationRequest {
+	return u.client.SMFSmfRegistrationApi.GetSmfRegistration(ctx, ueID)
+}
+
+// GetSMFRegistrationsExecute executes a GetSMFRegistrations request.
+func (u *UECMClient) GetSMFRegistrationsExecute(r nudmuecm.ApiGetSmfRegistrationRequest) (*nudmuecm.SmfRegistrationInfo, *http.Response, error) {
+	return r.Execute()
+}
+
+// RegisterSMF returns a request registering the SMF serving a PDU session of a UE.
+func (u *UECMClient) RegisterSMF(ctx context.Context, ueID, pduSessionID string) nudmuecm.ApiRegistrationRequest {
+	return u.client.SMFRegistrationApi.Registration(ctx, ueID, pduSessionID)
+}
+
+// RegisterSMFExecute executes a RegisterSMF request.
+func (u *UECMClient) RegisterSMFExecute(r nudmuecm.ApiRegistrationRequest) (*nudmuecm.SmfRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeregisterSMF returns a request removing the registration of the SMF serving a PDU session of a UE.
+func (u *UECMClient) DeregisterSMF(ctx context.Context, ueID, pduSessionID string) nudmuecm.ApiSmfDeregistrationRequest {
+	return u.client.SMFDeregistrationApi.SmfDeregistration(ctx, ueID, pduSessionID)
+}
+
+// DeregisterSMFExecute executes a DeregisterSMF request.
+func (u *UECMClient) DeregisterSMFExecute(r nudmuecm.ApiSmfDeregistrationRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMFRegistration returns a request retrieving the registration of the SMF serving a PDU session of a UE.
+func (u *UECMClient) GetSMFRegistration(ctx context.Context, ueID, pduSessionID string) nudmuecm.ApiRetrieveSmfRegistrationRequest {
+	return u.client.SMFRegistrationInfoRetrievalApi.RetrieveSmfRegistration(ctx, ueID, pduSessionID)
+}
+
+// GetSMFRegistrationExecute executes a GetSMFRegistration request.
+func (u *UECMClient) GetSMFRegistrationExecute(r nudmuecm.ApiRetrieveSmfRegistrationRequest) (*nudmuecm.SmfRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// RegisterSMSF3GPPAccess returns a request registering the SMSF serving a UE over the 3GPP access.
+func (u *UECMClient) RegisterSMSF3GPPAccess(ctx context.Context, ueID string) nudmuecm.ApiCall3GppSmsfRegistrationRequest {
+	return u.client.SMSFRegistrationFor3GPPAccessApi.Call3GppSmsfRegistration(ctx, ueID)
+}
+
+// RegisterSMSF3GPPAccessExecute executes a RegisterSMSF3GPPAccess request.
+func (u *UECMClient) RegisterSMSF3GPPAccessExecute(r nudmuecm.ApiCall3GppSmsfRegistrationRequest) (*nudmuecm.SmsfRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeregisterSMSF3GPPAccess returns a request removing the registration of the SMSF serving a UE over the 3GPP access.
+func (u *UECMClient) DeregisterSMSF3GPPAccess(ctx context.Context, ueID string) nudmuecm.ApiCall3GppSmsfDeregistrationRequest {
+	return u.client.SMSFDeregistrationFor3GPPAccessApi.Call3GppSmsfDeregistration(ctx, ueID)
+}
+
+// DeregisterSMSF3GPPAccessExecute executes a DeregisterSMSF3GPPAccess request.
+func (u *UECMClient) DeregisterSMSF3GPPAccessExecute(r nudmuecm.ApiCall3GppSmsfDeregistrationRequest) (*http.Response, error) {
+	return r.Execute()
+}
+
+// GetSMSF3GPPAccessRegistration returns a request retrieving the registration of the SMSF serving a UE over the 3GPP access.
+func (u *UECMClient) GetSMSF3GPPAccessRegistration(ctx context.Context, ueID string) nudmuecm.ApiGet3GppSmsfRegistrationRequest {
+	return u.client.SMSF3GPPAccessRegistrationInfoRetrievalApi.Get3GppSmsfRegistration(ctx, ueID)
+}
+
+// GetSMSF3GPPAccessRegistrationExecute executes a GetSMSF3GPPAccessRegistration request.
+func (u *UECMClient) GetSMSF3GPPAccessRegistrationExecute(r nudmuecm.ApiGet3GppSmsfRegistrationRequest) (*nudmuecm.SmsfRegistration, *http.Response, error) {
+	return r.Execute()
+}
+
+// DeregistrationNotify notifies an AMF or a SMF, at the deregCallbackUri of its registration, that it is no longer
+// registered for a UE.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (u *UECMClient) DeregistrationNotify(ctx context.Context, deregCallbackURI string, data nudmuecm.DeregistrationData) error {
+	if deregCallbackURI == "" {
+		return fmt.Errorf("deregistration notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("deregCallbackUri is missing"))
+	}
+	if err := u.request.PostJSON(ctx, deregCallbackURI, data); err != nil {
+		return fmt.Errorf("deregistration notify: %w", err)
+	}
+	return nil
+}
+
+// NotifyAMFDeregistration notifies the AMF of a 3GPP access registration that it is no longer registered for the UE,
+// for the reason given.
+func (u *UECMClient) NotifyAMFDeregistration(ctx context.Context, registration nudmuecm.Amf3GppAccessRegistration, reason string) error {
+	var data nudmuecm.DeregistrationData
+	data.DeregReason = nudmuecm.DeregistrationReason(reason)
+	data.SetAccessType(AccessType3GPP)
+	return u.DeregistrationNotify(ctx, registration.DeregCallbackUri, data)
+}
+
+// PCSCFRestorationNotify notifies an AMF or a SMF, at the pcscfRestorationCallbackUri of its registration, of the
+// failure of the P-CSCF serving a UE.
+// Error responses are returned as a fivegc.ProblemDetailsError, or as a *fivegc.RedirectError for the redirections.
+func (u *UECMClient) PCSCFRestorationNotify(ctx context.Context, pcscfRestorationCallbackURI string, data nudmuecm.PcscfRestorationNotification) error {
+	if pcscfRestorationCallbackURI == "" {
+		return fmt.Errorf("p-cscf restoration notify: %w", fivegc.ErrMandatoryIeMissing.WithDetail("pcscfRestorationCallbackUri is missing"))
+	}
+	if err := u.request.PostJSON(ctx, pcscfRestorationCallbackURI, data); err != nil {
+		return fmt.Errorf("p-cscf restoration notify: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package nudm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/apitest"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	nudmuecm "github.com/5GCoreNet/openapi/openapi_Nudm_UECM"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// registeringUDM stores the AMF registered for the 3GPP access and the SMFs registered for the PDU sessions of a
+// single UE.
+type registeringUDM struct {
+	supi             string
+	amfRegistration  *nudmuecm.Amf3GppAccessRegistration
+	smfRegistrations map[string]nudmuecm.SmfRegistration
+	smfRequest       GetSMFRegistrationsRequest
+}
+
+func (u *registeringUDM) Error(context.Context, error) openapicommon.ProblemDetails {
+	return openapicommon.ProblemDetails{}
+}
+
+func (u *registeringUDM) RegisterAMF3GPPAccess(_ context.Context, ueID string, req nudmuecm.Amf3GppAccessRegistration) (*AMF3GPPAccessRegistrationResponse, error) {
+	if ueID != u.supi {
+		return nil, ErrUserNotFound
+	}
+	created := u.amfRegistration == nil
+	u.amfRegistration = &req
+	return &AMF3GPPAccessRegistrationResponse{Created: created, JSONData: req}, nil
+}
+
+func (u *registeringUDM) UpdateAMF3GPPAccessRegistration(_ context.Context, _ string, req nudmuecm.Amf3GppAccessRegistrationModification) error {
+	if u.amfRegistration == nil {
+		return ErrContextNotFound
+	}
+	if req.Guami.AmfId != u.amfRegistration.Guami.AmfId {
+		return ErrInvalidGUAMI
+	}
+	u.amfRegistration.PurgeFlag = req.PurgeFlag
+	return nil
+}
+
+func (u *registeringUDM) GetAMF3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.Amf3GppAccessRegistration, error) {
+	if u.amfRegistration == nil {
+		return nil, ErrContextNotFound
+	}
+	return u.amfRegistration, nil
+}
+
+func (u *registeringUDM) RegisterAMFNon3GPPAccess(context.Context, string, nudmuecm.AmfNon3GppAccessRegistration) (*AMFNon3GPPAccessRegistrationResponse, error) {
+	return nil, ErrAccessNotAllowed
+}
+
+func (u *registeringUDM) UpdateAMFNon3GPPAccessRegistration(context.Context, string, nudmuecm.AmfNon3GppAccessRegistrationModification) error {
+	return ErrContextNotFound
+}
+
+func (u *registeringUDM) GetAMFNon3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.AmfNon3GppAccessRegistration, error) {
+	return nil, ErrContextNotFound
+}
+
+func (u *registeringUDM) GetSMFRegistrations(_ context.Context, _ string, req GetSMFRegistrationsRequest) (*nudmuecm.SmfRegistrationInfo, error) {
+	u.smfRequest = req
+	var res nudmuecm.SmfRegistrationInfo
+	for _, registration := range u.smfRegistrations {
+		res.SmfRegistrationList = append(res.SmfRegistrationList, registration)
+	}
+	return &res, nil
+}
+
+func (u *registeringUDM) RegisterSMF(_ context.Context, _ string, pduSessionID string, req nudmuecm.SmfRegistration) (*SMFRegistrationResponse, error) {
+	if _, ok := u.smfRegistrations[pduSessionID]; ok {
+		u.smfRegistrations[pduSessionID] = req
+		return nil, nil
+	}
+	u.smfRegistrations[pduSessionID] = req
+	return &SMFRegistrationResponse{Created: true, JSONData: req}, nil
+}
+
+func (u *registeringUDM) DeregisterSMF(_ context.Context, _ string, pduSessionID string, _ string) error {
+	if _, ok := u.smfRegistrations[pduSessionID]; !ok {
+		return ErrContextNotFound
+	}
+	delete(u.smfRegistrations, pduSessionID)
+	return nil
+}
+
+func (u *registeringUDM) GetSMFRegistration(_ context.Context, _ string, pduSessionID string, _ string) (*nudmuecm.SmfRegistration, error) {
+	registration, ok := u.smfRegistrations[pduSessionID]
+	if !ok {
+		return nil, ErrContextNotFound
+	}
+	return &registration, nil
+}
+
+func (u *registeringUDM) RegisterSMSF3GPPAccess(context.Context, string, nudmuecm.SmsfRegistration) (*SMSFRegistrationResponse, error) {
+	return nil, ErrNoPSSubscription
+}
+
+func (u *registeringUDM) DeregisterSMSF3GPPAccess(context.Context, string, string) error {
+	return ErrContextNotFound
+}
+
+func (u *registeringUDM) GetSMSF3GPPAccessRegistration(context.Context, string, string) (*nudmuecm.SmsfRegistration, error) {
+	return nil, ErrContextNotFound
+}
+
+func TestUECM(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	u := &registeringUDM{supi: "imsi-208930000000001", smfRegistrations: map[string]nudmuecm.SmfRegistration{}}
+	attachUECMHandler(router.Group(""), NewUECM(u), log.Default())
+
+	send := func(method, path string, body interface{}) (*http.Response, []byte) {
+		w := apitest.Send(t, router, method, "http://udm.example.com"+uecmRouterGroup+path, nil, body)
+		return w.Result(), w.Body.Bytes()
+	}
+
+	amfRegistration := nudmuecm.Amf3GppAccessRegistration{
+		AmfInstanceId:    "amf-1",
+		DeregCallbackUri: "http://amf-1.example.com/dereg-notify",
+		Guami:            nudmuecm.Guami{PlmnId: nudmuecm.PlmnId{Mcc: "208", Mnc: "93"}, AmfId: "cafe00"},
+		RatType:          "NR",
+	}
+	resp, _ := send(http.MethodPut, "/imsi-208930000000001/registrations/amf-3gpp-access", amfRegistration)
+	if resp.StatusCode != http.StatusCreated || !strings.HasSuffix(resp.Header.Get("Location"), "/imsi-208930000000001/registrations/amf-3gpp-access") {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	amfRegistration.AmfInstanceId = "amf-2"
+	amfRegistration.Guami.AmfId = "cafe01"
+	resp, raw := send(http.MethodPut, "/imsi-208930000000001/registrations/amf-3gpp-access", amfRegistration)
+	var registered nudmuecm.Amf3GppAccessRegistration
+	if err := json.Unmarshal(raw, &registered); err != nil || resp.StatusCode != http.StatusOK || registered.AmfInstanceId != "amf-2" {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if resp, _ := send(http.MethodPut, "/imsi-208930000000002/registrations/amf-3gpp-access", amfRegistration); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	modification := nudmuecm.Amf3GppAccessRegistrationModification{Guami: nudmuecm.Guami{PlmnId: nudmuecm.PlmnId{Mcc: "208", Mnc: "93"}, AmfId: "cafe00"}}
+	modification.SetPurgeFlag(true)
+	if resp, _ := send(http.MethodPatch, "/imsi-208930000000001/registrations/amf-3gpp-access", modification); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	modification.Guami.AmfId = "cafe01"
+	if resp, _ := send(http.MethodPatch, "/imsi-208930000000001/registrations/amf-3gpp-access", modification); resp.StatusCode != http.StatusNoContent || !u.amfRegistration.GetPurgeFlag() {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/registrations/amf-3gpp-access", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/registrations/amf-non-3gpp-access", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	smfRegistration := nudmuecm.SmfRegistration{
+		SmfInstanceId: "smf-1",
+		PduSessionId:  5,
+		SingleNssai:   nudmuecm.Snssai{Sst: 1},
+		PlmnId:        nudmuecm.PlmnId{Mcc: "208", Mnc: "93"},
+	}
+	if resp, _ := send(http.MethodPut, "/imsi-208930000000001/registrations/smf-registrations/5", smfRegistration); resp.StatusCode != http.StatusCreated {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodPut, "/imsi-208930000000001/registrations/smf-registrations/5", smfRegistration); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	singleNssai := url.QueryEscape(`{"sst":1}`)
+	resp, raw = send(http.MethodGet, "/imsi-208930000000001/registrations/smf-registrations?dnn=internet&single-nssai="+singleNssai, nil)
+	var info nudmuecm.SmfRegistrationInfo
+	if err := json.Unmarshal(raw, &info); err != nil || resp.StatusCode != http.StatusOK || len(info.SmfRegistrationList) != 1 {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+	if u.smfRequest.Dnn != "internet" || u.smfRequest.SingleNssai == nil || u.smfRequest.SingleNssai.Sst != 1 {
+		t.Errorf("unexpected smf registrations request %+v", u.smfRequest)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/registrations/smf-registrations?single-nssai=1", nil); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodDelete, "/imsi-208930000000001/registrations/smf-registrations/5?smf-set-id=set1", nil); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+	if resp, _ := send(http.MethodGet, "/imsi-208930000000001/registrations/smf-registrations/5", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected response %d", resp.StatusCode)
+	}
+
+	var smsfRegistration nudmuecm.SmsfRegistration
+	smsfRegistration.SmsfInstanceId = "smsf-1"
+	smsfRegistration.PlmnId = nudmuecm.PlmnId{Mcc: "208", Mnc: "93"}
+	resp, raw = send(http.MethodPut, "/imsi-208930000000001/registrations/smsf-3gpp-access", smsfRegistration)
+	var problemDetails openapicommon.ProblemDetails
+	if err := json.Unmarshal(raw, &problemDetails); err != nil || resp.StatusCode != http.StatusForbidden || problemDetails.Cause == nil || *problemDetails.Cause != CauseNoPSSubscription {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, raw)
+	}
+}
+
+func TestUECMService(t *testing.T) {
+	u := &registeringUDM{supi: "imsi-208930000000001", smfRegistrations: map[string]nudmuecm.SmfRegistration{}}
+	service := NewUECMService(NewUECM(u))
+	ctx := context.Background()
+	var registration nudmuecm.SmfRegistration
+	registration.SmfInstanceId = "smf-1"
+	res, err := service.RegisterSMF(ctx, u.supi, "5", registration)
+	if err != nil || res == nil || !res.Created || res.JSONData.SmfInstanceId != "smf-1" {
+		t.Errorf("unexpected result %+v %v", res, err)
+	}
+	if res, err := service.RegisterSMF(ctx, u.supi, "5", registration); err != nil || res != nil {
+		t.Errorf("unexpected result %+v %v", res, err)
+	}
+	err = service.DeregisterSMSF3GPPAccess(ctx, u.supi, "")
+	var problemDetailsErr fivegc.ProblemDetailsError
+	if !errors.As(err, &problemDetailsErr) || problemDetailsErr.Cause == nil || *problemDetailsErr.Cause != CauseContextNotFound {
+		t.Errorf("unexpected error %v", err)
+	}
+}
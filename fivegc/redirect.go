@@ -18,3 +18,33 @@ type RedirectResponse struct {
 	// String providing a URI formatted according to RFC 3986.
 	TargetSepp string `json:"targetSepp,omitempty"`
 }
+
+// RedirectError is an error redirecting the request to another service instance, it is answered with
+// 307 Temporary Redirect or 308 Permanent Redirect and the redirect response.
+type RedirectError struct {
+	Permanent bool
+	Response  RedirectResponse
+}
+
+// NewTemporaryRedirectError creates an error answered with 307 Temporary Redirect.
+func NewTemporaryRedirectError(response RedirectResponse) *RedirectError {
+	return &RedirectError{Response: response}
+}
+
+// NewPermanentRedirectError creates an error answered with 308 Permanent Redirect.
+func NewPermanentRedirectError(response RedirectResponse) *RedirectError {
+	return &RedirectError{Permanent: true, Response: response}
+}
+
+// Error returns the target of the redirection.
+func (e *RedirectError) Error() string {
+	return StatusText(e.StatusCode()) + " to " + e.Response.RedirectHeader.Location
+}
+
+// StatusCode returns the status code of the redirection.
+func (e *RedirectError) StatusCode() StatusCode {
+	if e.Permanent {
+		return StatusPermanentRedirect
+	}
+	return StatusTemporaryRedirect
+}
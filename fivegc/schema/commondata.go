@@ -0,0 +1,197 @@
+package schema
+
+import (
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"regexp"
+)
+
+// Common data types defined in TS 29.571.
+var (
+	// Supi is the Subscription Permanent Identifier.
+	Supi = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^(imsi-[0-9]{5,15}|nai-.+|gci-.+|gli-.+|.+)$`)}
+	// Gpsi is the Generic Public Subscription Identifier.
+	Gpsi = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^(msisdn-[0-9]{5,15}|extid-[^@]+@[^@]+|.+)$`)}
+	// Pei is the Permanent Equipment Identifier.
+	Pei = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^(imei-[0-9]{15}|imeisv-[0-9]{16}|mac((-[0-9a-fA-F]{2}){6})(-untrusted)?|eui((-[0-9a-fA-F]{2}){8})|.+)$`)}
+	// NfInstanceId is the identifier of a NF instance, formatted as an UUID.
+	NfInstanceId = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)}
+	// AmfId is the AMF identifier (AMF Region ID, AMF Set ID and AMF Pointer).
+	AmfId = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[A-Fa-f0-9]{6}$`)}
+	// SupportedFeatures is the list of supported features as an hexadecimal bit mask.
+	SupportedFeatures = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[A-Fa-f0-9]*$`)}
+	// Uri is a URI formatted according to RFC 3986.
+	Uri = &Schema{Type: TypeString}
+	// DateTime is a date-time formatted according to RFC 3339.
+	DateTime = &Schema{Type: TypeString}
+	// DurationSec is a duration in seconds.
+	DurationSec = &Schema{Type: TypeInteger}
+	// Uinteger is an unsigned integer.
+	Uinteger = &Schema{Type: TypeInteger, Minimum: fivegc.ToFloat64(0)}
+	// Mcc is the Mobile Country Code.
+	Mcc = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^\d{3}$`)}
+	// Mnc is the Mobile Network Code.
+	Mnc = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^\d{2,3}$`)}
+	// Nid is the Network Identifier of a SNPN.
+	Nid = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[A-Fa-f0-9]{11}$`)}
+	// Tac is the Tracking Area Code.
+	Tac = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`(^[A-Fa-f0-9]{4}$)|(^[A-Fa-f0-9]{6}$)`)}
+	// EutraCellId is the E-UTRA cell identity.
+	EutraCellId = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[A-Fa-f0-9]{7}$`)}
+	// NrCellId is the NR cell identity.
+	NrCellId = &Schema{Type: TypeString, Pattern: regexp.MustCompile(`^[A-Fa-f0-9]{9}$`)}
+	// PlmnId is the PLMN identifier.
+	PlmnId = &Schema{
+		Type:     TypeObject,
+		Required: []string{"mcc", "mnc"},
+		Properties: map[string]*Schema{
+			"mcc": Mcc,
+			"mnc": Mnc,
+		},
+	}
+	// Tai is the Tracking Area Identity.
+	Tai = &Schema{
+		Type:     TypeObject,
+		Required: []string{"plmnId", "tac"},
+		Properties: map[string]*Schema{
+			"plmnId": PlmnId,
+			"tac":    Tac,
+			"nid":    Nid,
+		},
+	}
+	// Ecgi is the E-UTRA Cell Global Identity.
+	Ecgi = &Schema{
+		Type:     TypeObject,
+		Required: []string{"plmnId", "eutraCellId"},
+		Properties: map[string]*Schema{
+			"plmnId":      PlmnId,
+			"eutraCellId": EutraCellId,
+			"nid":         Nid,
+		},
+	}
+	// Ncgi is the NR Cell Global Identity.
+	Ncgi = &Schema{
+		Type:     TypeObject,
+		Required: []string{"plmnId", "nrCellId"},
+		Properties: map[string]*Schema{
+			"plmnId":   PlmnId,
+			"nrCellId": NrCellId,
+			"nid":      Nid,
+		},
+	}
+)
+
+// GAD shapes defined in TS 29.572 clause 6.1.6.
+var (
+	// GeographicalCoordinates are the coordinates of a point on the WGS 84 ellipsoid.
+	GeographicalCoordinates = &Schema{
+		Type:     TypeObject,
+		Required: []string{"lon", "lat"},
+		Properties: map[string]*Schema{
+			"lon": {Type: TypeNumber, Minimum: fivegc.ToFloat64(-180), Maximum: fivegc.ToFloat64(180)},
+			"lat": {Type: TypeNumber, Minimum: fivegc.ToFloat64(-90), Maximum: fivegc.ToFloat64(90)},
+		},
+	}
+	// Uncertainty is an uncertainty in meters.
+	Uncertainty = &Schema{Type: TypeNumber, Minimum: fivegc.ToFloat64(0)}
+	// Orientation is an orientation angle in degrees.
+	Orientation = &Schema{Type: TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(180)}
+	// Confidence is a confidence in percent.
+	Confidence = &Schema{Type: TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(100)}
+	// Altitude is an altitude in meters.
+	Altitude = &Schema{Type: TypeNumber, Minimum: fivegc.ToFloat64(-32767), Maximum: fivegc.ToFloat64(32767)}
+	// InnerRadius is the inner radius of an ellipsoid arc in meters.
+	InnerRadius = &Schema{Type: TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(327675)}
+	// Angle is an angle in degrees.
+	Angle = &Schema{Type: TypeInteger, Minimum: fivegc.ToFloat64(0), Maximum: fivegc.ToFloat64(360)}
+	// UncertaintyEllipse is an ellipse of uncertainty.
+	UncertaintyEllipse = &Schema{
+		Type:     TypeObject,
+		Required: []string{"semiMajor", "semiMinor", "orientationMajor"},
+		Properties: map[string]*Schema{
+			"semiMajor":        Uncertainty,
+			"semiMinor":        Uncertainty,
+			"orientationMajor": Orientation,
+		},
+	}
+	// UncertaintyEllipsoid is an ellipsoid of uncertainty.
+	UncertaintyEllipsoid = &Schema{
+		Type:     TypeObject,
+		Required: []string{"semiMajor", "semiMinor", "vertical", "orientationMajor"},
+		Properties: map[string]*Schema{
+			"semiMajor":        Uncertainty,
+			"semiMinor":        Uncertainty,
+			"vertical":         Uncertainty,
+			"orientationMajor": Orientation,
+		},
+	}
+	// PointList is the list of points of a polygon.
+	PointList = &Schema{Type: TypeArray, Items: GeographicalCoordinates, MinItems: fivegc.ToInt(3), MaxItems: fivegc.ToInt(15)}
+	// Point is an ellipsoid point.
+	Point = gadShape("POINT", []string{"point"}, map[string]*Schema{
+		"point": GeographicalCoordinates,
+	})
+	// PointUncertaintyCircle is an ellipsoid point with uncertainty circle.
+	PointUncertaintyCircle = gadShape("POINT_UNCERTAINTY_CIRCLE", []string{"point", "uncertainty"}, map[string]*Schema{
+		"point":       GeographicalCoordinates,
+		"uncertainty": Uncertainty,
+	})
+	// PointUncertaintyEllipse is an ellipsoid point with uncertainty ellipse.
+	PointUncertaintyEllipse = gadShape("POINT_UNCERTAINTY_ELLIPSE", []string{"point", "uncertaintyEllipse", "confidence"}, map[string]*Schema{
+		"point":              GeographicalCoordinates,
+		"uncertaintyEllipse": UncertaintyEllipse,
+		"confidence":         Confidence,
+	})
+	// Polygon is a polygon.
+	Polygon = gadShape("POLYGON", []string{"pointList"}, map[string]*Schema{
+		"pointList": PointList,
+	})
+	// PointAltitude is an ellipsoid point with altitude.
+	PointAltitude = gadShape("POINT_ALTITUDE", []string{"point", "altitude"}, map[string]*Schema{
+		"point":    GeographicalCoordinates,
+		"altitude": Altitude,
+	})
+	// PointAltitudeUncertainty is an ellipsoid point with altitude and uncertainty ellipsoid.
+	PointAltitudeUncertainty = gadShape("POINT_ALTITUDE_UNCERTAINTY", []string{"point", "altitude", "uncertaintyEllipse", "uncertaintyAltitude", "confidence"}, map[string]*Schema{
+		"point":               GeographicalCoordinates,
+		"altitude":            Altitude,
+		"uncertaintyEllipse":  UncertaintyEllipse,
+		"uncertaintyAltitude": Uncertainty,
+		"confidence":          Confidence,
+		"vConfidence":         Confidence,
+	})
+	// EllipsoidArc is an ellipsoid arc.
+	EllipsoidArc = gadShape("ELLIPSOID_ARC", []string{"point", "innerRadius", "uncertaintyRadius", "offsetAngle", "includedAngle", "confidence"}, map[string]*Schema{
+		"point":             GeographicalCoordinates,
+		"innerRadius":       InnerRadius,
+		"uncertaintyRadius": Uncertainty,
+		"offsetAngle":       Angle,
+		"includedAngle":     Angle,
+		"confidence":        Confidence,
+	})
+	// GeographicArea is any of the geographic GAD shapes.
+	GeographicArea = &Schema{
+		Type: TypeObject,
+		Discriminator: &Discriminator{
+			PropertyName: "shape",
+			Mapping: map[string]*Schema{
+				"POINT":                      Point,
+				"POINT_UNCERTAINTY_CIRCLE":   PointUncertaintyCircle,
+				"POINT_UNCERTAINTY_ELLIPSE":  PointUncertaintyEllipse,
+				"POLYGON":                    Polygon,
+				"POINT_ALTITUDE":             PointAltitude,
+				"POINT_ALTITUDE_UNCERTAINTY": PointAltitudeUncertainty,
+				"ELLIPSOID_ARC":              EllipsoidArc,
+			},
+		},
+	}
+)
+
+// gadShape returns the schema of a GAD shape, discriminated by its shape attribute.
+func gadShape(shape string, required []string, properties map[string]*Schema) *Schema {
+	properties["shape"] = &Schema{Type: TypeString, Enum: []string{shape}}
+	return &Schema{
+		Type:       TypeObject,
+		Required:   append([]string{"shape"}, required...),
+		Properties: properties,
+	}
+}
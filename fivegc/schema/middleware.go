@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"bytes"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"io"
+	"strings"
+)
+
+// Middleware returns a gin middleware validating the request bodies against schemas.
+// Schemas are indexed by endpoint path (e.g. /nlmf-loc/v1/determine-location), a request is validated when its route ends with one of them.
+// Bodies that are not valid JSON are left to the handler, which reports them through CommonInterface.Error.
+func Middleware(schemas map[string]*Schema) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s := lookup(schemas, c.FullPath())
+		if s == nil || c.Request.Body == nil {
+			c.Next()
+			return
+		}
+		body, err := io.ReadAll(c.Request.Body)
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			c.Next()
+			return
+		}
+		violations, err := s.Validate(body)
+		if err != nil || len(violations) == 0 {
+			c.Next()
+			return
+		}
+		problemDetails := ProblemDetails(violations)
+		c.AbortWithStatusJSON(int(*problemDetails.Status), problemDetails)
+	}
+}
+
+// ProblemDetails builds the 400 ProblemDetails reporting violations, as described in TS 29.500 clause 5.2.7.2.
+func ProblemDetails(violations []Violation) openapicommon.ProblemDetails {
+	cause := fivegc.CauseMandatoryIeIncorrect
+	invalidParams := make([]openapicommon.InvalidParam, len(violations))
+	for i, violation := range violations {
+		if violation.Missing {
+			cause = fivegc.CauseMandatoryIeMissing
+		}
+		invalidParams[i] = openapicommon.InvalidParam{
+			Param:  violation.Pointer,
+			Reason: fivegc.ToString(violation.Reason),
+		}
+	}
+	return openapicommon.ProblemDetails{
+		Title:         fivegc.ToString(fivegc.StatusText(fivegc.StatusBadRequest)),
+		Status:        fivegc.ToInt32(int32(fivegc.StatusBadRequest)),
+		Detail:        fivegc.ToString("the request body does not comply with its schema"),
+		Cause:         fivegc.ToString(cause),
+		InvalidParams: invalidParams,
+	}
+}
+
+func lookup(schemas map[string]*Schema, path string) *Schema {
+	for endpoint, s := range schemas {
+		if strings.HasSuffix(path, endpoint) {
+			return s
+		}
+	}
+	return nil
+}
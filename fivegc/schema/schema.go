@@ -0,0 +1,275 @@
+// Package schema validates JSON bodies against the subset of the OpenAPI schemas used by the 3GPP specifications.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Type is the type of JSON value described by a Schema.
+type Type string
+
+const (
+	TypeObject  Type = "object"
+	TypeArray   Type = "array"
+	TypeString  Type = "string"
+	TypeInteger Type = "integer"
+	TypeNumber  Type = "number"
+	TypeBoolean Type = "boolean"
+)
+
+// Schema is an OpenAPI schema object. Only the keywords used by the 3GPP specifications are supported.
+type Schema struct {
+	Type       Type
+	Properties map[string]*Schema
+	Required   []string
+	Items      *Schema
+	MinItems   *int
+	MaxItems   *int
+	Enum       []string
+	Pattern    *regexp.Regexp
+	MinLength  *int
+	MaxLength  *int
+	Minimum    *float64
+	Maximum    *float64
+	AllOf      []*Schema
+	AnyOf      []*Schema
+	OneOf      []*Schema
+	// Discriminator selects the schema of an object from the value of one of its properties.
+	Discriminator *Discriminator
+}
+
+// Discriminator is an OpenAPI discriminator object.
+type Discriminator struct {
+	PropertyName string
+	Mapping      map[string]*Schema
+}
+
+// Violation describes an attribute that does not comply with its schema.
+type Violation struct {
+	// Pointer is the JSON Pointer (RFC 6901) of the attribute.
+	Pointer string
+	Reason  string
+	// Missing is true when a mandatory attribute is absent.
+	Missing bool
+}
+
+// Validate decodes data and validates it against s.
+func (s *Schema) Validate(data []byte) ([]Violation, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return s.ValidateValue(value), nil
+}
+
+// ValidateValue validates a value decoded with json.Decoder.UseNumber against s.
+func (s *Schema) ValidateValue(value interface{}) []Violation {
+	violations := s.validate("", value)
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Pointer < violations[j].Pointer
+	})
+	return violations
+}
+
+func (s *Schema) validate(pointer string, value interface{}) []Violation {
+	if s == nil {
+		return nil
+	}
+	var violations []Violation
+	if s.Type != "" && !hasType(s.Type, value) {
+		return []Violation{{Pointer: pointer, Reason: fmt.Sprintf("must be of type %s", s.Type)}}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		violations = append(violations, s.validateObject(pointer, v)...)
+	case []interface{}:
+		violations = append(violations, s.validateArray(pointer, v)...)
+	case string:
+		violations = append(violations, s.validateString(pointer, v)...)
+	case json.Number:
+		violations = append(violations, s.validateNumber(pointer, v)...)
+	}
+	for _, sub := range s.AllOf {
+		violations = append(violations, sub.validate(pointer, value)...)
+	}
+	if len(s.AnyOf) > 0 {
+		violations = append(violations, validateAnyOf(pointer, value, s.AnyOf)...)
+	}
+	if len(s.OneOf) > 0 {
+		violations = append(violations, validateOneOf(pointer, value, s.OneOf)...)
+	}
+	if s.Discriminator != nil {
+		violations = append(violations, s.Discriminator.validate(pointer, value)...)
+	}
+	return violations
+}
+
+func (d *Discriminator) validate(pointer string, value interface{}) []Violation {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	propertyPointer := pointer + "/" + escape(d.PropertyName)
+	property, ok := object[d.PropertyName]
+	if !ok {
+		return []Violation{{Pointer: propertyPointer, Reason: "mandatory attribute is missing", Missing: true}}
+	}
+	name, _ := property.(string)
+	s, ok := d.Mapping[name]
+	if !ok {
+		return []Violation{{Pointer: propertyPointer, Reason: fmt.Sprintf("unsupported value %v", property)}}
+	}
+	return s.validate(pointer, value)
+}
+
+func (s *Schema) validateObject(pointer string, object map[string]interface{}) []Violation {
+	var violations []Violation
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			violations = append(violations, Violation{Pointer: pointer + "/" + escape(name), Reason: "mandatory attribute is missing", Missing: true})
+		}
+	}
+	for name, property := range s.Properties {
+		if value, ok := object[name]; ok {
+			violations = append(violations, property.validate(pointer+"/"+escape(name), value)...)
+		}
+	}
+	return violations
+}
+
+func (s *Schema) validateArray(pointer string, array []interface{}) []Violation {
+	var violations []Violation
+	if s.MinItems != nil && len(array) < *s.MinItems {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must have at least %d items", *s.MinItems)})
+	}
+	if s.MaxItems != nil && len(array) > *s.MaxItems {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must have at most %d items", *s.MaxItems)})
+	}
+	for i, item := range array {
+		violations = append(violations, s.Items.validate(fmt.Sprintf("%s/%d", pointer, i), item)...)
+	}
+	return violations
+}
+
+func (s *Schema) validateString(pointer string, str string) []Violation {
+	var violations []Violation
+	if len(s.Enum) > 0 && !contains(s.Enum, str) {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must be one of %s", strings.Join(s.Enum, ", "))})
+	}
+	if s.Pattern != nil && !s.Pattern.MatchString(str) {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must match pattern %s", s.Pattern)})
+	}
+	length := utf8.RuneCountInString(str)
+	if s.MinLength != nil && length < *s.MinLength {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must be at least %d characters long", *s.MinLength)})
+	}
+	if s.MaxLength != nil && length > *s.MaxLength {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must be at most %d characters long", *s.MaxLength)})
+	}
+	return violations
+}
+
+func (s *Schema) validateNumber(pointer string, number json.Number) []Violation {
+	f, err := number.Float64()
+	if err != nil {
+		return []Violation{{Pointer: pointer, Reason: "must be a number"}}
+	}
+	var violations []Violation
+	if s.Minimum != nil && f < *s.Minimum {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must be greater than or equal to %v", *s.Minimum)})
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		violations = append(violations, Violation{Pointer: pointer, Reason: fmt.Sprintf("must be less than or equal to %v", *s.Maximum)})
+	}
+	return violations
+}
+
+func validateAnyOf(pointer string, value interface{}, schemas []*Schema) []Violation {
+	var best []Violation
+	for i, sub := range schemas {
+		violations := sub.validate(pointer, value)
+		if len(violations) == 0 {
+			return nil
+		}
+		if i == 0 || len(violations) < len(best) {
+			best = violations
+		}
+	}
+	// Report the violations of the closest alternative, they are the most likely to be relevant.
+	return best
+}
+
+func validateOneOf(pointer string, value interface{}, schemas []*Schema) []Violation {
+	matches := 0
+	var best []Violation
+	for i, sub := range schemas {
+		violations := sub.validate(pointer, value)
+		if len(violations) == 0 {
+			matches++
+			continue
+		}
+		if i == 0 || best == nil || len(violations) < len(best) {
+			best = violations
+		}
+	}
+	switch matches {
+	case 1:
+		return nil
+	case 0:
+		return best
+	default:
+		return []Violation{{Pointer: pointer, Reason: "must match exactly one schema"}}
+	}
+}
+
+func hasType(t Type, value interface{}) bool {
+	switch t {
+	case TypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case TypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	case TypeString:
+		_, ok := value.(string)
+		return ok
+	case TypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case TypeNumber:
+		_, ok := value.(json.Number)
+		return ok
+	case TypeInteger:
+		number, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := number.Float64()
+		return err == nil && f == math.Trunc(f)
+	default:
+		return true
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// escape escapes a reference token of a JSON Pointer (RFC 6901).
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
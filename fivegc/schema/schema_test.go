@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var testSchema = &Schema{
+	Type:     TypeObject,
+	Required: []string{"supi", "locationEstimate"},
+	Properties: map[string]*Schema{
+		"supi":             Supi,
+		"tai":              Tai,
+		"locationEstimate": GeographicArea,
+		"priority":         {Type: TypeString, Enum: []string{"HIGHEST_PRIORITY", "NORMAL_PRIORITY"}},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		pointers []string
+		missing  bool
+	}{
+		{
+			name: "valid",
+			body: `{"supi":"imsi-208930000000001","locationEstimate":{"shape":"POINT","point":{"lon":2.35,"lat":48.85}}}`,
+		},
+		{
+			name:     "missing attributes",
+			body:     `{"tai":{"plmnId":{"mcc":"208"},"tac":"000001"}}`,
+			pointers: []string{"/locationEstimate", "/supi", "/tai/plmnId/mnc"},
+			missing:  true,
+		},
+		{
+			name:     "out of range",
+			body:     `{"supi":"imsi-208930000000001","locationEstimate":{"shape":"POINT_UNCERTAINTY_CIRCLE","point":{"lon":2.35,"lat":98.85},"uncertainty":-1}}`,
+			pointers: []string{"/locationEstimate/point/lat", "/locationEstimate/uncertainty"},
+		},
+		{
+			name:     "bad pattern and enum",
+			body:     `{"supi":"imsi-208930000000001","tai":{"plmnId":{"mcc":"20","mnc":"93"},"tac":"01"},"priority":"LOW","locationEstimate":{"shape":"POINT","point":{"lon":2,"lat":4}}}`,
+			pointers: []string{"/priority", "/tai/plmnId/mcc", "/tai/tac"},
+		},
+		{
+			name:     "unknown shape",
+			body:     `{"supi":"imsi-208930000000001","locationEstimate":{"shape":"SPHERE"}}`,
+			pointers: []string{"/locationEstimate/shape"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := testSchema.Validate([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(violations) != len(tt.pointers) {
+				t.Fatalf("expected %d violations, got %v", len(tt.pointers), violations)
+			}
+			for i, violation := range violations {
+				if violation.Pointer != tt.pointers[i] {
+					t.Errorf("expected violation of %s, got %s", tt.pointers[i], violation.Pointer)
+				}
+			}
+			if tt.missing && !violations[0].Missing {
+				t.Errorf("violation should report a missing attribute")
+			}
+		})
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	s := &Schema{OneOf: []*Schema{{Type: TypeInteger}, {Type: TypeNumber}, {Type: TypeString}}}
+	if violations := s.ValidateValue("supi"); len(violations) != 0 {
+		t.Errorf("unexpected violations %v", violations)
+	}
+	if violations := s.ValidateValue(json.Number("1")); len(violations) != 1 {
+		t.Errorf("integer matches two schemas, got %v", violations)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware(map[string]*Schema{"/nlmf-loc/v1/determine-location": testSchema}))
+	router.POST("/v1/nlmf-loc/v1/determine-location", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/nlmf-loc/v1/determine-location", strings.NewReader(`{"supi":"imsi-208930000000001"}`)))
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, recorder.Code)
+	}
+	var problemDetails openapicommon.ProblemDetails
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problemDetails); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *problemDetails.Cause != fivegc.CauseMandatoryIeMissing {
+		t.Errorf("unexpected cause %s", *problemDetails.Cause)
+	}
+	if len(problemDetails.InvalidParams) != 1 || problemDetails.InvalidParams[0].Param != "/locationEstimate" {
+		t.Errorf("unexpected invalid params %v", problemDetails.InvalidParams)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/nlmf-loc/v1/determine-location", strings.NewReader(`{"supi":"imsi-208930000000001","locationEstimate":{"shape":"POINT","point":{"lon":2.35,"lat":48.85}}}`)))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, recorder.Code)
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"supi":"imsi-208930000000001"}`))
+	}))
+	defer server.Close()
+	schemas := map[string]*Schema{"/determine-location": testSchema}
+	client := NewHTTPClient(server.Client(), schemas, schemas)
+
+	_, err := client.Post(server.URL+"/determine-location", "application/json", strings.NewReader(`{"supi":1}`))
+	var validationErr *Error
+	if !errors.As(err, &validationErr) || validationErr.Response {
+		t.Fatalf("expected a request validation error, got %v", err)
+	}
+
+	_, err = client.Post(server.URL+"/determine-location", "application/json", strings.NewReader(`{"supi":"imsi-208930000000001","locationEstimate":{"shape":"POINT","point":{"lon":2.35,"lat":48.85}}}`))
+	if !errors.As(err, &validationErr) || !validationErr.Response {
+		t.Fatalf("expected a response validation error, got %v", err)
+	}
+}
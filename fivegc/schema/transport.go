@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Error is returned by the clients when a body does not comply with its schema.
+type Error struct {
+	// Path is the path of the request.
+	Path string
+	// Response is true when the violations come from the response body, false when they come from the request body.
+	Response   bool
+	Violations []Violation
+}
+
+// Error returns a summary of the violations.
+func (e *Error) Error() string {
+	body := "request"
+	if e.Response {
+		body = "response"
+	}
+	reasons := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		reasons[i] = fmt.Sprintf("%s %s", violation.Pointer, violation.Reason)
+	}
+	return fmt.Sprintf("%s body of %s does not comply with its schema: %s", body, e.Path, strings.Join(reasons, "; "))
+}
+
+// NewHTTPClient returns a copy of client validating the request and the successful response bodies.
+// Schemas are indexed by endpoint path, as for Middleware. Violations are returned as an *Error.
+func NewHTTPClient(client *http.Client, requests map[string]*Schema, responses map[string]*Schema) *http.Client {
+	validatingClient := &http.Client{}
+	if client != nil {
+		*validatingClient = *client
+	}
+	base := validatingClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	validatingClient.Transport = &transport{
+		base:      base,
+		requests:  requests,
+		responses: responses,
+	}
+	return validatingClient
+}
+
+type transport struct {
+	base      http.RoundTripper
+	requests  map[string]*Schema
+	responses map[string]*Schema
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := req.URL.Path
+	if s := lookup(t.requests, path); s != nil && req.Body != nil && isJSON(req.Header.Get("Content-Type")) {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if violations, err := s.Validate(body); err == nil && len(violations) > 0 {
+			return nil, &Error{Path: path, Violations: violations}
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	s := lookup(t.responses, path)
+	if s == nil || resp.StatusCode < 200 || resp.StatusCode > 299 || !isJSON(resp.Header.Get("Content-Type")) {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if violations, err := s.Validate(body); err == nil && len(violations) > 0 {
+		return nil, &Error{Path: path, Response: true, Violations: violations}
+	}
+	return resp, nil
+}
+
+// isJSON reports whether the content type is application/json or one of its +json variants (e.g. application/problem+json).
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,164 @@
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// resynchronisationAMF is the dummy AMF used to compute the MAC-S of the AUTS, defined in TS 33.102 clause 6.3.3.
+var resynchronisationAMF = []byte{0x00, 0x00}
+
+// HEAV is the 5G home environment authentication vector generated by the UDM for 5G AKA, defined in TS 33.501
+// clause 6.1.3.2.
+type HEAV struct {
+	RAND     []byte
+	AUTN     []byte
+	XRESStar []byte
+	KAUSF    []byte
+}
+
+// Generate5GHEAV generates the 5G HE AV of the RAND and the SQN for the serving network. The separation bit of the
+// AMF must be set as required by TS 33.501 clause 6.1.3.2, otherwise an ErrAMFSeparationBit is returned.
+func (m *Milenage) Generate5GHEAV(rand, sqn, amf []byte, servingNetworkName string) (*HEAV, error) {
+	if err := checkSeparationBit(amf); err != nil {
+		return nil, err
+	}
+	autn, err := m.GenerateAUTN(rand, sqn, amf)
+	if err != nil {
+		return nil, err
+	}
+	res, ck, ik, _, err := m.F2345(rand)
+	if err != nil {
+		return nil, err
+	}
+	xresStar, err := DeriveRESStar(ck, ik, servingNetworkName, rand, res)
+	if err != nil {
+		return nil, err
+	}
+	kausf, err := DeriveKAUSF(ck, ik, servingNetworkName, autn[:SQNLength])
+	if err != nil {
+		return nil, err
+	}
+	return &HEAV{RAND: rand, AUTN: autn, XRESStar: xresStar, KAUSF: kausf}, nil
+}
+
+// GenerateAUTN returns the authentication token AUTN = SQN ⊕ AK || AMF || MAC-A, defined in TS 33.102 clause 6.3.2.
+func (m *Milenage) GenerateAUTN(rand, sqn, amf []byte) ([]byte, error) {
+	macA, err := m.F1(rand, sqn, amf)
+	if err != nil {
+		return nil, err
+	}
+	_, _, _, ak, err := m.F2345(rand)
+	if err != nil {
+		return nil, err
+	}
+	autn := append(xor(sqn, ak), amf...)
+	return append(autn, macA...), nil
+}
+
+// GenerateAUTS returns the resynchronisation token AUTS = SQNMS ⊕ AK* || MAC-S sent by the UE when the SQN of the
+// AUTN is out of range, defined in TS 33.102 clause 6.3.3.
+func (m *Milenage) GenerateAUTS(rand, sqnMS []byte) ([]byte, error) {
+	macS, err := m.F1Star(rand, sqnMS, resynchronisationAMF)
+	if err != nil {
+		return nil, err
+	}
+	akStar, err := m.F5Star(rand)
+	if err != nil {
+		return nil, err
+	}
+	return append(xor(sqnMS, akStar), macS...), nil
+}
+
+// ResynchronizeSQN returns the SQNMS of the AUTS sent by the UE for the RAND, from which the UDM resets its SQN as
+// defined in TS 33.102 clause 6.3.5. An ErrMACFailure is returned when the MAC-S of the AUTS is not valid.
+func (m *Milenage) ResynchronizeSQN(rand, auts []byte) ([]byte, error) {
+	if err := checkLength("AUTS", auts, AUTSLength); err != nil {
+		return nil, err
+	}
+	akStar, err := m.F5Star(rand)
+	if err != nil {
+		return nil, err
+	}
+	sqnMS := xor(auts[:SQNLength], akStar)
+	macS, err := m.F1Star(rand, sqnMS, resynchronisationAMF)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(macS, auts[SQNLength:]) != 1 {
+		return nil, ErrMACFailure
+	}
+	return sqnMS, nil
+}
+
+// DeriveKAUSF returns the KAUSF of 5G AKA, derived from CK and IK as defined in TS 33.501 Annex A.2. The SQN ⊕ AK is
+// the first 6 bytes of the AUTN.
+func DeriveKAUSF(ck, ik []byte, servingNetworkName string, sqnXorAK []byte) ([]byte, error) {
+	if err := checkCKIK(ck, ik); err != nil {
+		return nil, err
+	}
+	if err := checkLength("SQN ⊕ AK", sqnXorAK, SQNLength); err != nil {
+		return nil, err
+	}
+	return KDF(append(append([]byte{}, ck...), ik...), FCKAUSF, []byte(servingNetworkName), sqnXorAK), nil
+}
+
+// DeriveRESStar returns the RES* computed by the UE, or the XRES* computed by the UDM, from the RES or the XRES as
+// defined in TS 33.501 Annex A.4.
+func DeriveRESStar(ck, ik []byte, servingNetworkName string, rand, res []byte) ([]byte, error) {
+	if err := checkCKIK(ck, ik); err != nil {
+		return nil, err
+	}
+	if err := checkLength("RAND", rand, RANDLength); err != nil {
+		return nil, err
+	}
+	out := KDF(append(append([]byte{}, ck...), ik...), FCRESStar, []byte(servingNetworkName), rand, res)
+	// The RES* is the 128 least significant bits of the output.
+	return out[len(out)-RESStarLength:], nil
+}
+
+// DeriveHXRESStar returns the HXRES* sent by the AUSF to the SEAF, or the HRES* computed by the SEAF, as defined in
+// TS 33.501 Annex A.5.
+func DeriveHXRESStar(rand, xresStar []byte) ([]byte, error) {
+	if err := checkLength("RAND", rand, RANDLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("XRES*", xresStar, RESStarLength); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(append(append([]byte{}, rand...), xresStar...))
+	// The HXRES* is the 128 least significant bits of the hash.
+	return sum[len(sum)-HXRESStarLength:], nil
+}
+
+// VerifyRESStar reports whether the RES* sent by the UE is the XRES* of the authentication vector, as checked by the
+// AUSF in TS 33.501 clause 6.1.3.2. The comparison runs in constant time.
+func VerifyRESStar(xresStar, resStar []byte) bool {
+	return len(xresStar) == RESStarLength && subtle.ConstantTimeCompare(xresStar, resStar) == 1
+}
+
+// DeriveKSEAF returns the KSEAF derived from the KAUSF by the AUSF, as defined in TS 33.501 Annex A.6.
+func DeriveKSEAF(kausf []byte, servingNetworkName string) ([]byte, error) {
+	if err := checkLength("KAUSF", kausf, KAUSFLength); err != nil {
+		return nil, err
+	}
+	return KDF(kausf, FCKSEAF, []byte(servingNetworkName)), nil
+}
+
+// DeriveKAMF returns the KAMF derived from the KSEAF by the SEAF, as defined in TS 33.501 Annex A.7.1. The SUPI may
+// hold its "imsi-" or "nai-" type prefix, which is not part of the derivation. The ABBA is 0x0000 in the current
+// releases.
+func DeriveKAMF(kseaf []byte, supi string, abba []byte) ([]byte, error) {
+	if err := checkLength("KSEAF", kseaf, KSEAFLength); err != nil {
+		return nil, err
+	}
+	return KDF(kseaf, FCKAMF, []byte(supiValue(supi)), abba), nil
+}
+
+// checkCKIK checks the lengths of CK and IK.
+func checkCKIK(ck, ik []byte) error {
+	if err := checkLength("CK", ck, KeyLength); err != nil {
+		return err
+	}
+	return checkLength("IK", ik, KeyLength)
+}
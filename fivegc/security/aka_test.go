@@ -0,0 +1,162 @@
+package security
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+	"testing"
+)
+
+// testSet1 returns the MILENAGE functions and the RAND of the test set 1 of TS 35.208.
+func testSet1(t *testing.T) (*Milenage, []byte) {
+	t.Helper()
+	m, err := NewMilenageOPc(mustDecodeHex(t, "465b5ce8b199b49faa5f0a2ee238a6bc"), mustDecodeHex(t, "cd63cb71954a9f4e48a5994e37a02baf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m, mustDecodeHex(t, "23553cbe9637a89d218ae64dae47bf35")
+}
+
+func TestKDF(t *testing.T) {
+	key := mustDecodeHex(t, "000102030405060708090a0b0c0d0e0f")
+	// S = FC || P0 || L0 || P1 || L1 of TS 33.220 Annex B.2.
+	s := append([]byte{FCKAMF}, "208930000000001"...)
+	s = append(s, 0x00, 0x0f, 0x00, 0x00, 0x00, 0x02)
+	mac := hmac.New(sha256.New, key)
+	mac.Write(s)
+	if out := KDF(key, FCKAMF, []byte("208930000000001"), []byte{0x00, 0x00}); !bytes.Equal(out, mac.Sum(nil)) {
+		t.Errorf("KDF is %x, expected %x", out, mac.Sum(nil))
+	}
+
+	if name := ServingNetworkName("208", "93"); name != "5G:mnc093.mcc208.3gppnetwork.org" {
+		t.Errorf("unexpected serving network name %s", name)
+	}
+}
+
+func TestEAPAKAPrime(t *testing.T) {
+	// Test case 1 of RFC 5448 Appendix C.
+	ck := mustDecodeHex(t, "5349fbe098649f948f5d2e973a81c00f")
+	ik := mustDecodeHex(t, "9744871ad32bf9bbd1dd5ce54e3e2e5a")
+	autn := mustDecodeHex(t, "bb52e91c747ac3ab2a5c23d15ee351d5")
+	ckPrime, ikPrime, err := DeriveCKPrimeIKPrime(ck, ik, "WLAN", autn[:SQNLength])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex.EncodeToString(ckPrime) != "0093962d0dd84aa5684b045c9edffa04" || hex.EncodeToString(ikPrime) != "ccfc230ca74fcc96c0a5d61164f5a76c" {
+		t.Errorf("CK' and IK' are %x and %x", ckPrime, ikPrime)
+	}
+	keys, err := DeriveEAPAKAPrimeKeys(ckPrime, ikPrime, "0555444333222111")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex.EncodeToString(keys.KEncr) != "766fa0a6c317174b812d52fbcd11a179" {
+		t.Errorf("K_encr is %x", keys.KEncr)
+	}
+	if hex.EncodeToString(keys.KAut) != "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea" {
+		t.Errorf("K_aut is %x", keys.KAut)
+	}
+	if len(keys.MSK) != 64 || len(keys.EMSK) != 64 || !bytes.Equal(keys.KAUSF(), keys.EMSK[:32]) {
+		t.Errorf("unexpected keys %+v", keys)
+	}
+
+	m, rand := testSet1(t)
+	av, err := m.GenerateEAPAKAPrimeAV(rand, mustDecodeHex(t, "ff9bb4d0b607"), mustDecodeHex(t, "b9b9"), ServingNetworkName("208", "93"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The AUTN holds SQN ⊕ AK, the AMF and the MAC-A of the test set 1 of TS 35.208.
+	if hex.EncodeToString(av.AUTN) != "55f328b43577"+"b9b9"+"4a9ffac354dfafb3" || hex.EncodeToString(av.XRES) != "a54211d5e3ba50bf" {
+		t.Errorf("unexpected vector %x", av.AUTN)
+	}
+	if _, err := m.GenerateEAPAKAPrimeAV(rand, mustDecodeHex(t, "ff9bb4d0b607"), mustDecodeHex(t, "39b9"), ServingNetworkName("208", "93")); !errors.Is(err, ErrAMFSeparationBit) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if v := av.AuthenticationVector(); v.AvType != "EAP_AKA_PRIME" || v.GetCkPrime() != hex.EncodeToString(av.CKPrime) {
+		t.Errorf("unexpected vector %+v", v)
+	}
+}
+
+func Test5GAKA(t *testing.T) {
+	// The expected keys are derived from the CK, IK, RES and SQN ⊕ AK of the test set 1 of TS 35.208 for the serving
+	// network of MCC 208 and MNC 93, with an implementation of the KDF of TS 33.220 Annex B.2 independent of this one.
+	m, rand := testSet1(t)
+	servingNetworkName := ServingNetworkName("208", "93")
+	heav, err := m.Generate5GHEAV(rand, mustDecodeHex(t, "ff9bb4d0b607"), mustDecodeHex(t, "b9b9"), servingNetworkName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex.EncodeToString(heav.XRESStar) != "5cc9527f4d21c43bee83a15443acf1c4" {
+		t.Errorf("XRES* is %x", heav.XRESStar)
+	}
+	if hex.EncodeToString(heav.KAUSF) != "f2e35260f85194d4f891504d02111e56689ac23dd393bee3abbcc5bfbc013ef9" {
+		t.Errorf("KAUSF is %x", heav.KAUSF)
+	}
+	ck := mustDecodeHex(t, "b40ba9a3c58b2a05bbf0d987b21bf8cb")
+	ik := mustDecodeHex(t, "f769bcd751044604127672711c6d3441")
+	// The UE computes the RES* from the RES of the USIM.
+	resStar, err := DeriveRESStar(ck, ik, servingNetworkName, rand, mustDecodeHex(t, "a54211d5e3ba50bf"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !VerifyRESStar(heav.XRESStar, resStar) || VerifyRESStar(heav.XRESStar, resStar[1:]) {
+		t.Errorf("RES* %x is not verified against %x", resStar, heav.XRESStar)
+	}
+
+	av, kseaf, err := NewAv5gAka(heav.AuthenticationVector(), servingNetworkName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if av.HxresStar != "6970075e3c8245fdc2073003cf166279" || av.Autn != hex.EncodeToString(heav.AUTN) {
+		t.Errorf("unexpected 5G SE AV %+v", av)
+	}
+	if hex.EncodeToString(kseaf) != "cfddde483bd1318a412e98870f556410905be4fb7500abed93ee16af71bbb3fa" {
+		t.Errorf("KSEAF is %x", kseaf)
+	}
+	kamf, err := DeriveKAMF(kseaf, "imsi-208930000000001", []byte{0x00, 0x00})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex.EncodeToString(kamf) != "9d63b519775a92ca861ca6a50d848fa8ebf160ea7b73735a85b33737e73c55b4" {
+		t.Errorf("KAMF is %x", kamf)
+	}
+	confirmation := nausfueauth.ConfirmationData{ResStar: hex.EncodeToString(resStar)}
+	if !ConfirmResStar(heav.AuthenticationVector(), confirmation) {
+		t.Errorf("RES* %s is not confirmed", confirmation.ResStar)
+	}
+	confirmation.ResStar = "00"
+	if ConfirmResStar(heav.AuthenticationVector(), confirmation) {
+		t.Errorf("RES* %s is confirmed", confirmation.ResStar)
+	}
+
+	if _, err := m.Generate5GHEAV(rand, mustDecodeHex(t, "ff9bb4d0b607"), mustDecodeHex(t, "0000"), servingNetworkName); !errors.Is(err, ErrAMFSeparationBit) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResynchronization(t *testing.T) {
+	m, rand := testSet1(t)
+	sqnMS := mustDecodeHex(t, "ff9bb4d0b607")
+	auts, err := m.GenerateAUTS(rand, sqnMS)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The SQNMS is concealed with the AK* of the test set 1 of TS 35.208.
+	if hex.EncodeToString(auts[:SQNLength]) != "ba853f3c123c" {
+		t.Errorf("AUTS is %x", auts)
+	}
+	sqn, err := m.Resynchronize(nudmueau.ResynchronizationInfo{Rand: hex.EncodeToString(rand), Auts: hex.EncodeToString(auts)})
+	if err != nil || !bytes.Equal(sqn, sqnMS) {
+		t.Errorf("SQN is %x, expected %x: %v", sqn, sqnMS, err)
+	}
+	auts[AUTSLength-1] ^= 0x01
+	if _, err := m.ResynchronizeSQN(rand, auts); !errors.Is(err, ErrMACFailure) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := m.ResynchronizeSQN(rand, auts[1:]); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
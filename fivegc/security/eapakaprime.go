@@ -0,0 +1,111 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// EAPAKAPrimeAV is the transformed authentication vector generated by the UDM for EAP-AKA', defined in TS 33.501
+// clause 6.1.3.1.
+type EAPAKAPrimeAV struct {
+	RAND    []byte
+	AUTN    []byte
+	XRES    []byte
+	CKPrime []byte
+	IKPrime []byte
+}
+
+// EAPAKAPrimeKeys are the keys derived from CK' and IK' by the AUSF, defined in RFC 5448 clause 3.3.
+type EAPAKAPrimeKeys struct {
+	KEncr []byte
+	KAut  []byte
+	KRe   []byte
+	MSK   []byte
+	EMSK  []byte
+}
+
+// Lengths in bytes of the EAP-AKA' keys, defined in RFC 5448 clause 3.3.
+const (
+	kEncrLength = 16
+	kAutLength  = 32
+	kReLength   = 32
+	mskLength   = 64
+	emskLength  = 64
+)
+
+// GenerateEAPAKAPrimeAV generates the transformed authentication vector of the RAND and the SQN for the serving
+// network. The separation bit of the AMF must be set as required by TS 33.501 clause 6.1.3.1, otherwise an
+// ErrAMFSeparationBit is returned.
+func (m *Milenage) GenerateEAPAKAPrimeAV(rand, sqn, amf []byte, servingNetworkName string) (*EAPAKAPrimeAV, error) {
+	if err := checkSeparationBit(amf); err != nil {
+		return nil, err
+	}
+	autn, err := m.GenerateAUTN(rand, sqn, amf)
+	if err != nil {
+		return nil, err
+	}
+	res, ck, ik, _, err := m.F2345(rand)
+	if err != nil {
+		return nil, err
+	}
+	ckPrime, ikPrime, err := DeriveCKPrimeIKPrime(ck, ik, servingNetworkName, autn[:SQNLength])
+	if err != nil {
+		return nil, err
+	}
+	return &EAPAKAPrimeAV{RAND: rand, AUTN: autn, XRES: res, CKPrime: ckPrime, IKPrime: ikPrime}, nil
+}
+
+// DeriveCKPrimeIKPrime returns the CK' and IK' of EAP-AKA', derived from CK and IK as defined in TS 33.501 Annex A.3.
+// The network name is the serving network name. The SQN ⊕ AK is the first 6 bytes of the AUTN.
+func DeriveCKPrimeIKPrime(ck, ik []byte, networkName string, sqnXorAK []byte) (ckPrime, ikPrime []byte, err error) {
+	if err := checkCKIK(ck, ik); err != nil {
+		return nil, nil, err
+	}
+	if err := checkLength("SQN ⊕ AK", sqnXorAK, SQNLength); err != nil {
+		return nil, nil, err
+	}
+	out := KDF(append(append([]byte{}, ck...), ik...), FCCKPrimeIKPrime, []byte(networkName), sqnXorAK)
+	return out[:CKPrimeLength], out[CKPrimeLength:], nil
+}
+
+// DeriveEAPAKAPrimeKeys returns the keys of EAP-AKA' derived from the MK = PRF'(IK' || CK', "EAP-AKA'" || Identity),
+// defined in RFC 5448 clause 3.3. The identity is the SUPI in 5G.
+func DeriveEAPAKAPrimeKeys(ckPrime, ikPrime []byte, identity string) (*EAPAKAPrimeKeys, error) {
+	if err := checkLength("CK'", ckPrime, CKPrimeLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("IK'", ikPrime, IKPrimeLength); err != nil {
+		return nil, err
+	}
+	key := append(append([]byte{}, ikPrime...), ckPrime...)
+	mk := prfPrime(key, []byte("EAP-AKA'"+identity), kEncrLength+kAutLength+kReLength+mskLength+emskLength)
+	keys := &EAPAKAPrimeKeys{}
+	for _, k := range []struct {
+		key    *[]byte
+		length int
+	}{{&keys.KEncr, kEncrLength}, {&keys.KAut, kAutLength}, {&keys.KRe, kReLength}, {&keys.MSK, mskLength}, {&keys.EMSK, emskLength}} {
+		*k.key, mk = mk[:k.length], mk[k.length:]
+	}
+	return keys, nil
+}
+
+// KAUSF returns the KAUSF of EAP-AKA', the 256 most significant bits of the EMSK as defined in TS 33.501 clause
+// 6.1.3.1.
+func (k *EAPAKAPrimeKeys) KAUSF() []byte {
+	return k.EMSK[:KAUSFLength]
+}
+
+// prfPrime returns the first n bytes of PRF'(K, S) = T1 | T2 | ..., with T1 = HMAC-SHA-256(K, S | 0x01) and
+// Ti = HMAC-SHA-256(K, Ti-1 | S | i), defined in RFC 5448 clause 3.4.
+func prfPrime(key, s []byte, n int) []byte {
+	var out, t []byte
+	for i := byte(1); len(out) < n; i++ {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(t)
+		mac.Write(s)
+		mac.Write([]byte{i})
+		t = mac.Sum(nil)
+		out = append(out, t...)
+	}
+	return out[:n]
+}
@@ -0,0 +1,152 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+)
+
+// Milenage implements the MILENAGE authentication and key generation functions f1, f1*, f2, f3, f4, f5 and f5* of
+// TS 35.206 for a subscriber key K and an operator variant OPc.
+type Milenage struct {
+	block cipher.Block
+	opc   []byte
+}
+
+// Rotations and constants of the MILENAGE functions, defined in TS 35.206 clause 4.1.
+var (
+	milenageR = [5]int{64, 0, 32, 64, 96}
+	milenageC = [5]byte{0x00, 0x01, 0x02, 0x04, 0x08}
+)
+
+// NewMilenage returns the MILENAGE functions of the subscriber key K and the operator variant OP, from which OPc
+// is computed.
+func NewMilenage(k, op []byte) (*Milenage, error) {
+	opc, err := ComputeOPc(k, op)
+	if err != nil {
+		return nil, err
+	}
+	return NewMilenageOPc(k, opc)
+}
+
+// NewMilenageOPc returns the MILENAGE functions of the subscriber key K and the operator variant OPc, as stored in
+// the UDM or the USIM instead of OP.
+func NewMilenageOPc(k, opc []byte) (*Milenage, error) {
+	if err := checkLength("K", k, KeyLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("OPc", opc, KeyLength); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return &Milenage{block: block, opc: append([]byte(nil), opc...)}, nil
+}
+
+// ComputeOPc returns OPc = OP ⊕ E[OP]K, defined in TS 35.206 clause 4.1.
+func ComputeOPc(k, op []byte) ([]byte, error) {
+	if err := checkLength("K", k, KeyLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("OP", op, KeyLength); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	opc := make([]byte, KeyLength)
+	block.Encrypt(opc, op)
+	return xor(opc, op), nil
+}
+
+// OPc returns the operator variant OPc of the functions.
+func (m *Milenage) OPc() []byte {
+	return append([]byte(nil), m.opc...)
+}
+
+// F1 returns the network authentication code MAC-A of the RAND, the SQN and the AMF.
+func (m *Milenage) F1(rand, sqn, amf []byte) ([]byte, error) {
+	out, err := m.out1(rand, sqn, amf)
+	if err != nil {
+		return nil, err
+	}
+	return out[:MACLength], nil
+}
+
+// F1Star returns the resynchronisation authentication code MAC-S of the RAND, the SQN and the AMF.
+func (m *Milenage) F1Star(rand, sqn, amf []byte) ([]byte, error) {
+	out, err := m.out1(rand, sqn, amf)
+	if err != nil {
+		return nil, err
+	}
+	return out[MACLength:], nil
+}
+
+// F2345 returns the response RES, the confidentiality key CK, the integrity key IK and the anonymity key AK of the
+// RAND.
+func (m *Milenage) F2345(rand []byte) (res, ck, ik, ak []byte, err error) {
+	temp, err := m.temp(rand)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	out2 := m.out(temp, 1)
+	return out2[8:], m.out(temp, 2), m.out(temp, 3), out2[:AKLength], nil
+}
+
+// F5Star returns the resynchronisation anonymity key AK* of the RAND.
+func (m *Milenage) F5Star(rand []byte) ([]byte, error) {
+	temp, err := m.temp(rand)
+	if err != nil {
+		return nil, err
+	}
+	return m.out(temp, 4)[:AKLength], nil
+}
+
+// temp returns TEMP = E[RAND ⊕ OPc]K.
+func (m *Milenage) temp(rand []byte) ([]byte, error) {
+	if err := checkLength("RAND", rand, RANDLength); err != nil {
+		return nil, err
+	}
+	temp := xor(rand, m.opc)
+	m.block.Encrypt(temp, temp)
+	return temp, nil
+}
+
+// out1 returns OUT1 = E[TEMP ⊕ rot(IN1 ⊕ OPc, r1) ⊕ c1]K ⊕ OPc, with IN1 = SQN || AMF || SQN || AMF.
+func (m *Milenage) out1(rand, sqn, amf []byte) ([]byte, error) {
+	if err := checkLength("SQN", sqn, SQNLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("AMF", amf, AMFLength); err != nil {
+		return nil, err
+	}
+	temp, err := m.temp(rand)
+	if err != nil {
+		return nil, err
+	}
+	in1 := append(append(append(append([]byte{}, sqn...), amf...), sqn...), amf...)
+	in := xor(temp, rotate(xor(in1, m.opc), milenageR[0]))
+	in[KeyLength-1] ^= milenageC[0]
+	m.block.Encrypt(in, in)
+	return xor(in, m.opc), nil
+}
+
+// out returns OUTi = E[rot(TEMP ⊕ OPc, ri) ⊕ ci]K ⊕ OPc, for i from 2 to 5.
+func (m *Milenage) out(temp []byte, i int) []byte {
+	in := rotate(xor(temp, m.opc), milenageR[i])
+	in[KeyLength-1] ^= milenageC[i]
+	m.block.Encrypt(in, in)
+	return xor(in, m.opc)
+}
+
+// rotate returns the cyclic rotation of the 128 bits of x by r bits towards the most significant bit, r being a
+// multiple of 8.
+func rotate(x []byte, r int) []byte {
+	out := make([]byte, len(x))
+	for i := range x {
+		out[i] = x[(i+r/8)%len(x)]
+	}
+	return out
+}
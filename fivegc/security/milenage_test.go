@@ -0,0 +1,97 @@
+package security
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestMilenage(t *testing.T) {
+	// Test sets 1 to 3 of TS 35.208 clause 4.
+	tests := []struct {
+		k, rand, sqn, amf, op, opc         string
+		f1, f1Star, f2, f5, f3, f4, f5Star string
+	}{
+		{
+			k: "465b5ce8b199b49faa5f0a2ee238a6bc", rand: "23553cbe9637a89d218ae64dae47bf35", sqn: "ff9bb4d0b607", amf: "b9b9",
+			op: "cdc202d5123e20f62b6d676ac72cb318", opc: "cd63cb71954a9f4e48a5994e37a02baf",
+			f1: "4a9ffac354dfafb3", f1Star: "01cfaf9ec4e871e9", f2: "a54211d5e3ba50bf", f5: "aa689c648370",
+			f3: "b40ba9a3c58b2a05bbf0d987b21bf8cb", f4: "f769bcd751044604127672711c6d3441", f5Star: "451e8beca43b",
+		},
+		{
+			k: "0396eb317b6d1c36f19c1c84cd6ffd16", rand: "c00d603103dcee52c4478119494202e8", sqn: "fd8eef40df7d", amf: "af17",
+			op: "ff53bade17df5d4e793073ce9d7579fa", opc: "53c15671c60a4b731c55b4a441c0bde2",
+			f1: "5df5b31807e258b0", f1Star: "a8c016e51ef4a343", f2: "d3a628ed988620f0", f5: "c47783995f72",
+			f3: "58c433ff7a7082acd424220f2b67c556", f4: "21a8c1f929702adb3e738488b9f5c5da", f5Star: "30f1197061c1",
+		},
+		{
+			k: "fec86ba6eb707ed08905757b1bb44b8f", rand: "9f7c8d021accf4db213ccff0c7f71a6a", sqn: "9d0277595ffc", amf: "725c",
+			op: "dbc59adcb6f9a0ef735477b7fadf8374", opc: "1006020f0a478bf6b699f15c062e42b3",
+			f1: "9cabc3e99baf7281", f1Star: "95814ba2b3044324", f2: "8011c48c0c214ed2", f5: "33484dc2136b",
+			f3: "5dbdbb2954e8f3cde665b046179a5098", f4: "59a92d3b476a0443487055cf88b2307b", f5Star: "deacdd848cc6",
+		},
+	}
+	for i, test := range tests {
+		m, err := NewMilenage(mustDecodeHex(t, test.k), mustDecodeHex(t, test.op))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if opc := hex.EncodeToString(m.OPc()); opc != test.opc {
+			t.Errorf("test set %d: OPc is %s, expected %s", i+1, opc, test.opc)
+		}
+		rand, sqn, amf := mustDecodeHex(t, test.rand), mustDecodeHex(t, test.sqn), mustDecodeHex(t, test.amf)
+		macA, err := m.F1(rand, sqn, amf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		macS, err := m.F1Star(rand, sqn, amf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res, ck, ik, ak, err := m.F2345(rand)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		akStar, err := m.F5Star(rand)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		outputs := []struct {
+			name     string
+			out      []byte
+			expected string
+		}{
+			{"f1", macA, test.f1}, {"f1*", macS, test.f1Star}, {"f2", res, test.f2}, {"f3", ck, test.f3},
+			{"f4", ik, test.f4}, {"f5", ak, test.f5}, {"f5*", akStar, test.f5Star},
+		}
+		for _, output := range outputs {
+			if hex.EncodeToString(output.out) != output.expected {
+				t.Errorf("test set %d: %s is %x, expected %s", i+1, output.name, output.out, output.expected)
+			}
+		}
+		withOPc, err := NewMilenageOPc(mustDecodeHex(t, test.k), mustDecodeHex(t, test.opc))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if macA, _ := withOPc.F1(rand, sqn, amf); hex.EncodeToString(macA) != test.f1 {
+			t.Errorf("test set %d: f1 with OPc is %x, expected %s", i+1, macA, test.f1)
+		}
+	}
+
+	if _, err := NewMilenage(make([]byte, 15), make([]byte, 16)); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	m, _ := NewMilenageOPc(make([]byte, 16), make([]byte, 16))
+	if _, err := m.F1(make([]byte, 16), make([]byte, 5), make([]byte, 2)); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package security
+
+import (
+	"encoding/hex"
+	"fmt"
+	nausfueauth "github.com/5GCoreNet/openapi/openapi_Nausf_UEAuthentication"
+	nudmueau "github.com/5GCoreNet/openapi/openapi_Nudm_UEAU"
+)
+
+// The keys and the parameters of the NUDM and NAUSF UEAuthentication services are hexadecimal strings, defined in
+// TS 29.503 clause 6.3.6.3.2 and TS 29.509 clause 6.1.6.3.2.
+
+// AuthenticationVector returns the 5G HE AV in the NUDM UEAuthentication format, as returned by the UDM in the
+// GenerateAuthData response.
+func (v *HEAV) AuthenticationVector() nudmueau.AuthenticationVector {
+	av := nudmueau.AuthenticationVector{
+		AvType: "5G_HE_AKA",
+		Rand:   hex.EncodeToString(v.RAND),
+		Autn:   hex.EncodeToString(v.AUTN),
+	}
+	av.SetXresStar(hex.EncodeToString(v.XRESStar))
+	av.SetKausf(hex.EncodeToString(v.KAUSF))
+	return av
+}
+
+// AuthenticationVector returns the transformed authentication vector in the NUDM UEAuthentication format, as
+// returned by the UDM in the GenerateAuthData response.
+func (v *EAPAKAPrimeAV) AuthenticationVector() nudmueau.AuthenticationVector {
+	av := nudmueau.AuthenticationVector{
+		AvType: "EAP_AKA_PRIME",
+		Rand:   hex.EncodeToString(v.RAND),
+		Autn:   hex.EncodeToString(v.AUTN),
+	}
+	av.SetXres(hex.EncodeToString(v.XRES))
+	av.SetCkPrime(hex.EncodeToString(v.CKPrime))
+	av.SetIkPrime(hex.EncodeToString(v.IKPrime))
+	return av
+}
+
+// NewAv5gAka returns the 5G SE AV sent by the AUSF to the SEAF in the Authenticate response, and the KSEAF sent
+// once the UE is authenticated, from the 5G HE AV received from the UDM (TS 33.501 clause 6.1.3.2).
+func NewAv5gAka(av nudmueau.AuthenticationVector, servingNetworkName string) (nausfueauth.Av5gAka, []byte, error) {
+	rand, err := decodeHex("rand", av.Rand)
+	if err != nil {
+		return nausfueauth.Av5gAka{}, nil, err
+	}
+	xresStar, err := decodeHex("xresStar", av.GetXresStar())
+	if err != nil {
+		return nausfueauth.Av5gAka{}, nil, err
+	}
+	kausf, err := decodeHex("kausf", av.GetKausf())
+	if err != nil {
+		return nausfueauth.Av5gAka{}, nil, err
+	}
+	hxresStar, err := DeriveHXRESStar(rand, xresStar)
+	if err != nil {
+		return nausfueauth.Av5gAka{}, nil, err
+	}
+	kseaf, err := DeriveKSEAF(kausf, servingNetworkName)
+	if err != nil {
+		return nausfueauth.Av5gAka{}, nil, err
+	}
+	return nausfueauth.Av5gAka{Rand: av.Rand, HxresStar: hex.EncodeToString(hxresStar), Autn: av.Autn}, kseaf, nil
+}
+
+// ConfirmResStar reports whether the RES* of the Confirm5GAKA request is the XRES* of the 5G HE AV received from
+// the UDM.
+func ConfirmResStar(av nudmueau.AuthenticationVector, data nausfueauth.ConfirmationData) bool {
+	xresStar, err := decodeHex("xresStar", av.GetXresStar())
+	if err != nil {
+		return false
+	}
+	resStar, err := decodeHex("resStar", data.ResStar)
+	if err != nil {
+		return false
+	}
+	return VerifyRESStar(xresStar, resStar)
+}
+
+// Resynchronize returns the SQNMS of the resynchronization info of a GenerateAuthData request.
+// An ErrMACFailure is returned when the MAC-S of the AUTS is not valid.
+func (m *Milenage) Resynchronize(info nudmueau.ResynchronizationInfo) ([]byte, error) {
+	rand, err := decodeHex("rand", info.Rand)
+	if err != nil {
+		return nil, err
+	}
+	auts, err := decodeHex("auts", info.Auts)
+	if err != nil {
+		return nil, err
+	}
+	return m.ResynchronizeSQN(rand, auts)
+}
+
+// decodeHex decodes a hexadecimal attribute of the openapi models.
+func decodeHex(name, s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("security: invalid %s: %w", name, err)
+	}
+	return b, nil
+}
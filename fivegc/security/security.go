@@ -0,0 +1,123 @@
+// Package security implements the authentication algorithms of the 5GC: the MILENAGE algorithm set of TS 35.206 and
+// the key derivations of 5G AKA and EAP-AKA' defined in TS 33.501 Annex A, as used by the UDM and the AUSF in the
+// NUDM and NAUSF UEAuthentication services.
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidLength is returned when a key or a parameter does not have the length of its definition.
+var ErrInvalidLength = errors.New("security: invalid length")
+
+// ErrMACFailure is returned when the MAC of a message received from the UE is not the expected one.
+var ErrMACFailure = errors.New("security: MAC failure")
+
+// ErrAMFSeparationBit is returned when the separation bit of the AMF of a 5G authentication vector is not set.
+var ErrAMFSeparationBit = errors.New("security: AMF separation bit not set")
+
+// Lengths in bytes of the keys and the parameters, defined in TS 33.102 clause 6.3.7 and TS 33.501 Annex A.
+const (
+	KeyLength       = 16
+	RANDLength      = 16
+	SQNLength       = 6
+	AMFLength       = 2
+	MACLength       = 8
+	RESLength       = 8
+	AKLength        = 6
+	AUTNLength      = SQNLength + AMFLength + MACLength
+	AUTSLength      = SQNLength + MACLength
+	RESStarLength   = 16
+	KAUSFLength     = 32
+	KSEAFLength     = 32
+	KAMFLength      = 32
+	ABBALength      = 2
+	CKPrimeLength   = 16
+	IKPrimeLength   = 16
+	HXRESStarLength = 16
+)
+
+// Function codes of the key derivations, defined in TS 33.220 Annex A and TS 33.501 Annex A.
+const (
+	FCCKPrimeIKPrime = 0x20
+	FCKAUSF          = 0x6a
+	FCRESStar        = 0x6b
+	FCKSEAF          = 0x6c
+	FCKAMF           = 0x6d
+)
+
+// KDF is the generic key derivation function of TS 33.220 Annex B.2. It returns the HMAC-SHA-256 of
+// S = FC || P0 || L0 || P1 || L1 ..., where Li is the length of Pi on two bytes.
+func KDF(key []byte, fc byte, params ...[]byte) []byte {
+	s := []byte{fc}
+	for _, p := range params {
+		s = append(s, p...)
+		s = append(s, byte(len(p)>>8), byte(len(p)))
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(s)
+	return mac.Sum(nil)
+}
+
+// ServingNetworkName returns the serving network name of a PLMN, defined in TS 24.501 clause 9.12.1, such as
+// "5G:mnc093.mcc208.3gppnetwork.org". The two digits MNCs are padded with a zero.
+func ServingNetworkName(mcc, mnc string) string {
+	if len(mnc) == 2 {
+		mnc = "0" + mnc
+	}
+	return fmt.Sprintf("5G:mnc%s.mcc%s.3gppnetwork.org", mnc, mcc)
+}
+
+// GenerateRAND returns a random challenge generated with crypto/rand.
+func GenerateRAND() ([]byte, error) {
+	b := make([]byte, RANDLength)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// checkLength returns an ErrInvalidLength if the parameter is not n bytes long.
+func checkLength(name string, b []byte, n int) error {
+	if len(b) != n {
+		return fmt.Errorf("%w: %s of %d bytes instead of %d", ErrInvalidLength, name, len(b), n)
+	}
+	return nil
+}
+
+// checkSeparationBit returns an ErrAMFSeparationBit if the separation bit of the AMF, its most significant bit, is not
+// set (TS 33.102 Annex H).
+func checkSeparationBit(amf []byte) error {
+	if err := checkLength("AMF", amf, AMFLength); err != nil {
+		return err
+	}
+	if amf[0]&0x80 == 0 {
+		return ErrAMFSeparationBit
+	}
+	return nil
+}
+
+// xor returns a ⊕ b, a and b having the same length.
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
+
+// supiValue returns the SUPI without its "imsi-" or "nai-" type prefix, as used in the key derivations of
+// TS 33.501 Annex A.7.
+func supiValue(supi string) string {
+	for _, prefix := range []string{"imsi-", "nai-"} {
+		if strings.HasPrefix(supi, prefix) {
+			return strings.TrimPrefix(supi, prefix)
+		}
+	}
+	return supi
+}
@@ -7,5 +7,7 @@ import (
 
 type CommonInterface interface {
 	// Error returns a problem details, it is used to handle errors when unmarshalling the request.
+	// The error is a *BindingError holding the default ProblemDetails built by the SDK; every field set
+	// in the returned ProblemDetails overrides the default one, so an empty ProblemDetails keeps the default.
 	Error(ctx context.Context, err error) openapicommon.ProblemDetails
 }
@@ -0,0 +1,43 @@
+// Package apitest sends requests to the handlers of the network functions in their tests, as a consumer would.
+package apitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Send sends a request to the handler and returns the recorded response. The target is either a path or an absolute
+// URI whose authority is then the one of the request, such as the URL of an httptest.Server serving the handler.
+// The body is sent as is when it is a string or a []byte, and JSON encoded otherwise, unless nil. It is sent with the
+// application/json content type, unless the header sets another one.
+func Send(t testing.TB, handler http.Handler, method, target string, header http.Header, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader io.Reader
+	switch body := body.(type) {
+	case nil:
+	case string:
+		reader = bytes.NewReader([]byte(body))
+	case []byte:
+		reader = bytes.NewReader(body)
+	default:
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if reader != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
@@ -0,0 +1,42 @@
+package apitest
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      http.Header
+		body        interface{}
+		contentType string
+		expected    string
+	}{
+		{name: "no body"},
+		{name: "json", body: map[string]string{"supi": "imsi-208930000000001"}, contentType: "application/json", expected: `{"supi":"imsi-208930000000001"}`},
+		{name: "string", body: `{}`, contentType: "application/json", expected: `{}`},
+		{
+			name:        "content type",
+			header:      http.Header{"Content-Type": {"multipart/related; boundary=b"}},
+			body:        []byte("--b--"),
+			contentType: "multipart/related; boundary=b",
+			expected:    "--b--",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				if r.Host != "amf.example.com" || r.Header.Get("Content-Type") != tt.contentType || string(b) != tt.expected {
+					t.Errorf("unexpected request %s %s %s", r.Host, r.Header.Get("Content-Type"), b)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+			if w := Send(t, handler, http.MethodPost, "http://amf.example.com/namf-comm/v1/subscriptions", tt.header, tt.body); w.Code != http.StatusNoContent {
+				t.Errorf("unexpected response %d", w.Code)
+			}
+		})
+	}
+}
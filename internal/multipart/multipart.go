@@ -0,0 +1,202 @@
+// Package multipart encodes and decodes the multipart/related bodies (RFC 2387) of the service based interfaces,
+// whose root part is the JSON data of the request, and whose other parts are binary data, such as NAS or NGAP
+// messages, referenced from the JSON data by their Content-Id (TS 29.500 clause 5.4.2).
+package multipart
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/5GCoreNet/5GCoreNetSDK/internal/response"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// ContentType is the media type of the multipart bodies.
+const ContentType = "multipart/related"
+
+// ErrMediaType is returned when decoding a body which is neither JSON nor multipart/related. It is
+// fivegc.ErrMediaType, so the binding errors wrapping it are answered with 415 Unsupported Media Type.
+var ErrMediaType = fivegc.ErrMediaType
+
+// Part is a binary part of a multipart body.
+type Part struct {
+	ContentID   string
+	ContentType string
+	Body        []byte
+}
+
+// Encode returns the body and the content type of a request with its JSON root and binary parts.
+// Without binary part, the body is the JSON root alone.
+func Encode(root interface{}, parts []Part) ([]byte, string, error) {
+	data, err := json.Marshal(root)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(parts) == 0 {
+		return data, "application/json", nil
+	}
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", "application/json")
+	p, err := w.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := p.Write(data); err != nil {
+		return nil, "", err
+	}
+	for _, part := range parts {
+		if part.ContentID == "" {
+			return nil, "", fmt.Errorf("multipart: binary part without Content-Id")
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("Content-Id", part.ContentID)
+		p, err := w.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := p.Write(part.Body); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	contentType := mime.FormatMediaType(ContentType, map[string]string{"boundary": w.Boundary(), "type": "application/json"})
+	return body.Bytes(), contentType, nil
+}
+
+// Decode decodes the JSON root of a body into root, and returns its binary parts.
+// The root part is the one given by the start parameter, or else the first part.
+func Decode(contentType string, body []byte, root interface{}) ([]Part, error) {
+	data, parts, err := split(contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return parts, json.Unmarshal(data, root)
+}
+
+// Bind decodes a JSON or multipart/related request body into root, and returns its binary parts.
+// The JSON root is kept in the context under gin.BodyBytesKey, as gin.Context.ShouldBindBodyWith does, for
+// fivegc.BindingProblemDetails to point at a missing attribute.
+func Bind(c *gin.Context, root interface{}) ([]Part, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	data, parts, err := split(c.GetHeader("Content-Type"), body)
+	if err != nil {
+		return nil, err
+	}
+	c.Set(gin.BodyBytesKey, data)
+	return parts, json.Unmarshal(data, root)
+}
+
+// split returns the JSON root and the binary parts of a body.
+func split(contentType string, body []byte) ([]byte, []Part, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrMediaType, err)
+	}
+	switch mediaType {
+	case "application/json":
+		return body, nil, nil
+	case ContentType:
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrMediaType, mediaType)
+	}
+	r := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	var parts []Part
+	var data []byte
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		b, err := io.ReadAll(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		id := p.Header.Get("Content-Id")
+		if data == nil && (params["start"] == "" || params["start"] == id) {
+			data = b
+			continue
+		}
+		parts = append(parts, Part{ContentID: id, ContentType: p.Header.Get("Content-Type"), Body: b})
+	}
+	if data == nil {
+		return nil, nil, fmt.Errorf("multipart: missing root part")
+	}
+	return data, parts, nil
+}
+
+// Render writes root, and its binary parts if any, as a JSON or multipart/related response body.
+func Render(c *gin.Context, logger *log.Logger, status int, root interface{}, parts []Part) {
+	body, contentType, err := Encode(root, parts)
+	if err != nil {
+		response.InvalidResponse(c, logger, err)
+		return
+	}
+	c.Data(status, contentType, body)
+}
+
+// RenderError writes the error data of an error response, such as a SmContextCreateError, whose error attribute
+// dataError is set from the ProblemDetails, along with its binary parts. The ProblemDetails is converted through its
+// JSON encoding, as the error data of a service embed the ProblemDetails of its openapi package.
+func RenderError(c *gin.Context, logger *log.Logger, status int, problemDetails openapicommon.ProblemDetails, data, dataError interface{}, parts []Part) {
+	if problemDetails.Status == nil {
+		problemDetails.Status = fivegc.ToInt32(int32(status))
+	}
+	b, err := json.Marshal(problemDetails)
+	if err == nil {
+		err = json.Unmarshal(b, dataError)
+	}
+	if err != nil {
+		response.InvalidResponse(c, logger, fmt.Errorf("convert problem details: %w", err))
+		return
+	}
+	Render(c, logger, status, data, parts)
+}
+
+// DecodeError decodes the body of an error response into data when it carries an error attribute, such as a
+// SmContextCreateError rather than a ProblemDetails, and returns the ProblemDetails of the error attribute along with
+// the binary parts.
+func DecodeError(resp *http.Response, raw []byte, data interface{}) (fivegc.ProblemDetailsError, []Part, bool) {
+	var probe struct {
+		Error *openapicommon.ProblemDetails `json:"error"`
+	}
+	if _, err := Decode(resp.Header.Get("Content-Type"), raw, &probe); err != nil || probe.Error == nil {
+		return fivegc.ProblemDetailsError{}, nil, false
+	}
+	parts, err := Decode(resp.Header.Get("Content-Type"), raw, data)
+	if err != nil {
+		return fivegc.ProblemDetailsError{}, nil, false
+	}
+	if probe.Error.Status == nil {
+		probe.Error.Status = fivegc.ToInt32(int32(resp.StatusCode))
+	}
+	return fivegc.ProblemDetailsError(*probe.Error), parts, true
+}
+
+// Find returns the part with the content ID.
+func Find(parts []Part, contentID string) (Part, bool) {
+	for _, part := range parts {
+		if part.ContentID == contentID {
+			return part, true
+		}
+	}
+	return Part{}, false
+}
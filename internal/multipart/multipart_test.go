@@ -0,0 +1,87 @@
+package multipart
+
+import (
+	"bytes"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	type data struct {
+		ContentID string `json:"contentId"`
+	}
+	parts := []Part{
+		{ContentID: "n1msg", ContentType: "application/vnd.3gpp.5gnas", Body: []byte{0x7e, 0x00}},
+		{ContentID: "n2msg", ContentType: "application/vnd.3gpp.ngap", Body: []byte{0x00, 0x05}},
+	}
+	tests := map[string][]Part{"json": nil, "multipart": parts}
+	for name, parts := range tests {
+		t.Run(name, func(t *testing.T) {
+			body, contentType, err := Encode(data{ContentID: "n1msg"}, parts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var root data
+			decoded, err := Decode(contentType, body, &root)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if root.ContentID != "n1msg" || !reflect.DeepEqual(decoded, parts) {
+				t.Errorf("decoded as %+v %+v", root, decoded)
+			}
+		})
+	}
+	if part, ok := Find(parts, "n2msg"); !ok || part.Body[1] != 0x05 {
+		t.Errorf("unexpected part %+v", part)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var root map[string]interface{}
+	if _, err := Decode("text/plain", nil, &root); !errors.Is(err, ErrMediaType) {
+		t.Errorf("unexpected error %v", err)
+	}
+	body := "--b\r\nContent-Type: application/vnd.3gpp.5gnas\r\nContent-Id: n1msg\r\n\r\n\x7e\r\n--b--\r\n"
+	if _, err := Decode("multipart/related; boundary=b; start=root", []byte(body), &root); err == nil {
+		t.Error("expected an error without root part")
+	}
+}
+
+func TestBindRender(t *testing.T) {
+	parts := []Part{{ContentID: "n2msg", ContentType: "application/vnd.3gpp.ngap", Body: []byte{0x00, 0x05}}}
+	body, contentType, err := Encode(map[string]string{"ngapData": "n2msg"}, parts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/n2", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", contentType)
+	var root map[string]string
+	bound, err := Bind(c, &root)
+	if err != nil || root["ngapData"] != "n2msg" || !reflect.DeepEqual(bound, parts) {
+		t.Fatalf("bound as %+v %+v: %v", root, bound, err)
+	}
+	if data, _ := c.Get(gin.BodyBytesKey); string(data.([]byte)) != `{"ngapData":"n2msg"}` {
+		t.Errorf("unexpected body bytes %s", data)
+	}
+
+	Render(c, log.New(io.Discard, "", 0), http.StatusOK, root, bound)
+	if recorder.Code != http.StatusOK || !strings.HasPrefix(recorder.Header().Get("Content-Type"), ContentType) {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	recorder = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/n2", nil)
+	Render(c, log.New(io.Discard, "", 0), http.StatusOK, root, []Part{{Body: []byte{0x00}}})
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("a binary part without Content-Id should be rejected, got %d", recorder.Code)
+	}
+}
@@ -0,0 +1,323 @@
+package per
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrTruncated is returned when an encoding ends before the value being decoded.
+var ErrTruncated = errors.New("per: truncated encoding")
+
+// Decoder reads a PER encoding.
+type Decoder struct {
+	aligned bool
+	buf     []byte
+	pos     int
+}
+
+// NewDecoder creates a new decoder of b, of the ALIGNED variant when aligned is true.
+func NewDecoder(b []byte, aligned bool) *Decoder {
+	return &Decoder{aligned: aligned, buf: b}
+}
+
+// ReadBits reads n bits, most significant first.
+func (d *Decoder) ReadBits(n int) (uint64, error) {
+	if n > 64 {
+		return 0, fmt.Errorf("%w: %d bits field", ErrUnsupported, n)
+	}
+	if d.pos+n > 8*len(d.buf) {
+		return 0, ErrTruncated
+	}
+	var v uint64
+	for i := 0; i < n; i++ {
+		v <<= 1
+		if d.buf[d.pos/8]&(0x80>>(d.pos%8)) != 0 {
+			v |= 1
+		}
+		d.pos++
+	}
+	return v, nil
+}
+
+// Align skips the padding up to the next octet boundary in the ALIGNED variant.
+func (d *Decoder) Align() {
+	if d.aligned && d.pos%8 != 0 {
+		d.pos += 8 - d.pos%8
+	}
+}
+
+// ReadBool reads a BOOLEAN, also used for the extension and presence bits.
+func (d *Decoder) ReadBool() (bool, error) {
+	v, err := d.ReadBits(1)
+	return v == 1, err
+}
+
+// ReadConstrainedInt reads an INTEGER (lb..ub).
+func (d *Decoder) ReadConstrainedInt(lb, ub int64) (int64, error) {
+	n, err := d.readConstrainedWholeNumber(uint64(ub - lb))
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(ub-lb) {
+		return 0, fmt.Errorf("per: %d out of [%d, %d]", int64(n)+lb, lb, ub)
+	}
+	return int64(n) + lb, nil
+}
+
+// ReadExtensibleInt reads an INTEGER (lb..ub, ...).
+func (d *Decoder) ReadExtensibleInt(lb, ub int64) (int64, error) {
+	extended, err := d.ReadBool()
+	if err != nil {
+		return 0, err
+	}
+	if extended {
+		return d.ReadUnconstrainedInt()
+	}
+	return d.ReadConstrainedInt(lb, ub)
+}
+
+// ReadSemiConstrainedInt reads an INTEGER (lb..MAX).
+func (d *Decoder) ReadSemiConstrainedInt(lb int64) (int64, error) {
+	size, err := d.ReadLength(0, Unbounded)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 || size > 8 {
+		return 0, fmt.Errorf("%w: %d octets integer", ErrUnsupported, size)
+	}
+	d.Align()
+	n, err := d.ReadBits(8 * size)
+	return int64(n) + lb, err
+}
+
+// ReadUnconstrainedInt reads an INTEGER without constraint.
+func (d *Decoder) ReadUnconstrainedInt() (int64, error) {
+	size, err := d.ReadLength(0, Unbounded)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 || size > 8 {
+		return 0, fmt.Errorf("%w: %d octets integer", ErrUnsupported, size)
+	}
+	d.Align()
+	n, err := d.ReadBits(8 * size)
+	// The value is sign extended from its size.
+	return int64(n<<(64-8*uint(size))) >> (64 - 8*uint(size)), err
+}
+
+func (d *Decoder) readConstrainedWholeNumber(max uint64) (uint64, error) {
+	if max == 0 {
+		return 0, nil
+	}
+	if !d.aligned || max < 255 {
+		return d.ReadBits(bits.Len64(max))
+	}
+	switch {
+	case max == 255:
+		d.Align()
+		return d.ReadBits(8)
+	case max < 65536:
+		d.Align()
+		return d.ReadBits(16)
+	default:
+		maxSize := (bits.Len64(max) + 7) / 8
+		size, err := d.ReadBits(bits.Len64(uint64(maxSize - 1)))
+		if err != nil {
+			return 0, err
+		}
+		d.Align()
+		return d.ReadBits(8 * (int(size) + 1))
+	}
+}
+
+// ReadNormallySmall reads a normally small non-negative whole number.
+func (d *Decoder) ReadNormallySmall() (int, error) {
+	large, err := d.ReadBool()
+	if err != nil {
+		return 0, err
+	}
+	if large {
+		return 0, fmt.Errorf("%w: normally small number above 63", ErrUnsupported)
+	}
+	n, err := d.ReadBits(6)
+	return int(n), err
+}
+
+// ReadLength reads a length determinant in [lb, ub], ub being Unbounded for an unconstrained length.
+func (d *Decoder) ReadLength(lb, ub int) (int, error) {
+	if ub != Unbounded && ub < 65536 {
+		n, err := d.readConstrainedWholeNumber(uint64(ub - lb))
+		if err != nil {
+			return 0, err
+		}
+		if int(n)+lb > ub {
+			return 0, fmt.Errorf("per: length %d out of [%d, %d]", int(n)+lb, lb, ub)
+		}
+		return int(n) + lb, nil
+	}
+	d.Align()
+	first, err := d.ReadBits(8)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case first&0x80 == 0:
+		return int(first), nil
+	case first&0x40 == 0:
+		second, err := d.ReadBits(8)
+		return int(first&0x3f)<<8 | int(second), err
+	default:
+		return 0, fmt.Errorf("%w: fragmented length", ErrUnsupported)
+	}
+}
+
+// ReadEnumerated reads the index of an ENUMERATED of count root values. The indexes of the extension values follow
+// the root ones.
+func (d *Decoder) ReadEnumerated(count int, extensible bool) (int, error) {
+	if extensible {
+		extended, err := d.ReadBool()
+		if err != nil {
+			return 0, err
+		}
+		if extended {
+			n, err := d.ReadNormallySmall()
+			return count + n, err
+		}
+	}
+	n, err := d.readConstrainedWholeNumber(uint64(count - 1))
+	if err != nil {
+		return 0, err
+	}
+	if int(n) >= count {
+		return 0, fmt.Errorf("per: enumerated %d out of [0, %d]", n, count-1)
+	}
+	return int(n), nil
+}
+
+// ReadChoice reads the index of a CHOICE alternative among count root alternatives. The value of an extension
+// alternative is returned as the content of its open type, the value of a root alternative follows in d.
+func (d *Decoder) ReadChoice(count int, extensible bool) (int, []byte, error) {
+	if extensible {
+		extended, err := d.ReadBool()
+		if err != nil {
+			return 0, nil, err
+		}
+		if extended {
+			n, err := d.ReadNormallySmall()
+			if err != nil {
+				return 0, nil, err
+			}
+			value, err := d.ReadOpenType()
+			return count + n, value, err
+		}
+	}
+	n, err := d.readConstrainedWholeNumber(uint64(count - 1))
+	if err != nil {
+		return 0, nil, err
+	}
+	if int(n) >= count {
+		return 0, nil, fmt.Errorf("per: choice %d out of [0, %d]", n, count-1)
+	}
+	return int(n), nil, nil
+}
+
+// ReadBitString reads a BIT STRING (SIZE (lb..ub)), ub being Unbounded for an unconstrained size.
+func (d *Decoder) ReadBitString(lb, ub int, extensible bool) (BitString, error) {
+	if extensible {
+		extended, err := d.ReadBool()
+		if err != nil {
+			return BitString{}, err
+		}
+		if extended {
+			lb, ub = 0, Unbounded
+		}
+	}
+	length := lb
+	if lb != ub {
+		var err error
+		if length, err = d.ReadLength(lb, ub); err != nil {
+			return BitString{}, err
+		}
+	}
+	if length > 16 || (lb != ub && length > 0) {
+		d.Align()
+	}
+	b := NewBitString(length)
+	for i := 0; i < length; i++ {
+		set, err := d.ReadBool()
+		if err != nil {
+			return BitString{}, err
+		}
+		if set {
+			b.Bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b, nil
+}
+
+// ReadOctetString reads an OCTET STRING (SIZE (lb..ub)), ub being Unbounded for an unconstrained size.
+func (d *Decoder) ReadOctetString(lb, ub int, extensible bool) ([]byte, error) {
+	if extensible {
+		extended, err := d.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+		if extended {
+			lb, ub = 0, Unbounded
+		}
+	}
+	length := lb
+	if lb != ub {
+		var err error
+		if length, err = d.ReadLength(lb, ub); err != nil {
+			return nil, err
+		}
+	}
+	if length > 2 || (lb != ub && length > 0) {
+		d.Align()
+	}
+	if d.pos+8*length > 8*len(d.buf) {
+		return nil, ErrTruncated
+	}
+	b := make([]byte, length)
+	for i := range b {
+		o, _ := d.ReadBits(8)
+		b[i] = byte(o)
+	}
+	return b, nil
+}
+
+// ReadOpenType reads the encoding of an open type.
+func (d *Decoder) ReadOpenType() ([]byte, error) {
+	return d.ReadOctetString(0, Unbounded, false)
+}
+
+// ReadExtensions reads the extension additions of a SEQUENCE whose extension bit is set. The encodings of the
+// additions are returned in order, nil for the absent ones.
+func (d *Decoder) ReadExtensions() ([][]byte, error) {
+	n, err := d.ReadNormallySmall()
+	if err != nil {
+		return nil, err
+	}
+	present := make([]bool, n+1)
+	for i := range present {
+		if present[i], err = d.ReadBool(); err != nil {
+			return nil, err
+		}
+	}
+	additions := make([][]byte, n+1)
+	for i := range additions {
+		if present[i] {
+			if additions[i], err = d.ReadOpenType(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return additions, nil
+}
+
+// Sub returns a decoder of an open type encoding, of the same variant as d.
+func (d *Decoder) Sub(b []byte) *Decoder {
+	return NewDecoder(b, d.aligned)
+}
@@ -0,0 +1,337 @@
+// Package per implements the Packed Encoding Rules of ITU-T X.691, in the ALIGNED and UNALIGNED variants, as used by
+// the positioning protocols: LPP (TS 37.355) uses the UNALIGNED variant, NRPPa (TS 38.455) the ALIGNED one.
+// It provides the encoding of the ASN.1 building blocks; the types themselves are written by hand on top of it.
+// Lengths of 16K and above, which require fragmentation, are not supported.
+package per
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrUnsupported is returned for the encodings which are not supported by the package, e.g. fragmented lengths.
+var ErrUnsupported = errors.New("per: unsupported encoding")
+
+// Unbounded is the upper bound of an unconstrained length.
+const Unbounded = -1
+
+// BitString is an ASN.1 BIT STRING, whose first bit is the most significant bit of the first octet.
+type BitString struct {
+	Bytes  []byte
+	Length int
+}
+
+// At returns the bit i of the bit string.
+func (b BitString) At(i int) bool {
+	if i < 0 || i >= b.Length {
+		return false
+	}
+	return b.Bytes[i/8]&(0x80>>(i%8)) != 0
+}
+
+// NewBitString returns a bit string of length bits, with the listed bits set.
+func NewBitString(length int, set ...int) BitString {
+	b := BitString{Bytes: make([]byte, (length+7)/8), Length: length}
+	for _, i := range set {
+		if i >= 0 && i < length {
+			b.Bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b
+}
+
+// TrimNamed removes the trailing zero bits of a bit string with named bits, keeping at least min bits, as required by
+// X.691 clause 16.2.
+func (b BitString) TrimNamed(min int) BitString {
+	length := b.Length
+	for length > min && !b.At(length-1) {
+		length--
+	}
+	trimmed := NewBitString(length)
+	for i := 0; i < length; i++ {
+		if b.At(i) {
+			trimmed.Bytes[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return trimmed
+}
+
+// Encoder writes a PER encoding.
+type Encoder struct {
+	aligned bool
+	buf     []byte
+	bits    int
+}
+
+// NewEncoder creates a new encoder, of the ALIGNED variant when aligned is true.
+func NewEncoder(aligned bool) *Encoder {
+	return &Encoder{aligned: aligned}
+}
+
+// Bytes returns the complete encoding, padded to an octet boundary. An empty encoding is a single zero octet
+// (X.691 clause 11.1).
+func (e *Encoder) Bytes() []byte {
+	if e.bits == 0 {
+		return []byte{0}
+	}
+	return e.buf
+}
+
+// WriteBits writes the n lower bits of v, most significant first.
+func (e *Encoder) WriteBits(v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if e.bits%8 == 0 {
+			e.buf = append(e.buf, 0)
+		}
+		if v&(1<<uint(i)) != 0 {
+			e.buf[len(e.buf)-1] |= 0x80 >> (e.bits % 8)
+		}
+		e.bits++
+	}
+}
+
+// Align pads the encoding to an octet boundary in the ALIGNED variant.
+func (e *Encoder) Align() {
+	if e.aligned && e.bits%8 != 0 {
+		e.bits += 8 - e.bits%8
+	}
+}
+
+// WriteBool writes a BOOLEAN, also used for the extension and presence bits.
+func (e *Encoder) WriteBool(b bool) {
+	if b {
+		e.WriteBits(1, 1)
+	} else {
+		e.WriteBits(0, 1)
+	}
+}
+
+// WriteConstrainedInt writes an INTEGER (lb..ub).
+func (e *Encoder) WriteConstrainedInt(v, lb, ub int64) error {
+	if v < lb || v > ub {
+		return fmt.Errorf("per: %d out of [%d, %d]", v, lb, ub)
+	}
+	e.writeConstrainedWholeNumber(uint64(v-lb), uint64(ub-lb))
+	return nil
+}
+
+// WriteExtensibleInt writes an INTEGER (lb..ub, ...): the values out of the root are written unconstrained.
+func (e *Encoder) WriteExtensibleInt(v, lb, ub int64) error {
+	if v < lb || v > ub {
+		e.WriteBool(true)
+		return e.WriteUnconstrainedInt(v)
+	}
+	e.WriteBool(false)
+	return e.WriteConstrainedInt(v, lb, ub)
+}
+
+// WriteSemiConstrainedInt writes an INTEGER (lb..MAX).
+func (e *Encoder) WriteSemiConstrainedInt(v, lb int64) error {
+	if v < lb {
+		return fmt.Errorf("per: %d below %d", v, lb)
+	}
+	n := uint64(v - lb)
+	size := (bits.Len64(n) + 7) / 8
+	if size == 0 {
+		size = 1
+	}
+	if err := e.WriteLength(size, 0, Unbounded); err != nil {
+		return err
+	}
+	e.Align()
+	e.WriteBits(n, 8*size)
+	return nil
+}
+
+// WriteUnconstrainedInt writes an INTEGER without constraint, in two's complement.
+func (e *Encoder) WriteUnconstrainedInt(v int64) error {
+	size := 1
+	for size < 8 && (v < -(1<<(8*size-1)) || v >= 1<<(8*size-1)) {
+		size++
+	}
+	if err := e.WriteLength(size, 0, Unbounded); err != nil {
+		return err
+	}
+	e.Align()
+	e.WriteBits(uint64(v), 8*size)
+	return nil
+}
+
+// writeConstrainedWholeNumber writes n in [0, max] (X.691 clause 10.5).
+func (e *Encoder) writeConstrainedWholeNumber(n, max uint64) {
+	if max == 0 {
+		return
+	}
+	if !e.aligned || max < 255 {
+		e.WriteBits(n, bits.Len64(max))
+		return
+	}
+	switch {
+	case max == 255:
+		e.Align()
+		e.WriteBits(n, 8)
+	case max < 65536:
+		e.Align()
+		e.WriteBits(n, 16)
+	default:
+		// The value is written in the minimal number of octets, preceded by this number.
+		size := (bits.Len64(n) + 7) / 8
+		if size == 0 {
+			size = 1
+		}
+		maxSize := (bits.Len64(max) + 7) / 8
+		e.WriteBits(uint64(size-1), bits.Len64(uint64(maxSize-1)))
+		e.Align()
+		e.WriteBits(n, 8*size)
+	}
+}
+
+// WriteNormallySmall writes a normally small non-negative whole number, the index of an extension (X.691 clause 10.6).
+func (e *Encoder) WriteNormallySmall(n int) error {
+	if n < 0 || n > 63 {
+		return fmt.Errorf("%w: normally small number %d", ErrUnsupported, n)
+	}
+	e.WriteBits(uint64(n), 7)
+	return nil
+}
+
+// WriteLength writes a length determinant in [lb, ub], ub being Unbounded for an unconstrained length
+// (X.691 clause 10.9). Nothing is written for a fixed length.
+func (e *Encoder) WriteLength(n, lb, ub int) error {
+	if n < lb || (ub != Unbounded && n > ub) {
+		return fmt.Errorf("per: length %d out of [%d, %d]", n, lb, ub)
+	}
+	if ub != Unbounded && ub < 65536 {
+		e.writeConstrainedWholeNumber(uint64(n-lb), uint64(ub-lb))
+		return nil
+	}
+	e.Align()
+	switch {
+	case n < 128:
+		e.WriteBits(uint64(n), 8)
+	case n < 16384:
+		e.WriteBits(uint64(n)|0x8000, 16)
+	default:
+		return fmt.Errorf("%w: length %d requires fragmentation", ErrUnsupported, n)
+	}
+	return nil
+}
+
+// WriteEnumerated writes the index of an ENUMERATED of count root values. Indexes above the root are extension
+// values, which requires an extensible type.
+func (e *Encoder) WriteEnumerated(index, count int, extensible bool) error {
+	if index < 0 || (!extensible && index >= count) {
+		return fmt.Errorf("per: enumerated %d out of [0, %d]", index, count-1)
+	}
+	if extensible {
+		e.WriteBool(index >= count)
+		if index >= count {
+			return e.WriteNormallySmall(index - count)
+		}
+	}
+	e.writeConstrainedWholeNumber(uint64(index), uint64(count-1))
+	return nil
+}
+
+// WriteChoice writes the index of a CHOICE alternative among count root alternatives, and its value with encode.
+// Indexes above the root are extension alternatives, written as open types.
+func (e *Encoder) WriteChoice(index, count int, extensible bool, encode func(*Encoder) error) error {
+	if index < 0 || (!extensible && index >= count) {
+		return fmt.Errorf("per: choice %d out of [0, %d]", index, count-1)
+	}
+	if extensible {
+		e.WriteBool(index >= count)
+		if index >= count {
+			if err := e.WriteNormallySmall(index - count); err != nil {
+				return err
+			}
+			return e.WriteOpenType(encode)
+		}
+	}
+	e.writeConstrainedWholeNumber(uint64(index), uint64(count-1))
+	if encode == nil {
+		return nil
+	}
+	return encode(e)
+}
+
+// WriteBitString writes a BIT STRING (SIZE (lb..ub)), ub being Unbounded for an unconstrained size.
+func (e *Encoder) WriteBitString(b BitString, lb, ub int, extensible bool) error {
+	if extensible {
+		e.WriteBool(b.Length < lb || (ub != Unbounded && b.Length > ub))
+		if b.Length < lb || (ub != Unbounded && b.Length > ub) {
+			lb, ub = 0, Unbounded
+		}
+	}
+	if lb != ub {
+		if err := e.WriteLength(b.Length, lb, ub); err != nil {
+			return err
+		}
+	} else if b.Length != lb {
+		return fmt.Errorf("per: bit string of %d bits instead of %d", b.Length, lb)
+	}
+	if b.Length > 16 || (lb != ub && b.Length > 0) {
+		e.Align()
+	}
+	for i := 0; i < b.Length; i++ {
+		e.WriteBool(b.At(i))
+	}
+	return nil
+}
+
+// WriteOctetString writes an OCTET STRING (SIZE (lb..ub)), ub being Unbounded for an unconstrained size.
+func (e *Encoder) WriteOctetString(b []byte, lb, ub int, extensible bool) error {
+	if extensible {
+		e.WriteBool(len(b) < lb || (ub != Unbounded && len(b) > ub))
+		if len(b) < lb || (ub != Unbounded && len(b) > ub) {
+			lb, ub = 0, Unbounded
+		}
+	}
+	if lb != ub {
+		if err := e.WriteLength(len(b), lb, ub); err != nil {
+			return err
+		}
+	} else if len(b) != lb {
+		return fmt.Errorf("per: octet string of %d octets instead of %d", len(b), lb)
+	}
+	if len(b) > 2 || (lb != ub && len(b) > 0) {
+		e.Align()
+	}
+	for _, o := range b {
+		e.WriteBits(uint64(o), 8)
+	}
+	return nil
+}
+
+// WriteOpenType writes the complete encoding of a value as an open type, preceded by its length in octets
+// (X.691 clause 11.2).
+func (e *Encoder) WriteOpenType(encode func(*Encoder) error) error {
+	inner := NewEncoder(e.aligned)
+	if encode != nil {
+		if err := encode(inner); err != nil {
+			return err
+		}
+	}
+	return e.WriteOctetString(inner.Bytes(), 0, Unbounded, false)
+}
+
+// WriteExtensions writes the extension additions of a SEQUENCE, whose extension bit is set: nil additions are absent.
+// Each extension addition group is written as a SEQUENCE of its components.
+func (e *Encoder) WriteExtensions(additions []func(*Encoder) error) error {
+	if len(additions) == 0 || len(additions) > 64 {
+		return fmt.Errorf("%w: %d extension additions", ErrUnsupported, len(additions))
+	}
+	e.WriteBits(uint64(len(additions)-1), 7)
+	for _, addition := range additions {
+		e.WriteBool(addition != nil)
+	}
+	for _, addition := range additions {
+		if addition != nil {
+			if err := e.WriteOpenType(addition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
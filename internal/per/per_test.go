@@ -0,0 +1,159 @@
+package per
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestEncoding(t *testing.T) {
+	tests := []struct {
+		name    string
+		aligned bool
+		encode  func(*Encoder) error
+		decode  func(*Decoder) error
+		encoded string
+	}{
+		{
+			name:    "constrained integer",
+			encode:  func(e *Encoder) error { return e.WriteConstrainedInt(5, 0, 7) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { return d.ReadConstrainedInt(0, 7) }, 5),
+			encoded: "a0",
+		},
+		{
+			name:   "negative constrained integer",
+			encode: func(e *Encoder) error { return e.WriteConstrainedInt(-8388608, -8388608, 8388607) },
+			decode: expectInt(func(d *Decoder) (int64, error) {
+				return d.ReadConstrainedInt(-8388608, 8388607)
+			}, -8388608),
+			encoded: "000000",
+		},
+		{
+			name:    "aligned one octet integer",
+			aligned: true,
+			encode: func(e *Encoder) error {
+				e.WriteBool(true)
+				return e.WriteConstrainedInt(3, 0, 255)
+			},
+			decode: func(d *Decoder) error {
+				if _, err := d.ReadBool(); err != nil {
+					return err
+				}
+				return expectInt(func(d *Decoder) (int64, error) { return d.ReadConstrainedInt(0, 255) }, 3)(d)
+			},
+			encoded: "8003",
+		},
+		{
+			name:    "aligned two octets integer",
+			aligned: true,
+			encode:  func(e *Encoder) error { return e.WriteConstrainedInt(0x1234, 0, 65535) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { return d.ReadConstrainedInt(0, 65535) }, 0x1234),
+			encoded: "1234",
+		},
+		{
+			name:    "aligned indefinite length integer",
+			aligned: true,
+			encode:  func(e *Encoder) error { return e.WriteConstrainedInt(256, 0, 4294967295) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { return d.ReadConstrainedInt(0, 4294967295) }, 256),
+			encoded: "400100",
+		},
+		{
+			name:    "extensible integer out of the root",
+			aligned: true,
+			encode:  func(e *Encoder) error { return e.WriteExtensibleInt(-1, 0, 15) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { return d.ReadExtensibleInt(0, 15) }, -1),
+			encoded: "8001ff",
+		},
+		{
+			name:    "unconstrained length",
+			encode:  func(e *Encoder) error { return e.WriteLength(200, 0, Unbounded) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { n, err := d.ReadLength(0, Unbounded); return int64(n), err }, 200),
+			encoded: "80c8",
+		},
+		{
+			name:    "extension enumerated",
+			encode:  func(e *Encoder) error { return e.WriteEnumerated(5, 4, true) },
+			decode:  expectInt(func(d *Decoder) (int64, error) { n, err := d.ReadEnumerated(4, true); return int64(n), err }, 5),
+			encoded: "81",
+		},
+		{
+			name:   "named bit string",
+			encode: func(e *Encoder) error { return e.WriteBitString(NewBitString(8, 0, 2).TrimNamed(1), 1, 8, false) },
+			decode: func(d *Decoder) error {
+				b, err := d.ReadBitString(1, 8, false)
+				if err == nil && (b.Length != 3 || !b.At(0) || b.At(1) || !b.At(2)) {
+					t.Errorf("unexpected bit string %+v", b)
+				}
+				return err
+			},
+			encoded: "54",
+		},
+		{
+			name:    "aligned octet string",
+			aligned: true,
+			encode: func(e *Encoder) error {
+				e.WriteBool(true)
+				return e.WriteOctetString([]byte{1, 2, 3}, 0, 8, false)
+			},
+			decode: func(d *Decoder) error {
+				d.ReadBool()
+				b, err := d.ReadOctetString(0, 8, false)
+				if err == nil && !bytes.Equal(b, []byte{1, 2, 3}) {
+					t.Errorf("unexpected octet string %x", b)
+				}
+				return err
+			},
+			encoded: "98010203",
+		},
+		{
+			name: "extension additions",
+			encode: func(e *Encoder) error {
+				e.WriteBool(true)
+				return e.WriteExtensions([]func(*Encoder) error{nil, func(e *Encoder) error { return e.WriteConstrainedInt(1, 0, 1) }})
+			},
+			decode: func(d *Decoder) error {
+				d.ReadBool()
+				additions, err := d.ReadExtensions()
+				if err == nil && (len(additions) != 2 || additions[0] != nil || !bytes.Equal(additions[1], []byte{0x80})) {
+					t.Errorf("unexpected additions %x", additions)
+				}
+				return err
+			},
+			encoded: "81406000",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := NewEncoder(test.aligned)
+			if err := test.encode(e); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded := hex.EncodeToString(e.Bytes()); encoded != test.encoded {
+				t.Errorf("encoded as %s, expected %s", encoded, test.encoded)
+			}
+			if err := test.decode(NewDecoder(e.Bytes(), test.aligned)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	if _, err := NewDecoder([]byte{0x80}, true).ReadConstrainedInt(0, 65535); err != ErrTruncated {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := NewDecoder([]byte{0x05, 0x01}, false).ReadOctetString(0, Unbounded, false); err != ErrTruncated {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func expectInt(read func(*Decoder) (int64, error), expected int64) func(*Decoder) error {
+	return func(d *Decoder) error {
+		v, err := read(d)
+		if err == nil && v != expected {
+			return fmt.Errorf("decoded %d instead of %d", v, expected)
+		}
+		return err
+	}
+}
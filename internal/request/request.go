@@ -0,0 +1,139 @@
+// Package request sends the requests that the generated openapi clients do not support, such as the multipart
+// requests and the callbacks to URIs given by the consumers.
+package request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// defaultAPIRoot is the apiRoot of the servers when none is configured, as in the generated openapi clients.
+const defaultAPIRoot = "https://example.com"
+
+// Client sends requests with the HTTP client, the default header and the user agent of a client configuration.
+type Client struct {
+	httpClient    *http.Client
+	defaultHeader map[string]string
+	userAgent     string
+	baseURL       string
+}
+
+// NewClient returns a client for the service whose path follows the apiRoot, such as /namf-comm/v1.
+// The base URL is the first server of the configuration, or else the default apiRoot followed by the path, whose
+// scheme and host are overridden by the ones of the configuration as done by the generated openapi clients.
+func NewClient(cfg fivegc.ClientConfiguration, path string) *Client {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	base := defaultAPIRoot + path
+	if len(cfg.Servers) > 0 {
+		base = cfg.Servers[0].URL
+		for name, variable := range cfg.Servers[0].Variables {
+			base = strings.ReplaceAll(base, "{"+name+"}", variable.DefaultValue)
+		}
+	}
+	if u, err := url.Parse(base); err == nil {
+		if cfg.Scheme != "" {
+			u.Scheme = cfg.Scheme
+		}
+		if cfg.Host != "" {
+			u.Host = cfg.Host
+		}
+		base = u.String()
+	}
+	return &Client{
+		httpClient:    httpClient,
+		defaultHeader: cfg.DefaultHeader,
+		userAgent:     cfg.UserAgent,
+		baseURL:       strings.TrimSuffix(base, "/"),
+	}
+}
+
+// URL returns the URL of a resource of the service, whose path segments are escaped.
+func (c *Client) URL(segments ...string) string {
+	var b strings.Builder
+	b.WriteString(c.baseURL)
+	for _, segment := range segments {
+		b.WriteString("/")
+		b.WriteString(url.PathEscape(segment))
+	}
+	return b.String()
+}
+
+// Do sends a request with a body of the content type, and returns the response with its body read.
+// A nil body is sent without content type.
+func (c *Client) Do(ctx context.Context, method, uri string, body []byte, contentType string) (*http.Response, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, uri, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range c.defaultHeader {
+		req.Header.Set(name, value)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json, multipart/related, application/problem+json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, raw, nil
+}
+
+// PostJSON posts data as a JSON body to uri, such as the callback URI of a notification.
+// The error of a response which is not successful is returned as by Error.
+func (c *Client) PostJSON(ctx context.Context, uri string, data interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	resp, raw, err := c.Do(ctx, http.MethodPost, uri, body, "application/json")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return Error(resp, raw)
+	}
+	return nil
+}
+
+// Error returns the error of a response which is not successful: a *fivegc.RedirectError for the redirections,
+// otherwise a fivegc.ProblemDetailsError with the ProblemDetails of the body, if any. A body which is not a
+// ProblemDetails, such as the HTML page of a proxy, is kept as the detail.
+func Error(resp *http.Response, body []byte) error {
+	status := fivegc.StatusCode(resp.StatusCode)
+	if status == fivegc.StatusTemporaryRedirect || status == fivegc.StatusPermanentRedirect {
+		redirectResponse := fivegc.RedirectResponse{RedirectHeader: fivegc.RedirectHeader{
+			Location:  resp.Header.Get("Location"),
+			SbiTarget: resp.Header.Get("3gpp-Sbi-Target-Nf-Id"),
+		}}
+		json.Unmarshal(body, &redirectResponse)
+		return fivegc.ErrorFromResponse(openapicommon.ProblemDetails{}, redirectResponse, status)
+	}
+	var problemDetails openapicommon.ProblemDetails
+	if err := json.Unmarshal(body, &problemDetails); err != nil && len(body) > 0 {
+		problemDetails = openapicommon.ProblemDetails{Detail: fivegc.ToString(string(body))}
+	}
+	return fivegc.ErrorFromResponse(problemDetails, fivegc.RedirectResponse{}, status)
+}
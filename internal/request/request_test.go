@@ -0,0 +1,72 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPostJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		detail      string
+		cause       string
+	}{
+		{name: "success", status: http.StatusNoContent},
+		{
+			name:        "problem details",
+			status:      http.StatusNotFound,
+			contentType: "application/problem+json",
+			body:        `{"status":404,"cause":"CONTEXT_NOT_FOUND"}`,
+			cause:       "CONTEXT_NOT_FOUND",
+		},
+		{name: "raw body", status: http.StatusBadGateway, contentType: "text/html", body: "<html>Bad Gateway</html>", detail: "<html>Bad Gateway</html>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			err := NewClient(fivegc.ClientConfiguration{HTTPClient: server.Client()}, "").PostJSON(context.Background(), server.URL+"/notify", map[string]string{"event": "test"})
+			if tt.status == http.StatusNoContent {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			var problemDetailsErr fivegc.ProblemDetailsError
+			if !errors.As(err, &problemDetailsErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if problemDetailsErr.Status == nil || int(*problemDetailsErr.Status) != tt.status {
+				t.Errorf("unexpected status in %+v", problemDetailsErr)
+			}
+			if value(problemDetailsErr.Detail) != tt.detail || value(problemDetailsErr.Cause) != tt.cause {
+				t.Errorf("unexpected problem details %+v", problemDetailsErr)
+			}
+		})
+	}
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
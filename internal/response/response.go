@@ -0,0 +1,89 @@
+package response
+
+import (
+	"fmt"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"log"
+)
+
+// Errors is the set of the error status codes of an operation, as listed in the responses of its OpenAPI.
+// Besides its successful and redirection status codes, declared by its StatusCode type, an operation only answers with
+// one of its error status codes along with a matching ProblemDetails. Most operations have the error status codes of
+// their kind below; the StatusCode types only document the other ones.
+type Errors []fivegc.StatusCode
+
+// Error status codes of the operations of the 3GPP OpenAPIs, by kind of operation.
+var (
+	// BodyErrors are the error status codes of the operations with a request body: 400, 401, 403, 404, 411, 413,
+	// 415, 429, 500, 502, 503 and 504.
+	BodyErrors = Errors{
+		fivegc.StatusBadRequest, fivegc.StatusUnauthorized, fivegc.StatusForbidden, fivegc.StatusNotFound,
+		fivegc.StatusLengthRequired, fivegc.StatusRequestEntityTooLarge, fivegc.StatusUnsupportedMediaType,
+		fivegc.StatusTooManyRequests, fivegc.StatusInternalServerError, fivegc.StatusBadGateway,
+		fivegc.StatusServiceUnavailable, fivegc.StatusGatewayTimeout,
+	}
+	// QueryErrors are the error status codes of the operations retrieving a resource: 400, 401, 403, 404, 406, 429,
+	// 500, 502, 503 and 504.
+	QueryErrors = Errors{
+		fivegc.StatusBadRequest, fivegc.StatusUnauthorized, fivegc.StatusForbidden, fivegc.StatusNotFound,
+		fivegc.StatusNotAcceptable, fivegc.StatusTooManyRequests, fivegc.StatusInternalServerError,
+		fivegc.StatusBadGateway, fivegc.StatusServiceUnavailable, fivegc.StatusGatewayTimeout,
+	}
+	// DeletionErrors are the error status codes of the operations deleting a resource: 400, 401, 403, 404, 429, 500,
+	// 502, 503 and 504.
+	DeletionErrors = Errors{
+		fivegc.StatusBadRequest, fivegc.StatusUnauthorized, fivegc.StatusForbidden, fivegc.StatusNotFound,
+		fivegc.StatusTooManyRequests, fivegc.StatusInternalServerError, fivegc.StatusBadGateway,
+		fivegc.StatusServiceUnavailable, fivegc.StatusGatewayTimeout,
+	}
+)
+
+// With returns a copy of the error status codes with the given ones added.
+func (e Errors) With(codes ...fivegc.StatusCode) Errors {
+	return append(append(Errors{}, e...), codes...)
+}
+
+// Allows reports whether the status code is one of the error status codes.
+func (e Errors) Allows(status fivegc.StatusCode) bool {
+	for _, code := range e {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ProblemDetails writes the ProblemDetails answering a request which is neither successful nor redirected.
+// Handlers must answer such requests with one of the error status codes of the operation, matching the status of the
+// ProblemDetails. Any other response violates the OpenAPI of the operation: it is logged and answered with
+// 500 Internal Server Error. A ProblemDetails without status gets the status code of the response.
+func ProblemDetails(c *gin.Context, logger *log.Logger, status fivegc.StatusCode, problemDetails openapicommon.ProblemDetails, errors Errors) {
+	if err := check(status, problemDetails, errors); err != nil {
+		InvalidResponse(c, logger, err)
+		return
+	}
+	if problemDetails.Status == nil {
+		problemDetails.Status = fivegc.ToInt32(int32(status))
+	}
+	c.JSON(int(status), problemDetails)
+}
+
+// InvalidResponse logs the error making the response of the network function invalid, and answers the request with
+// 500 Internal Server Error instead.
+func InvalidResponse(c *gin.Context, logger *log.Logger, err error) {
+	logger.Printf("%s %s: %v", c.Request.Method, c.FullPath(), err)
+	problemDetails, status := fivegc.ProblemDetailsFromError(fivegc.ErrSystemFailure.WithDetail("invalid response from the network function"))
+	c.JSON(int(status), problemDetails)
+}
+
+func check(status fivegc.StatusCode, problemDetails openapicommon.ProblemDetails, errors Errors) error {
+	if !errors.Allows(status) {
+		return fmt.Errorf("status code %d is not allowed for this operation", status)
+	}
+	if problemDetails.Status != nil && fivegc.StatusCode(*problemDetails.Status) != status {
+		return fmt.Errorf("problem details status %d does not match status code %d", *problemDetails.Status, status)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProblemDetails(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         fivegc.StatusCode
+		problemDetails openapicommon.ProblemDetails
+		expected       fivegc.StatusCode
+		logged         bool
+	}{
+		{name: "error", status: fivegc.StatusForbidden, problemDetails: fivegc.ErrModificationNotAllowed.ProblemDetails(), expected: fivegc.StatusForbidden},
+		{name: "missing status", status: fivegc.StatusNotFound, expected: fivegc.StatusNotFound},
+		{name: "success status", status: fivegc.StatusAccepted, expected: fivegc.StatusInternalServerError, logged: true},
+		{name: "status not allowed", status: fivegc.StatusNotAcceptable, expected: fivegc.StatusInternalServerError, logged: true},
+		{name: "status out of range", status: 599, expected: fivegc.StatusInternalServerError, logged: true},
+		{name: "status mismatch", status: fivegc.StatusBadRequest, problemDetails: fivegc.ErrNfCongestion.ProblemDetails(), expected: fivegc.StatusInternalServerError, logged: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = httptest.NewRequest(http.MethodPost, "/nlmf-loc/v1/determine-location", nil)
+			ProblemDetails(c, log.New(&logs, "", 0), tt.status, tt.problemDetails, BodyErrors)
+
+			if recorder.Code != int(tt.expected) {
+				t.Errorf("expected status %d, got %d", tt.expected, recorder.Code)
+			}
+			var problemDetails openapicommon.ProblemDetails
+			if err := json.Unmarshal(recorder.Body.Bytes(), &problemDetails); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if problemDetails.Status == nil || *problemDetails.Status != int32(tt.expected) {
+				t.Errorf("problem details status does not match %d", tt.expected)
+			}
+			if tt.logged != (logs.Len() > 0) {
+				t.Errorf("unexpected logs %q", logs.String())
+			}
+		})
+	}
+}
+
+func TestErrorsWith(t *testing.T) {
+	errors := DeletionErrors.With(fivegc.StatusConflict)
+	if !errors.Allows(fivegc.StatusConflict) || !errors.Allows(fivegc.StatusNotFound) {
+		t.Errorf("unexpected error status codes %v", errors)
+	}
+	if DeletionErrors.Allows(fivegc.StatusConflict) {
+		t.Errorf("the error status codes %v are modified", DeletionErrors)
+	}
+}
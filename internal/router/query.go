@@ -0,0 +1,36 @@
+package router
+
+import (
+	"encoding/json"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	openapicommon "github.com/5GCoreNet/openapi/openapi_CommonData"
+	"github.com/gin-gonic/gin"
+)
+
+// JSONQuery decodes into v the query parameter name of the request, encoded in JSON as the complex query parameters of
+// the OpenAPI specifications, such as plmn-id or old-guami. It returns false when the parameter is absent.
+// The returned errors are *fivegc.ApplicationError pointing to the parameter, to be answered with a 400 Bad Request
+// (see fivegc.BindingProblemDetails): MANDATORY_QUERY_PARAM_MISSING when a mandatory parameter is absent, and
+// MANDATORY_QUERY_PARAM_INCORRECT or OPTIONAL_QUERY_PARAM_INCORRECT when it cannot be decoded.
+func JSONQuery(c *gin.Context, name string, v interface{}, mandatory bool) (bool, error) {
+	value, ok := c.GetQuery(name)
+	if !ok {
+		if mandatory {
+			return false, fivegc.ErrMandatoryQueryParamMissing.WithDetail("%s is missing", name).WithInvalidParams(openapicommon.InvalidParam{
+				Param: name,
+			})
+		}
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		appErr := fivegc.ErrOptionalQueryParamIncorrect
+		if mandatory {
+			appErr = fivegc.ErrMandatoryQueryParamIncorrect
+		}
+		return false, appErr.WithDetail("%s", err.Error()).WithInvalidParams(openapicommon.InvalidParam{
+			Param:  name,
+			Reason: fivegc.ToString("invalid JSON value"),
+		})
+	}
+	return true, nil
+}
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"errors"
+	"github.com/5GCoreNet/5GCoreNetSDK/fivegc"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestJSONQuery(t *testing.T) {
+	type plmnID struct {
+		Mcc string `json:"mcc"`
+		Mnc string `json:"mnc"`
+	}
+	tests := []struct {
+		name      string
+		query     string
+		mandatory bool
+		found     bool
+		cause     string
+	}{
+		{name: "present", query: `{"mcc":"208","mnc":"93"}`, found: true},
+		{name: "optional absent"},
+		{name: "mandatory absent", mandatory: true, cause: fivegc.CauseMandatoryQueryParamMissing},
+		{name: "optional incorrect", query: `{"mcc":208}`, cause: fivegc.CauseOptionalQueryParamIncorrect},
+		{name: "mandatory incorrect", query: `208-93`, mandatory: true, cause: fivegc.CauseMandatoryQueryParamIncorrect},
+	}
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			target := "/shared-data"
+			if tt.query != "" {
+				target += "?plmn-id=" + url.QueryEscape(tt.query)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+			var v plmnID
+			found, err := JSONQuery(c, "plmn-id", &v, tt.mandatory)
+			if found != tt.found {
+				t.Errorf("found is %t, expected %t", found, tt.found)
+			}
+			if found && (v.Mcc != "208" || v.Mnc != "93") {
+				t.Errorf("unexpected value %+v", v)
+			}
+			var appErr *fivegc.ApplicationError
+			switch {
+			case tt.cause == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.cause != "" && (!errors.As(err, &appErr) || appErr.Cause != tt.cause || appErr.InvalidParams[0].Param != "plmn-id"):
+				t.Errorf("unexpected error %v, expected %s", err, tt.cause)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package router adapts the resource paths of the OpenAPI specifications, such as /ue-contexts/{ueContextId}, to the
+// routes of the gin servers.
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/url"
+	"strings"
+)
+
+// Path returns the gin route of a resource path whose parameters are written {name} as in the OpenAPI
+// specifications, such as /ue-contexts/:ueContextId for /ue-contexts/{ueContextId}.
+// The value of a parameter is then read with the Param method of the gin.Context.
+// It panics if a brace is not closed, the paths being constants of the SDK.
+func Path(path string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(path, '{')
+		if start < 0 {
+			b.WriteString(path)
+			return b.String()
+		}
+		end := strings.IndexByte(path[start:], '}')
+		if end < 0 {
+			panic("router: unclosed parameter in path " + path)
+		}
+		b.WriteString(path[:start])
+		b.WriteString(":")
+		b.WriteString(path[start+1 : start+end])
+		path = path[start+end+1:]
+	}
+}
+
+// apiRootKey is the key of the apiRoot of the server in the gin.Context.
+const apiRootKey = "router.apiRoot"
+
+// Root returns the group of the routes of a server under its apiRoot, the {apiRoot} of the resource URIs defined in
+// TS 29.501 clause 4.4.1, such as https://amf.example.com/prefix. Only the path of the apiRoot is routed, its scheme
+// and authority being the ones of the URIs of the created resources. An apiRoot without authority, such as /prefix,
+// leaves them to the ones of the requests.
+func Root(r gin.IRouter, apiRoot string) *gin.RouterGroup {
+	u, err := url.Parse(apiRoot)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return r.Group(apiRoot)
+	}
+	return r.Group(u.Path, func(c *gin.Context) {
+		c.Set(apiRootKey, u)
+	})
+}
+
+// ResourceURI returns the URI of a resource created by a request, to be sent in the Location header of a 201 Created
+// response: the URI of the request, with the scheme and authority of the apiRoot given to Root, followed by the path
+// segments of the resource, which are escaped.
+func ResourceURI(c *gin.Context, segments ...string) string {
+	u := url.URL{Scheme: "http", Host: c.Request.Host, Path: strings.TrimSuffix(c.Request.URL.Path, "/")}
+	if c.Request.TLS != nil {
+		u.Scheme = "https"
+	}
+	if apiRoot, ok := c.Value(apiRootKey).(*url.URL); ok {
+		u.Scheme, u.Host = apiRoot.Scheme, apiRoot.Host
+	}
+	uri := u.String()
+	for _, segment := range segments {
+		uri += "/" + url.PathEscape(segment)
+	}
+	return uri
+}
@@ -0,0 +1,77 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	tests := map[string]string{
+		"/subscriptions": "/subscriptions",
+		"/ue-contexts/{ueContextId}/n1-n2-messages/subscriptions/{subscriptionId}": "/ue-contexts/:ueContextId/n1-n2-messages/subscriptions/:subscriptionId",
+		"/{ueContextId}/provide-pos-info":                                          "/:ueContextId/provide-pos-info",
+	}
+	for path, expected := range tests {
+		if route := Path(path); route != expected {
+			t.Errorf("%s routed as %s, expected %s", path, route, expected)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for an unclosed parameter")
+		}
+	}()
+	Path("/ue-contexts/{ueContextId")
+}
+
+func TestResourceURI(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiRoot  string
+		target   string
+		expected string
+	}{
+		{
+			name:     "request authority",
+			apiRoot:  "/prefix",
+			target:   "http://10.0.0.1:8080/prefix/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions",
+			expected: "http://10.0.0.1:8080/prefix/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions/sub%201",
+		},
+		{
+			name:     "api root authority",
+			apiRoot:  "https://amf.example.com/prefix",
+			target:   "http://10.0.0.1:8080/prefix/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions",
+			expected: "https://amf.example.com/prefix/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions/sub%201",
+		},
+		{
+			name:     "api root without path",
+			apiRoot:  "https://amf.example.com",
+			target:   "http://10.0.0.1:8080/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions",
+			expected: "https://amf.example.com/ue-contexts/imsi-208930000000001/n1-n2-messages/subscriptions/sub%201",
+		},
+	}
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			var uri string
+			Root(engine, tt.apiRoot).POST(Path("/ue-contexts/{ueContextId}/n1-n2-messages/subscriptions"), func(c *gin.Context) {
+				if c.Param("ueContextId") != "imsi-208930000000001" {
+					t.Errorf("unexpected parameter %s", c.Param("ueContextId"))
+				}
+				uri = ResourceURI(c, "sub 1")
+				c.Status(http.StatusCreated)
+			})
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.target, nil))
+			if w.Code != http.StatusCreated {
+				t.Fatalf("unexpected response %d", w.Code)
+			}
+			if uri != tt.expected {
+				t.Errorf("resource URI %s, expected %s", uri, tt.expected)
+			}
+		})
+	}
+}